@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// CanaryProbeFailedError is returned when the health probe run against the
+// canary instances of a deployment fails.
+type CanaryProbeFailedError struct {
+	Probe  string
+	Reason string
+}
+
+func (e CanaryProbeFailedError) Error() string {
+	return fmt.Sprintf("Canary probe '%s' failed: %s", e.Probe, e.Reason)
+}
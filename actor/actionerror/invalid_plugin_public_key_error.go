@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginPublicKeyError is returned when a public key to trust for a
+// plugin repository is not a base64-encoded ed25519 public key.
+type InvalidPluginPublicKeyError struct {
+	PublicKey string
+}
+
+func (e InvalidPluginPublicKeyError) Error() string {
+	return fmt.Sprintf("Public key %s is not a base64-encoded ed25519 public key", e.PublicKey)
+}
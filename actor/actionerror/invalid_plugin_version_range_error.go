@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginVersionRangeError is returned when the version range a plugin
+// is pinned to is not a valid semantic version range.
+type InvalidPluginVersionRangeError struct {
+	VersionRange string
+}
+
+func (e InvalidPluginVersionRangeError) Error() string {
+	return fmt.Sprintf("Version range '%s' is not a valid semantic version range", e.VersionRange)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidTaskScheduleError is returned when a task schedule is not a valid
+// cron schedule.
+type InvalidTaskScheduleError struct {
+	Schedule string
+	Reason   string
+}
+
+func (e InvalidTaskScheduleError) Error() string {
+	return fmt.Sprintf("Invalid task schedule '%s': %s", e.Schedule, e.Reason)
+}
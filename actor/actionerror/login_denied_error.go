@@ -0,0 +1,9 @@
+package actionerror
+
+// LoginDeniedError is returned when the user denies the CLI access to their
+// account during a browser or device code login.
+type LoginDeniedError struct{}
+
+func (LoginDeniedError) Error() string {
+	return "The login was denied"
+}
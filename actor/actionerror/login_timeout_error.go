@@ -0,0 +1,16 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoginTimeoutError is returned when a browser or device code login is not
+// approved before the timeout.
+type LoginTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e LoginTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for the login to be approved", e.Timeout)
+}
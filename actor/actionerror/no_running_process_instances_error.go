@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// NoRunningProcessInstancesError is returned when an action needs at least
+// one running instance of a process and there are none.
+type NoRunningProcessInstancesError struct {
+	ProcessType string
+}
+
+func (e NoRunningProcessInstancesError) Error() string {
+	return fmt.Sprintf("Process %s has no running instances", e.ProcessType)
+}
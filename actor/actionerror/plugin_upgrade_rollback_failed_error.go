@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// PluginUpgradeRollbackFailedError is returned when an upgraded plugin fails
+// to install or validate and the previous version of the plugin could not be
+// restored.
+type PluginUpgradeRollbackFailedError struct {
+	PluginName  string
+	Err         error
+	RollbackErr error
+}
+
+func (e PluginUpgradeRollbackFailedError) Error() string {
+	return fmt.Sprintf("Upgrading plugin %s failed: %s. Restoring the previous version failed: %s", e.PluginName, e.Err, e.RollbackErr)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// PluginUpgradeRolledBackError is returned when an upgraded plugin fails to
+// install or validate and the previous version of the plugin was restored.
+type PluginUpgradeRolledBackError struct {
+	PluginName      string
+	PreviousVersion string
+	Err             error
+}
+
+func (e PluginUpgradeRolledBackError) Error() string {
+	return fmt.Sprintf("Upgrading plugin %s failed, restored version %s: %s", e.PluginName, e.PreviousVersion, e.Err)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceKeyNotFoundError is returned when a service key cannot be found for
+// a service instance.
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (e ServiceKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Service key '%s' for service instance '%s' not found.", e.Name, e.ServiceInstanceName)
+}
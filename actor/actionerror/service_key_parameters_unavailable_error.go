@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceKeyParametersUnavailableError is returned when the parameters of a
+// service key cannot be retrieved from the service broker.
+type ServiceKeyParametersUnavailableError struct {
+	Name string
+	Err  error
+}
+
+func (e ServiceKeyParametersUnavailableError) Error() string {
+	return fmt.Sprintf("Could not get the parameters of service key '%s': %s", e.Name, e.Err)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// ServiceOperationFailedError is returned when the last operation of a
+// service instance or binding fails.
+type ServiceOperationFailedError struct {
+	Operation   string
+	Description string
+}
+
+func (e ServiceOperationFailedError) Error() string {
+	return fmt.Sprintf("Service %s failed: %s", e.Operation, e.Description)
+}
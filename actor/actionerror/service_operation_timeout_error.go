@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServiceOperationTimeoutError is returned when the last operation of a
+// service instance or binding is still in progress after the timeout.
+type ServiceOperationTimeoutError struct {
+	Operation string
+	Timeout   time.Duration
+}
+
+func (e ServiceOperationTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for service %s to complete", e.Timeout, e.Operation)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// TaskFailedError is returned when a task ends in the FAILED state.
+type TaskFailedError struct {
+	Name       string
+	SequenceID int64
+	Reason     string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %s (%d) failed: %s", e.Name, e.SequenceID, e.Reason)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// TaskScheduleNotFoundError is returned when an application has no task
+// schedule with the requested name.
+type TaskScheduleNotFoundError struct {
+	AppName string
+	Name    string
+}
+
+func (e TaskScheduleNotFoundError) Error() string {
+	return fmt.Sprintf("Task schedule '%s' for app '%s' not found.", e.Name, e.AppName)
+}
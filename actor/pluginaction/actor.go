@@ -1,6 +1,9 @@
 // Package pluginaction handles all operations related to plugin commands
 package pluginaction
 
+// Warnings is a list of warnings returned back from an action
+type Warnings []string
+
 // Actor handles all plugin actions
 type Actor struct {
 	config Config
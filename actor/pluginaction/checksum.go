@@ -1,8 +1,42 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
 
 func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
 	plugin := configv3.Plugin{Location: path}
 	return plugin.CalculateSHA1() == checksum
 }
+
+// ValidateFileSHA256Checksum returns true if the SHA256 of the file matches
+// the hex-encoded checksum.
+func (actor Actor) ValidateFileSHA256Checksum(path string, checksum string) bool {
+	fileChecksum, err := calculateSHA256(path)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(fileChecksum, checksum)
+}
+
+func calculateSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
@@ -8,10 +8,14 @@ import "code.cloudfoundry.org/cli/util/configv3"
 type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(repoName string, repoURL string)
+	AddPluginRepositoryPublicKeys(repoName string, publicKeys []string)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
+	PluginVersionPin(pluginName string) (string, bool)
 	RemovePlugin(string)
+	RemovePluginVersionPin(pluginName string)
+	SetPluginVersionPin(pluginName string, versionRange string)
 	WritePluginConfig() error
 }
@@ -9,10 +9,12 @@ import (
 )
 
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
-	Checksum string
+	Name      string
+	Version   string
+	URL       string
+	Checksum  string
+	SHA256    string
+	Signature string
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
@@ -74,10 +76,12 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
 					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
+						Name:      plugin.Name,
+						Version:   plugin.Version,
+						URL:       pluginBinary.URL,
+						Checksum:  pluginBinary.Checksum,
+						SHA256:    pluginBinary.SHA256,
+						Signature: pluginBinary.Signature,
 					}, nil
 				}
 			}
@@ -20,6 +20,12 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	AddPluginRepositoryPublicKeysStub        func(string, []string)
+	addPluginRepositoryPublicKeysMutex       sync.RWMutex
+	addPluginRepositoryPublicKeysArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
 	GetPluginStub        func(string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -53,6 +59,19 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginVersionPinStub        func(string) (string, bool)
+	pluginVersionPinMutex       sync.RWMutex
+	pluginVersionPinArgsForCall []struct {
+		arg1 string
+	}
+	pluginVersionPinReturns struct {
+		result1 string
+		result2 bool
+	}
+	pluginVersionPinReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct {
@@ -68,6 +87,17 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemovePluginVersionPinStub        func(string)
+	removePluginVersionPinMutex       sync.RWMutex
+	removePluginVersionPinArgsForCall []struct {
+		arg1 string
+	}
+	SetPluginVersionPinStub        func(string, string)
+	setPluginVersionPinMutex       sync.RWMutex
+	setPluginVersionPinArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct {
@@ -145,6 +175,43 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) AddPluginRepositoryPublicKeys(arg1 string, arg2 []string) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	fake.addPluginRepositoryPublicKeysArgsForCall = append(fake.addPluginRepositoryPublicKeysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddPluginRepositoryPublicKeys", []interface{}{arg1, arg2Copy})
+	fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	if fake.AddPluginRepositoryPublicKeysStub != nil {
+		fake.AddPluginRepositoryPublicKeysStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) AddPluginRepositoryPublicKeysCallCount() int {
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
+	return len(fake.addPluginRepositoryPublicKeysArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginRepositoryPublicKeysCalls(stub func(string, []string)) {
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	defer fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	fake.AddPluginRepositoryPublicKeysStub = stub
+}
+
+func (fake *FakeConfig) AddPluginRepositoryPublicKeysArgsForCall(i int) (string, []string) {
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
+	argsForCall := fake.addPluginRepositoryPublicKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
 	fake.getPluginMutex.Lock()
 	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
@@ -312,6 +379,69 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginVersionPin(arg1 string) (string, bool) {
+	fake.pluginVersionPinMutex.Lock()
+	ret, specificReturn := fake.pluginVersionPinReturnsOnCall[len(fake.pluginVersionPinArgsForCall)]
+	fake.pluginVersionPinArgsForCall = append(fake.pluginVersionPinArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PluginVersionPin", []interface{}{arg1})
+	fake.pluginVersionPinMutex.Unlock()
+	if fake.PluginVersionPinStub != nil {
+		return fake.PluginVersionPinStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pluginVersionPinReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) PluginVersionPinCallCount() int {
+	fake.pluginVersionPinMutex.RLock()
+	defer fake.pluginVersionPinMutex.RUnlock()
+	return len(fake.pluginVersionPinArgsForCall)
+}
+
+func (fake *FakeConfig) PluginVersionPinCalls(stub func(string) (string, bool)) {
+	fake.pluginVersionPinMutex.Lock()
+	defer fake.pluginVersionPinMutex.Unlock()
+	fake.PluginVersionPinStub = stub
+}
+
+func (fake *FakeConfig) PluginVersionPinArgsForCall(i int) string {
+	fake.pluginVersionPinMutex.RLock()
+	defer fake.pluginVersionPinMutex.RUnlock()
+	argsForCall := fake.pluginVersionPinArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) PluginVersionPinReturns(result1 string, result2 bool) {
+	fake.pluginVersionPinMutex.Lock()
+	defer fake.pluginVersionPinMutex.Unlock()
+	fake.PluginVersionPinStub = nil
+	fake.pluginVersionPinReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) PluginVersionPinReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.pluginVersionPinMutex.Lock()
+	defer fake.pluginVersionPinMutex.Unlock()
+	fake.PluginVersionPinStub = nil
+	if fake.pluginVersionPinReturnsOnCall == nil {
+		fake.pluginVersionPinReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.pluginVersionPinReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -395,6 +525,69 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) RemovePluginVersionPin(arg1 string) {
+	fake.removePluginVersionPinMutex.Lock()
+	fake.removePluginVersionPinArgsForCall = append(fake.removePluginVersionPinArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RemovePluginVersionPin", []interface{}{arg1})
+	fake.removePluginVersionPinMutex.Unlock()
+	if fake.RemovePluginVersionPinStub != nil {
+		fake.RemovePluginVersionPinStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) RemovePluginVersionPinCallCount() int {
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	return len(fake.removePluginVersionPinArgsForCall)
+}
+
+func (fake *FakeConfig) RemovePluginVersionPinCalls(stub func(string)) {
+	fake.removePluginVersionPinMutex.Lock()
+	defer fake.removePluginVersionPinMutex.Unlock()
+	fake.RemovePluginVersionPinStub = stub
+}
+
+func (fake *FakeConfig) RemovePluginVersionPinArgsForCall(i int) string {
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	argsForCall := fake.removePluginVersionPinArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetPluginVersionPin(arg1 string, arg2 string) {
+	fake.setPluginVersionPinMutex.Lock()
+	fake.setPluginVersionPinArgsForCall = append(fake.setPluginVersionPinArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetPluginVersionPin", []interface{}{arg1, arg2})
+	fake.setPluginVersionPinMutex.Unlock()
+	if fake.SetPluginVersionPinStub != nil {
+		fake.SetPluginVersionPinStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) SetPluginVersionPinCallCount() int {
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	return len(fake.setPluginVersionPinArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginVersionPinCalls(stub func(string, string)) {
+	fake.setPluginVersionPinMutex.Lock()
+	defer fake.setPluginVersionPinMutex.Unlock()
+	fake.SetPluginVersionPinStub = stub
+}
+
+func (fake *FakeConfig) SetPluginVersionPinArgsForCall(i int) (string, string) {
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	argsForCall := fake.setPluginVersionPinArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -454,16 +647,24 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginVersionPinMutex.RLock()
+	defer fake.pluginVersionPinMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,226 @@
+package pluginaction
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/generic"
+)
+
+// SignatureFileExtension is the extension of the file holding the signature
+// of a plugin binary, next to the binary.
+const SignatureFileExtension = ".sig"
+
+// GeneratePluginRepositoryIndex scans the directory for plugin binaries and
+// returns a plugin repository listing them, with each binary's URL under
+// baseURL, or only its path when baseURL is empty. Each subdirectory holds the
+// binaries of one plugin, one per platform; the platform of a binary is read
+// from its executable header. The name and version of the plugin are read by
+// copying its binary for the current platform to tempPluginDir and running it.
+// Directories that cannot be indexed are skipped with a warning.
+func (actor Actor) GeneratePluginRepositoryIndex(pluginMetadata PluginMetadata, dir string, baseURL string, tempPluginDir string) (plugin.PluginRepository, Warnings, error) {
+	filesByDir := map[string][]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), ".") && path != dir {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		filesByDir[filepath.Dir(relPath)] = append(filesByDir[filepath.Dir(relPath)], relPath)
+		return nil
+	})
+	if err != nil {
+		return plugin.PluginRepository{}, nil, err
+	}
+
+	pluginDirs := make([]string, 0, len(filesByDir))
+	for pluginDir := range filesByDir {
+		pluginDirs = append(pluginDirs, pluginDir)
+	}
+	sort.Strings(pluginDirs)
+
+	var (
+		repository plugin.PluginRepository
+		warnings   Warnings
+	)
+	indexedFrom := map[string]string{}
+	for _, pluginDir := range pluginDirs {
+		pluginEntry, found, err := actor.indexPluginDirectory(pluginMetadata, dir, filesByDir[pluginDir], baseURL, tempPluginDir)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Skipping %s: %s", filepath.Join(dir, pluginDir), err))
+			continue
+		}
+		if !found {
+			continue
+		}
+		if otherDir, ok := indexedFrom[pluginEntry.Name]; ok {
+			warnings = append(warnings, fmt.Sprintf("Skipping %s: plugin %s is already indexed from %s", filepath.Join(dir, pluginDir), pluginEntry.Name, filepath.Join(dir, otherDir)))
+			continue
+		}
+
+		indexedFrom[pluginEntry.Name] = pluginDir
+		repository.Plugins = append(repository.Plugins, pluginEntry)
+	}
+
+	sort.Slice(repository.Plugins, func(i, j int) bool {
+		return strings.ToLower(repository.Plugins[i].Name) < strings.ToLower(repository.Plugins[j].Name)
+	})
+
+	return repository, warnings, nil
+}
+
+// indexPluginDirectory returns the plugin whose binaries are among the files,
+// or false if none of the files are executables.
+func (actor Actor) indexPluginDirectory(pluginMetadata PluginMetadata, dir string, relPaths []string, baseURL string, tempPluginDir string) (plugin.Plugin, bool, error) {
+	hostPlatform := actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+
+	var (
+		binaries   []plugin.PluginBinary
+		hostBinary string
+	)
+	for _, relPath := range relPaths {
+		path := filepath.Join(dir, relPath)
+		platform, ok := binaryPlatform(path)
+		if !ok {
+			continue
+		}
+
+		for _, binary := range binaries {
+			if binary.Platform == platform {
+				return plugin.Plugin{}, false, fmt.Errorf("more than one binary for platform %s", platform)
+			}
+		}
+		if platform == hostPlatform {
+			hostBinary = path
+		}
+
+		binary, err := newPluginBinary(path, relPath, platform, baseURL)
+		if err != nil {
+			return plugin.Plugin{}, false, err
+		}
+		binaries = append(binaries, binary)
+	}
+
+	if len(binaries) == 0 {
+		return plugin.Plugin{}, false, nil
+	}
+	if hostBinary == "" {
+		return plugin.Plugin{}, false, fmt.Errorf("no binary for platform %s to read the plugin name and version from", hostPlatform)
+	}
+
+	executablePath, err := actor.CreateExecutableCopy(hostBinary, tempPluginDir)
+	if err != nil {
+		return plugin.Plugin{}, false, err
+	}
+	defer os.Remove(executablePath)
+
+	metadata, err := pluginMetadata.GetMetadata(executablePath)
+	if err != nil {
+		return plugin.Plugin{}, false, err
+	}
+	if metadata.Name == "" {
+		return plugin.Plugin{}, false, fmt.Errorf("%s is not a valid cf CLI plugin", hostBinary)
+	}
+
+	sort.Slice(binaries, func(i, j int) bool {
+		return binaries[i].Platform < binaries[j].Platform
+	})
+
+	return plugin.Plugin{
+		Name:     metadata.Name,
+		Version:  metadata.Version.String(),
+		Binaries: binaries,
+	}, true, nil
+}
+
+func newPluginBinary(path string, relPath string, platform string, baseURL string) (plugin.PluginBinary, error) {
+	sha1, err := util.NewSha1Checksum(path).ComputeFileSha1()
+	if err != nil {
+		return plugin.PluginBinary{}, err
+	}
+
+	sha256, err := calculateSHA256(path)
+	if err != nil {
+		return plugin.PluginBinary{}, err
+	}
+
+	var signature string
+	rawSignature, err := ioutil.ReadFile(path + SignatureFileExtension)
+	switch {
+	case err == nil:
+		signature = strings.TrimSpace(string(rawSignature))
+	case !os.IsNotExist(err):
+		return plugin.PluginBinary{}, err
+	}
+
+	segments := strings.Split(filepath.ToSlash(relPath), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return plugin.PluginBinary{
+		Platform:  platform,
+		URL:       strings.TrimSuffix(baseURL, "/") + "/" + strings.Join(segments, "/"),
+		Checksum:  fmt.Sprintf("%x", sha1),
+		SHA256:    sha256,
+		Signature: signature,
+	}, nil
+}
+
+// binaryPlatform returns the plugin repository platform of the executable,
+// or false if the file is not an executable for a supported platform.
+func binaryPlatform(path string) (string, bool) {
+	var goos, goarch string
+
+	if file, err := elf.Open(path); err == nil {
+		defer file.Close()
+		goos = "linux"
+		switch file.Machine {
+		case elf.EM_X86_64:
+			goarch = "amd64"
+		case elf.EM_386:
+			goarch = "386"
+		}
+	} else if file, err := pe.Open(path); err == nil {
+		defer file.Close()
+		goos = "windows"
+		switch file.Machine {
+		case pe.IMAGE_FILE_MACHINE_AMD64:
+			goarch = "amd64"
+		case pe.IMAGE_FILE_MACHINE_I386:
+			goarch = "386"
+		}
+	} else if file, err := macho.Open(path); err == nil {
+		defer file.Close()
+		goos = "darwin"
+	} else if file, err := macho.OpenFat(path); err == nil {
+		defer file.Close()
+		goos = "darwin"
+	}
+
+	platform := generic.GeneratePlatform(goos, goarch)
+	return platform, platform != ""
+}
@@ -0,0 +1,266 @@
+package pluginaction_test
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// writeBinary writes the header of an executable for the plugin repository
+// platform, followed by the contents.
+func writeBinary(path string, platform string, contents string) {
+	buffer := new(bytes.Buffer)
+	switch platform {
+	case "linux64", "linux32":
+		header := elf.Header32{Type: uint16(elf.ET_EXEC), Machine: uint16(elf.EM_386), Version: uint32(elf.EV_CURRENT), Ehsize: 52}
+		copy(header.Ident[:], elf.ELFMAG)
+		header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS32)
+		header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+		header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+		if platform == "linux64" {
+			header.Machine = uint16(elf.EM_X86_64)
+		}
+		Expect(binary.Write(buffer, binary.LittleEndian, header)).To(Succeed())
+	case "win64", "win32":
+		dosHeader := make([]byte, 0x40)
+		copy(dosHeader, "MZ")
+		binary.LittleEndian.PutUint32(dosHeader[0x3c:], 0x40)
+		buffer.Write(dosHeader)
+		buffer.WriteString("PE\x00\x00")
+		header := pe.FileHeader{Machine: pe.IMAGE_FILE_MACHINE_I386}
+		if platform == "win64" {
+			header.Machine = pe.IMAGE_FILE_MACHINE_AMD64
+		}
+		Expect(binary.Write(buffer, binary.LittleEndian, header)).To(Succeed())
+	case "osx":
+		header := macho.FileHeader{Magic: macho.Magic64, Cpu: macho.CpuAmd64, Type: macho.TypeExec}
+		Expect(binary.Write(buffer, binary.LittleEndian, header)).To(Succeed())
+		buffer.Write(make([]byte, 4))
+	default:
+		Fail(fmt.Sprintf("unsupported platform %s", platform))
+	}
+	buffer.WriteString(contents)
+
+	Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+	Expect(ioutil.WriteFile(path, buffer.Bytes(), 0600)).To(Succeed())
+}
+
+func fileChecksums(path string) (string, string) {
+	contents, err := ioutil.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	return fmt.Sprintf("%x", sha1.Sum(contents)), fmt.Sprintf("%x", sha256.Sum256(contents))
+}
+
+var _ = Describe("Plugin Repository Index Actions", func() {
+	var (
+		actor              *Actor
+		fakeConfig         *pluginactionfakes.FakeConfig
+		fakePluginMetadata *pluginactionfakes.FakePluginMetadata
+
+		dir           string
+		tempPluginDir string
+		baseURL       string
+		hostPlatform  string
+		otherPlatform string
+
+		repository plugin.PluginRepository
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
+		actor = NewActor(fakeConfig, nil)
+
+		baseURL = "https://example.com/plugins/"
+
+		var err error
+		dir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+		tempPluginDir, err = ioutil.TempDir("", "plugin-repo-temp")
+		Expect(err).ToNot(HaveOccurred())
+
+		hostPlatform = generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH)
+		otherPlatform = "win32"
+		if hostPlatform == "win32" {
+			otherPlatform = "linux32"
+		}
+
+		fakePluginMetadata.GetMetadataReturns(configv3.Plugin{
+			Name:    "some-plugin",
+			Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+		}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+		os.RemoveAll(tempPluginDir)
+	})
+
+	JustBeforeEach(func() {
+		repository, warnings, executeErr = actor.GeneratePluginRepositoryIndex(fakePluginMetadata, dir, baseURL, tempPluginDir)
+	})
+
+	When("each plugin directory has a binary for the current platform", func() {
+		BeforeEach(func() {
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-host"), hostPlatform, "some-plugin host")
+			writeBinary(filepath.Join(dir, "some-plugin", "some plugin other"), otherPlatform, "some-plugin other")
+			Expect(ioutil.WriteFile(filepath.Join(dir, "some-plugin", "some plugin other.sig"), []byte("some-signature\n"), 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "some-plugin", "README.md"), []byte("not a binary"), 0600)).To(Succeed())
+
+			writeBinary(filepath.Join(dir, "another-plugin", "another-plugin-host"), hostPlatform, "another-plugin host")
+			writeBinary(filepath.Join(dir, ".hidden", "hidden-plugin-host"), hostPlatform, "hidden-plugin host")
+
+			fakePluginMetadata.GetMetadataReturnsOnCall(0, configv3.Plugin{
+				Name:    "another-plugin",
+				Version: configv3.PluginVersion{Major: 4, Minor: 5, Build: 6},
+			}, nil)
+		})
+
+		It("indexes every plugin with its binaries", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			anotherHostSHA1, anotherHostSHA256 := fileChecksums(filepath.Join(dir, "another-plugin", "another-plugin-host"))
+			hostSHA1, hostSHA256 := fileChecksums(filepath.Join(dir, "some-plugin", "some-plugin-host"))
+			otherSHA1, otherSHA256 := fileChecksums(filepath.Join(dir, "some-plugin", "some plugin other"))
+
+			hostBinary := plugin.PluginBinary{
+				Platform: hostPlatform,
+				URL:      "https://example.com/plugins/some-plugin/some-plugin-host",
+				Checksum: hostSHA1,
+				SHA256:   hostSHA256,
+			}
+			otherBinary := plugin.PluginBinary{
+				Platform:  otherPlatform,
+				URL:       "https://example.com/plugins/some-plugin/some%20plugin%20other",
+				Checksum:  otherSHA1,
+				SHA256:    otherSHA256,
+				Signature: "some-signature",
+			}
+			someBinaries := []plugin.PluginBinary{hostBinary, otherBinary}
+			if otherPlatform < hostPlatform {
+				someBinaries = []plugin.PluginBinary{otherBinary, hostBinary}
+			}
+
+			Expect(repository).To(Equal(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{
+					{
+						Name:    "another-plugin",
+						Version: "4.5.6",
+						Binaries: []plugin.PluginBinary{{
+							Platform: hostPlatform,
+							URL:      "https://example.com/plugins/another-plugin/another-plugin-host",
+							Checksum: anotherHostSHA1,
+							SHA256:   anotherHostSHA256,
+						}},
+					},
+					{
+						Name:     "some-plugin",
+						Version:  "1.2.3",
+						Binaries: someBinaries,
+					},
+				},
+			}))
+		})
+
+		It("reads the metadata from an executable copy of the binary for the current platform", func() {
+			Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(2))
+			Expect(filepath.Dir(fakePluginMetadata.GetMetadataArgsForCall(0))).To(Equal(tempPluginDir))
+		})
+	})
+
+	When("a plugin directory has no binary for the current platform", func() {
+		BeforeEach(func() {
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-other"), otherPlatform, "some-plugin other")
+		})
+
+		It("skips the directory with a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(repository.Plugins).To(BeEmpty())
+			Expect(warnings).To(ConsistOf(fmt.Sprintf("Skipping %s: no binary for platform %s to read the plugin name and version from", filepath.Join(dir, "some-plugin"), hostPlatform)))
+			Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a plugin directory has more than one binary for a platform", func() {
+		BeforeEach(func() {
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-1"), hostPlatform, "some-plugin 1")
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-2"), hostPlatform, "some-plugin 2")
+		})
+
+		It("skips the directory with a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(repository.Plugins).To(BeEmpty())
+			Expect(warnings).To(ConsistOf(fmt.Sprintf("Skipping %s: more than one binary for platform %s", filepath.Join(dir, "some-plugin"), hostPlatform)))
+		})
+	})
+
+	When("reading the metadata of a plugin fails", func() {
+		BeforeEach(func() {
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-host"), hostPlatform, "some-plugin host")
+			fakePluginMetadata.GetMetadataReturns(configv3.Plugin{}, errors.New("some-error"))
+		})
+
+		It("skips the directory with a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(repository.Plugins).To(BeEmpty())
+			Expect(warnings).To(ConsistOf(fmt.Sprintf("Skipping %s: some-error", filepath.Join(dir, "some-plugin"))))
+		})
+	})
+
+	When("no base URL is provided", func() {
+		BeforeEach(func() {
+			baseURL = ""
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-host"), hostPlatform, "some-plugin host")
+		})
+
+		It("uses the path of each binary as its URL", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(repository.Plugins[0].Binaries[0].URL).To(Equal("/some-plugin/some-plugin-host"))
+		})
+	})
+
+	When("two plugin directories hold the same plugin", func() {
+		BeforeEach(func() {
+			writeBinary(filepath.Join(dir, "some-plugin", "some-plugin-host"), hostPlatform, "some-plugin host")
+			writeBinary(filepath.Join(dir, "some-plugin-copy", "some-plugin-host"), hostPlatform, "some-plugin copy")
+		})
+
+		It("indexes the first and skips the second with a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(repository.Plugins).To(HaveLen(1))
+			Expect(repository.Plugins[0].Binaries[0].URL).To(Equal("https://example.com/plugins/some-plugin/some-plugin-host"))
+			Expect(warnings).To(ConsistOf(fmt.Sprintf("Skipping %s: plugin some-plugin is already indexed from %s", filepath.Join(dir, "some-plugin-copy"), filepath.Join(dir, "some-plugin"))))
+		})
+	})
+
+	When("the directory does not exist", func() {
+		BeforeEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"golang.org/x/crypto/ed25519"
+)
+
+// AddPluginRepositoryPublicKeys trusts the base64-encoded ed25519 public keys
+// to sign plugins installed from the repository.
+func (actor Actor) AddPluginRepositoryPublicKeys(repositoryName string, publicKeys []string) error {
+	repository, err := actor.GetPluginRepository(repositoryName)
+	if err != nil {
+		return err
+	}
+
+	for _, publicKey := range publicKeys {
+		if _, ok := decodePublicKey(publicKey); !ok {
+			return actionerror.InvalidPluginPublicKeyError{PublicKey: publicKey}
+		}
+	}
+
+	actor.config.AddPluginRepositoryPublicKeys(repository.Name, publicKeys)
+	return nil
+}
+
+// ValidateFileSignature returns true if the base64-encoded ed25519 signature
+// of the file was made by one of the public keys.
+func (actor Actor) ValidateFileSignature(path string, signature string, publicKeys []string) bool {
+	rawSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(rawSignature) != ed25519.SignatureSize {
+		return false
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	for _, publicKey := range publicKeys {
+		key, ok := decodePublicKey(publicKey)
+		if ok && ed25519.Verify(key, contents, rawSignature) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodePublicKey(publicKey string) (ed25519.PublicKey, bool) {
+	rawKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(rawKey) != ed25519.PublicKeySize {
+		return nil, false
+	}
+	return ed25519.PublicKey(rawKey), true
+}
@@ -0,0 +1,143 @@
+package pluginaction_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
+)
+
+var _ = Describe("Signatures", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+
+		publicKey  string
+		privateKey ed25519.PrivateKey
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+
+		rawPublicKey, rawPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		publicKey = base64.StdEncoding.EncodeToString(rawPublicKey)
+		privateKey = rawPrivateKey
+	})
+
+	Describe("AddPluginRepositoryPublicKeys", func() {
+		var (
+			publicKeys []string
+			err        error
+		)
+
+		BeforeEach(func() {
+			publicKeys = []string{publicKey}
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "Some-Repo", URL: "https://some-url"},
+			})
+		})
+
+		JustBeforeEach(func() {
+			err = actor.AddPluginRepositoryPublicKeys("some-repo", publicKeys)
+		})
+
+		It("trusts the keys for the repository", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.AddPluginRepositoryPublicKeysCallCount()).To(Equal(1))
+			repoName, keys := fakeConfig.AddPluginRepositoryPublicKeysArgsForCall(0)
+			Expect(repoName).To(Equal("Some-Repo"))
+			Expect(keys).To(Equal([]string{publicKey}))
+		})
+
+		When("the repository is not registered", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns a RepositoryNotRegisteredError", func() {
+				Expect(err).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "some-repo"}))
+				Expect(fakeConfig.AddPluginRepositoryPublicKeysCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a key is not a base64-encoded ed25519 public key", func() {
+			BeforeEach(func() {
+				publicKeys = []string{publicKey, "bm90IGEga2V5"}
+			})
+
+			It("returns an InvalidPluginPublicKeyError without trusting any key", func() {
+				Expect(err).To(MatchError(actionerror.InvalidPluginPublicKeyError{PublicKey: "bm90IGEga2V5"}))
+				Expect(fakeConfig.AddPluginRepositoryPublicKeysCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ValidateFileSignature", func() {
+		var (
+			file      *os.File
+			signature string
+		)
+
+		BeforeEach(func() {
+			var err error
+			file, err = ioutil.TempFile("", "")
+			defer file.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("foo")))
+		})
+
+		AfterEach(func() {
+			err := os.Remove(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the file is signed by one of the keys", func() {
+			It("returns true", func() {
+				otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+				Expect(err).ToNot(HaveOccurred())
+
+				publicKeys := []string{base64.StdEncoding.EncodeToString(otherPublicKey), publicKey}
+				Expect(actor.ValidateFileSignature(file.Name(), signature, publicKeys)).To(BeTrue())
+			})
+		})
+
+		When("the file is not signed by any of the keys", func() {
+			It("returns false", func() {
+				otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+				Expect(err).ToNot(HaveOccurred())
+
+				publicKeys := []string{base64.StdEncoding.EncodeToString(otherPublicKey)}
+				Expect(actor.ValidateFileSignature(file.Name(), signature, publicKeys)).To(BeFalse())
+			})
+		})
+
+		When("the file has been modified", func() {
+			It("returns false", func() {
+				err := ioutil.WriteFile(file.Name(), []byte("bar"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actor.ValidateFileSignature(file.Name(), signature, []string{publicKey})).To(BeFalse())
+			})
+		})
+
+		When("the signature is not base64-encoded", func() {
+			It("returns false", func() {
+				Expect(actor.ValidateFileSignature(file.Name(), "not base64!", []string{publicKey})).To(BeFalse())
+			})
+		})
+	})
+})
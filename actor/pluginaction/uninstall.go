@@ -23,20 +23,12 @@ func (actor Actor) UninstallPlugin(uninstaller PluginUninstaller, name string) e
 	var binaryErr error
 
 	if actor.FileExists(plugin.Location) {
-		err := uninstaller.Run(plugin.Location, "CLI-MESSAGE-UNINSTALL")
+		err := runUninstallHook(uninstaller, plugin.Location)
 		if err != nil {
-			switch err.(type) {
-			case *exec.ExitError:
-				binaryErr = actionerror.PluginExecuteError{
-					Err: err,
-				}
-			case *os.PathError:
-				binaryErr = actionerror.PluginExecuteError{
-					Err: err,
-				}
-			default:
+			if _, isExecuteError := err.(actionerror.PluginExecuteError); !isExecuteError {
 				return err
 			}
+			binaryErr = err
 		}
 
 		// No test for sleeping for 500 ms for parity with pre-refactored behavior.
@@ -62,3 +54,15 @@ func (actor Actor) UninstallPlugin(uninstaller PluginUninstaller, name string) e
 
 	return binaryErr
 }
+
+// runUninstallHook runs the plugin binary with CLI-MESSAGE-UNINSTALL so that
+// the plugin can clean up before it is removed or replaced. A binary that
+// cannot be run or exits with an error is reported as a PluginExecuteError.
+func runUninstallHook(uninstaller PluginUninstaller, pluginPath string) error {
+	err := uninstaller.Run(pluginPath, "CLI-MESSAGE-UNINSTALL")
+	switch err.(type) {
+	case *exec.ExitError, *os.PathError:
+		return actionerror.PluginExecuteError{Err: err}
+	}
+	return err
+}
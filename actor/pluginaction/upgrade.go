@@ -0,0 +1,220 @@
+package pluginaction
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/gofileutils/fileutils"
+	"github.com/blang/semver"
+)
+
+// PluginUpgrade is an installed plugin and the newest version of it in the
+// plugin repositories that it can be upgraded to.
+type PluginUpgrade struct {
+	Plugin configv3.Plugin
+
+	// Info is the newest version of the plugin with a binary for the
+	// platform, within VersionRange when the plugin is pinned. It is empty
+	// when the pin excludes every version in the repositories.
+	Info           PluginInfo
+	RepositoryName string
+
+	// LatestVersion is the newest version of the plugin with a binary for the
+	// platform, regardless of VersionRange.
+	LatestVersion string
+	VersionRange  string
+}
+
+// Available returns true if the plugin can be upgraded to a newer version
+// within its pinned version range.
+func (upgrade PluginUpgrade) Available() bool {
+	return upgrade.Info.Version != "" && lessThan(upgrade.Plugin.Version.String(), upgrade.Info.Version)
+}
+
+// GetPluginUpgrades returns an upgrade for each of the plugins that has a
+// newer version in the repositories, in the order of the plugins. An upgrade
+// of a plugin that is pinned to a version range excluding every newer
+// version is not Available.
+func (actor Actor) GetPluginUpgrades(plugins []configv3.Plugin, pluginRepos []configv3.PluginRepository, platform string) ([]PluginUpgrade, error) {
+	upgrades := map[string]*PluginUpgrade{}
+	versionRanges := map[string]semver.Range{}
+	for _, plugin := range plugins {
+		upgrade := PluginUpgrade{Plugin: plugin}
+		if versionRange, pinned := actor.config.PluginVersionPin(plugin.Name); pinned {
+			parsedRange, err := semver.ParseRange(versionRange)
+			if err != nil {
+				return nil, actionerror.InvalidPluginVersionRangeError{VersionRange: versionRange}
+			}
+			versionRanges[plugin.Name] = parsedRange
+			upgrade.VersionRange = versionRange
+		}
+		upgrades[plugin.Name] = &upgrade
+	}
+
+	for _, repo := range pluginRepos {
+		repository, err := actor.client.GetPluginRepository(repo.URL)
+		if err != nil {
+			return nil, actionerror.GettingPluginRepositoryError{Name: repo.Name, Message: err.Error()}
+		}
+
+		for _, repoPlugin := range repository.Plugins {
+			upgrade, installed := upgrades[repoPlugin.Name]
+			if !installed {
+				continue
+			}
+
+			for _, binary := range repoPlugin.Binaries {
+				if binary.Platform != platform {
+					continue
+				}
+
+				if upgrade.LatestVersion == "" || lessThan(upgrade.LatestVersion, repoPlugin.Version) {
+					upgrade.LatestVersion = repoPlugin.Version
+				}
+
+				if versionRange, pinned := versionRanges[repoPlugin.Name]; pinned && !inRange(versionRange, repoPlugin.Version) {
+					break
+				}
+
+				if upgrade.Info.Version == "" || lessThan(upgrade.Info.Version, repoPlugin.Version) {
+					upgrade.Info = PluginInfo{
+						Name:      repoPlugin.Name,
+						Version:   repoPlugin.Version,
+						URL:       binary.URL,
+						Checksum:  binary.Checksum,
+						SHA256:    binary.SHA256,
+						Signature: binary.Signature,
+					}
+					upgrade.RepositoryName = repo.Name
+				}
+				break
+			}
+		}
+	}
+
+	var outdatedPlugins []PluginUpgrade
+	for _, plugin := range plugins {
+		upgrade := upgrades[plugin.Name]
+		if lessThan(plugin.Version.String(), upgrade.LatestVersion) {
+			outdatedPlugins = append(outdatedPlugins, *upgrade)
+		}
+	}
+
+	return outdatedPlugins, nil
+}
+
+// SetPluginVersionPin pins the plugin to the semantic version range, e.g.
+// ">=1.2.0 <2.0.0", so that it is only upgraded to versions in the range.
+func (actor Actor) SetPluginVersionPin(pluginName string, versionRange string) error {
+	_, err := semver.ParseRange(versionRange)
+	if err != nil {
+		return actionerror.InvalidPluginVersionRangeError{VersionRange: versionRange}
+	}
+
+	actor.config.SetPluginVersionPin(pluginName, versionRange)
+	return actor.config.WritePluginConfig()
+}
+
+// RemovePluginVersionPin unpins the plugin.
+func (actor Actor) RemovePluginVersionPin(pluginName string) error {
+	actor.config.RemovePluginVersionPin(pluginName)
+	return actor.config.WritePluginConfig()
+}
+
+// UpgradePlugin replaces the installed plugin with the plugin binary at path,
+// which must be a valid plugin of the same name. The installed binary is
+// backed up to tempPluginDir, and once the new binary is installed and
+// validated, the backup is run with CLI-MESSAGE-UNINSTALL, as when a plugin is
+// replaced by install-plugin. If installing the new binary fails, its metadata
+// cannot be read back once it is installed, or the uninstall hook fails, the
+// backup and the previous plugin configuration are restored.
+func (actor Actor) UpgradePlugin(pluginMetadata PluginMetadata, uninstaller PluginUninstaller, commandList CommandList, installed configv3.Plugin, path string, tempPluginDir string) (configv3.Plugin, error) {
+	plugin, err := actor.GetAndValidatePlugin(pluginMetadata, commandList, path)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+	if plugin.Name != installed.Name {
+		return configv3.Plugin{}, actionerror.PluginInvalidError{
+			Err: fmt.Errorf("plugin is named %s, not %s", plugin.Name, installed.Name),
+		}
+	}
+
+	backupPath, err := actor.CreateExecutableCopy(installed.Location, tempPluginDir)
+	if err != nil {
+		return configv3.Plugin{}, err
+	}
+
+	err = actor.InstallPluginFromPath(path, plugin)
+	if err == nil {
+		err = actor.validateInstalledPlugin(pluginMetadata, plugin)
+	}
+	if err == nil {
+		err = runUninstallHook(uninstaller, backupPath)
+	}
+	if err != nil {
+		rollbackErr := actor.restorePlugin(installed, backupPath)
+		if rollbackErr != nil {
+			return configv3.Plugin{}, actionerror.PluginUpgradeRollbackFailedError{
+				PluginName:  installed.Name,
+				Err:         err,
+				RollbackErr: rollbackErr,
+			}
+		}
+		return configv3.Plugin{}, actionerror.PluginUpgradeRolledBackError{
+			PluginName:      installed.Name,
+			PreviousVersion: installed.Version.String(),
+			Err:             err,
+		}
+	}
+
+	upgraded, _ := actor.config.GetPlugin(plugin.Name)
+	if upgraded.Location != installed.Location {
+		_ = os.Remove(installed.Location)
+	}
+	return upgraded, nil
+}
+
+// validateInstalledPlugin checks that the installed binary of the plugin
+// still reports the name and version it was validated with.
+func (actor Actor) validateInstalledPlugin(pluginMetadata PluginMetadata, plugin configv3.Plugin) error {
+	installed, _ := actor.config.GetPlugin(plugin.Name)
+	metadata, err := pluginMetadata.GetMetadata(installed.Location)
+	if err != nil {
+		return err
+	}
+	if metadata.Name != plugin.Name || metadata.Version != plugin.Version {
+		return actionerror.PluginInvalidError{}
+	}
+	return nil
+}
+
+// restorePlugin copies the backup of the plugin binary back to its location
+// and restores its configuration, removing any binary installed elsewhere.
+func (actor Actor) restorePlugin(plugin configv3.Plugin, backupPath string) error {
+	current, exists := actor.config.GetPlugin(plugin.Name)
+	if exists && current.Location != plugin.Location {
+		_ = os.Remove(current.Location)
+	}
+
+	err := fileutils.CopyPathToPath(backupPath, plugin.Location)
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(plugin.Location, 0755)
+	if err != nil {
+		return err
+	}
+
+	actor.config.AddPlugin(plugin)
+	return actor.config.WritePluginConfig()
+}
+
+func inRange(versionRange semver.Range, version string) bool {
+	v, err := semver.Make(version)
+	if err != nil {
+		return false
+	}
+	return versionRange(v)
+}
@@ -0,0 +1,412 @@
+package pluginaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("upgrade actions", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+	})
+
+	Describe("GetPluginUpgrades", func() {
+		var (
+			plugins    []configv3.Plugin
+			repos      []configv3.PluginRepository
+			upgrades   []PluginUpgrade
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			plugins = []configv3.Plugin{
+				{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}},
+				{Name: "plugin-2", Version: configv3.PluginVersion{Major: 2}},
+				{Name: "plugin-3", Version: configv3.PluginVersion{Major: 3}},
+				{Name: "plugin-4", Version: configv3.PluginVersion{Major: 4}},
+			}
+			repos = []configv3.PluginRepository{
+				{Name: "repo-1", URL: "https://repo-1"},
+				{Name: "repo-2", URL: "https://repo-2"},
+			}
+
+			fakePluginClient.GetPluginRepositoryStub = func(url string) (plugin.PluginRepository, error) {
+				switch url {
+				case "https://repo-1":
+					return plugin.PluginRepository{Plugins: []plugin.Plugin{
+						{Name: "plugin-1", Version: "1.1.0", Binaries: []plugin.PluginBinary{
+							{Platform: "linux64", URL: "https://repo-1/plugin-1", Checksum: "sha1-1", SHA256: "sha256-1", Signature: "signature-1"},
+						}},
+						{Name: "plugin-2", Version: "2.0.0", Binaries: []plugin.PluginBinary{
+							{Platform: "linux64", URL: "https://repo-1/plugin-2"},
+						}},
+						{Name: "plugin-3", Version: "3.1.0", Binaries: []plugin.PluginBinary{
+							{Platform: "osx", URL: "https://repo-1/plugin-3"},
+						}},
+					}}, nil
+				default:
+					return plugin.PluginRepository{Plugins: []plugin.Plugin{
+						{Name: "plugin-1", Version: "2.0.0", Binaries: []plugin.PluginBinary{
+							{Platform: "linux64", URL: "https://repo-2/plugin-1", Checksum: "sha1-2"},
+						}},
+						{Name: "not-installed", Version: "9.0.0", Binaries: []plugin.PluginBinary{
+							{Platform: "linux64", URL: "https://repo-2/not-installed"},
+						}},
+					}}, nil
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			upgrades, executeErr = actor.GetPluginUpgrades(plugins, repos, "linux64")
+		})
+
+		It("returns the newest version of each outdated plugin for the platform", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(upgrades).To(Equal([]PluginUpgrade{
+				{
+					Plugin:         plugins[0],
+					Info:           PluginInfo{Name: "plugin-1", Version: "2.0.0", URL: "https://repo-2/plugin-1", Checksum: "sha1-2"},
+					RepositoryName: "repo-2",
+					LatestVersion:  "2.0.0",
+				},
+			}))
+			Expect(upgrades[0].Available()).To(BeTrue())
+		})
+
+		When("a plugin is pinned to a version range", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginVersionPinStub = func(pluginName string) (string, bool) {
+					if pluginName == "plugin-1" {
+						return "<2.0.0", true
+					}
+					return "", false
+				}
+			})
+
+			It("upgrades to the newest version in the range", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(upgrades).To(Equal([]PluginUpgrade{
+					{
+						Plugin:         plugins[0],
+						Info:           PluginInfo{Name: "plugin-1", Version: "1.1.0", URL: "https://repo-1/plugin-1", Checksum: "sha1-1", SHA256: "sha256-1", Signature: "signature-1"},
+						RepositoryName: "repo-1",
+						LatestVersion:  "2.0.0",
+						VersionRange:   "<2.0.0",
+					},
+				}))
+				Expect(upgrades[0].Available()).To(BeTrue())
+			})
+
+			When("the range excludes every newer version", func() {
+				BeforeEach(func() {
+					fakeConfig.PluginVersionPinReturns("1.0.x", true)
+					fakeConfig.PluginVersionPinStub = nil
+				})
+
+				It("returns an upgrade that is not available", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(upgrades).To(HaveLen(1))
+					Expect(upgrades[0].Info).To(Equal(PluginInfo{}))
+					Expect(upgrades[0].LatestVersion).To(Equal("2.0.0"))
+					Expect(upgrades[0].VersionRange).To(Equal("1.0.x"))
+					Expect(upgrades[0].Available()).To(BeFalse())
+				})
+			})
+
+			When("the range is invalid", func() {
+				BeforeEach(func() {
+					fakeConfig.PluginVersionPinReturns("not a range", true)
+					fakeConfig.PluginVersionPinStub = nil
+				})
+
+				It("returns an InvalidPluginVersionRangeError", func() {
+					Expect(executeErr).To(MatchError(actionerror.InvalidPluginVersionRangeError{VersionRange: "not a range"}))
+					Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("getting a repository fails", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryStub = nil
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("some-error"))
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				Expect(executeErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "some-error"}))
+			})
+		})
+	})
+
+	Describe("SetPluginVersionPin", func() {
+		It("pins the plugin and writes the config", func() {
+			err := actor.SetPluginVersionPin("some-plugin", ">=1.2.0 <2.0.0")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SetPluginVersionPinCallCount()).To(Equal(1))
+			pluginName, versionRange := fakeConfig.SetPluginVersionPinArgsForCall(0)
+			Expect(pluginName).To(Equal("some-plugin"))
+			Expect(versionRange).To(Equal(">=1.2.0 <2.0.0"))
+			Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+		})
+
+		When("the version range is invalid", func() {
+			It("returns an InvalidPluginVersionRangeError", func() {
+				err := actor.SetPluginVersionPin("some-plugin", "latest")
+				Expect(err).To(MatchError(actionerror.InvalidPluginVersionRangeError{VersionRange: "latest"}))
+				Expect(fakeConfig.SetPluginVersionPinCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RemovePluginVersionPin", func() {
+		It("unpins the plugin and writes the config", func() {
+			err := actor.RemovePluginVersionPin("some-plugin")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.RemovePluginVersionPinArgsForCall(0)).To(Equal("some-plugin"))
+			Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("UpgradePlugin", func() {
+		var (
+			fakePluginMetadata    *pluginactionfakes.FakePluginMetadata
+			fakePluginUninstaller *pluginactionfakes.FakePluginUninstaller
+			fakeCommandList       *pluginactionfakes.FakeCommandList
+
+			tempDir       string
+			pluginHome    string
+			tempPluginDir string
+			installPath   string
+			newPath       string
+			configPlugins map[string]configv3.Plugin
+
+			installed  configv3.Plugin
+			newPlugin  configv3.Plugin
+			upgraded   configv3.Plugin
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakePluginMetadata = new(pluginactionfakes.FakePluginMetadata)
+			fakePluginUninstaller = new(pluginactionfakes.FakePluginUninstaller)
+			fakeCommandList = new(pluginactionfakes.FakeCommandList)
+
+			var err error
+			tempDir, err = ioutil.TempDir("", "upgrade")
+			Expect(err).ToNot(HaveOccurred())
+			pluginHome = filepath.Join(tempDir, "plugins")
+			tempPluginDir = filepath.Join(tempDir, "temp")
+			Expect(os.MkdirAll(pluginHome, 0700)).To(Succeed())
+			Expect(os.MkdirAll(tempPluginDir, 0700)).To(Succeed())
+			fakeConfig.PluginHomeReturns(pluginHome)
+
+			installPath = generic.ExecutableFilename(filepath.Join(pluginHome, "some-plugin"))
+			installed = configv3.Plugin{
+				Name:     "some-plugin",
+				Location: filepath.Join(tempDir, "some-plugin-old"),
+				Version:  configv3.PluginVersion{Major: 1},
+				Commands: []configv3.PluginCommand{{Name: "some-command"}},
+			}
+			Expect(ioutil.WriteFile(installed.Location, []byte("old"), 0700)).To(Succeed())
+
+			newPath = filepath.Join(tempPluginDir, "some-plugin-new")
+			Expect(ioutil.WriteFile(newPath, []byte("new"), 0700)).To(Succeed())
+			newPlugin = configv3.Plugin{
+				Name:     "some-plugin",
+				Version:  configv3.PluginVersion{Major: 2},
+				Commands: []configv3.PluginCommand{{Name: "some-command"}},
+			}
+			fakePluginMetadata.GetMetadataReturns(newPlugin, nil)
+
+			configPlugins = map[string]configv3.Plugin{installed.Name: installed}
+			fakeConfig.AddPluginStub = func(plugin configv3.Plugin) {
+				configPlugins[plugin.Name] = plugin
+			}
+			fakeConfig.GetPluginStub = func(pluginName string) (configv3.Plugin, bool) {
+				plugin, exists := configPlugins[pluginName]
+				return plugin, exists
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			upgraded, executeErr = actor.UpgradePlugin(fakePluginMetadata, fakePluginUninstaller, fakeCommandList, installed, newPath, tempPluginDir)
+		})
+
+		It("installs the new binary and removes the previous one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			expectedPlugin := newPlugin
+			expectedPlugin.Location = installPath
+			Expect(upgraded).To(Equal(expectedPlugin))
+			Expect(configPlugins["some-plugin"]).To(Equal(expectedPlugin))
+
+			contents, err := ioutil.ReadFile(installPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("new"))
+			Expect(installed.Location).ToNot(BeAnExistingFile())
+
+			Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(2))
+			Expect(fakePluginMetadata.GetMetadataArgsForCall(0)).To(Equal(newPath))
+			Expect(fakePluginMetadata.GetMetadataArgsForCall(1)).To(Equal(installPath))
+
+			Expect(fakePluginUninstaller.RunCallCount()).To(Equal(1))
+			pluginPath, command := fakePluginUninstaller.RunArgsForCall(0)
+			Expect(filepath.Dir(pluginPath)).To(Equal(tempPluginDir))
+			Expect(command).To(Equal("CLI-MESSAGE-UNINSTALL"))
+		})
+
+		When("the uninstall hook of the installed plugin runs", func() {
+			var (
+				validatedBeforeHook int
+				hookContents        string
+			)
+
+			BeforeEach(func() {
+				fakePluginUninstaller.RunStub = func(pluginPath string, _ string) error {
+					validatedBeforeHook = fakePluginMetadata.GetMetadataCallCount()
+					contents, err := ioutil.ReadFile(pluginPath)
+					hookContents = string(contents)
+					return err
+				}
+			})
+
+			It("runs the previous binary after the new binary is installed and validated", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(validatedBeforeHook).To(Equal(2))
+				Expect(hookContents).To(Equal("old"))
+			})
+		})
+
+		When("the uninstall hook of the installed plugin fails", func() {
+			var hookErr error
+
+			BeforeEach(func() {
+				hookErr = &exec.ExitError{}
+				fakePluginUninstaller.RunReturns(hookErr)
+			})
+
+			It("restores the previous binary and configuration", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginUpgradeRolledBackError{
+					PluginName:      "some-plugin",
+					PreviousVersion: "1.0.0",
+					Err:             actionerror.PluginExecuteError{Err: hookErr},
+				}))
+
+				Expect(configPlugins["some-plugin"]).To(Equal(installed))
+				contents, err := ioutil.ReadFile(installed.Location)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("old"))
+				Expect(installPath).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the new binary is a different plugin", func() {
+			BeforeEach(func() {
+				fakePluginMetadata.GetMetadataReturns(configv3.Plugin{
+					Name:     "some-other-plugin",
+					Commands: []configv3.PluginCommand{{Name: "some-other-command"}},
+				}, nil)
+			})
+
+			It("returns a PluginInvalidError without installing it", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.PluginInvalidError{}))
+				Expect(fakePluginUninstaller.RunCallCount()).To(Equal(0))
+				Expect(fakeConfig.AddPluginCallCount()).To(Equal(0))
+				Expect(installPath).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the installed binary fails validation", func() {
+			BeforeEach(func() {
+				fakePluginMetadata.GetMetadataReturnsOnCall(1, configv3.Plugin{}, errors.New("some-error"))
+			})
+
+			It("restores the previous binary and configuration", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginUpgradeRolledBackError{
+					PluginName:      "some-plugin",
+					PreviousVersion: "1.0.0",
+					Err:             errors.New("some-error"),
+				}))
+
+				Expect(configPlugins["some-plugin"]).To(Equal(installed))
+				contents, err := ioutil.ReadFile(installed.Location)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("old"))
+				Expect(installPath).ToNot(BeAnExistingFile())
+				Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the installed binary reports a different version", func() {
+			BeforeEach(func() {
+				fakePluginMetadata.GetMetadataReturnsOnCall(1, configv3.Plugin{Name: "some-plugin", Version: configv3.PluginVersion{Major: 3}}, nil)
+			})
+
+			It("restores the previous binary", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginUpgradeRolledBackError{
+					PluginName:      "some-plugin",
+					PreviousVersion: "1.0.0",
+					Err:             actionerror.PluginInvalidError{},
+				}))
+				Expect(configPlugins["some-plugin"]).To(Equal(installed))
+			})
+		})
+
+		When("installing the new binary fails", func() {
+			BeforeEach(func() {
+				fakeConfig.WritePluginConfigReturnsOnCall(0, errors.New("write-error"))
+			})
+
+			It("restores the previous binary and configuration", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginUpgradeRolledBackError{
+					PluginName:      "some-plugin",
+					PreviousVersion: "1.0.0",
+					Err:             errors.New("write-error"),
+				}))
+				Expect(configPlugins["some-plugin"]).To(Equal(installed))
+				Expect(fakePluginMetadata.GetMetadataCallCount()).To(Equal(1))
+			})
+		})
+
+		When("restoring the previous binary fails", func() {
+			BeforeEach(func() {
+				fakePluginMetadata.GetMetadataReturnsOnCall(1, configv3.Plugin{}, errors.New("some-error"))
+				fakeConfig.WritePluginConfigReturnsOnCall(1, errors.New("write-error"))
+			})
+
+			It("returns a PluginUpgradeRollbackFailedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginUpgradeRollbackFailedError{
+					PluginName:  "some-plugin",
+					Err:         errors.New("some-error"),
+					RollbackErr: errors.New("write-error"),
+				}))
+			})
+		})
+	})
+})
@@ -5,6 +5,10 @@ package sharedaction
 // Actor handles all shared actions
 type Actor struct {
 	Config Config
+
+	// BitsCache caches the SHA1s and archives of pushed directories. It is
+	// nil unless caching is enabled.
+	BitsCache *BitsCache
 }
 
 // NewActor returns an Actor with default settings
@@ -0,0 +1,418 @@
+package sharedaction
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	bitsCacheSHA1sFile  = "sha1s.json"
+	bitsCacheArchiveDir = "archives"
+
+	// DefaultBitsCacheMaxAge is how long an unused cache entry is kept.
+	DefaultBitsCacheMaxAge = 30 * 24 * time.Hour
+	// DefaultBitsCacheMaxArchivesSize is the combined size in bytes above
+	// which the least recently used archives are evicted.
+	DefaultBitsCacheMaxArchivesSize = 1 << 30
+)
+
+// BitsCache is a local cache that speeds up repeated pushes of unchanged
+// sources. It stores the SHA1 of every gathered file keyed by its path, size
+// and modification time, and the last archive built from each source
+// directory keyed by the set of resources it contains.
+//
+// Entries that have not been used for MaxAge are evicted, as are the SHA1s of
+// files that no longer exist. The least recently used archives are evicted
+// once the archives take up more than MaxArchivesSize bytes.
+//
+// A nil *BitsCache is valid and caches nothing.
+type BitsCache struct {
+	MaxAge          time.Duration
+	MaxArchivesSize int64
+
+	dir string
+
+	mutex    sync.Mutex
+	loaded   bool
+	loadedAt time.Time
+	dirty    bool
+	sha1s    map[string]cachedSHA1
+	used     map[string]bool
+}
+
+type cachedSHA1 struct {
+	Size     int64  `json:"size"`
+	ModTime  int64  `json:"mtime"`
+	SHA1     string `json:"sha1"`
+	LastUsed int64  `json:"last_used"`
+}
+
+type cachedArchive struct {
+	ResourcesDigest string `json:"resources_digest"`
+}
+
+// NewBitsCache returns a BitsCache that is stored in the provided directory.
+func NewBitsCache(dir string) *BitsCache {
+	return &BitsCache{
+		MaxAge:          DefaultBitsCacheMaxAge,
+		MaxArchivesSize: DefaultBitsCacheMaxArchivesSize,
+		dir:             dir,
+	}
+}
+
+// Clear removes everything stored in the cache.
+func (cache *BitsCache) Clear() error {
+	if cache == nil {
+		return nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.loaded = false
+	cache.dirty = false
+	cache.sha1s = nil
+	cache.used = nil
+	return os.RemoveAll(cache.dir)
+}
+
+// lookupSHA1 returns the cached SHA1 of the file at path if the file has not
+// changed size or modification time since it was cached.
+func (cache *BitsCache) lookupSHA1(path string, info os.FileInfo) (string, bool) {
+	if cache == nil {
+		return "", false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.load()
+
+	key := absPath(path)
+	cached, found := cache.sha1s[key]
+	if !found || cached.Size != info.Size() || cached.ModTime != info.ModTime().UnixNano() {
+		return "", false
+	}
+
+	cache.used[key] = true
+	if cached.LastUsed != cache.loadedAt.Unix() {
+		cached.LastUsed = cache.loadedAt.Unix()
+		cache.sha1s[key] = cached
+		cache.dirty = true
+	}
+	return cached.SHA1, true
+}
+
+func (cache *BitsCache) storeSHA1(path string, info os.FileInfo, sum string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.load()
+
+	key := absPath(path)
+	cache.used[key] = true
+	cache.sha1s[key] = cachedSHA1{
+		Size:     info.Size(),
+		ModTime:  info.ModTime().UnixNano(),
+		SHA1:     sum,
+		LastUsed: cache.loadedAt.Unix(),
+	}
+	cache.dirty = true
+}
+
+// save evicts the SHA1s of files that were not used for MaxAge or no longer
+// exist, and writes the cached SHA1s to disk if they changed.
+func (cache *BitsCache) save() error {
+	if cache == nil {
+		return nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.pruneSHA1s()
+	if !cache.dirty {
+		return nil
+	}
+
+	raw, err := json.Marshal(cache.sha1s)
+	if err != nil {
+		return err
+	}
+
+	err = cache.writeFile(bitsCacheSHA1sFile, raw)
+	if err != nil {
+		return err
+	}
+
+	cache.dirty = false
+	return nil
+}
+
+// lookupArchive returns a new path to the archive previously built from
+// sourceDir if it was built from the same resources. The caller may remove
+// the returned path once it is done with it.
+func (cache *BitsCache) lookupArchive(sourceDir string, resources []Resource) (string, bool) {
+	if cache == nil {
+		return "", false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	archiveName := cache.archiveName(sourceDir)
+	metadataPath := filepath.Join(cache.dir, bitsCacheArchiveDir, archiveName+".json")
+	rawMetadata, err := ioutil.ReadFile(metadataPath)
+	if err != nil {
+		return "", false
+	}
+
+	var metadata cachedArchive
+	if json.Unmarshal(rawMetadata, &metadata) != nil || metadata.ResourcesDigest != resourcesDigest(resources) {
+		return "", false
+	}
+
+	zipPath, err := linkToTempFile(filepath.Join(cache.dir, bitsCacheArchiveDir, archiveName+".zip"))
+	if err != nil {
+		log.WithField("sourceDir", sourceDir).Errorln("linking cached archive:", err)
+		return "", false
+	}
+
+	if err := os.Chtimes(metadataPath, time.Now(), time.Now()); err != nil {
+		log.WithField("sourceDir", sourceDir).Errorln("marking cached archive as used:", err)
+	}
+
+	log.WithField("sourceDir", sourceDir).Info("reusing cached archive")
+	return zipPath, true
+}
+
+// storeArchive stores a copy of the archive at zipPath as the archive built
+// from sourceDir, replacing the previously stored one, and evicts the archives
+// that exceed the age and size limits.
+func (cache *BitsCache) storeArchive(sourceDir string, resources []Resource, zipPath string) error {
+	if cache == nil {
+		return nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	archiveDir := filepath.Join(cache.dir, bitsCacheArchiveDir)
+	err := os.MkdirAll(archiveDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	archiveName := cache.archiveName(sourceDir)
+	cachedZipPath := filepath.Join(archiveDir, archiveName+".zip")
+	os.Remove(cachedZipPath)
+	if os.Link(zipPath, cachedZipPath) != nil {
+		err = copyFile(zipPath, cachedZipPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	rawMetadata, err := json.Marshal(cachedArchive{ResourcesDigest: resourcesDigest(resources)})
+	if err != nil {
+		return err
+	}
+	err = cache.writeFile(filepath.Join(bitsCacheArchiveDir, archiveName+".json"), rawMetadata)
+	if err != nil {
+		return err
+	}
+
+	return cache.pruneArchives()
+}
+
+// pruneSHA1s evicts the SHA1s that were not used for MaxAge and those of files
+// that no longer exist. Files used since the cache was loaded are known to
+// exist and are not checked again. Entries cached before their last use was
+// recorded only expire when their file is gone.
+func (cache *BitsCache) pruneSHA1s() {
+	cache.load()
+
+	oldest := cache.loadedAt.Add(-cache.MaxAge).Unix()
+	for path, cached := range cache.sha1s {
+		if cache.used[path] {
+			continue
+		}
+
+		expired := cached.LastUsed != 0 && cached.LastUsed < oldest
+		if _, err := os.Lstat(path); expired || os.IsNotExist(err) {
+			delete(cache.sha1s, path)
+			cache.dirty = true
+		}
+	}
+}
+
+// pruneArchives evicts the archives that were not used for MaxAge, then the
+// least recently used archives until the remaining ones fit in
+// MaxArchivesSize. An archive is used when it is stored or reused; the
+// modification time of its metadata file records when it was last used.
+func (cache *BitsCache) pruneArchives() error {
+	archiveDir := filepath.Join(cache.dir, bitsCacheArchiveDir)
+	metadataPaths, err := filepath.Glob(filepath.Join(archiveDir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	type archive struct {
+		name     string
+		size     int64
+		lastUsed time.Time
+	}
+	var archives []archive
+	for _, metadataPath := range metadataPaths {
+		metadataInfo, err := os.Stat(metadataPath)
+		if err != nil {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(metadataPath), ".json")
+		zipInfo, err := os.Stat(filepath.Join(archiveDir, name+".zip"))
+		if err != nil {
+			cache.removeArchive(name)
+			continue
+		}
+		archives = append(archives, archive{name: name, size: zipInfo.Size(), lastUsed: metadataInfo.ModTime()})
+	}
+
+	sort.Slice(archives, func(i int, j int) bool {
+		return archives[i].lastUsed.After(archives[j].lastUsed)
+	})
+
+	oldest := time.Now().Add(-cache.MaxAge)
+	var totalSize int64
+	for _, archive := range archives {
+		totalSize += archive.size
+		if archive.lastUsed.Before(oldest) || totalSize > cache.MaxArchivesSize {
+			log.WithField("archive", archive.name).Info("evicting cached archive")
+			cache.removeArchive(archive.name)
+		}
+	}
+
+	return nil
+}
+
+func (cache *BitsCache) removeArchive(name string) {
+	archiveDir := filepath.Join(cache.dir, bitsCacheArchiveDir)
+	os.Remove(filepath.Join(archiveDir, name+".json"))
+	os.Remove(filepath.Join(archiveDir, name+".zip"))
+}
+
+// load reads the cached SHA1s from disk the first time it is called. A
+// missing or corrupt cache is treated as empty.
+func (cache *BitsCache) load() {
+	if cache.loaded {
+		return
+	}
+	cache.loaded = true
+	cache.loadedAt = time.Now()
+	cache.sha1s = map[string]cachedSHA1{}
+	cache.used = map[string]bool{}
+
+	raw, err := ioutil.ReadFile(filepath.Join(cache.dir, bitsCacheSHA1sFile))
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(raw, &cache.sha1s)
+	if err != nil {
+		log.Errorln("reading bits cache:", err)
+		cache.sha1s = map[string]cachedSHA1{}
+	}
+}
+
+func (cache *BitsCache) archiveName(sourceDir string) string {
+	return fmt.Sprintf("%x", sha1.Sum([]byte(absPath(sourceDir))))
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// writeFile atomically writes data to the named file in the cache directory.
+func (cache *BitsCache) writeFile(name string, data []byte) error {
+	path := filepath.Join(cache.dir, name)
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = tempFile.Write(data)
+	tempFile.Close()
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
+
+func resourcesDigest(resources []Resource) string {
+	raw, _ := json.Marshal(resources)
+	return fmt.Sprintf("%x", sha1.Sum(raw))
+}
+
+// linkToTempFile returns a new path to the file at srcPath, next to it, so
+// that removing the returned path leaves the original in place. The file is
+// hard linked when possible to avoid copying large archives.
+func linkToTempFile(srcPath string) (string, error) {
+	tempFile, err := ioutil.TempFile(filepath.Dir(srcPath), "upload-")
+	if err != nil {
+		return "", err
+	}
+	tempFile.Close()
+	os.Remove(tempFile.Name())
+
+	if os.Link(srcPath, tempFile.Name()) == nil {
+		return tempFile.Name(), nil
+	}
+
+	err = copyFile(srcPath, tempFile.Name())
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return "", err
+	}
+	return tempFile.Name(), nil
+}
+
+func copyFile(srcPath string, destPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dest, err := os.OpenFile(destPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dest, src)
+	closeErr := dest.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
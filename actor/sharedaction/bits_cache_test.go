@@ -0,0 +1,276 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bits Cache", func() {
+	var (
+		actor    *Actor
+		srcDir   string
+		cacheDir string
+		filePath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		srcDir, err = ioutil.TempDir("", "bits-cache-src")
+		Expect(err).ToNot(HaveOccurred())
+		cacheDir, err = ioutil.TempDir("", "bits-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		filePath = filepath.Join(srcDir, "some-file")
+		Expect(ioutil.WriteFile(filePath, []byte("some-contents"), 0600)).To(Succeed())
+
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		actor.BitsCache = NewBitsCache(cacheDir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	Describe("GatherDirectoryResources", func() {
+		var originalResources []Resource
+
+		BeforeEach(func() {
+			var err error
+			originalResources, err = actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filepath.Join(cacheDir, "sha1s.json")).To(BeARegularFile())
+		})
+
+		When("the file has not changed", func() {
+			BeforeEach(func() {
+				// Rewriting the cache with a bogus SHA1 proves the file is not
+				// hashed again.
+				rawCache, err := ioutil.ReadFile(filepath.Join(cacheDir, "sha1s.json"))
+				Expect(err).ToNot(HaveOccurred())
+				rawCache = []byte(strings.Replace(string(rawCache), originalResources[0].SHA1, "cached-sha1", -1))
+				Expect(ioutil.WriteFile(filepath.Join(cacheDir, "sha1s.json"), rawCache, 0600)).To(Succeed())
+
+				actor.BitsCache = NewBitsCache(cacheDir)
+			})
+
+			It("uses the cached SHA1", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources[0].SHA1).To(Equal("cached-sha1"))
+			})
+		})
+
+		When("the file has changed", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filePath, []byte("some-other-contents"), 0600)).To(Succeed())
+				later := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(filePath, later, later)).To(Succeed())
+			})
+
+			It("hashes the file again", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resources[0].SHA1).ToNot(Equal(originalResources[0].SHA1))
+				Expect(resources[0].Size).To(BeEquivalentTo(len("some-other-contents")))
+			})
+		})
+
+		When("a cached file is deleted", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(srcDir, "other-file"), []byte("other-contents"), 0600)).To(Succeed())
+				_, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Remove(filePath)).To(Succeed())
+
+				actor.BitsCache = NewBitsCache(cacheDir)
+			})
+
+			It("evicts its SHA1", func() {
+				_, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				rawCache := string(mustReadFile(filepath.Join(cacheDir, "sha1s.json")))
+				Expect(rawCache).ToNot(ContainSubstring(filePath))
+				Expect(rawCache).To(ContainSubstring(filepath.Join(srcDir, "other-file")))
+			})
+		})
+
+		When("a cached SHA1 has not been used for longer than MaxAge", func() {
+			var otherDir string
+
+			BeforeEach(func() {
+				var err error
+				otherDir, err = ioutil.TempDir("", "bits-cache-other")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(filepath.Join(otherDir, "other-file"), []byte("other-contents"), 0600)).To(Succeed())
+
+				actor.BitsCache = NewBitsCache(cacheDir)
+				actor.BitsCache.MaxAge = time.Hour
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(otherDir)).To(Succeed())
+			})
+
+			It("evicts it", func() {
+				rawCache := string(mustReadFile(filepath.Join(cacheDir, "sha1s.json")))
+				rawCache = regexp.MustCompile(`"last_used":\d+`).ReplaceAllString(rawCache, `"last_used":1`)
+				Expect(ioutil.WriteFile(filepath.Join(cacheDir, "sha1s.json"), []byte(rawCache), 0600)).To(Succeed())
+
+				_, err := actor.GatherDirectoryResources(otherDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				rawCache = string(mustReadFile(filepath.Join(cacheDir, "sha1s.json")))
+				Expect(rawCache).ToNot(ContainSubstring(filePath))
+				Expect(rawCache).To(ContainSubstring(filepath.Join(otherDir, "other-file")))
+			})
+		})
+	})
+
+	Describe("ZipDirectoryResources", func() {
+		var (
+			resources    []Resource
+			firstZipPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			resources, err = actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			firstZipPath, err = actor.ZipDirectoryResources(srcDir, resources)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(firstZipPath)).To(Succeed())
+		})
+
+		When("the resources have not changed", func() {
+			It("reuses the previous archive", func() {
+				// Removing the source proves the archive is not rebuilt.
+				Expect(os.Remove(filePath)).To(Succeed())
+
+				zipPath, err := actor.ZipDirectoryResources(srcDir, resources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.Remove(zipPath)
+
+				Expect(zipPath).ToNot(Equal(firstZipPath))
+				Expect(ioutil.ReadFile(zipPath)).To(Equal(mustReadFile(firstZipPath)))
+
+				By("leaving the cached archive in place when the returned path is removed")
+				Expect(os.Remove(zipPath)).To(Succeed())
+				secondZipPath, err := actor.ZipDirectoryResources(srcDir, resources)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Remove(secondZipPath)).To(Succeed())
+			})
+		})
+
+		When("the resources have changed", func() {
+			It("builds a new archive", func() {
+				Expect(ioutil.WriteFile(filepath.Join(srcDir, "new-file"), []byte("new"), 0600)).To(Succeed())
+				newResources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				zipPath, err := actor.ZipDirectoryResources(srcDir, newResources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.Remove(zipPath)
+
+				Expect(mustReadFile(zipPath)).ToNot(Equal(mustReadFile(firstZipPath)))
+			})
+		})
+
+		When("the archives exceed MaxArchivesSize", func() {
+			It("evicts the least recently used archives", func() {
+				otherDir, err := ioutil.TempDir("", "bits-cache-other")
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(otherDir)
+				Expect(ioutil.WriteFile(filepath.Join(otherDir, "other-file"), []byte("other-contents"), 0600)).To(Succeed())
+
+				firstArchives, err := filepath.Glob(filepath.Join(cacheDir, "archives", "*.zip"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(firstArchives).To(HaveLen(1))
+				past := time.Now().Add(-time.Minute)
+				Expect(os.Chtimes(strings.TrimSuffix(firstArchives[0], ".zip")+".json", past, past)).To(Succeed())
+
+				info, err := os.Stat(firstArchives[0])
+				Expect(err).ToNot(HaveOccurred())
+				actor.BitsCache.MaxArchivesSize = 2*info.Size() - 1
+
+				otherResources, err := actor.GatherDirectoryResources(otherDir)
+				Expect(err).ToNot(HaveOccurred())
+				zipPath, err := actor.ZipDirectoryResources(otherDir, otherResources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.Remove(zipPath)
+
+				archives, err := filepath.Glob(filepath.Join(cacheDir, "archives", "*.zip"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(archives).To(HaveLen(1))
+				Expect(archives).ToNot(ContainElement(firstArchives[0]))
+			})
+		})
+
+		When("an archive has not been used for longer than MaxAge", func() {
+			It("evicts it", func() {
+				metadataPaths, err := filepath.Glob(filepath.Join(cacheDir, "archives", "*.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(metadataPaths).To(HaveLen(1))
+				past := time.Now().Add(-2 * time.Hour)
+				Expect(os.Chtimes(metadataPaths[0], past, past)).To(Succeed())
+				actor.BitsCache.MaxAge = time.Hour
+
+				otherDir, err := ioutil.TempDir("", "bits-cache-other")
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(otherDir)
+				Expect(ioutil.WriteFile(filepath.Join(otherDir, "other-file"), []byte("other-contents"), 0600)).To(Succeed())
+				otherResources, err := actor.GatherDirectoryResources(otherDir)
+				Expect(err).ToNot(HaveOccurred())
+				zipPath, err := actor.ZipDirectoryResources(otherDir, otherResources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.Remove(zipPath)
+
+				Expect(metadataPaths[0]).ToNot(BeAnExistingFile())
+				Expect(strings.TrimSuffix(metadataPaths[0], ".json") + ".zip").ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the cache is disabled", func() {
+			BeforeEach(func() {
+				actor.BitsCache = nil
+			})
+
+			It("builds a new archive", func() {
+				Expect(os.Remove(filePath)).To(Succeed())
+
+				_, err := actor.ZipDirectoryResources(srcDir, resources)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Clear", func() {
+		It("removes everything stored in the cache", func() {
+			_, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(actor.BitsCache.Clear()).To(Succeed())
+			Expect(cacheDir).ToNot(BeAnExistingFile())
+		})
+	})
+})
+
+func mustReadFile(path string) []byte {
+	raw, err := ioutil.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	return raw
+}
@@ -6,9 +6,7 @@ import "code.cloudfoundry.org/cli/actor/actionerror"
 // check if an organization and space are targeted.
 func (actor Actor) CheckTarget(targetedOrganizationRequired bool, targetedSpaceRequired bool) error {
 	if !actor.IsLoggedIn() {
-		return actionerror.NotLoggedInError{
-			BinaryName: actor.Config.BinaryName(),
-		}
+		return actor.notLoggedInError()
 	}
 
 	if targetedOrganizationRequired {
@@ -32,14 +30,25 @@ func (actor Actor) CheckTarget(targetedOrganizationRequired bool, targetedSpaceR
 
 func (actor Actor) RequireCurrentUser() (string, error) {
 	if !actor.IsLoggedIn() {
-		return "", actionerror.NotLoggedInError{
-			BinaryName: actor.Config.BinaryName(),
-		}
+		return "", actor.notLoggedInError()
 	}
 
 	return actor.Config.CurrentUserName()
 }
 
+// notLoggedInError returns the reason the credentials could not be loaded,
+// if any, since the user may be logged in with credentials that are
+// inaccessible; otherwise it returns a NotLoggedInError.
+func (actor Actor) notLoggedInError() error {
+	if err := actor.Config.CredentialsError(); err != nil {
+		return err
+	}
+
+	return actionerror.NotLoggedInError{
+		BinaryName: actor.Config.BinaryName(),
+	}
+}
+
 func (actor Actor) RequireTargetedOrg() (string, error) {
 	if !actor.IsOrgTargeted() {
 		return "", actionerror.NoOrganizationTargetedError{
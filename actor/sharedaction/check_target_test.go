@@ -32,6 +32,17 @@ var _ = Describe("Checking target", func() {
 					BinaryName: binaryName,
 				}))
 			})
+
+			When("the credentials could not be loaded", func() {
+				BeforeEach(func() {
+					fakeConfig.CredentialsErrorReturns(errors.New("missing passphrase"))
+				})
+
+				It("returns the reason instead", func() {
+					err := actor.CheckTarget(false, false)
+					Expect(err).To(MatchError("missing passphrase"))
+				})
+			})
 		})
 
 		When("the user is logged in", func() {
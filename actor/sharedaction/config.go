@@ -6,6 +6,7 @@ package sharedaction
 type Config interface {
 	AccessToken() string
 	BinaryName() string
+	CredentialsError() error
 	CurrentUserName() (string, error)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
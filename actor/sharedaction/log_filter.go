@@ -0,0 +1,69 @@
+package sharedaction
+
+import (
+	"regexp"
+	"strings"
+)
+
+// LogMessage is the part of a log message that a LogFilter matches on.
+type LogMessage interface {
+	Message() string
+	Type() string
+	SourceType() string
+	SourceInstance() string
+}
+
+// LogFilter selects log messages by source type, instance, message type and
+// body. Empty criteria match every message.
+type LogFilter struct {
+	// SourceTypes are source types such as APP or RTR. A source type also
+	// matches its sub types, e.g. APP matches APP/PROC/WEB.
+	SourceTypes []string
+	// Instances are source instance indexes.
+	Instances []string
+	// MessageType is OUT or ERR.
+	MessageType string
+	// Pattern is matched against the message body.
+	Pattern *regexp.Regexp
+}
+
+// Matches returns true if the message meets every criteria of the filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !matchesSourceType(filter.SourceTypes, message.SourceType()) {
+		return false
+	}
+
+	if len(filter.Instances) > 0 && !contains(filter.Instances, message.SourceInstance()) {
+		return false
+	}
+
+	if filter.MessageType != "" && filter.MessageType != message.Type() {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	return true
+}
+
+func matchesSourceType(sourceTypes []string, sourceType string) bool {
+	sourceType = strings.ToUpper(sourceType)
+	for _, wanted := range sourceTypes {
+		wanted = strings.ToUpper(wanted)
+		if sourceType == wanted || strings.HasPrefix(sourceType, wanted+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
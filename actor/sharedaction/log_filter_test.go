@@ -0,0 +1,86 @@
+package sharedaction_test
+
+import (
+	"regexp"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	var (
+		filter  LogFilter
+		message *uifakes.FakeLogMessage
+	)
+
+	BeforeEach(func() {
+		filter = LogFilter{}
+		message = new(uifakes.FakeLogMessage)
+		message.MessageReturns("GET /health 200")
+		message.TypeReturns("OUT")
+		message.SourceTypeReturns("APP/PROC/WEB")
+		message.SourceInstanceReturns("1")
+	})
+
+	When("the filter is empty", func() {
+		It("matches every message", func() {
+			Expect(filter.Matches(message)).To(BeTrue())
+		})
+	})
+
+	Describe("source types", func() {
+		It("matches the source type and its sub types", func() {
+			filter.SourceTypes = []string{"RTR", "APP"}
+			Expect(filter.Matches(message)).To(BeTrue())
+		})
+
+		It("does not match a source type sharing a prefix", func() {
+			message.SourceTypeReturns("APPLICATION")
+			filter.SourceTypes = []string{"APP"}
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+
+		It("does not match other source types", func() {
+			filter.SourceTypes = []string{"STG", "CELL"}
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("instances", func() {
+		It("matches any of the instances", func() {
+			filter.Instances = []string{"0", "1"}
+			Expect(filter.Matches(message)).To(BeTrue())
+
+			filter.Instances = []string{"2"}
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("message type", func() {
+		It("matches the message type", func() {
+			filter.MessageType = "OUT"
+			Expect(filter.Matches(message)).To(BeTrue())
+
+			filter.MessageType = "ERR"
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("pattern", func() {
+		It("matches the message body", func() {
+			filter.Pattern = regexp.MustCompile(`^GET /health`)
+			Expect(filter.Matches(message)).To(BeTrue())
+
+			filter.Pattern = regexp.MustCompile(`POST`)
+			Expect(filter.Matches(message)).To(BeFalse())
+		})
+	})
+
+	It("requires every criteria to match", func() {
+		filter.SourceTypes = []string{"APP"}
+		filter.MessageType = "ERR"
+		Expect(filter.Matches(message)).To(BeFalse())
+	})
+})
@@ -156,7 +156,16 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			resource.Mode = fixMode(info.Mode())
 		default:
 			// If the file is regular we want to open
-			// and calculate the sha of the file
+			// and calculate the sha of the file, unless
+			// it has not changed since it was cached
+			resource.Mode = fixMode(info.Mode())
+			resource.Size = info.Size()
+
+			if cachedSHA1, found := actor.BitsCache.lookupSHA1(fullPath, info); found {
+				resource.SHA1 = cachedSHA1
+				break
+			}
+
 			file, err := os.Open(fullPath)
 			if err != nil {
 				return err
@@ -169,9 +178,8 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 				return err
 			}
 
-			resource.Mode = fixMode(info.Mode())
 			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
-			resource.Size = info.Size()
+			actor.BitsCache.storeSHA1(fullPath, info, resource.SHA1)
 		}
 
 		resources = append(resources, resource)
@@ -182,6 +190,12 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
+	if walkErr == nil {
+		if err := actor.BitsCache.save(); err != nil {
+			log.Errorln("saving bits cache:", err)
+		}
+	}
+
 	return resources, walkErr
 }
 
@@ -248,7 +262,27 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 // ZipDirectoryResources zips a directory and a sorted (based on full
 // path/filename) list of resources and returns the location. On Windows, the
 // filemode for user is forced to be readable and executable.
+//
+// When the bits cache is enabled, the archive previously built from the same
+// directory and resources is reused instead of being rebuilt.
 func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Resource) (string, error) {
+	if zipPath, found := actor.BitsCache.lookupArchive(sourceDir, filesToInclude); found {
+		return zipPath, nil
+	}
+
+	zipPath, err := actor.zipDirectoryResources(sourceDir, filesToInclude)
+	if err != nil {
+		return zipPath, err
+	}
+
+	if err := actor.BitsCache.storeArchive(sourceDir, filesToInclude, zipPath); err != nil {
+		log.WithField("sourceDir", sourceDir).Errorln("caching archive:", err)
+	}
+
+	return zipPath, nil
+}
+
+func (actor Actor) zipDirectoryResources(sourceDir string, filesToInclude []Resource) (string, error) {
 	log.WithField("sourceDir", sourceDir).Info("zipping source files from directory")
 	zipFile, err := ioutil.TempFile("", "cf-cli-")
 	if err != nil {
@@ -1,13 +1,23 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/sftp"
+)
 
 //go:generate counterfeiter . SecureShellClient
 
 type SecureShellClient interface {
 	Connect(username string, passcode string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
 	Close() error
+	CopyFromRemote(remotePath string, localPath string, recursive bool, progressBar sftp.ProgressBar) error
+	CopyToRemote(localPath string, remotePath string, recursive bool, progressBar sftp.ProgressBar) error
+	DynamicPortForward(addresses []string) error
+	ExecuteCommand(commands []string, stdout io.Writer, stderr io.Writer) error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
 	Wait() error
 }
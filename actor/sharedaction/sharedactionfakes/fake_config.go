@@ -28,6 +28,16 @@ type FakeConfig struct {
 	binaryNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CredentialsErrorStub        func() error
+	credentialsErrorMutex       sync.RWMutex
+	credentialsErrorArgsForCall []struct {
+	}
+	credentialsErrorReturns struct {
+		result1 error
+	}
+	credentialsErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CurrentUserNameStub        func() (string, error)
 	currentUserNameMutex       sync.RWMutex
 	currentUserNameArgsForCall []struct {
@@ -200,6 +210,58 @@ func (fake *FakeConfig) BinaryNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CredentialsError() error {
+	fake.credentialsErrorMutex.Lock()
+	ret, specificReturn := fake.credentialsErrorReturnsOnCall[len(fake.credentialsErrorArgsForCall)]
+	fake.credentialsErrorArgsForCall = append(fake.credentialsErrorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialsError", []interface{}{})
+	fake.credentialsErrorMutex.Unlock()
+	if fake.CredentialsErrorStub != nil {
+		return fake.CredentialsErrorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialsErrorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CredentialsErrorCallCount() int {
+	fake.credentialsErrorMutex.RLock()
+	defer fake.credentialsErrorMutex.RUnlock()
+	return len(fake.credentialsErrorArgsForCall)
+}
+
+func (fake *FakeConfig) CredentialsErrorCalls(stub func() error) {
+	fake.credentialsErrorMutex.Lock()
+	defer fake.credentialsErrorMutex.Unlock()
+	fake.CredentialsErrorStub = stub
+}
+
+func (fake *FakeConfig) CredentialsErrorReturns(result1 error) {
+	fake.credentialsErrorMutex.Lock()
+	defer fake.credentialsErrorMutex.Unlock()
+	fake.CredentialsErrorStub = nil
+	fake.credentialsErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CredentialsErrorReturnsOnCall(i int, result1 error) {
+	fake.credentialsErrorMutex.Lock()
+	defer fake.credentialsErrorMutex.Unlock()
+	fake.CredentialsErrorStub = nil
+	if fake.credentialsErrorReturnsOnCall == nil {
+		fake.credentialsErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.credentialsErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUserName() (string, error) {
 	fake.currentUserNameMutex.Lock()
 	ret, specificReturn := fake.currentUserNameReturnsOnCall[len(fake.currentUserNameArgsForCall)]
@@ -525,6 +587,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.accessTokenMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
+	fake.credentialsErrorMutex.RLock()
+	defer fake.credentialsErrorMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
 	defer fake.currentUserNameMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
@@ -2,10 +2,12 @@
 package sharedactionfakes
 
 import (
+	io "io"
 	sync "sync"
 
 	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
 	clissh "code.cloudfoundry.org/cli/util/clissh"
+	sftp "code.cloudfoundry.org/cli/util/clissh/sftp"
 )
 
 type FakeSecureShellClient struct {
@@ -34,6 +36,58 @@ type FakeSecureShellClient struct {
 	connectReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CopyFromRemoteStub        func(string, string, bool, sftp.ProgressBar) error
+	copyFromRemoteMutex       sync.RWMutex
+	copyFromRemoteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 sftp.ProgressBar
+	}
+	copyFromRemoteReturns struct {
+		result1 error
+	}
+	copyFromRemoteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CopyToRemoteStub        func(string, string, bool, sftp.ProgressBar) error
+	copyToRemoteMutex       sync.RWMutex
+	copyToRemoteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 sftp.ProgressBar
+	}
+	copyToRemoteReturns struct {
+		result1 error
+	}
+	copyToRemoteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func([]string) error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+		arg1 []string
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExecuteCommandStub        func([]string, io.Writer, io.Writer) error
+	executeCommandMutex       sync.RWMutex
+	executeCommandArgsForCall []struct {
+		arg1 []string
+		arg2 io.Writer
+		arg3 io.Writer
+	}
+	executeCommandReturns struct {
+		result1 error
+	}
+	executeCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func([]string, clissh.TTYRequest) error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct {
@@ -57,6 +111,17 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemotePortForwardStub        func([]clissh.RemotePortForward) error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+		arg1 []clissh.RemotePortForward
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct {
@@ -187,6 +252,264 @@ func (fake *FakeSecureShellClient) ConnectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) CopyFromRemote(arg1 string, arg2 string, arg3 bool, arg4 sftp.ProgressBar) error {
+	fake.copyFromRemoteMutex.Lock()
+	ret, specificReturn := fake.copyFromRemoteReturnsOnCall[len(fake.copyFromRemoteArgsForCall)]
+	fake.copyFromRemoteArgsForCall = append(fake.copyFromRemoteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 sftp.ProgressBar
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CopyFromRemote", []interface{}{arg1, arg2, arg3, arg4})
+	fake.copyFromRemoteMutex.Unlock()
+	if fake.CopyFromRemoteStub != nil {
+		return fake.CopyFromRemoteStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.copyFromRemoteReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteCallCount() int {
+	fake.copyFromRemoteMutex.RLock()
+	defer fake.copyFromRemoteMutex.RUnlock()
+	return len(fake.copyFromRemoteArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteCalls(stub func(string, string, bool, sftp.ProgressBar) error) {
+	fake.copyFromRemoteMutex.Lock()
+	defer fake.copyFromRemoteMutex.Unlock()
+	fake.CopyFromRemoteStub = stub
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteArgsForCall(i int) (string, string, bool, sftp.ProgressBar) {
+	fake.copyFromRemoteMutex.RLock()
+	defer fake.copyFromRemoteMutex.RUnlock()
+	argsForCall := fake.copyFromRemoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteReturns(result1 error) {
+	fake.copyFromRemoteMutex.Lock()
+	defer fake.copyFromRemoteMutex.Unlock()
+	fake.CopyFromRemoteStub = nil
+	fake.copyFromRemoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) CopyFromRemoteReturnsOnCall(i int, result1 error) {
+	fake.copyFromRemoteMutex.Lock()
+	defer fake.copyFromRemoteMutex.Unlock()
+	fake.CopyFromRemoteStub = nil
+	if fake.copyFromRemoteReturnsOnCall == nil {
+		fake.copyFromRemoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyFromRemoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) CopyToRemote(arg1 string, arg2 string, arg3 bool, arg4 sftp.ProgressBar) error {
+	fake.copyToRemoteMutex.Lock()
+	ret, specificReturn := fake.copyToRemoteReturnsOnCall[len(fake.copyToRemoteArgsForCall)]
+	fake.copyToRemoteArgsForCall = append(fake.copyToRemoteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 sftp.ProgressBar
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CopyToRemote", []interface{}{arg1, arg2, arg3, arg4})
+	fake.copyToRemoteMutex.Unlock()
+	if fake.CopyToRemoteStub != nil {
+		return fake.CopyToRemoteStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.copyToRemoteReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteCallCount() int {
+	fake.copyToRemoteMutex.RLock()
+	defer fake.copyToRemoteMutex.RUnlock()
+	return len(fake.copyToRemoteArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteCalls(stub func(string, string, bool, sftp.ProgressBar) error) {
+	fake.copyToRemoteMutex.Lock()
+	defer fake.copyToRemoteMutex.Unlock()
+	fake.CopyToRemoteStub = stub
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteArgsForCall(i int) (string, string, bool, sftp.ProgressBar) {
+	fake.copyToRemoteMutex.RLock()
+	defer fake.copyToRemoteMutex.RUnlock()
+	argsForCall := fake.copyToRemoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteReturns(result1 error) {
+	fake.copyToRemoteMutex.Lock()
+	defer fake.copyToRemoteMutex.Unlock()
+	fake.CopyToRemoteStub = nil
+	fake.copyToRemoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) CopyToRemoteReturnsOnCall(i int, result1 error) {
+	fake.copyToRemoteMutex.Lock()
+	defer fake.copyToRemoteMutex.Unlock()
+	fake.CopyToRemoteStub = nil
+	if fake.copyToRemoteReturnsOnCall == nil {
+		fake.copyToRemoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyToRemoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForward(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("DynamicPortForward", []interface{}{arg1Copy})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.dynamicPortForwardReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCalls(stub func([]string) error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = stub
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardArgsForCall(i int) []string {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	argsForCall := fake.dynamicPortForwardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturns(result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) ExecuteCommand(arg1 []string, arg2 io.Writer, arg3 io.Writer) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.executeCommandMutex.Lock()
+	ret, specificReturn := fake.executeCommandReturnsOnCall[len(fake.executeCommandArgsForCall)]
+	fake.executeCommandArgsForCall = append(fake.executeCommandArgsForCall, struct {
+		arg1 []string
+		arg2 io.Writer
+		arg3 io.Writer
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("ExecuteCommand", []interface{}{arg1Copy, arg2, arg3})
+	fake.executeCommandMutex.Unlock()
+	if fake.ExecuteCommandStub != nil {
+		return fake.ExecuteCommandStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeCommandReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) ExecuteCommandCallCount() int {
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
+	return len(fake.executeCommandArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) ExecuteCommandCalls(stub func([]string, io.Writer, io.Writer) error) {
+	fake.executeCommandMutex.Lock()
+	defer fake.executeCommandMutex.Unlock()
+	fake.ExecuteCommandStub = stub
+}
+
+func (fake *FakeSecureShellClient) ExecuteCommandArgsForCall(i int) ([]string, io.Writer, io.Writer) {
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
+	argsForCall := fake.executeCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecureShellClient) ExecuteCommandReturns(result1 error) {
+	fake.executeCommandMutex.Lock()
+	defer fake.executeCommandMutex.Unlock()
+	fake.ExecuteCommandStub = nil
+	fake.executeCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) ExecuteCommandReturnsOnCall(i int, result1 error) {
+	fake.executeCommandMutex.Lock()
+	defer fake.executeCommandMutex.Unlock()
+	fake.ExecuteCommandStub = nil
+	if fake.executeCommandReturnsOnCall == nil {
+		fake.executeCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) InteractiveSession(arg1 []string, arg2 clissh.TTYRequest) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -318,6 +641,71 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RemotePortForward(arg1 []clissh.RemotePortForward) error {
+	var arg1Copy []clissh.RemotePortForward
+	if arg1 != nil {
+		arg1Copy = make([]clissh.RemotePortForward, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+		arg1 []clissh.RemotePortForward
+	}{arg1Copy})
+	fake.recordInvocation("RemotePortForward", []interface{}{arg1Copy})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.remotePortForwardReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCalls(stub func([]clissh.RemotePortForward) error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = stub
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardArgsForCall(i int) []clissh.RemotePortForward {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	argsForCall := fake.remotePortForwardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturns(result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -377,10 +765,20 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
+	fake.copyFromRemoteMutex.RLock()
+	defer fake.copyFromRemoteMutex.RUnlock()
+	fake.copyToRemoteMutex.RLock()
+	defer fake.copyToRemoteMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
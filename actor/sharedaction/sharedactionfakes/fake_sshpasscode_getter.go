@@ -0,0 +1,106 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package sharedactionfakes
+
+import (
+	sync "sync"
+
+	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+type FakeSSHPasscodeGetter struct {
+	GetSSHPasscodeStub        func() (string, error)
+	getSSHPasscodeMutex       sync.RWMutex
+	getSSHPasscodeArgsForCall []struct {
+	}
+	getSSHPasscodeReturns struct {
+		result1 string
+		result2 error
+	}
+	getSSHPasscodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSSHPasscodeGetter) GetSSHPasscode() (string, error) {
+	fake.getSSHPasscodeMutex.Lock()
+	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
+	fake.getSSHPasscodeArgsForCall = append(fake.getSSHPasscodeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetSSHPasscode", []interface{}{})
+	fake.getSSHPasscodeMutex.Unlock()
+	if fake.GetSSHPasscodeStub != nil {
+		return fake.GetSSHPasscodeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getSSHPasscodeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSSHPasscodeGetter) GetSSHPasscodeCallCount() int {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return len(fake.getSSHPasscodeArgsForCall)
+}
+
+func (fake *FakeSSHPasscodeGetter) GetSSHPasscodeCalls(stub func() (string, error)) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = stub
+}
+
+func (fake *FakeSSHPasscodeGetter) GetSSHPasscodeReturns(result1 string, result2 error) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = nil
+	fake.getSSHPasscodeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSSHPasscodeGetter) GetSSHPasscodeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = nil
+	if fake.getSSHPasscodeReturnsOnCall == nil {
+		fake.getSSHPasscodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getSSHPasscodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSSHPasscodeGetter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSSHPasscodeGetter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sharedaction.SSHPasscodeGetter = new(FakeSSHPasscodeGetter)
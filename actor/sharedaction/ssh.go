@@ -1,6 +1,12 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/fanout"
+	"code.cloudfoundry.org/cli/util/clissh/sftp"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,16 +19,20 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                    []string
+	Username                    string
+	Passcode                    string
+	Endpoint                    string
+	HostKeyFingerprint          string
+	SkipHostValidation          bool
+	SkipRemoteExecution         bool
+	TTYOption                   TTYOption
+	LocalPortForwardSpecs       []LocalPortForward
+	RemotePortForwardSpecs      []RemotePortForward
+	DynamicPortForwardAddresses []string
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +47,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(sshOptions.DynamicPortForwardAddresses)
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -45,6 +65,86 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+//go:generate counterfeiter . SSHPasscodeGetter
+
+// SSHPasscodeGetter gets one-time passcodes for connecting to application
+// instances.
+type SSHPasscodeGetter interface {
+	GetSSHPasscode() (string, error)
+}
+
+// SSHInstance is an application instance to run a command on.
+type SSHInstance struct {
+	Index    int
+	Username string
+}
+
+// SSHInstanceResult is the outcome of running a command on an application
+// instance.
+type SSHInstanceResult fanout.Result
+
+// ExecuteSecureShellOnInstances runs sshOptions.Commands on each instance,
+// with at most maxInFlight connections open at the same time. Each line of
+// output is prefixed with the index of the instance it came from. A new
+// passcode is requested just before connecting to each instance, because
+// passcodes can only be used once. The results are returned in the order of
+// instances.
+func (actor Actor) ExecuteSecureShellOnInstances(newSSHClient func() SecureShellClient, passcodeGetter SSHPasscodeGetter, sshOptions SSHOptions, instances []SSHInstance, maxInFlight int, stdout io.Writer, stderr io.Writer) []SSHInstanceResult {
+	usernames := map[int]string{}
+	var indexes []int
+	for _, instance := range instances {
+		usernames[instance.Index] = instance.Username
+		indexes = append(indexes, instance.Index)
+	}
+
+	results := fanout.Run(indexes, maxInFlight, stdout, stderr, func(index int, instanceStdout io.Writer, instanceStderr io.Writer) error {
+		passcode, err := passcodeGetter.GetSSHPasscode()
+		if err != nil {
+			return err
+		}
+
+		sshClient := newSSHClient()
+		err = sshClient.Connect(usernames[index], passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+		if err != nil {
+			return err
+		}
+		defer sshClient.Close()
+
+		return sshClient.ExecuteCommand(sshOptions.Commands, instanceStdout, instanceStderr)
+	})
+
+	var instanceResults []SSHInstanceResult
+	for _, result := range results {
+		instanceResults = append(instanceResults, SSHInstanceResult(result))
+	}
+	return instanceResults
+}
+
+// SecureCopyOptions describes a copy between the local machine and an
+// application instance.
+type SecureCopyOptions struct {
+	Source      string
+	Destination string
+	ToRemote    bool
+	Recursive   bool
+}
+
+// ExecuteSecureCopy connects to the application instance and copies the
+// source to the destination. Progress is not displayed when progressBar is
+// nil.
+func (actor Actor) ExecuteSecureCopy(sshClient SecureShellClient, sshOptions SSHOptions, copyOptions SecureCopyOptions, progressBar sftp.ProgressBar) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if copyOptions.ToRemote {
+		return sshClient.CopyToRemote(copyOptions.Source, copyOptions.Destination, copyOptions.Recursive, progressBar)
+	}
+	return sshClient.CopyFromRemote(copyOptions.Source, copyOptions.Destination, copyOptions.Recursive, progressBar)
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -54,3 +154,13 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
@@ -2,12 +2,15 @@ package sharedaction_test
 
 import (
 	"errors"
+	"io"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/sftp/sftpfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("SSH Actions", func() {
@@ -73,6 +76,10 @@ var _ = Describe("SSH Actions", func() {
 					{LocalAddress: "local-address-1", RemoteAddress: "remote-address-1"},
 					{LocalAddress: "local-address-2", RemoteAddress: "remote-address-2"},
 				}
+				sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+					{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+				}
+				sshOptions.DynamicPortForwardAddresses = []string{"local-address-4"}
 			})
 
 			AfterEach(func() {
@@ -96,6 +103,43 @@ var _ = Describe("SSH Actions", func() {
 
 				It("returns the error", func() {
 					Expect(executeErr).To(MatchError("some-forwarding-error"))
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			It("forwards the remote ports", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+					[]clissh.RemotePortForward{
+						{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+					},
+				))
+			})
+
+			When("remote port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			It("starts dynamic forwarding on the local addresses", func() {
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal([]string{"local-address-4"}))
+			})
+
+			When("dynamic port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+					Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
 				})
 			})
 
@@ -163,4 +207,185 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureShellOnInstances", func() {
+		var (
+			fakePasscodeGetter *sharedactionfakes.FakeSSHPasscodeGetter
+			sshOptions         SSHOptions
+			instances          []SSHInstance
+			stdout, stderr     *Buffer
+
+			results []SSHInstanceResult
+		)
+
+		BeforeEach(func() {
+			fakePasscodeGetter = new(sharedactionfakes.FakeSSHPasscodeGetter)
+			fakePasscodeGetter.GetSSHPasscodeReturns("some-passcode", nil)
+
+			sshOptions = SSHOptions{
+				Commands:           []string{"uptime"},
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+			}
+			instances = []SSHInstance{
+				{Index: 0, Username: "cf:some-process-guid/0"},
+				{Index: 2, Username: "cf:some-process-guid/2"},
+			}
+			stdout = NewBuffer()
+			stderr = NewBuffer()
+
+			fakeSecureShellClient.ExecuteCommandStub = func(_ []string, out io.Writer, _ io.Writer) error {
+				_, err := io.WriteString(out, "up 3 days\n")
+				return err
+			}
+		})
+
+		JustBeforeEach(func() {
+			newSSHClient := func() SecureShellClient { return fakeSecureShellClient }
+			results = actor.ExecuteSecureShellOnInstances(newSSHClient, fakePasscodeGetter, sshOptions, instances, 1, stdout, stderr)
+		})
+
+		It("connects to each instance with a new passcode and runs the command", func() {
+			Expect(fakePasscodeGetter.GetSSHPasscodeCallCount()).To(Equal(2))
+
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(2))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("cf:some-process-guid/0"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+			usernameArg, _, _, _, _ = fakeSecureShellClient.ConnectArgsForCall(1)
+			Expect(usernameArg).To(Equal("cf:some-process-guid/2"))
+
+			Expect(fakeSecureShellClient.ExecuteCommandCallCount()).To(Equal(2))
+			commandsArg, _, _ := fakeSecureShellClient.ExecuteCommandArgsForCall(0)
+			Expect(commandsArg).To(Equal([]string{"uptime"}))
+
+			Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(2))
+		})
+
+		It("prefixes the output with the instance index", func() {
+			Expect(stdout).To(Say(`\[0\] up 3 days`))
+			Expect(stdout).To(Say(`\[2\] up 3 days`))
+		})
+
+		It("returns a result for each instance", func() {
+			Expect(results).To(Equal([]SSHInstanceResult{{Index: 0}, {Index: 2}}))
+		})
+
+		When("getting a passcode fails", func() {
+			BeforeEach(func() {
+				fakePasscodeGetter.GetSSHPasscodeReturnsOnCall(1, "", errors.New("uaa is down"))
+			})
+
+			It("returns the error for that instance", func() {
+				Expect(results).To(Equal([]SSHInstanceResult{
+					{Index: 0},
+					{Index: 2, Err: errors.New("uaa is down")},
+				}))
+				Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			})
+		})
+
+		When("connecting to an instance fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturnsOnCall(0, errors.New("some-connect-error"))
+			})
+
+			It("returns the error for that instance and does not close it", func() {
+				Expect(results).To(Equal([]SSHInstanceResult{
+					{Index: 0, Err: errors.New("some-connect-error")},
+					{Index: 2},
+				}))
+				Expect(fakeSecureShellClient.ExecuteCommandCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			sshOptions      SSHOptions
+			copyOptions     SecureCopyOptions
+			fakeProgressBar *sftpfakes.FakeProgressBar
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+			}
+			copyOptions = SecureCopyOptions{
+				Source:      "some-source",
+				Destination: "some-destination",
+				Recursive:   true,
+			}
+			fakeProgressBar = new(sftpfakes.FakeProgressBar)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(fakeSecureShellClient, sshOptions, copyOptions, fakeProgressBar)
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("copying from the application instance", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.CopyFromRemoteReturns(errors.New("some-copy-error"))
+			})
+
+			It("connects, copies from the remote path and closes the connection", func() {
+				Expect(executeErr).To(MatchError("some-copy-error"))
+
+				usernameArg, passcodeArg, endpointArg, fingerprintArg, _ := fakeSecureShellClient.ConnectArgsForCall(0)
+				Expect(usernameArg).To(Equal("some-user"))
+				Expect(passcodeArg).To(Equal("some-passcode"))
+				Expect(endpointArg).To(Equal("some-endpoint"))
+				Expect(fingerprintArg).To(Equal("some-fingerprint"))
+
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(1))
+				remotePath, localPath, recursive, progressBar := fakeSecureShellClient.CopyFromRemoteArgsForCall(0)
+				Expect(remotePath).To(Equal("some-source"))
+				Expect(localPath).To(Equal("some-destination"))
+				Expect(recursive).To(BeTrue())
+				Expect(progressBar).To(Equal(fakeProgressBar))
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(0))
+
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("copying to the application instance", func() {
+			BeforeEach(func() {
+				copyOptions.ToRemote = true
+			})
+
+			It("copies to the remote path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(1))
+				localPath, remotePath, recursive, _ := fakeSecureShellClient.CopyToRemoteArgsForCall(0)
+				Expect(localPath).To(Equal("some-source"))
+				Expect(remotePath).To(Equal("some-destination"))
+				Expect(recursive).To(BeTrue())
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
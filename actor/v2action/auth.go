@@ -11,10 +11,13 @@ import (
 // Authenticate authenticates the user in UAA and sets the returned tokens in
 // the config.
 //
+// For the jwt-bearer grant type, secret is the signed identity token to
+// exchange and ID is not used.
+//
 // It unsets the currently targeted org and space whether authentication
 // succeeds or not.
 func (actor Actor) Authenticate(ID string, secret string, origin string, grantType constant.GrantType) error {
-	if grantType == constant.GrantTypePassword && actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+	if grantType != constant.GrantTypeClientCredentials && actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
 		return actionerror.PasswordGrantTypeLogoutRequiredError{}
 	}
 
@@ -27,6 +30,8 @@ func (actor Actor) Authenticate(ID string, secret string, origin string, grantTy
 	} else if grantType == constant.GrantTypeClientCredentials {
 		credentials["client_id"] = ID
 		credentials["client_secret"] = secret
+	} else if grantType == constant.GrantTypeJWTBearer {
+		credentials["assertion"] = secret
 	}
 
 	accessToken, refreshToken, err := actor.UAAClient.Authenticate(credentials, origin, grantType)
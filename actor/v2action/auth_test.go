@@ -89,6 +89,37 @@ var _ = Describe("Auth Actions", func() {
 				})
 			})
 
+			When("the grant type is jwt-bearer", func() {
+				BeforeEach(func() {
+					grantType = constant.GrantTypeJWTBearer
+				})
+
+				It("exchanges the assertion for access and refresh tokens", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+					creds, _, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+					Expect(creds).To(Equal(map[string]string{
+						"assertion": "some-password",
+					}))
+					Expect(passedGrantType).To(Equal(constant.GrantTypeJWTBearer))
+
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("urn:ietf:params:oauth:grant-type:jwt-bearer"))
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				})
+
+				When("a previous user authenticated with a client grant type", func() {
+					BeforeEach(func() {
+						fakeConfig.UAAGrantTypeReturns("client_credentials")
+					})
+
+					It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+						Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+						Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("the grant type is client credentials", func() {
 				BeforeEach(func() {
 					grantType = constant.GrantTypeClientCredentials
@@ -0,0 +1,225 @@
+package v2action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// DefaultEventLimit is the number of events that are returned when no time
+// window is given.
+const DefaultEventLimit = 50
+
+// Event represents a Cloud Controller audit event.
+type Event ccv2.Event
+
+// EventFilter narrows down the events returned by GetEvents and PollEvents.
+// Zero valued fields are ignored.
+type EventFilter struct {
+	// OrganizationGUID only includes events that happened in the organization.
+	OrganizationGUID string
+
+	// SpaceGUID only includes events that happened in the space.
+	SpaceGUID string
+
+	// ActeeGUID only includes events about the given resource, such as an
+	// application.
+	ActeeGUID string
+
+	// Types only includes events with one of the given types, such as
+	// audit.app.update.
+	Types []string
+
+	// Actor only includes events initiated by an actor with the given name or
+	// GUID.
+	Actor string
+
+	// Since only includes events that happened at or after the given time.
+	Since time.Time
+
+	// Until only includes events that happened before the given time.
+	Until time.Time
+
+	// Limit only includes the given number of most recent events.
+	Limit int
+}
+
+// GetEvents returns the events matching the filter, oldest first.
+func (actor Actor) GetEvents(filter EventFilter) ([]Event, Warnings, error) {
+	var (
+		ccEvents []ccv2.Event
+		warnings ccv2.Warnings
+		err      error
+	)
+	if filter.Limit > 0 {
+		ccEvents, warnings, err = actor.CloudControllerClient.GetNewestEvents(filter.Limit, func(event ccv2.Event) bool {
+			return filter.matchesActor(Event(event))
+		}, filter.ccFilters()...)
+	} else {
+		ccEvents, warnings, err = actor.CloudControllerClient.GetEvents(filter.ccFilters()...)
+	}
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		event := Event(ccEvent)
+		if filter.matchesActor(event) {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i int, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+
+	return events, Warnings(warnings), nil
+}
+
+// PollEvents sends the events matching the filter, oldest first, and then
+// polls for new events every polling interval until stop is closed or an
+// error occurs. When filter.Since is zero, only the events that happen after
+// the most recent existing event are sent. Filter.Until and filter.Limit are
+// ignored. All returned channels are closed when polling stops.
+func (actor Actor) PollEvents(filter EventFilter, stop <-chan bool) (<-chan Event, <-chan string, <-chan error) {
+	events := make(chan Event)
+	allWarnings := make(chan string)
+	errs := make(chan error)
+
+	go func() {
+		defer close(events)
+		defer close(allWarnings)
+		defer close(errs)
+
+		filter.Until = time.Time{}
+		filter.Limit = 0
+		seen := map[string]bool{}
+
+		sendWarningsAndError := func(warnings Warnings, err error) bool {
+			for _, warning := range warnings {
+				select {
+				case allWarnings <- warning:
+				case <-stop:
+					return false
+				}
+			}
+			if err != nil {
+				select {
+				case errs <- err:
+				case <-stop:
+				}
+				return false
+			}
+			return true
+		}
+
+		if filter.Since.IsZero() {
+			// The most recent events of every actor mark where polling starts, so
+			// that finding them does not page through the whole history when the
+			// actor has no recent events.
+			latestFilter := filter
+			latestFilter.Actor = ""
+			latestFilter.Limit = DefaultEventLimit
+			latestEvents, warnings, err := actor.GetEvents(latestFilter)
+			if !sendWarningsAndError(warnings, err) {
+				return
+			}
+			for _, event := range latestEvents {
+				if event.Timestamp.After(filter.Since) {
+					filter.Since = event.Timestamp
+					seen = map[string]bool{}
+				}
+				if event.Timestamp.Equal(filter.Since) {
+					seen[event.GUID] = true
+				}
+			}
+		}
+
+		for {
+			newEvents, warnings, err := actor.GetEvents(filter)
+			if !sendWarningsAndError(warnings, err) {
+				return
+			}
+
+			for _, event := range newEvents {
+				if seen[event.GUID] {
+					continue
+				}
+
+				// Events are fetched again from the newest timestamp seen so far, so
+				// only the GUIDs with that timestamp need to be remembered.
+				if event.Timestamp.After(filter.Since) {
+					filter.Since = event.Timestamp
+					seen = map[string]bool{}
+				}
+				seen[event.GUID] = true
+
+				select {
+				case events <- event:
+				case <-stop:
+					return
+				}
+			}
+
+			select {
+			case <-time.After(actor.Config.PollingInterval()):
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return events, allWarnings, errs
+}
+
+func (filter EventFilter) ccFilters() []ccv2.Filter {
+	var filters []ccv2.Filter
+	if filter.OrganizationGUID != "" {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.OrganizationGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{filter.OrganizationGUID},
+		})
+	}
+	if filter.SpaceGUID != "" {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.SpaceGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{filter.SpaceGUID},
+		})
+	}
+	if filter.ActeeGUID != "" {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.ActeeFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{filter.ActeeGUID},
+		})
+	}
+	if len(filter.Types) > 0 {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.TypeFilter,
+			Operator: constant.InOperator,
+			Values:   filter.Types,
+		})
+	}
+	if !filter.Since.IsZero() {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.TimestampFilter,
+			Operator: constant.GreaterThanOrEqualOperator,
+			Values:   []string{filter.Since.UTC().Format(time.RFC3339)},
+		})
+	}
+	if !filter.Until.IsZero() {
+		filters = append(filters, ccv2.Filter{
+			Type:     constant.TimestampFilter,
+			Operator: constant.LessThanOperator,
+			Values:   []string{filter.Until.UTC().Format(time.RFC3339)},
+		})
+	}
+	return filters
+}
+
+func (filter EventFilter) matchesActor(event Event) bool {
+	return filter.Actor == "" || filter.Actor == event.ActorName || filter.Actor == event.ActorGUID
+}
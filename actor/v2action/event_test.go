@@ -0,0 +1,242 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+		since                     time.Time
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, fakeConfig = NewTestActor()
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		since = time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+	})
+
+	Describe("GetEvents", func() {
+		var (
+			filter     EventFilter
+			events     []Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = EventFilter{
+				SpaceGUID: "some-space-guid",
+				Types:     []string{"audit.app.crash", "audit.app.update"},
+				Since:     since,
+				Until:     since.Add(time.Hour),
+			}
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetEvents(filter)
+		})
+
+		When("getting the events succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv2.Event{
+						{GUID: "event-2", ActorName: "bob", Timestamp: since.Add(2 * time.Minute)},
+						{GUID: "event-1", ActorName: "alice", Timestamp: since.Add(time.Minute)},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("queries the events with the filter and returns them oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]Event{
+					{GUID: "event-1", ActorName: "alice", Timestamp: since.Add(time.Minute)},
+					{GUID: "event-2", ActorName: "bob", Timestamp: since.Add(2 * time.Minute)},
+				}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+					ccv2.Filter{Type: constant.TypeFilter, Operator: constant.InOperator, Values: []string{"audit.app.crash", "audit.app.update"}},
+					ccv2.Filter{Type: constant.TimestampFilter, Operator: constant.GreaterThanOrEqualOperator, Values: []string{"2019-03-01T10:00:00Z"}},
+					ccv2.Filter{Type: constant.TimestampFilter, Operator: constant.LessThanOperator, Values: []string{"2019-03-01T11:00:00Z"}},
+				))
+			})
+
+			When("an actor is provided", func() {
+				BeforeEach(func() {
+					filter = EventFilter{OrganizationGUID: "some-org-guid", Actor: "bob"}
+				})
+
+				It("only returns the events initiated by that actor", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(events).To(Equal([]Event{
+						{GUID: "event-2", ActorName: "bob", Timestamp: since.Add(2 * time.Minute)},
+					}))
+					Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+						ccv2.Filter{Type: constant.OrganizationGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-org-guid"}},
+					))
+				})
+			})
+		})
+
+		When("a limit is provided", func() {
+			BeforeEach(func() {
+				filter = EventFilter{SpaceGUID: "some-space-guid", Limit: 2}
+				fakeCloudControllerClient.GetNewestEventsReturns(
+					[]ccv2.Event{
+						{GUID: "event-3", Timestamp: since.Add(3 * time.Minute)},
+						{GUID: "event-2", Timestamp: since.Add(2 * time.Minute)},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("only queries the most recent events and returns them oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]Event{
+					{GUID: "event-2", Timestamp: since.Add(2 * time.Minute)},
+					{GUID: "event-3", Timestamp: since.Add(3 * time.Minute)},
+				}))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(0))
+				limit, include, filters := fakeCloudControllerClient.GetNewestEventsArgsForCall(0)
+				Expect(limit).To(Equal(2))
+				Expect(include(ccv2.Event{ActorName: "anyone"})).To(BeTrue())
+				Expect(filters).To(ConsistOf(
+					ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+				))
+			})
+
+			When("an actor is also provided", func() {
+				BeforeEach(func() {
+					filter.Actor = "bob"
+				})
+
+				It("applies the limit to the events of the actor", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					limit, include, _ := fakeCloudControllerClient.GetNewestEventsArgsForCall(0)
+					Expect(limit).To(Equal(2))
+					Expect(include(ccv2.Event{ActorName: "bob"})).To(BeTrue())
+					Expect(include(ccv2.Event{ActorGUID: "bob"})).To(BeTrue())
+					Expect(include(ccv2.Event{ActorName: "alice"})).To(BeFalse())
+				})
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"warning-1"}, errors.New("get-events-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("get-events-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("PollEvents", func() {
+		var (
+			stop   chan bool
+			events <-chan Event
+			warns  <-chan string
+			errs   <-chan error
+		)
+
+		BeforeEach(func() {
+			stop = make(chan bool)
+		})
+
+		AfterEach(func() {
+			close(stop)
+		})
+
+		It("sends each new event once and polls from the newest timestamp", func() {
+			fakeCloudControllerClient.GetEventsReturnsOnCall(0,
+				[]ccv2.Event{{GUID: "event-1", Timestamp: since}},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetEventsReturnsOnCall(1,
+				[]ccv2.Event{{GUID: "event-1", Timestamp: since}, {GUID: "event-2", Timestamp: since.Add(time.Minute)}},
+				nil,
+				nil,
+			)
+			fakeCloudControllerClient.GetEventsReturnsOnCall(2, nil, nil, errors.New("poll-error"))
+
+			events, warns, errs = actor.PollEvents(EventFilter{SpaceGUID: "some-space-guid", Until: since}, stop)
+
+			Eventually(warns).Should(Receive(Equal("warning-1")))
+			Eventually(events).Should(Receive(Equal(Event{GUID: "event-1", Timestamp: since})))
+			Eventually(events).Should(Receive(Equal(Event{GUID: "event-2", Timestamp: since.Add(time.Minute)})))
+			Eventually(errs).Should(Receive(MatchError("poll-error")))
+			Eventually(events).Should(BeClosed())
+
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(2)).To(ConsistOf(
+				ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+				ccv2.Filter{Type: constant.TimestampFilter, Operator: constant.GreaterThanOrEqualOperator, Values: []string{"2019-03-01T10:01:00Z"}},
+			))
+		})
+
+		When("no start time is given", func() {
+			It("only sends the events after the most recent existing event", func() {
+				fakeCloudControllerClient.GetNewestEventsReturns(
+					[]ccv2.Event{
+						{GUID: "old-2", ActorName: "alice", Timestamp: since.Add(time.Minute)},
+						{GUID: "old-1", ActorName: "bob", Timestamp: since},
+					},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetEventsReturnsOnCall(0,
+					[]ccv2.Event{
+						{GUID: "old-2", ActorName: "alice", Timestamp: since.Add(time.Minute)},
+						{GUID: "new-1", ActorName: "bob", Timestamp: since.Add(time.Minute)},
+						{GUID: "new-2", ActorName: "bob", Timestamp: since.Add(2 * time.Minute)},
+					},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetEventsReturnsOnCall(1, nil, nil, errors.New("poll-error"))
+
+				events, warns, errs = actor.PollEvents(EventFilter{SpaceGUID: "some-space-guid", Actor: "bob"}, stop)
+
+				Eventually(warns).Should(Receive(Equal("warning-1")))
+				Eventually(events).Should(Receive(Equal(Event{GUID: "new-1", ActorName: "bob", Timestamp: since.Add(time.Minute)})))
+				Eventually(events).Should(Receive(Equal(Event{GUID: "new-2", ActorName: "bob", Timestamp: since.Add(2 * time.Minute)})))
+				Eventually(errs).Should(Receive(MatchError("poll-error")))
+				Eventually(events).Should(BeClosed())
+
+				limit, _, filters := fakeCloudControllerClient.GetNewestEventsArgsForCall(0)
+				Expect(limit).To(Equal(DefaultEventLimit))
+				Expect(filters).To(ConsistOf(
+					ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv2.Filter{Type: constant.SpaceGUIDFilter, Operator: constant.EqualOperator, Values: []string{"some-space-guid"}},
+					ccv2.Filter{Type: constant.TimestampFilter, Operator: constant.GreaterThanOrEqualOperator, Values: []string{"2019-03-01T10:01:00Z"}},
+				))
+			})
+		})
+	})
+})
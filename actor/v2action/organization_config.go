@@ -0,0 +1,638 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// OrganizationConfig is the configuration of an organization and its spaces.
+// Nil or empty values are left untouched when the configuration is applied.
+// FeatureFlags apply to the whole foundation rather than the organization, so
+// callers must opt in before exporting or applying them.
+type OrganizationConfig struct {
+	Name                    string                   `json:"name" yaml:"name"`
+	Quota                   *OrganizationQuotaConfig `json:"quota,omitempty" yaml:"quota,omitempty"`
+	FeatureFlags            map[string]bool          `json:"feature_flags,omitempty" yaml:"feature_flags,omitempty"`
+	IsolationSegments       []string                 `json:"isolation_segments,omitempty" yaml:"isolation_segments,omitempty"`
+	DefaultIsolationSegment string                   `json:"default_isolation_segment,omitempty" yaml:"default_isolation_segment,omitempty"`
+	Managers                []string                 `json:"managers,omitempty" yaml:"managers,omitempty"`
+	BillingManagers         []string                 `json:"billing_managers,omitempty" yaml:"billing_managers,omitempty"`
+	Auditors                []string                 `json:"auditors,omitempty" yaml:"auditors,omitempty"`
+	Users                   []string                 `json:"users,omitempty" yaml:"users,omitempty"`
+	SpaceQuotas             []QuotaConfig            `json:"space_quotas,omitempty" yaml:"space_quotas,omitempty"`
+	Spaces                  []SpaceConfig            `json:"spaces,omitempty" yaml:"spaces,omitempty"`
+}
+
+// QuotaConfig is the configuration of a quota. A limit of -1 means
+// unlimited.
+type QuotaConfig struct {
+	Name                    string `json:"name" yaml:"name"`
+	MemoryLimit             int    `json:"memory_limit" yaml:"memory_limit"`
+	InstanceMemoryLimit     int    `json:"instance_memory_limit" yaml:"instance_memory_limit"`
+	AppInstanceLimit        int    `json:"app_instance_limit" yaml:"app_instance_limit"`
+	AppTaskLimit            int    `json:"app_task_limit" yaml:"app_task_limit"`
+	TotalServices           int    `json:"total_services" yaml:"total_services"`
+	TotalServiceKeys        int    `json:"total_service_keys" yaml:"total_service_keys"`
+	TotalRoutes             int    `json:"total_routes" yaml:"total_routes"`
+	TotalReservedRoutePorts int    `json:"total_reserved_route_ports" yaml:"total_reserved_route_ports"`
+	NonBasicServicesAllowed bool   `json:"non_basic_services_allowed" yaml:"non_basic_services_allowed"`
+}
+
+// OrganizationQuotaConfig is the configuration of an organization quota.
+type OrganizationQuotaConfig struct {
+	QuotaConfig         `yaml:",inline"`
+	TotalPrivateDomains int `json:"total_private_domains" yaml:"total_private_domains"`
+}
+
+// SpaceConfig is the configuration of a space.
+type SpaceConfig struct {
+	Name                  string   `json:"name" yaml:"name"`
+	AllowSSH              *bool    `json:"allow_ssh,omitempty" yaml:"allow_ssh,omitempty"`
+	SpaceQuota            string   `json:"space_quota,omitempty" yaml:"space_quota,omitempty"`
+	IsolationSegment      string   `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Managers              []string `json:"managers,omitempty" yaml:"managers,omitempty"`
+	Developers            []string `json:"developers,omitempty" yaml:"developers,omitempty"`
+	Auditors              []string `json:"auditors,omitempty" yaml:"auditors,omitempty"`
+	RunningSecurityGroups []string `json:"running_security_groups,omitempty" yaml:"running_security_groups,omitempty"`
+	StagingSecurityGroups []string `json:"staging_security_groups,omitempty" yaml:"staging_security_groups,omitempty"`
+}
+
+// ConfigChangeAction is what applying a configuration did to a resource.
+type ConfigChangeAction string
+
+const (
+	ConfigCreated   ConfigChangeAction = "created"
+	ConfigUpdated   ConfigChangeAction = "updated"
+	ConfigUnchanged ConfigChangeAction = "unchanged"
+)
+
+// ConfigChange reports the outcome of applying a configuration to a single
+// resource.
+type ConfigChange struct {
+	Resource string
+	Name     string
+	Action   ConfigChangeAction
+}
+
+// GetOrganizationConfig returns the configuration of the organization with
+// the given name. Isolation segments are not part of the V2 API and are left
+// empty.
+func (actor Actor) GetOrganizationConfig(orgName string) (OrganizationConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationConfig{}, allWarnings, err
+	}
+
+	config := OrganizationConfig{Name: org.Name}
+
+	if org.QuotaDefinitionGUID != "" {
+		quota, ccWarnings, err := actor.CloudControllerClient.GetOrganizationQuota(org.QuotaDefinitionGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return OrganizationConfig{}, allWarnings, err
+		}
+		config.Quota = &OrganizationQuotaConfig{
+			QuotaConfig:         newQuotaConfig(quota.Name, quota.QuotaLimits),
+			TotalPrivateDomains: quota.TotalPrivateDomains,
+		}
+	}
+
+	for role, usernames := range map[constant.OrganizationRole]*[]string{
+		constant.OrgManager:        &config.Managers,
+		constant.OrgBillingManager: &config.BillingManagers,
+		constant.OrgAuditor:        &config.Auditors,
+		constant.OrgUser:           &config.Users,
+	} {
+		users, ccWarnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role, org.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return OrganizationConfig{}, allWarnings, err
+		}
+		*usernames = sortedUsernames(users)
+	}
+
+	spaceQuotas, ccWarnings, err := actor.CloudControllerClient.GetSpaceQuotas(org.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrganizationConfig{}, allWarnings, err
+	}
+	spaceQuotaNames := map[string]string{}
+	for _, spaceQuota := range spaceQuotas {
+		spaceQuotaNames[spaceQuota.GUID] = spaceQuota.Name
+		config.SpaceQuotas = append(config.SpaceQuotas, newQuotaConfig(spaceQuota.Name, spaceQuota.QuotaLimits))
+	}
+	sort.Slice(config.SpaceQuotas, func(i, j int) bool { return config.SpaceQuotas[i].Name < config.SpaceQuotas[j].Name })
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationConfig{}, allWarnings, err
+	}
+	for _, space := range spaces {
+		spaceConfig, warnings, err := actor.getSpaceConfig(space, spaceQuotaNames)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return OrganizationConfig{}, allWarnings, err
+		}
+		config.Spaces = append(config.Spaces, spaceConfig)
+	}
+	sort.Slice(config.Spaces, func(i, j int) bool { return config.Spaces[i].Name < config.Spaces[j].Name })
+
+	return config, allWarnings, nil
+}
+
+// ApplyOrganizationConfig converges the organization, its quotas, roles,
+// spaces and security group bindings to the given configuration. Feature
+// flags in the configuration are applied to the whole foundation. Resources
+// and roles that are not part of the configuration are left untouched, so
+// applying the same configuration again does not change anything.
+// Isolation segments are not part of the V2 API and are ignored.
+func (actor Actor) ApplyOrganizationConfig(config OrganizationConfig) (Organization, []ConfigChange, Warnings, error) {
+	var (
+		allWarnings Warnings
+		changes     []ConfigChange
+	)
+
+	quotaName := ""
+	if config.Quota != nil {
+		quotaName = config.Quota.Name
+		change, warnings, err := actor.applyOrganizationQuotaConfig(*config.Quota)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Organization{}, changes, allWarnings, err
+		}
+		changes = append(changes, change)
+	}
+
+	org, change, warnings, err := actor.applyOrganization(config.Name, quotaName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, changes, allWarnings, err
+	}
+	changes = append(changes, change)
+
+	featureFlagChanges, warnings, err := actor.applyFeatureFlags(config.FeatureFlags)
+	changes = append(changes, featureFlagChanges...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, changes, allWarnings, err
+	}
+
+	roleChanges, warnings, err := actor.applyOrganizationRoles(org.GUID, config)
+	changes = append(changes, roleChanges...)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Organization{}, changes, allWarnings, err
+	}
+
+	for _, quotaConfig := range config.SpaceQuotas {
+		change, warnings, err := actor.applySpaceQuotaConfig(org.GUID, quotaConfig)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Organization{}, changes, allWarnings, err
+		}
+		changes = append(changes, change)
+	}
+
+	for _, spaceConfig := range config.Spaces {
+		spaceChanges, warnings, err := actor.applySpaceConfig(org.GUID, spaceConfig)
+		changes = append(changes, spaceChanges...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Organization{}, changes, allWarnings, err
+		}
+	}
+
+	return org, changes, allWarnings, nil
+}
+
+func (actor Actor) getSpaceConfig(space Space, spaceQuotaNames map[string]string) (SpaceConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	allowSSH := space.AllowSSH
+	config := SpaceConfig{
+		Name:       space.Name,
+		AllowSSH:   &allowSSH,
+		SpaceQuota: spaceQuotaNames[space.SpaceQuotaDefinitionGUID],
+	}
+
+	for role, usernames := range map[constant.SpaceRole]*[]string{
+		constant.SpaceManager:   &config.Managers,
+		constant.SpaceDeveloper: &config.Developers,
+		constant.SpaceAuditor:   &config.Auditors,
+	} {
+		users, ccWarnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role, space.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return SpaceConfig{}, allWarnings, err
+		}
+		*usernames = sortedUsernames(users)
+	}
+
+	runningGroups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfig{}, allWarnings, err
+	}
+	config.RunningSecurityGroups = securityGroupNames(runningGroups)
+
+	stagingGroups, warnings, err := actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfig{}, allWarnings, err
+	}
+	config.StagingSecurityGroups = securityGroupNames(stagingGroups)
+
+	return config, allWarnings, nil
+}
+
+func (actor Actor) applyOrganizationQuotaConfig(config OrganizationQuotaConfig) (ConfigChange, Warnings, error) {
+	change := ConfigChange{Resource: "org quota", Name: config.Name}
+	desired := ccv2.OrganizationQuota{
+		Name:                config.Name,
+		TotalPrivateDomains: config.TotalPrivateDomains,
+		QuotaLimits:         config.QuotaConfig.limits(),
+	}
+
+	quota, warnings, err := actor.GetOrganizationQuotaByName(config.Name)
+	if _, ok := err.(actionerror.OrganizationQuotaNotFoundForNameError); ok {
+		_, ccWarnings, err := actor.CloudControllerClient.CreateOrganizationQuota(desired)
+		change.Action = ConfigCreated
+		return change, append(warnings, ccWarnings...), err
+	}
+	if err != nil {
+		return change, warnings, err
+	}
+
+	if quota.TotalPrivateDomains == desired.TotalPrivateDomains && quota.QuotaLimits == desired.QuotaLimits {
+		change.Action = ConfigUnchanged
+		return change, warnings, nil
+	}
+
+	desired.GUID = quota.GUID
+	_, ccWarnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(desired)
+	change.Action = ConfigUpdated
+	return change, append(warnings, ccWarnings...), err
+}
+
+func (actor Actor) applyOrganization(orgName string, quotaName string) (Organization, ConfigChange, Warnings, error) {
+	change := ConfigChange{Resource: "org", Name: orgName}
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if _, ok := err.(actionerror.OrganizationNotFoundError); ok {
+		org, createWarnings, err := actor.CreateOrganization(orgName, quotaName)
+		change.Action = ConfigCreated
+		return org, change, append(warnings, createWarnings...), err
+	}
+	if err != nil {
+		return Organization{}, change, warnings, err
+	}
+
+	change.Action = ConfigUnchanged
+	if quotaName == "" {
+		return org, change, warnings, nil
+	}
+
+	quota, quotaWarnings, err := actor.GetOrganizationQuotaByName(quotaName)
+	warnings = append(warnings, quotaWarnings...)
+	if err != nil || quota.GUID == org.QuotaDefinitionGUID {
+		return org, change, warnings, err
+	}
+
+	ccWarnings, err := actor.CloudControllerClient.SetOrganizationQuota(org.GUID, quota.GUID)
+	org.QuotaDefinitionGUID = quota.GUID
+	change.Action = ConfigUpdated
+	return org, change, append(warnings, ccWarnings...), err
+}
+
+func (actor Actor) applyFeatureFlags(featureFlags map[string]bool) ([]ConfigChange, Warnings, error) {
+	if len(featureFlags) == 0 {
+		return nil, nil, nil
+	}
+
+	current, warnings, err := actor.GetFeatureFlags()
+	if err != nil {
+		return nil, warnings, err
+	}
+	enabled := map[string]bool{}
+	for _, featureFlag := range current {
+		enabled[featureFlag.Name] = featureFlag.Enabled
+	}
+
+	var changes []ConfigChange
+	for _, name := range sortedKeys(featureFlags) {
+		change := ConfigChange{Resource: "feature flag", Name: name, Action: ConfigUnchanged}
+		if current, found := enabled[name]; !found || current != featureFlags[name] {
+			ccWarnings, err := actor.CloudControllerClient.UpdateConfigFeatureFlag(ccv2.FeatureFlag{Name: name, Enabled: featureFlags[name]})
+			warnings = append(warnings, ccWarnings...)
+			if err != nil {
+				return changes, warnings, err
+			}
+			change.Action = ConfigUpdated
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, warnings, nil
+}
+
+// applyOrganizationRoles grants the organization roles in the configuration.
+// Every user with a space role is made an organization user first, as the
+// Cloud Controller requires space members to be members of the organization.
+func (actor Actor) applyOrganizationRoles(orgGUID string, config OrganizationConfig) ([]ConfigChange, Warnings, error) {
+	members := append([]string{}, config.Users...)
+	members = append(members, config.Managers...)
+	members = append(members, config.BillingManagers...)
+	members = append(members, config.Auditors...)
+	for _, space := range config.Spaces {
+		members = append(members, space.Managers...)
+		members = append(members, space.Developers...)
+		members = append(members, space.Auditors...)
+	}
+
+	var (
+		allChanges  []ConfigChange
+		allWarnings Warnings
+	)
+	for _, role := range []struct {
+		role      constant.OrganizationRole
+		resource  string
+		usernames []string
+	}{
+		{constant.OrgUser, "org user", members},
+		{constant.OrgManager, "org manager", config.Managers},
+		{constant.OrgBillingManager, "org billing manager", config.BillingManagers},
+		{constant.OrgAuditor, "org auditor", config.Auditors},
+	} {
+		if len(role.usernames) == 0 {
+			continue
+		}
+
+		users, ccWarnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role.role, orgGUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return allChanges, allWarnings, err
+		}
+
+		changes, warnings, err := grantMissingRoles(role.resource, "", users, role.usernames, func(username string) (ccv2.Warnings, error) {
+			return actor.CloudControllerClient.UpdateOrganizationUserByRole(role.role, orgGUID, username)
+		})
+		allChanges = append(allChanges, changes...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allChanges, allWarnings, err
+		}
+	}
+
+	return allChanges, allWarnings, nil
+}
+
+func (actor Actor) applySpaceQuotaConfig(orgGUID string, config QuotaConfig) (ConfigChange, Warnings, error) {
+	change := ConfigChange{Resource: "space quota", Name: config.Name}
+	desired := ccv2.SpaceQuota{
+		Name:             config.Name,
+		OrganizationGUID: orgGUID,
+		QuotaLimits:      config.limits(),
+	}
+
+	quota, warnings, err := actor.GetSpaceQuotaByName(config.Name, orgGUID)
+	if _, ok := err.(actionerror.SpaceQuotaNotFoundByNameError); ok {
+		_, ccWarnings, err := actor.CloudControllerClient.CreateSpaceQuotaDefinition(desired)
+		change.Action = ConfigCreated
+		return change, append(warnings, ccWarnings...), err
+	}
+	if err != nil {
+		return change, warnings, err
+	}
+
+	if quota.QuotaLimits == desired.QuotaLimits {
+		change.Action = ConfigUnchanged
+		return change, warnings, nil
+	}
+
+	desired.GUID = quota.GUID
+	_, ccWarnings, err := actor.CloudControllerClient.UpdateSpaceQuotaDefinition(desired)
+	change.Action = ConfigUpdated
+	return change, append(warnings, ccWarnings...), err
+}
+
+func (actor Actor) applySpaceConfig(orgGUID string, config SpaceConfig) ([]ConfigChange, Warnings, error) {
+	change := ConfigChange{Resource: "space", Name: config.Name, Action: ConfigUnchanged}
+	changes := []ConfigChange{change}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, config.Name)
+	if _, ok := err.(actionerror.SpaceNotFoundError); ok {
+		ccSpace, ccWarnings, createErr := actor.CloudControllerClient.CreateSpace(config.Name, orgGUID)
+		warnings = append(warnings, ccWarnings...)
+		space, err = Space(ccSpace), createErr
+		changes[0].Action = ConfigCreated
+	}
+	if err != nil {
+		return changes, warnings, err
+	}
+
+	if config.AllowSSH != nil && *config.AllowSSH != space.AllowSSH {
+		ccWarnings, err := actor.CloudControllerClient.UpdateSpaceAllowSSH(space.GUID, *config.AllowSSH)
+		warnings = append(warnings, ccWarnings...)
+		if err != nil {
+			return changes, warnings, err
+		}
+		changes[0].Action = updatedUnlessCreated(changes[0].Action)
+	}
+
+	if config.SpaceQuota != "" {
+		quota, quotaWarnings, err := actor.GetSpaceQuotaByName(config.SpaceQuota, orgGUID)
+		warnings = append(warnings, quotaWarnings...)
+		if err != nil {
+			return changes, warnings, err
+		}
+		if quota.GUID != space.SpaceQuotaDefinitionGUID {
+			ccWarnings, err := actor.CloudControllerClient.SetSpaceQuota(space.GUID, quota.GUID)
+			warnings = append(warnings, ccWarnings...)
+			if err != nil {
+				return changes, warnings, err
+			}
+			changes[0].Action = updatedUnlessCreated(changes[0].Action)
+		}
+	}
+
+	for _, role := range []struct {
+		role      constant.SpaceRole
+		resource  string
+		usernames []string
+	}{
+		{constant.SpaceManager, "space manager", config.Managers},
+		{constant.SpaceDeveloper, "space developer", config.Developers},
+		{constant.SpaceAuditor, "space auditor", config.Auditors},
+	} {
+		if len(role.usernames) == 0 {
+			continue
+		}
+
+		users, ccWarnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role.role, space.GUID)
+		warnings = append(warnings, ccWarnings...)
+		if err != nil {
+			return changes, warnings, err
+		}
+
+		roleChanges, roleWarnings, err := grantMissingRoles(role.resource, space.Name+"/", users, role.usernames, func(username string) (ccv2.Warnings, error) {
+			return actor.CloudControllerClient.UpdateSpaceUserByRole(role.role, space.GUID, username)
+		})
+		changes = append(changes, roleChanges...)
+		warnings = append(warnings, roleWarnings...)
+		if err != nil {
+			return changes, warnings, err
+		}
+	}
+
+	for _, lifecycle := range []struct {
+		lifecycle constant.SecurityGroupLifecycle
+		resource  string
+		names     []string
+		get       func(string) ([]SecurityGroup, Warnings, error)
+	}{
+		{constant.SecurityGroupLifecycleRunning, "running security group", config.RunningSecurityGroups, actor.GetSpaceRunningSecurityGroupsBySpace},
+		{constant.SecurityGroupLifecycleStaging, "staging security group", config.StagingSecurityGroups, actor.GetSpaceStagingSecurityGroupsBySpace},
+	} {
+		if len(lifecycle.names) == 0 {
+			continue
+		}
+
+		bound, boundWarnings, err := lifecycle.get(space.GUID)
+		warnings = append(warnings, boundWarnings...)
+		if err != nil {
+			return changes, warnings, err
+		}
+		boundNames := map[string]bool{}
+		for _, securityGroup := range bound {
+			boundNames[securityGroup.Name] = true
+		}
+
+		for _, name := range lifecycle.names {
+			change := ConfigChange{Resource: lifecycle.resource, Name: space.Name + "/" + name, Action: ConfigUnchanged}
+			if !boundNames[name] {
+				securityGroup, sgWarnings, err := actor.GetSecurityGroupByName(name)
+				warnings = append(warnings, sgWarnings...)
+				if err != nil {
+					return changes, warnings, err
+				}
+				bindWarnings, err := actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID, lifecycle.lifecycle)
+				warnings = append(warnings, bindWarnings...)
+				if err != nil {
+					return changes, warnings, err
+				}
+				change.Action = ConfigCreated
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, warnings, nil
+}
+
+// grantMissingRoles calls grant for every username that is not one of the
+// current users and reports a change per distinct username.
+func grantMissingRoles(resource string, namePrefix string, current []ccv2.User, usernames []string, grant func(string) (ccv2.Warnings, error)) ([]ConfigChange, Warnings, error) {
+	existing := map[string]bool{}
+	for _, user := range current {
+		existing[user.Username] = true
+	}
+
+	var (
+		changes  []ConfigChange
+		warnings Warnings
+	)
+	reported := map[string]bool{}
+	for _, username := range usernames {
+		if reported[username] {
+			continue
+		}
+		reported[username] = true
+
+		if existing[username] {
+			changes = append(changes, ConfigChange{Resource: resource, Name: namePrefix + username, Action: ConfigUnchanged})
+			continue
+		}
+
+		ccWarnings, err := grant(username)
+		warnings = append(warnings, ccWarnings...)
+		if err != nil {
+			return changes, warnings, err
+		}
+		existing[username] = true
+		changes = append(changes, ConfigChange{Resource: resource, Name: namePrefix + username, Action: ConfigCreated})
+	}
+
+	return changes, warnings, nil
+}
+
+func newQuotaConfig(name string, limits ccv2.QuotaLimits) QuotaConfig {
+	return QuotaConfig{
+		Name:                    name,
+		MemoryLimit:             limits.MemoryLimit,
+		InstanceMemoryLimit:     limits.InstanceMemoryLimit,
+		AppInstanceLimit:        limits.AppInstanceLimit,
+		AppTaskLimit:            limits.AppTaskLimit,
+		TotalServices:           limits.TotalServices,
+		TotalServiceKeys:        limits.TotalServiceKeys,
+		TotalRoutes:             limits.TotalRoutes,
+		TotalReservedRoutePorts: limits.TotalReservedRoutePorts,
+		NonBasicServicesAllowed: limits.NonBasicServicesAllowed,
+	}
+}
+
+func (config QuotaConfig) limits() ccv2.QuotaLimits {
+	return ccv2.QuotaLimits{
+		NonBasicServicesAllowed: config.NonBasicServicesAllowed,
+		TotalServices:           config.TotalServices,
+		TotalServiceKeys:        config.TotalServiceKeys,
+		TotalRoutes:             config.TotalRoutes,
+		TotalReservedRoutePorts: config.TotalReservedRoutePorts,
+		MemoryLimit:             config.MemoryLimit,
+		InstanceMemoryLimit:     config.InstanceMemoryLimit,
+		AppInstanceLimit:        config.AppInstanceLimit,
+		AppTaskLimit:            config.AppTaskLimit,
+	}
+}
+
+func updatedUnlessCreated(action ConfigChangeAction) ConfigChangeAction {
+	if action == ConfigCreated {
+		return ConfigCreated
+	}
+	return ConfigUpdated
+}
+
+func sortedUsernames(users []ccv2.User) []string {
+	var usernames []string
+	for _, user := range users {
+		if user.Username != "" {
+			usernames = append(usernames, user.Username)
+		}
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+func securityGroupNames(securityGroups []SecurityGroup) []string {
+	var names []string
+	for _, securityGroup := range securityGroups {
+		names = append(names, securityGroup.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(values map[string]bool) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
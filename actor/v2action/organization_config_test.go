@@ -0,0 +1,333 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Organization Config Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationConfig", func() {
+		var (
+			config     OrganizationConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			config, warnings, executeErr = actor.GetOrganizationConfig("some-org")
+		})
+
+		When("the organization exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}},
+					ccv2.Warnings{"org-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(
+					ccv2.OrganizationQuota{
+						Name:                "some-quota",
+						TotalPrivateDomains: 3,
+						QuotaLimits:         ccv2.QuotaLimits{MemoryLimit: 1024, AppInstanceLimit: -1},
+					},
+					ccv2.Warnings{"quota-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationUsersByRoleStub = func(role constant.OrganizationRole, _ string) ([]ccv2.User, ccv2.Warnings, error) {
+					switch role {
+					case constant.OrgManager:
+						return []ccv2.User{{Username: "zed"}, {Username: "amy"}, {GUID: "some-client"}}, nil, nil
+					case constant.OrgUser:
+						return []ccv2.User{{Username: "amy"}, {Username: "zed"}, {Username: "bob"}}, nil, nil
+					}
+					return nil, nil, nil
+				}
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "space-quota-guid", Name: "small", QuotaLimits: ccv2.QuotaLimits{MemoryLimit: 256}}},
+					nil, nil)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-2-guid", Name: "space-2"},
+						{GUID: "space-1-guid", Name: "space-1", AllowSSH: true, SpaceQuotaDefinitionGUID: "space-quota-guid"},
+					},
+					nil, nil)
+				fakeCloudControllerClient.GetSpaceUsersByRoleStub = func(role constant.SpaceRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+					if role == constant.SpaceDeveloper && spaceGUID == "space-1-guid" {
+						return []ccv2.User{{Username: "bob"}}, nil, nil
+					}
+					return nil, nil, nil
+				}
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{{Name: "public"}, {Name: "dns"}}, nil, nil)
+			})
+
+			It("returns the sorted organization configuration and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("org-warning", "quota-warning"))
+
+				Expect(config.Name).To(Equal("some-org"))
+				Expect(config.Quota).To(Equal(&OrganizationQuotaConfig{
+					QuotaConfig:         QuotaConfig{Name: "some-quota", MemoryLimit: 1024, AppInstanceLimit: -1},
+					TotalPrivateDomains: 3,
+				}))
+				Expect(config.FeatureFlags).To(BeEmpty())
+				Expect(config.Managers).To(Equal([]string{"amy", "zed"}))
+				Expect(config.Users).To(Equal([]string{"amy", "bob", "zed"}))
+				Expect(config.SpaceQuotas).To(Equal([]QuotaConfig{{Name: "small", MemoryLimit: 256}}))
+
+				Expect(config.Spaces).To(HaveLen(2))
+				Expect(config.Spaces[0].Name).To(Equal("space-1"))
+				Expect(*config.Spaces[0].AllowSSH).To(BeTrue())
+				Expect(config.Spaces[0].SpaceQuota).To(Equal("small"))
+				Expect(config.Spaces[0].Developers).To(Equal([]string{"bob"}))
+				Expect(config.Spaces[0].RunningSecurityGroups).To(Equal([]string{"dns", "public"}))
+				Expect(config.Spaces[1].Name).To(Equal("space-2"))
+				Expect(*config.Spaces[1].AllowSSH).To(BeFalse())
+				Expect(config.Spaces[1].SpaceQuota).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("some-quota-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeCloudControllerClient.GetConfigFeatureFlagsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the users returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("users error")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}}, ccv2.Warnings{"org-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(nil, ccv2.Warnings{"users-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("org-warning", "users-warning"))
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ApplyOrganizationConfig", func() {
+		var (
+			config     OrganizationConfig
+			org        Organization
+			changes    []ConfigChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			allowSSH := true
+			config = OrganizationConfig{
+				Name:         "some-org",
+				Quota:        &OrganizationQuotaConfig{QuotaConfig: QuotaConfig{Name: "some-quota", MemoryLimit: 1024}},
+				FeatureFlags: map[string]bool{"flag-a": true},
+				Managers:     []string{"amy"},
+				SpaceQuotas:  []QuotaConfig{{Name: "small", MemoryLimit: 256}},
+				Spaces: []SpaceConfig{{
+					Name:                  "space-1",
+					AllowSSH:              &allowSSH,
+					SpaceQuota:            "small",
+					Developers:            []string{"bob"},
+					RunningSecurityGroups: []string{"public"},
+				}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			org, changes, warnings, executeErr = actor.ApplyOrganizationConfig(config)
+		})
+
+		When("nothing exists yet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturnsOnCall(0, nil, ccv2.Warnings{"quota-warning"}, nil)
+				fakeCloudControllerClient.GetOrganizationQuotasReturns([]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota"}}, nil, nil)
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, nil, nil)
+				fakeCloudControllerClient.CreateOrganizationReturns(ccv2.Organization{GUID: "some-org-guid", Name: "some-org"}, ccv2.Warnings{"create-org-warning"}, nil)
+				fakeCloudControllerClient.GetConfigFeatureFlagsReturns([]ccv2.FeatureFlag{{Name: "flag-a"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceQuotasReturnsOnCall(0, nil, nil, nil)
+				fakeCloudControllerClient.GetSpaceQuotasReturns([]ccv2.SpaceQuota{{GUID: "space-quota-guid", Name: "small"}}, nil, nil)
+				fakeCloudControllerClient.GetSpacesReturns(nil, nil, nil)
+				fakeCloudControllerClient.CreateSpaceReturns(ccv2.Space{GUID: "space-1-guid", Name: "space-1"}, nil, nil)
+				fakeCloudControllerClient.GetSecurityGroupsReturns([]ccv2.SecurityGroup{{GUID: "public-guid", Name: "public"}}, nil, nil)
+			})
+
+			It("creates everything and reports the changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("quota-warning"))
+				Expect(warnings).To(ContainElement("create-org-warning"))
+				Expect(org.GUID).To(Equal("some-org-guid"))
+
+				Expect(changes).To(Equal([]ConfigChange{
+					{Resource: "org quota", Name: "some-quota", Action: ConfigCreated},
+					{Resource: "org", Name: "some-org", Action: ConfigCreated},
+					{Resource: "feature flag", Name: "flag-a", Action: ConfigUpdated},
+					{Resource: "org user", Name: "amy", Action: ConfigCreated},
+					{Resource: "org user", Name: "bob", Action: ConfigCreated},
+					{Resource: "org manager", Name: "amy", Action: ConfigCreated},
+					{Resource: "space quota", Name: "small", Action: ConfigCreated},
+					{Resource: "space", Name: "space-1", Action: ConfigCreated},
+					{Resource: "space developer", Name: "space-1/bob", Action: ConfigCreated},
+					{Resource: "running security group", Name: "space-1/public", Action: ConfigCreated},
+				}))
+
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaArgsForCall(0).QuotaLimits.MemoryLimit).To(Equal(1024))
+
+				orgName, quotaGUID := fakeCloudControllerClient.CreateOrganizationArgsForCall(0)
+				Expect(orgName).To(Equal("some-org"))
+				Expect(quotaGUID).To(Equal("some-quota-guid"))
+
+				Expect(fakeCloudControllerClient.UpdateConfigFeatureFlagArgsForCall(0)).To(Equal(ccv2.FeatureFlag{Name: "flag-a", Enabled: true}))
+
+				spaceQuota := fakeCloudControllerClient.CreateSpaceQuotaDefinitionArgsForCall(0)
+				Expect(spaceQuota.OrganizationGUID).To(Equal("some-org-guid"))
+				Expect(spaceQuota.MemoryLimit).To(Equal(256))
+
+				spaceGUID, allowSSH := fakeCloudControllerClient.UpdateSpaceAllowSSHArgsForCall(0)
+				Expect(spaceGUID).To(Equal("space-1-guid"))
+				Expect(allowSSH).To(BeTrue())
+
+				spaceGUID, quotaGUID = fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+				Expect(spaceGUID).To(Equal("space-1-guid"))
+				Expect(quotaGUID).To(Equal("space-quota-guid"))
+
+				role, guid, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.SpaceDeveloper))
+				Expect(guid).To(Equal("space-1-guid"))
+				Expect(username).To(Equal("bob"))
+
+				sgGUID, spaceGUID := fakeCloudControllerClient.UpdateSecurityGroupSpaceArgsForCall(0)
+				Expect(sgGUID).To(Equal("public-guid"))
+				Expect(spaceGUID).To(Equal("space-1-guid"))
+			})
+		})
+
+		When("everything already matches the configuration", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota", QuotaLimits: ccv2.QuotaLimits{MemoryLimit: 1024}}}, nil, nil)
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}}, nil, nil)
+				fakeCloudControllerClient.GetConfigFeatureFlagsReturns([]ccv2.FeatureFlag{{Name: "flag-a", Enabled: true}}, nil, nil)
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns([]ccv2.User{{Username: "amy"}, {Username: "bob"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "space-quota-guid", Name: "small", QuotaLimits: ccv2.QuotaLimits{MemoryLimit: 256}}}, nil, nil)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{{GUID: "space-1-guid", Name: "space-1", AllowSSH: true, SpaceQuotaDefinitionGUID: "space-quota-guid"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns([]ccv2.User{{Username: "bob"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns([]ccv2.SecurityGroup{{Name: "public"}}, nil, nil)
+			})
+
+			It("reports every resource as unchanged without updating anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				for _, change := range changes {
+					Expect(change.Action).To(Equal(ConfigUnchanged), change.Resource+" "+change.Name)
+				}
+				Expect(changes).To(HaveLen(10))
+
+				Expect(fakeCloudControllerClient.CreateOrganizationQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateOrganizationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.SetOrganizationQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateConfigFeatureFlagCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSpaceQuotaDefinitionCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSpaceAllowSSHCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.SetSpaceQuotaCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupSpaceCallCount()).To(Equal(0))
+			})
+
+			When("users appear in several roles", func() {
+				BeforeEach(func() {
+					config.Users = []string{"amy"}
+					config.Spaces[0].Managers = []string{"bob"}
+				})
+
+				It("reports each org user once", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					var orgUsers []ConfigChange
+					for _, change := range changes {
+						if change.Resource == "org user" {
+							orgUsers = append(orgUsers, change)
+						}
+					}
+					Expect(orgUsers).To(Equal([]ConfigChange{
+						{Resource: "org user", Name: "amy", Action: ConfigUnchanged},
+						{Resource: "org user", Name: "bob", Action: ConfigUnchanged},
+					}))
+				})
+			})
+		})
+
+		When("the quotas differ from the configuration", func() {
+			BeforeEach(func() {
+				config.FeatureFlags = nil
+				config.Managers = nil
+				config.Spaces = nil
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(
+					[]ccv2.OrganizationQuota{{GUID: "some-quota-guid", Name: "some-quota", QuotaLimits: ccv2.QuotaLimits{MemoryLimit: 512}}}, nil, nil)
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "other-quota-guid"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceQuotasReturns(
+					[]ccv2.SpaceQuota{{GUID: "space-quota-guid", Name: "small", QuotaLimits: ccv2.QuotaLimits{MemoryLimit: 128}}}, nil, nil)
+			})
+
+			It("updates the quotas and sets the organization quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal([]ConfigChange{
+					{Resource: "org quota", Name: "some-quota", Action: ConfigUpdated},
+					{Resource: "org", Name: "some-org", Action: ConfigUpdated},
+					{Resource: "space quota", Name: "small", Action: ConfigUpdated},
+				}))
+
+				orgQuota := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+				Expect(orgQuota.GUID).To(Equal("some-quota-guid"))
+				Expect(orgQuota.MemoryLimit).To(Equal(1024))
+
+				orgGUID, quotaGUID := fakeCloudControllerClient.SetOrganizationQuotaArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(quotaGUID).To(Equal("some-quota-guid"))
+
+				spaceQuota := fakeCloudControllerClient.UpdateSpaceQuotaDefinitionArgsForCall(0)
+				Expect(spaceQuota.GUID).To(Equal("space-quota-guid"))
+				Expect(spaceQuota.MemoryLimit).To(Equal(256))
+			})
+		})
+
+		When("creating the organization returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create org error")
+				config.Quota = nil
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+				fakeCloudControllerClient.CreateOrganizationReturns(ccv2.Organization{}, ccv2.Warnings{"create-org-warning"}, expectedErr)
+			})
+
+			It("returns the error, the changes so far and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "create-org-warning"))
+				Expect(changes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetConfigFeatureFlagsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// RouteSummary is a route with the name of its space and the names of the
+// applications mapped to it.
+type RouteSummary struct {
+	Route
+	SpaceName string
+	AppNames  []string
+}
+
+// GetSpaceRouteSummaries returns a summary of each route in the space.
+func (actor Actor) GetSpaceRouteSummaries(spaceGUID string, spaceName string) ([]RouteSummary, Warnings, error) {
+	routes, warnings, err := actor.GetSpaceRoutes(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	summaries, summaryWarnings, err := actor.routeSummaries(routes, map[string]string{spaceGUID: spaceName})
+	return summaries, append(warnings, summaryWarnings...), err
+}
+
+// GetOrganizationRouteSummaries returns a summary of each route in every
+// space of the organization.
+func (actor Actor) GetOrganizationRouteSummaries(orgGUID string) ([]RouteSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+	}
+
+	ccv2Routes, ccWarnings, err := actor.CloudControllerClient.GetRoutes(ccv2.Filter{
+		Type:     constant.OrganizationGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{orgGUID},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	routes, warnings, err := actor.applyDomain(ccv2Routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	summaries, warnings, err := actor.routeSummaries(routes, spaceNames)
+	return summaries, append(allWarnings, warnings...), err
+}
+
+func (actor Actor) routeSummaries(routes Routes, spaceNames map[string]string) ([]RouteSummary, Warnings, error) {
+	var allWarnings Warnings
+	summaries := []RouteSummary{}
+	for _, route := range routes {
+		apps, warnings, err := actor.GetRouteApplications(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		summary := RouteSummary{
+			Route:     route,
+			SpaceName: spaceNames[route.SpaceGUID],
+			AppNames:  []string{},
+		}
+		for _, app := range apps {
+			summary.AppNames = append(summary.AppNames, app.Name)
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, allWarnings, nil
+}
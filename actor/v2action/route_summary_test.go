@@ -0,0 +1,132 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _ = NewTestActor()
+
+		fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "example.com"}, ccv2.Warnings{"domain-warning"}, nil)
+		fakeCloudControllerClient.GetRouteApplicationsStub = func(routeGUID string, _ ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+			if routeGUID == "route-guid-1" {
+				return []ccv2.Application{{Name: "app-1"}, {Name: "app-2"}}, ccv2.Warnings{"apps-warning"}, nil
+			}
+			return nil, ccv2.Warnings{"apps-warning"}, nil
+		}
+	})
+
+	Describe("GetSpaceRouteSummaries", func() {
+		var (
+			summaries  []RouteSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{
+				{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid", SpaceGUID: "some-space-guid"},
+				{GUID: "route-guid-2", Host: "host-2", Path: "/path", DomainGUID: "domain-guid", SpaceGUID: "some-space-guid"},
+			}, ccv2.Warnings{"routes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetSpaceRouteSummaries("some-space-guid", "some-space")
+		})
+
+		It("returns each route with its space and app names", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("routes-warning", "domain-warning", "apps-warning", "apps-warning"))
+
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].String()).To(Equal("host-1.example.com"))
+			Expect(summaries[0].SpaceName).To(Equal("some-space"))
+			Expect(summaries[0].AppNames).To(Equal([]string{"app-1", "app-2"}))
+			Expect(summaries[1].String()).To(Equal("host-2.example.com/path"))
+			Expect(summaries[1].AppNames).To(BeEmpty())
+
+			Expect(fakeCloudControllerClient.GetSpaceRoutesCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceRoutesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		When("getting the apps of a route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteApplicationsStub = nil
+				fakeCloudControllerClient.GetRouteApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ContainElement("apps-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationRouteSummaries", func() {
+		var (
+			summaries  []RouteSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{
+				{GUID: "space-guid-1", Name: "space-1"},
+				{GUID: "space-guid-2", Name: "space-2"},
+			}, ccv2.Warnings{"spaces-warning"}, nil)
+			fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+				{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid", SpaceGUID: "space-guid-2"},
+				{GUID: "route-guid-2", DomainGUID: "domain-guid", SpaceGUID: "space-guid-1", Port: types.NullInt{IsSet: true, Value: 1024}},
+			}, ccv2.Warnings{"routes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetOrganizationRouteSummaries("some-org-guid")
+		})
+
+		It("returns the routes of the organization with the names of their spaces", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("spaces-warning"))
+			Expect(warnings).To(ContainElement("routes-warning"))
+
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].SpaceName).To(Equal("space-2"))
+			Expect(summaries[0].AppNames).To(Equal([]string{"app-1", "app-2"}))
+			Expect(summaries[1].SpaceName).To(Equal("space-1"))
+			Expect(summaries[1].String()).To(Equal("example.com:1024"))
+
+			Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.OrganizationGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-org-guid"},
+			}))
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("spaces-warning", "routes-warning"))
+			})
+		})
+	})
+})
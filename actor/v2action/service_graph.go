@@ -0,0 +1,281 @@
+package v2action
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// ServiceGraphNodeType is the kind of resource a service graph node
+// represents.
+type ServiceGraphNodeType string
+
+const (
+	ServiceGraphApplication     ServiceGraphNodeType = "app"
+	ServiceGraphServiceInstance ServiceGraphNodeType = "service instance"
+	ServiceGraphServiceKey      ServiceGraphNodeType = "service key"
+	ServiceGraphSpace           ServiceGraphNodeType = "space"
+	ServiceGraphRoute           ServiceGraphNodeType = "route"
+)
+
+// ServiceGraphEdgeType is the kind of dependency a service graph edge
+// represents.
+type ServiceGraphEdgeType string
+
+const (
+	ServiceGraphBinding      ServiceGraphEdgeType = "binding"
+	ServiceGraphKey          ServiceGraphEdgeType = "service key"
+	ServiceGraphShare        ServiceGraphEdgeType = "share"
+	ServiceGraphRouteService ServiceGraphEdgeType = "route service"
+)
+
+// ServiceGraphNode is an app, service instance, service key, space or route
+// in a service graph. Space is the name of the space the resource belongs to
+// and is only set for graphs of an entire org.
+type ServiceGraphNode struct {
+	GUID  string
+	Type  ServiceGraphNodeType
+	Name  string
+	Space string
+}
+
+// ServiceGraphEdge points from a resource to the service instance it depends
+// on.
+type ServiceGraphEdge struct {
+	From string
+	To   string
+	Type ServiceGraphEdgeType
+}
+
+// ServiceGraph contains the service instances of a space or org together with
+// the apps bound to them, their service keys, the spaces they are shared to
+// and the routes that use them as route services.
+type ServiceGraph struct {
+	Nodes []ServiceGraphNode
+	Edges []ServiceGraphEdge
+}
+
+// ServiceInstances returns the service instance nodes of the graph sorted by
+// name.
+func (graph ServiceGraph) ServiceInstances() []ServiceGraphNode {
+	var instances []ServiceGraphNode
+	for _, node := range graph.Nodes {
+		if node.Type == ServiceGraphServiceInstance {
+			instances = append(instances, node)
+		}
+	}
+	sortServiceGraphNodes(instances)
+	return instances
+}
+
+// Dependents returns the nodes of the given type that depend on the service
+// instance, sorted by name.
+func (graph ServiceGraph) Dependents(serviceInstanceGUID string, nodeType ServiceGraphNodeType) []ServiceGraphNode {
+	nodes := map[string]ServiceGraphNode{}
+	for _, node := range graph.Nodes {
+		nodes[node.GUID] = node
+	}
+
+	var dependents []ServiceGraphNode
+	for _, edge := range graph.Edges {
+		if edge.To != serviceInstanceGUID {
+			continue
+		}
+		if node, ok := nodes[edge.From]; ok && node.Type == nodeType {
+			dependents = append(dependents, node)
+		}
+	}
+	sortServiceGraphNodes(dependents)
+	return dependents
+}
+
+// GetServiceGraphBySpace returns the service graph of the service instances
+// in the space.
+func (actor Actor) GetServiceGraphBySpace(spaceGUID string) (ServiceGraph, Warnings, error) {
+	builder := newServiceGraphBuilder()
+	warnings, err := actor.addSpaceToServiceGraph(builder, spaceGUID, "")
+	return builder.graph(), warnings, err
+}
+
+// GetServiceGraphByOrganization returns the service graph of the service
+// instances in every space of the org.
+func (actor Actor) GetServiceGraphByOrganization(orgGUID string) (ServiceGraph, Warnings, error) {
+	spaces, allWarnings, err := actor.GetOrganizationSpaces(orgGUID)
+	if err != nil {
+		return ServiceGraph{}, allWarnings, err
+	}
+
+	builder := newServiceGraphBuilder()
+	for _, space := range spaces {
+		warnings, err := actor.addSpaceToServiceGraph(builder, space.GUID, space.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ServiceGraph{}, allWarnings, err
+		}
+	}
+
+	return builder.graph(), allWarnings, nil
+}
+
+func (actor Actor) addSpaceToServiceGraph(builder *serviceGraphBuilder, spaceGUID string, spaceName string) (Warnings, error) {
+	instances, ccWarnings, err := actor.CloudControllerClient.GetSpaceServiceInstances(spaceGUID, true)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return allWarnings, err
+	}
+	if len(instances) == 0 {
+		return allWarnings, nil
+	}
+
+	var instanceGUIDs []string
+	for _, instance := range instances {
+		instanceGUIDs = append(instanceGUIDs, instance.GUID)
+		builder.addNode(ServiceGraphNode{GUID: instance.GUID, Type: ServiceGraphServiceInstance, Name: instance.Name, Space: spaceName})
+	}
+	instanceFilter := ccv2.Filter{
+		Type:     constant.ServiceInstanceGUIDFilter,
+		Operator: constant.InOperator,
+		Values:   instanceGUIDs,
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	for _, app := range apps {
+		builder.addNode(ServiceGraphNode{GUID: app.GUID, Type: ServiceGraphApplication, Name: app.Name, Space: spaceName})
+	}
+
+	bindings, ccWarnings, err := actor.CloudControllerClient.GetServiceBindings(instanceFilter)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	for _, binding := range bindings {
+		if binding.AppGUID == "" {
+			continue
+		}
+
+		// Apps in other spaces can be bound to instances shared from this
+		// space.
+		if !builder.hasNode(binding.AppGUID) {
+			app, warnings, err := actor.GetApplication(binding.AppGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+			builder.addNode(ServiceGraphNode{GUID: app.GUID, Type: ServiceGraphApplication, Name: app.Name})
+		}
+		builder.addEdge(ServiceGraphEdge{From: binding.AppGUID, To: binding.ServiceInstanceGUID, Type: ServiceGraphBinding})
+	}
+
+	keys, ccWarnings, err := actor.CloudControllerClient.GetServiceKeys(instanceFilter)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	for _, key := range keys {
+		builder.addNode(ServiceGraphNode{GUID: key.GUID, Type: ServiceGraphServiceKey, Name: key.Name, Space: spaceName})
+		builder.addEdge(ServiceGraphEdge{From: key.GUID, To: key.ServiceInstanceGUID, Type: ServiceGraphKey})
+	}
+
+	for _, instance := range instances {
+		if !instance.Managed() || instance.SpaceGUID != spaceGUID {
+			continue
+		}
+
+		sharedTos, warnings, err := actor.GetServiceInstanceSharedTosByServiceInstance(instance.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			// if the API version does not support service instance sharing, ignore the 404
+			if _, ok := err.(ccerror.ResourceNotFoundError); !ok {
+				return allWarnings, err
+			}
+		}
+		for _, sharedTo := range sharedTos {
+			builder.addNode(ServiceGraphNode{
+				GUID: sharedTo.SpaceGUID,
+				Type: ServiceGraphSpace,
+				Name: fmt.Sprintf("%s/%s", sharedTo.OrganizationName, sharedTo.SpaceName),
+			})
+			builder.addEdge(ServiceGraphEdge{From: sharedTo.SpaceGUID, To: instance.GUID, Type: ServiceGraphShare})
+		}
+	}
+
+	ccRoutes, ccWarnings, err := actor.CloudControllerClient.GetSpaceRoutes(spaceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	var routeServiceRoutes []ccv2.Route
+	for _, route := range ccRoutes {
+		if route.ServiceInstanceGUID != "" {
+			routeServiceRoutes = append(routeServiceRoutes, route)
+		}
+	}
+	routes, warnings, err := actor.applyDomain(routeServiceRoutes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	for i, route := range routes {
+		builder.addNode(ServiceGraphNode{GUID: route.GUID, Type: ServiceGraphRoute, Name: route.String(), Space: spaceName})
+		builder.addEdge(ServiceGraphEdge{From: route.GUID, To: routeServiceRoutes[i].ServiceInstanceGUID, Type: ServiceGraphRouteService})
+	}
+
+	return allWarnings, nil
+}
+
+// serviceGraphBuilder collects the nodes and edges of a service graph,
+// ignoring nodes and edges that have already been added.
+type serviceGraphBuilder struct {
+	nodes     []ServiceGraphNode
+	edges     []ServiceGraphEdge
+	nodeGUIDs map[string]bool
+	edgeKeys  map[ServiceGraphEdge]bool
+}
+
+func newServiceGraphBuilder() *serviceGraphBuilder {
+	return &serviceGraphBuilder{
+		nodeGUIDs: map[string]bool{},
+		edgeKeys:  map[ServiceGraphEdge]bool{},
+	}
+}
+
+func (builder *serviceGraphBuilder) hasNode(guid string) bool {
+	return builder.nodeGUIDs[guid]
+}
+
+func (builder *serviceGraphBuilder) addNode(node ServiceGraphNode) {
+	if builder.nodeGUIDs[node.GUID] {
+		return
+	}
+	builder.nodeGUIDs[node.GUID] = true
+	builder.nodes = append(builder.nodes, node)
+}
+
+func (builder *serviceGraphBuilder) addEdge(edge ServiceGraphEdge) {
+	if builder.edgeKeys[edge] {
+		return
+	}
+	builder.edgeKeys[edge] = true
+	builder.edges = append(builder.edges, edge)
+}
+
+func (builder *serviceGraphBuilder) graph() ServiceGraph {
+	return ServiceGraph{Nodes: builder.nodes, Edges: builder.edges}
+}
+
+func sortServiceGraphNodes(nodes []ServiceGraphNode) {
+	sort.Slice(nodes, func(i int, j int) bool {
+		if nodes[i].Name == nodes[j].Name {
+			return nodes[i].Space < nodes[j].Space
+		}
+		return sorting.LessIgnoreCase(nodes[i].Name, nodes[j].Name)
+	})
+}
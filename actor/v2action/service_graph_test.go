@@ -0,0 +1,199 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Graph Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+
+		fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+			[]ccv2.ServiceInstance{
+				{GUID: "db-guid", Name: "db", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeManagedService},
+				{GUID: "logger-guid", Name: "logger", SpaceGUID: "some-space-guid", Type: constant.ServiceInstanceTypeUserProvidedService},
+			},
+			ccv2.Warnings{"instances-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]ccv2.Application{{GUID: "web-guid", Name: "web"}},
+			ccv2.Warnings{"apps-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceBindingsReturns(
+			[]ccv2.ServiceBinding{
+				{AppGUID: "web-guid", ServiceInstanceGUID: "db-guid"},
+				{AppGUID: "web-guid", ServiceInstanceGUID: "logger-guid"},
+				{AppGUID: "worker-guid", ServiceInstanceGUID: "db-guid"},
+			},
+			ccv2.Warnings{"bindings-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{GUID: "worker-guid", Name: "worker"}, ccv2.Warnings{"app-warning"}, nil)
+		fakeCloudControllerClient.GetServiceKeysReturns(
+			[]ccv2.ServiceKey{{GUID: "key-guid", Name: "db-key", ServiceInstanceGUID: "db-guid"}},
+			ccv2.Warnings{"keys-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+			[]ccv2.ServiceInstanceSharedTo{{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "other-org"}},
+			ccv2.Warnings{"shared-to-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetSpaceRoutesReturns(
+			[]ccv2.Route{
+				{GUID: "route-guid", Host: "www", DomainGUID: "domain-guid", ServiceInstanceGUID: "logger-guid"},
+				{GUID: "plain-route-guid", Host: "plain", DomainGUID: "domain-guid"},
+			},
+			ccv2.Warnings{"routes-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "domain-guid", Name: "example.com"}, nil, nil)
+	})
+
+	Describe("GetServiceGraphBySpace", func() {
+		var (
+			graph      ServiceGraph
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			graph, warnings, executeErr = actor.GetServiceGraphBySpace("some-space-guid")
+		})
+
+		It("returns the graph of the space's service instances", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"instances-warning",
+				"apps-warning",
+				"bindings-warning",
+				"app-warning",
+				"keys-warning",
+				"shared-to-warning",
+				"routes-warning",
+			))
+
+			Expect(graph.ServiceInstances()).To(Equal([]ServiceGraphNode{
+				{GUID: "db-guid", Type: ServiceGraphServiceInstance, Name: "db"},
+				{GUID: "logger-guid", Type: ServiceGraphServiceInstance, Name: "logger"},
+			}))
+			Expect(graph.Dependents("db-guid", ServiceGraphApplication)).To(Equal([]ServiceGraphNode{
+				{GUID: "web-guid", Type: ServiceGraphApplication, Name: "web"},
+				{GUID: "worker-guid", Type: ServiceGraphApplication, Name: "worker"},
+			}))
+			Expect(graph.Dependents("db-guid", ServiceGraphServiceKey)).To(Equal([]ServiceGraphNode{
+				{GUID: "key-guid", Type: ServiceGraphServiceKey, Name: "db-key"},
+			}))
+			Expect(graph.Dependents("db-guid", ServiceGraphSpace)).To(Equal([]ServiceGraphNode{
+				{GUID: "other-space-guid", Type: ServiceGraphSpace, Name: "other-org/other-space"},
+			}))
+			Expect(graph.Dependents("logger-guid", ServiceGraphRoute)).To(Equal([]ServiceGraphNode{
+				{GUID: "route-guid", Type: ServiceGraphRoute, Name: "www.example.com"},
+			}))
+			Expect(graph.Dependents("logger-guid", ServiceGraphSpace)).To(BeEmpty())
+
+			Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.ServiceInstanceGUIDFilter,
+				Operator: constant.InOperator,
+				Values:   []string{"db-guid", "logger-guid"},
+			}))
+			Expect(fakeCloudControllerClient.GetApplicationArgsForCall(0)).To(Equal("worker-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("db-guid"))
+		})
+
+		When("the API does not support service instance sharing", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(nil, nil, ccerror.ResourceNotFoundError{})
+			})
+
+			It("ignores the error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(graph.Dependents("db-guid", ServiceGraphSpace)).To(BeEmpty())
+			})
+		})
+
+		When("the space has no service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, nil, nil)
+			})
+
+			It("returns an empty graph", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(graph.Nodes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the service keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"keys-warning"}, errors.New("keys-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("keys-error"))
+				Expect(warnings).To(ContainElement("keys-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceGraphByOrganization", func() {
+		var (
+			graph      ServiceGraph
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+				ccv2.Warnings{"spaces-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			graph, warnings, executeErr = actor.GetServiceGraphByOrganization("some-org-guid")
+		})
+
+		It("returns the graph of every space with the space names", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("spaces-warning"))
+			Expect(graph.ServiceInstances()).To(Equal([]ServiceGraphNode{
+				{GUID: "db-guid", Type: ServiceGraphServiceInstance, Name: "db", Space: "some-space"},
+				{GUID: "logger-guid", Type: ServiceGraphServiceInstance, Name: "logger", Space: "some-space"},
+			}))
+
+			spaceGUID, includeUserProvided, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(includeUserProvided).To(BeTrue())
+		})
+
+		When("getting the spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv2.Warnings{"spaces-warning"}, errors.New("spaces-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("spaces-error"))
+				Expect(warnings).To(ConsistOf("spaces-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,171 @@
+package v2action
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+var serviceKeyVersionSuffix = regexp.MustCompile(`^(.*)-v(\d+)$`)
+
+// ServiceKeyRotation is a service key of a service instance together with the
+// successor key created to replace it.
+type ServiceKeyRotation struct {
+	ServiceInstance ServiceInstance
+	OldKey          ServiceKey
+	NewKey          ServiceKey
+}
+
+// CreateSuccessorServiceKey creates a service key to replace the named key of
+// the service instance. The successor is created with the provided parameters,
+// or with the parameters of the key it replaces when they are nil. It is named
+// after the key it replaces with a "-vN" suffix, e.g. "my-key-v2" succeeds
+// "my-key" and "my-key-v3" succeeds "my-key-v2".
+func (actor Actor) CreateSuccessorServiceKey(serviceInstanceName string, keyName string, spaceGUID string, parameters map[string]interface{}) (ServiceKeyRotation, Warnings, error) {
+	serviceInstance, allWarnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return ServiceKeyRotation{}, allWarnings, err
+	}
+
+	keys, ccWarnings, err := actor.CloudControllerClient.GetServiceKeys(ccv2.Filter{
+		Type:     constant.ServiceInstanceGUIDFilter,
+		Operator: constant.EqualOperator,
+		Values:   []string{serviceInstance.GUID},
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceKeyRotation{}, allWarnings, err
+	}
+
+	keyNames := map[string]bool{}
+	var oldKey ServiceKey
+	for _, key := range keys {
+		keyNames[key.Name] = true
+		if key.Name == keyName {
+			oldKey = ServiceKey(key)
+		}
+	}
+	if oldKey.GUID == "" {
+		return ServiceKeyRotation{}, allWarnings, actionerror.ServiceKeyNotFoundError{
+			Name:                keyName,
+			ServiceInstanceName: serviceInstanceName,
+		}
+	}
+
+	if parameters == nil {
+		parameters, ccWarnings, err = actor.CloudControllerClient.GetServiceKeyParameters(oldKey.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return ServiceKeyRotation{}, allWarnings, actionerror.ServiceKeyParametersUnavailableError{Name: keyName, Err: err}
+		}
+	}
+
+	newKeyName := successorServiceKeyName(keyName)
+	for keyNames[newKeyName] {
+		newKeyName = successorServiceKeyName(newKeyName)
+	}
+
+	newKey, ccWarnings, err := actor.CloudControllerClient.CreateServiceKey(serviceInstance.GUID, newKeyName, parameters)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceKeyRotation{}, allWarnings, err
+	}
+
+	return ServiceKeyRotation{
+		ServiceInstance: serviceInstance,
+		OldKey:          oldKey,
+		NewKey:          ServiceKey(newKey),
+	}, allWarnings, nil
+}
+
+// ServiceBindingReplacement records the binding between an application and a
+// service instance that was deleted to rebind them, so that it can be
+// restored.
+type ServiceBindingReplacement struct {
+	App                 Application
+	ServiceInstanceGUID string
+	Name                string
+	Parameters          map[string]interface{}
+
+	// NewBindingGUID is the GUID of the binding that replaced the deleted one.
+	// It is empty when creating the new binding failed.
+	NewBindingGUID string
+}
+
+// RebindServiceInstanceToApplication replaces the binding between the
+// application and the service instance with a new binding of the same name
+// and parameters, so that the application is given new credentials. Once the
+// old binding is deleted, the returned replacement records it, even when
+// creating the new binding fails.
+func (actor Actor) RebindServiceInstanceToApplication(app Application, serviceInstanceGUID string) (ServiceBindingReplacement, Warnings, error) {
+	binding, allWarnings, err := actor.GetServiceBindingByApplicationAndServiceInstance(app.GUID, serviceInstanceGUID)
+	if err != nil {
+		return ServiceBindingReplacement{}, allWarnings, err
+	}
+
+	parameters, ccWarnings, err := actor.CloudControllerClient.GetServiceBindingParameters(binding.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceBindingReplacement{}, allWarnings, err
+	}
+
+	_, ccWarnings, err = actor.CloudControllerClient.DeleteServiceBinding(binding.GUID, false)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceBindingReplacement{}, allWarnings, err
+	}
+
+	replacement := ServiceBindingReplacement{
+		App:                 app,
+		ServiceInstanceGUID: serviceInstanceGUID,
+		Name:                binding.Name,
+		Parameters:          parameters,
+	}
+	newBinding, ccWarnings, err := actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstanceGUID, binding.Name, false, parameters)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return replacement, allWarnings, err
+	}
+
+	replacement.NewBindingGUID = newBinding.GUID
+	return replacement, allWarnings, nil
+}
+
+// RestoreServiceBinding undoes a replacement made by
+// RebindServiceInstanceToApplication: the new binding, if any, is deleted and
+// a binding with the name and parameters of the deleted one is created. The
+// service broker issues new credentials for the restored binding.
+func (actor Actor) RestoreServiceBinding(replacement ServiceBindingReplacement) (Warnings, error) {
+	var allWarnings Warnings
+	if replacement.NewBindingGUID != "" {
+		_, warnings, err := actor.CloudControllerClient.DeleteServiceBinding(replacement.NewBindingGUID, false)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(replacement.App.GUID, replacement.ServiceInstanceGUID, replacement.Name, false, replacement.Parameters)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// DeleteServiceKey deletes the service key with the provided GUID.
+func (actor Actor) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKeyGUID)
+	return Warnings(warnings), err
+}
+
+func successorServiceKeyName(keyName string) string {
+	if matches := serviceKeyVersionSuffix.FindStringSubmatch(keyName); matches != nil {
+		version, err := strconv.Atoi(matches[2])
+		if err == nil {
+			return fmt.Sprintf("%s-v%d", matches[1], version+1)
+		}
+	}
+	return keyName + "-v2"
+}
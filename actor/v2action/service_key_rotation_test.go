@@ -0,0 +1,285 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Rotation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateSuccessorServiceKey", func() {
+		var (
+			parameters map[string]interface{}
+			rotation   ServiceKeyRotation
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			parameters = nil
+
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"instance-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceKeysReturns(
+				[]ccv2.ServiceKey{
+					{GUID: "old-key-guid", Name: "my-key"},
+					{GUID: "other-key-guid", Name: "my-key-v2"},
+				},
+				ccv2.Warnings{"keys-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceKeyParametersReturns(
+				map[string]interface{}{"permissions": "read-only"},
+				ccv2.Warnings{"parameters-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateServiceKeyReturns(
+				ccv2.ServiceKey{GUID: "new-key-guid", Name: "my-key-v3"},
+				ccv2.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			rotation, warnings, executeErr = actor.CreateSuccessorServiceKey("some-service-instance", "my-key", "some-space-guid", parameters)
+		})
+
+		It("creates a successor key with the parameters of the old key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("instance-warning", "keys-warning", "parameters-warning", "create-warning"))
+			Expect(rotation).To(Equal(ServiceKeyRotation{
+				ServiceInstance: ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+				OldKey:          ServiceKey{GUID: "old-key-guid", Name: "my-key"},
+				NewKey:          ServiceKey{GUID: "new-key-guid", Name: "my-key-v3"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+				Type:     constant.ServiceInstanceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-service-instance-guid"},
+			}))
+			Expect(fakeCloudControllerClient.GetServiceKeyParametersArgsForCall(0)).To(Equal("old-key-guid"))
+
+			instanceGUID, keyName, keyParameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+			Expect(instanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(keyName).To(Equal("my-key-v3"))
+			Expect(keyParameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+		})
+
+		When("parameters are provided", func() {
+			BeforeEach(func() {
+				parameters = map[string]interface{}{"permissions": "read-write"}
+			})
+
+			It("creates the successor key with them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceKeyParametersCallCount()).To(Equal(0))
+				_, _, keyParameters := fakeCloudControllerClient.CreateServiceKeyArgsForCall(0)
+				Expect(keyParameters).To(Equal(parameters))
+			})
+		})
+
+		When("the key does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, nil, nil)
+			})
+
+			It("returns a ServiceKeyNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{Name: "my-key", ServiceInstanceName: "some-service-instance"}))
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the parameters of the old key fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceKeyParametersReturns(nil, ccv2.Warnings{"parameters-warning"}, errors.New("parameters-error"))
+			})
+
+			It("returns a ServiceKeyParametersUnavailableError without creating a key", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceKeyParametersUnavailableError{Name: "my-key", Err: errors.New("parameters-error")}))
+				Expect(warnings).To(ContainElement("parameters-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RebindServiceInstanceToApplication", func() {
+		var (
+			replacement ServiceBindingReplacement
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceBindingsReturns(
+				[]ccv2.ServiceBinding{{GUID: "binding-guid", Name: "my-binding"}},
+				ccv2.Warnings{"bindings-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceBindingParametersReturns(map[string]interface{}{"role": "reader"}, ccv2.Warnings{"parameters-warning"}, nil)
+			fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{GUID: "new-binding-guid"}, ccv2.Warnings{"create-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			replacement, warnings, executeErr = actor.RebindServiceInstanceToApplication(Application{GUID: "app-guid"}, "some-service-instance-guid")
+		})
+
+		It("replaces the binding with a new binding of the same name and parameters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("bindings-warning", "parameters-warning", "delete-warning", "create-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceBindingParametersArgsForCall(0)).To(Equal("binding-guid"))
+
+			bindingGUID, acceptsIncomplete := fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)
+			Expect(bindingGUID).To(Equal("binding-guid"))
+			Expect(acceptsIncomplete).To(BeFalse())
+
+			appGUID, instanceGUID, bindingName, acceptsIncomplete, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(instanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(bindingName).To(Equal("my-binding"))
+			Expect(acceptsIncomplete).To(BeFalse())
+			Expect(parameters).To(Equal(map[string]interface{}{"role": "reader"}))
+
+			Expect(replacement).To(Equal(ServiceBindingReplacement{
+				App:                 Application{GUID: "app-guid"},
+				ServiceInstanceGUID: "some-service-instance-guid",
+				Name:                "my-binding",
+				Parameters:          map[string]interface{}{"role": "reader"},
+				NewBindingGUID:      "new-binding-guid",
+			}))
+		})
+
+		When("getting the parameters of the binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingParametersReturns(nil, nil, errors.New("parameters-error"))
+			})
+
+			It("returns the error without deleting the binding", func() {
+				Expect(executeErr).To(MatchError("parameters-error"))
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+
+		When("deleting the binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.ServiceBinding{}, nil, errors.New("delete-error"))
+			})
+
+			It("returns the error without creating a binding", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+				Expect(replacement).To(Equal(ServiceBindingReplacement{}))
+			})
+		})
+
+		When("creating the new binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, nil, errors.New("create-error"))
+			})
+
+			It("returns the error and records the deleted binding", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(replacement).To(Equal(ServiceBindingReplacement{
+					App:                 Application{GUID: "app-guid"},
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Name:                "my-binding",
+					Parameters:          map[string]interface{}{"role": "reader"},
+				}))
+			})
+		})
+	})
+
+	Describe("RestoreServiceBinding", func() {
+		var (
+			replacement ServiceBindingReplacement
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			replacement = ServiceBindingReplacement{
+				App:                 Application{GUID: "app-guid"},
+				ServiceInstanceGUID: "some-service-instance-guid",
+				Name:                "my-binding",
+				Parameters:          map[string]interface{}{"role": "reader"},
+				NewBindingGUID:      "new-binding-guid",
+			}
+			fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"create-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.RestoreServiceBinding(replacement)
+		})
+
+		It("replaces the new binding with one of the recorded name and parameters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning", "create-warning"))
+
+			bindingGUID, _ := fakeCloudControllerClient.DeleteServiceBindingArgsForCall(0)
+			Expect(bindingGUID).To(Equal("new-binding-guid"))
+
+			appGUID, instanceGUID, bindingName, _, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(instanceGUID).To(Equal("some-service-instance-guid"))
+			Expect(bindingName).To(Equal("my-binding"))
+			Expect(parameters).To(Equal(map[string]interface{}{"role": "reader"}))
+		})
+
+		When("no new binding was created", func() {
+			BeforeEach(func() {
+				replacement.NewBindingGUID = ""
+			})
+
+			It("only recreates the deleted binding", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteServiceBindingCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			})
+		})
+
+		When("deleting the new binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error without creating a binding", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		It("deletes the service key", func() {
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-warning"}, nil)
+
+			warnings, err := actor.DeleteServiceKey("some-key-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// PollServiceInstanceOperation polls the last operation of the service
+// instance until it is no longer in progress. See pollLastOperation for the
+// values sent on the returned channels.
+func (actor Actor) PollServiceInstanceOperation(serviceInstanceGUID string, timeout time.Duration) (<-chan LastOperation, <-chan string, <-chan error) {
+	return actor.pollLastOperation(timeout, func() (ccv2.LastOperation, ccv2.Warnings, error) {
+		instance, warnings, err := actor.CloudControllerClient.GetServiceInstance(serviceInstanceGUID)
+		return instance.LastOperation, warnings, err
+	})
+}
+
+// PollServiceBindingOperation polls the last operation of the service binding
+// until it is no longer in progress. See pollLastOperation for the values sent
+// on the returned channels.
+func (actor Actor) PollServiceBindingOperation(serviceBindingGUID string, timeout time.Duration) (<-chan LastOperation, <-chan string, <-chan error) {
+	return actor.pollLastOperation(timeout, func() (ccv2.LastOperation, ccv2.Warnings, error) {
+		binding, warnings, err := actor.CloudControllerClient.GetServiceBinding(serviceBindingGUID)
+		return binding.LastOperation, warnings, err
+	})
+}
+
+// pollLastOperation sends the last operation returned by getLastOperation
+// every time its state or description changes, until it has succeeded. A
+// resource that no longer exists is treated as a successfully completed
+// delete. A failed operation sends a ServiceOperationFailedError and an
+// operation still in progress after the timeout sends a
+// ServiceOperationTimeoutError; a timeout of 0 waits forever. All channels are
+// closed once polling has finished.
+func (actor Actor) pollLastOperation(timeout time.Duration, getLastOperation func() (ccv2.LastOperation, ccv2.Warnings, error)) (<-chan LastOperation, <-chan string, <-chan error) {
+	lastOperations := make(chan LastOperation)
+	allWarnings := make(chan string)
+	errs := make(chan error)
+
+	go func() {
+		defer close(lastOperations)
+		defer close(allWarnings)
+		defer close(errs)
+
+		var (
+			deadline <-chan time.Time
+			previous LastOperation
+			polled   bool
+		)
+		if timeout > 0 {
+			deadline = time.After(timeout)
+		}
+
+		for {
+			ccLastOperation, warnings, err := getLastOperation()
+			for _, warning := range warnings {
+				allWarnings <- warning
+			}
+			if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+				lastOperations <- LastOperation{Type: "delete", State: constant.LastOperationSucceeded}
+				return
+			}
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			lastOperation := LastOperation(ccLastOperation)
+			if !polled || lastOperation.State != previous.State || lastOperation.Description != previous.Description {
+				lastOperations <- lastOperation
+			}
+			polled = true
+			previous = lastOperation
+
+			switch lastOperation.State {
+			case constant.LastOperationInProgress:
+			case constant.LastOperationFailed:
+				errs <- actionerror.ServiceOperationFailedError{
+					Operation:   lastOperation.Type,
+					Description: lastOperation.Description,
+				}
+				return
+			default:
+				return
+			}
+
+			select {
+			case <-time.After(actor.Config.PollingInterval()):
+			case <-deadline:
+				errs <- actionerror.ServiceOperationTimeoutError{
+					Operation: lastOperation.Type,
+					Timeout:   timeout,
+				}
+				return
+			}
+		}
+	}()
+
+	return lastOperations, allWarnings, errs
+}
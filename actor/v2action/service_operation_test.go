@@ -0,0 +1,141 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Operation Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+		lastOperations            <-chan LastOperation
+		warnings                  <-chan string
+		errs                      <-chan error
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, fakeConfig = NewTestActor()
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+	})
+
+	Describe("PollServiceInstanceOperation", func() {
+		var timeout time.Duration
+
+		BeforeEach(func() {
+			timeout = 0
+		})
+
+		JustBeforeEach(func() {
+			lastOperations, warnings, errs = actor.PollServiceInstanceOperation("some-service-instance-guid", timeout)
+		})
+
+		When("the operation succeeds", func() {
+			BeforeEach(func() {
+				inProgress := ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationInProgress, Description: "provisioning"}}
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(0, inProgress, ccv2.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(1, inProgress, ccv2.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetServiceInstanceReturnsOnCall(2,
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationSucceeded}},
+					nil,
+					nil,
+				)
+			})
+
+			It("sends each state transition once and closes the channels", func() {
+				Eventually(warnings).Should(Receive(Equal("warning-1")))
+				Eventually(lastOperations).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationInProgress, Description: "provisioning"})))
+				Eventually(warnings).Should(Receive(Equal("warning-2")))
+				Eventually(lastOperations).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationSucceeded})))
+				Eventually(lastOperations).Should(BeClosed())
+				Eventually(errs).Should(BeClosed())
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		When("the operation fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "update", State: constant.LastOperationFailed, Description: "quota exceeded"}},
+					nil,
+					nil,
+				)
+			})
+
+			It("sends a ServiceOperationFailedError with the broker's description", func() {
+				Eventually(lastOperations).Should(Receive())
+				Eventually(errs).Should(Receive(MatchError(actionerror.ServiceOperationFailedError{Operation: "update", Description: "quota exceeded"})))
+				Eventually(errs).Should(BeClosed())
+			})
+		})
+
+		When("the service instance has been deleted", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, nil, ccerror.ResourceNotFoundError{})
+			})
+
+			It("sends a succeeded delete operation", func() {
+				Eventually(lastOperations).Should(Receive(Equal(LastOperation{Type: "delete", State: constant.LastOperationSucceeded})))
+				Eventually(errs).Should(BeClosed())
+			})
+		})
+
+		When("the operation is still in progress after the timeout", func() {
+			BeforeEach(func() {
+				timeout = 10 * time.Millisecond
+				fakeCloudControllerClient.GetServiceInstanceReturns(
+					ccv2.ServiceInstance{LastOperation: ccv2.LastOperation{Type: "delete", State: constant.LastOperationInProgress}},
+					nil,
+					nil,
+				)
+			})
+
+			It("sends a ServiceOperationTimeoutError", func() {
+				Eventually(lastOperations).Should(Receive())
+				Eventually(errs).Should(Receive(MatchError(actionerror.ServiceOperationTimeoutError{Operation: "delete", Timeout: timeout})))
+			})
+		})
+
+		When("getting the service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{}, ccv2.Warnings{"warning-1"}, errors.New("get-error"))
+			})
+
+			It("sends the warnings and the error", func() {
+				Eventually(warnings).Should(Receive(Equal("warning-1")))
+				Eventually(errs).Should(Receive(MatchError("get-error")))
+			})
+		})
+	})
+
+	Describe("PollServiceBindingOperation", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceBindingReturns(
+				ccv2.ServiceBinding{LastOperation: ccv2.LastOperation{Type: "create", State: constant.LastOperationSucceeded}},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("polls the service binding", func() {
+			lastOperations, warnings, errs = actor.PollServiceBindingOperation("some-binding-guid", 0)
+
+			Eventually(warnings).Should(Receive(Equal("warning-1")))
+			Eventually(lastOperations).Should(Receive(Equal(LastOperation{Type: "create", State: constant.LastOperationSucceeded})))
+			Eventually(errs).Should(BeClosed())
+			Expect(fakeCloudControllerClient.GetServiceBindingArgsForCall(0)).To(Equal("some-binding-guid"))
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// SpaceApplicationSummary is an application in a space with its instance
+// counts, quotas and the URLs of its routes.
+type SpaceApplicationSummary ccv2.SpaceSummaryApplication
+
+// GetSpaceApplicationSummaries returns a summary of each application in the
+// space, sorted by name, using a single request to the space summary.
+func (actor Actor) GetSpaceApplicationSummaries(spaceGUID string) ([]SpaceApplicationSummary, Warnings, error) {
+	spaceSummary, warnings, err := actor.CloudControllerClient.GetSpaceSummary(spaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	summaries := make([]SpaceApplicationSummary, len(spaceSummary.Applications))
+	for i, app := range spaceSummary.Applications {
+		summaries[i] = SpaceApplicationSummary(app)
+	}
+	sort.Slice(summaries, func(i int, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+
+	return summaries, Warnings(warnings), nil
+}
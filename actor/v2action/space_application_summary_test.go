@@ -0,0 +1,68 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Application Summary Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _ = NewTestActor()
+	})
+
+	Describe("GetSpaceApplicationSummaries", func() {
+		var (
+			summaries  []SpaceApplicationSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetSpaceApplicationSummaries("some-space-guid")
+		})
+
+		When("getting the space summary succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceSummaryReturns(ccv2.SpaceSummary{
+					Applications: []ccv2.SpaceSummaryApplication{
+						{Name: "app-2", GUID: "app-guid-2", State: "STOPPED"},
+						{Name: "app-1", GUID: "app-guid-1", State: "STARTED", Instances: 2, RunningInstances: 1, URLs: []string{"app-1.example.com"}},
+					},
+				}, ccv2.Warnings{"summary-warning"}, nil)
+			})
+
+			It("returns the applications sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("summary-warning"))
+				Expect(summaries).To(Equal([]SpaceApplicationSummary{
+					{Name: "app-1", GUID: "app-guid-1", State: "STARTED", Instances: 2, RunningInstances: 1, URLs: []string{"app-1.example.com"}},
+					{Name: "app-2", GUID: "app-guid-2", State: "STOPPED"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpaceSummaryCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceSummaryArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+		})
+
+		When("getting the space summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceSummaryReturns(ccv2.SpaceSummary{}, ccv2.Warnings{"summary-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("summary-warning"))
+			})
+		})
+	})
+})
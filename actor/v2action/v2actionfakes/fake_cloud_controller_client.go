@@ -7,6 +7,7 @@ import (
 
 	v2action "code.cloudfoundry.org/cli/actor/v2action"
 	ccv2 "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -101,6 +102,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateOrganizationQuotaStub        func(ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	createOrganizationQuotaMutex       sync.RWMutex
+	createOrganizationQuotaArgsForCall []struct {
+		arg1 ccv2.OrganizationQuota
+	}
+	createOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(ccv2.Route, bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -238,6 +254,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSpaceQuotaDefinitionStub        func(ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	createSpaceQuotaDefinitionMutex       sync.RWMutex
+	createSpaceQuotaDefinitionArgsForCall []struct {
+		arg1 ccv2.SpaceQuota
+	}
+	createSpaceQuotaDefinitionReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceQuotaDefinitionReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -353,6 +384,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteServiceKeyStub        func(string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteServicePlanVisibilityStub        func(string) (ccv2.Warnings, error)
 	deleteServicePlanVisibilityMutex       sync.RWMutex
 	deleteServicePlanVisibilityArgsForCall []struct {
@@ -509,6 +553,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetEventsStub        func(...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 []ccv2.Filter
+	}
+	getEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -524,6 +583,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetNewestEventsStub        func(int, func(ccv2.Event) bool, ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)
+	getNewestEventsMutex       sync.RWMutex
+	getNewestEventsArgsForCall []struct {
+		arg1 int
+		arg2 func(ccv2.Event) bool
+		arg3 []ccv2.Filter
+	}
+	getNewestEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getNewestEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationStub        func(string) (ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationMutex       sync.RWMutex
 	getOrganizationArgsForCall []struct {
@@ -585,6 +661,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationUsersByRoleStub        func(constant.OrganizationRole, string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		arg1 constant.OrganizationRole
+		arg2 string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -706,6 +798,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceBindingStub        func(string) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	getServiceBindingMutex       sync.RWMutex
+	getServiceBindingArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBindingReturns struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceBindingParametersStub        func(string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceBindingParametersMutex       sync.RWMutex
+	getServiceBindingParametersArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBindingParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceBindingParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServiceBindingsStub        func(...ccv2.Filter) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	getServiceBindingsMutex       sync.RWMutex
 	getServiceBindingsArgsForCall []struct {
@@ -811,6 +933,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeyParametersStub        func(string) (map[string]interface{}, ccv2.Warnings, error)
+	getServiceKeyParametersMutex       sync.RWMutex
+	getServiceKeyParametersArgsForCall []struct {
+		arg1 string
+	}
+	getServiceKeyParametersReturns struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeyParametersReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceKeysStub        func(...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		arg1 []ccv2.Filter
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServicePlanStub        func(string) (ccv2.ServicePlan, ccv2.Warnings, error)
 	getServicePlanMutex       sync.RWMutex
 	getServicePlanArgsForCall []struct {
@@ -1027,6 +1179,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(constant.SpaceRole, string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		arg1 constant.SpaceRole
+		arg2 string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -1135,6 +1303,20 @@ type FakeCloudControllerClient struct {
 	routingEndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetOrganizationQuotaStub        func(string, string) (ccv2.Warnings, error)
+	setOrganizationQuotaMutex       sync.RWMutex
+	setOrganizationQuotaArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setOrganizationQuotaReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	setOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	SetSpaceQuotaStub        func(string, string) (ccv2.Warnings, error)
 	setSpaceQuotaMutex       sync.RWMutex
 	setSpaceQuotaArgsForCall []struct {
@@ -1202,6 +1384,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateConfigFeatureFlagStub        func(ccv2.FeatureFlag) (ccv2.Warnings, error)
+	updateConfigFeatureFlagMutex       sync.RWMutex
+	updateConfigFeatureFlagArgsForCall []struct {
+		arg1 ccv2.FeatureFlag
+	}
+	updateConfigFeatureFlagReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateConfigFeatureFlagReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateOrganizationManagerStub        func(string, string) (ccv2.Warnings, error)
 	updateOrganizationManagerMutex       sync.RWMutex
 	updateOrganizationManagerArgsForCall []struct {
@@ -1230,6 +1425,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateOrganizationQuotaStub        func(ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		arg1 ccv2.OrganizationQuota
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	UpdateOrganizationUserStub        func(string, string) (ccv2.Warnings, error)
 	updateOrganizationUserMutex       sync.RWMutex
 	updateOrganizationUserArgsForCall []struct {
@@ -1244,6 +1454,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateOrganizationUserByRoleStub        func(constant.OrganizationRole, string, string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleMutex       sync.RWMutex
+	updateOrganizationUserByRoleArgsForCall []struct {
+		arg1 constant.OrganizationRole
+		arg2 string
+		arg3 string
+	}
+	updateOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateOrganizationUserByUsernameStub        func(string, string) (ccv2.Warnings, error)
 	updateOrganizationUserByUsernameMutex       sync.RWMutex
 	updateOrganizationUserByUsernameArgsForCall []struct {
@@ -1331,6 +1556,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateSpaceAllowSSHStub        func(string, bool) (ccv2.Warnings, error)
+	updateSpaceAllowSSHMutex       sync.RWMutex
+	updateSpaceAllowSSHArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	updateSpaceAllowSSHReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceAllowSSHReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateSpaceDeveloperStub        func(string, string) (ccv2.Warnings, error)
 	updateSpaceDeveloperMutex       sync.RWMutex
 	updateSpaceDeveloperArgsForCall []struct {
@@ -1387,6 +1626,36 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateSpaceQuotaDefinitionStub        func(ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	updateSpaceQuotaDefinitionMutex       sync.RWMutex
+	updateSpaceQuotaDefinitionArgsForCall []struct {
+		arg1 ccv2.SpaceQuota
+	}
+	updateSpaceQuotaDefinitionReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSpaceQuotaDefinitionReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceUserByRoleStub        func(constant.SpaceRole, string, string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleMutex       sync.RWMutex
+	updateSpaceUserByRoleArgsForCall []struct {
+		arg1 constant.SpaceRole
+		arg2 string
+		arg3 string
+	}
+	updateSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UploadApplicationPackageStub        func(string, []ccv2.Resource, ccv2.Reader, int64) (ccv2.Job, ccv2.Warnings, error)
 	uploadApplicationPackageMutex       sync.RWMutex
 	uploadApplicationPackageArgsForCall []struct {
@@ -1863,6 +2132,72 @@ func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateOrganizationQuota(arg1 ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.createOrganizationQuotaReturnsOnCall[len(fake.createOrganizationQuotaArgsForCall)]
+	fake.createOrganizationQuotaArgsForCall = append(fake.createOrganizationQuotaArgsForCall, struct {
+		arg1 ccv2.OrganizationQuota
+	}{arg1})
+	fake.recordInvocation("CreateOrganizationQuota", []interface{}{arg1})
+	fake.createOrganizationQuotaMutex.Unlock()
+	if fake.CreateOrganizationQuotaStub != nil {
+		return fake.CreateOrganizationQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createOrganizationQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCallCount() int {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	return len(fake.createOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaCalls(stub func(ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)) {
+	fake.createOrganizationQuotaMutex.Lock()
+	defer fake.createOrganizationQuotaMutex.Unlock()
+	fake.CreateOrganizationQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.createOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	defer fake.createOrganizationQuotaMutex.Unlock()
+	fake.CreateOrganizationQuotaStub = nil
+	fake.createOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.createOrganizationQuotaMutex.Lock()
+	defer fake.createOrganizationQuotaMutex.Unlock()
+	fake.CreateOrganizationQuotaStub = nil
+	if fake.createOrganizationQuotaReturnsOnCall == nil {
+		fake.createOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(arg1 ccv2.Route, arg2 bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -2412,6 +2747,72 @@ func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinition(arg1 ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.createSpaceQuotaDefinitionMutex.Lock()
+	ret, specificReturn := fake.createSpaceQuotaDefinitionReturnsOnCall[len(fake.createSpaceQuotaDefinitionArgsForCall)]
+	fake.createSpaceQuotaDefinitionArgsForCall = append(fake.createSpaceQuotaDefinitionArgsForCall, struct {
+		arg1 ccv2.SpaceQuota
+	}{arg1})
+	fake.recordInvocation("CreateSpaceQuotaDefinition", []interface{}{arg1})
+	fake.createSpaceQuotaDefinitionMutex.Unlock()
+	if fake.CreateSpaceQuotaDefinitionStub != nil {
+		return fake.CreateSpaceQuotaDefinitionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createSpaceQuotaDefinitionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionCallCount() int {
+	fake.createSpaceQuotaDefinitionMutex.RLock()
+	defer fake.createSpaceQuotaDefinitionMutex.RUnlock()
+	return len(fake.createSpaceQuotaDefinitionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionCalls(stub func(ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)) {
+	fake.createSpaceQuotaDefinitionMutex.Lock()
+	defer fake.createSpaceQuotaDefinitionMutex.Unlock()
+	fake.CreateSpaceQuotaDefinitionStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionArgsForCall(i int) ccv2.SpaceQuota {
+	fake.createSpaceQuotaDefinitionMutex.RLock()
+	defer fake.createSpaceQuotaDefinitionMutex.RUnlock()
+	argsForCall := fake.createSpaceQuotaDefinitionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.createSpaceQuotaDefinitionMutex.Lock()
+	defer fake.createSpaceQuotaDefinitionMutex.Unlock()
+	fake.CreateSpaceQuotaDefinitionStub = nil
+	fake.createSpaceQuotaDefinitionReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.createSpaceQuotaDefinitionMutex.Lock()
+	defer fake.createSpaceQuotaDefinitionMutex.Unlock()
+	fake.CreateSpaceQuotaDefinitionStub = nil
+	if fake.createSpaceQuotaDefinitionReturnsOnCall == nil {
+		fake.createSpaceQuotaDefinitionReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceQuotaDefinitionReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(arg1 string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -2930,6 +3331,69 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceKey(arg1 string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{arg1})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteServiceKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCalls(stub func(string) (ccv2.Warnings, error)) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	argsForCall := fake.deleteServiceKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServicePlanVisibility(arg1 string) (ccv2.Warnings, error) {
 	fake.deleteServicePlanVisibilityMutex.Lock()
 	ret, specificReturn := fake.deleteServicePlanVisibilityReturnsOnCall[len(fake.deleteServicePlanVisibilityArgsForCall)]
@@ -3629,15 +4093,81 @@ func (fake *FakeCloudControllerClient) GetConfigFeatureFlagsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetJob(arg1 string) (ccv2.Job, ccv2.Warnings, error) {
-	fake.getJobMutex.Lock()
-	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
-	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
-		arg1 string
+func (fake *FakeCloudControllerClient) GetEvents(arg1 ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 []ccv2.Filter
 	}{arg1})
-	fake.recordInvocation("GetJob", []interface{}{arg1})
-	fake.getJobMutex.Unlock()
-	if fake.GetJobStub != nil {
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetEventsCalls(stub func(...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetEventsArgsForCall(i int) []ccv2.Filter {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJob(arg1 string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetJob", []interface{}{arg1})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
 		return fake.GetJobStub(arg1)
 	}
 	if specificReturn {
@@ -3695,6 +4225,74 @@ func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv2.J
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetNewestEvents(arg1 int, arg2 func(ccv2.Event) bool, arg3 ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error) {
+	fake.getNewestEventsMutex.Lock()
+	ret, specificReturn := fake.getNewestEventsReturnsOnCall[len(fake.getNewestEventsArgsForCall)]
+	fake.getNewestEventsArgsForCall = append(fake.getNewestEventsArgsForCall, struct {
+		arg1 int
+		arg2 func(ccv2.Event) bool
+		arg3 []ccv2.Filter
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetNewestEvents", []interface{}{arg1, arg2, arg3})
+	fake.getNewestEventsMutex.Unlock()
+	if fake.GetNewestEventsStub != nil {
+		return fake.GetNewestEventsStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getNewestEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetNewestEventsCallCount() int {
+	fake.getNewestEventsMutex.RLock()
+	defer fake.getNewestEventsMutex.RUnlock()
+	return len(fake.getNewestEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetNewestEventsCalls(stub func(int, func(ccv2.Event) bool, ...ccv2.Filter) ([]ccv2.Event, ccv2.Warnings, error)) {
+	fake.getNewestEventsMutex.Lock()
+	defer fake.getNewestEventsMutex.Unlock()
+	fake.GetNewestEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetNewestEventsArgsForCall(i int) (int, func(ccv2.Event) bool, []ccv2.Filter) {
+	fake.getNewestEventsMutex.RLock()
+	defer fake.getNewestEventsMutex.RUnlock()
+	argsForCall := fake.getNewestEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) GetNewestEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.getNewestEventsMutex.Lock()
+	defer fake.getNewestEventsMutex.Unlock()
+	fake.GetNewestEventsStub = nil
+	fake.getNewestEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetNewestEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.getNewestEventsMutex.Lock()
+	defer fake.getNewestEventsMutex.Unlock()
+	fake.GetNewestEventsStub = nil
+	if fake.getNewestEventsReturnsOnCall == nil {
+		fake.getNewestEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getNewestEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganization(arg1 string) (ccv2.Organization, ccv2.Warnings, error) {
 	fake.getOrganizationMutex.Lock()
 	ret, specificReturn := fake.getOrganizationReturnsOnCall[len(fake.getOrganizationArgsForCall)]
@@ -3960,6 +4558,73 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(arg1 constant.OrganizationRole, arg2 string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		arg1 constant.OrganizationRole
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{arg1, arg2})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationUsersByRoleReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCalls(stub func(constant.OrganizationRole, string) ([]ccv2.User, ccv2.Warnings, error)) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	defer fake.getOrganizationUsersByRoleMutex.Unlock()
+	fake.GetOrganizationUsersByRoleStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (constant.OrganizationRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	argsForCall := fake.getOrganizationUsersByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	defer fake.getOrganizationUsersByRoleMutex.Unlock()
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	defer fake.getOrganizationUsersByRoleMutex.Unlock()
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -4489,6 +5154,138 @@ func (fake *FakeCloudControllerClient) GetServiceReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceBinding(arg1 string) (ccv2.ServiceBinding, ccv2.Warnings, error) {
+	fake.getServiceBindingMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingReturnsOnCall[len(fake.getServiceBindingArgsForCall)]
+	fake.getServiceBindingArgsForCall = append(fake.getServiceBindingArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceBinding", []interface{}{arg1})
+	fake.getServiceBindingMutex.Unlock()
+	if fake.GetServiceBindingStub != nil {
+		return fake.GetServiceBindingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceBindingReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingCallCount() int {
+	fake.getServiceBindingMutex.RLock()
+	defer fake.getServiceBindingMutex.RUnlock()
+	return len(fake.getServiceBindingArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingCalls(stub func(string) (ccv2.ServiceBinding, ccv2.Warnings, error)) {
+	fake.getServiceBindingMutex.Lock()
+	defer fake.getServiceBindingMutex.Unlock()
+	fake.GetServiceBindingStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingArgsForCall(i int) string {
+	fake.getServiceBindingMutex.RLock()
+	defer fake.getServiceBindingMutex.RUnlock()
+	argsForCall := fake.getServiceBindingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingReturns(result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceBindingMutex.Lock()
+	defer fake.getServiceBindingMutex.Unlock()
+	fake.GetServiceBindingStub = nil
+	fake.getServiceBindingReturns = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingReturnsOnCall(i int, result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceBindingMutex.Lock()
+	defer fake.getServiceBindingMutex.Unlock()
+	fake.GetServiceBindingStub = nil
+	if fake.getServiceBindingReturnsOnCall == nil {
+		fake.getServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParameters(arg1 string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingParametersReturnsOnCall[len(fake.getServiceBindingParametersArgsForCall)]
+	fake.getServiceBindingParametersArgsForCall = append(fake.getServiceBindingParametersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceBindingParameters", []interface{}{arg1})
+	fake.getServiceBindingParametersMutex.Unlock()
+	if fake.GetServiceBindingParametersStub != nil {
+		return fake.GetServiceBindingParametersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceBindingParametersReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersCallCount() int {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	return len(fake.getServiceBindingParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersCalls(stub func(string) (map[string]interface{}, ccv2.Warnings, error)) {
+	fake.getServiceBindingParametersMutex.Lock()
+	defer fake.getServiceBindingParametersMutex.Unlock()
+	fake.GetServiceBindingParametersStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersArgsForCall(i int) string {
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
+	argsForCall := fake.getServiceBindingParametersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	defer fake.getServiceBindingParametersMutex.Unlock()
+	fake.GetServiceBindingParametersStub = nil
+	fake.getServiceBindingParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceBindingParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceBindingParametersMutex.Lock()
+	defer fake.getServiceBindingParametersMutex.Unlock()
+	fake.GetServiceBindingParametersStub = nil
+	if fake.getServiceBindingParametersReturnsOnCall == nil {
+		fake.getServiceBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceBindings(arg1 ...ccv2.Filter) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.getServiceBindingsMutex.Lock()
 	ret, specificReturn := fake.getServiceBindingsReturnsOnCall[len(fake.getServiceBindingsArgsForCall)]
@@ -4951,6 +5748,138 @@ func (fake *FakeCloudControllerClient) GetServiceInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceKeyParameters(arg1 string) (map[string]interface{}, ccv2.Warnings, error) {
+	fake.getServiceKeyParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceKeyParametersReturnsOnCall[len(fake.getServiceKeyParametersArgsForCall)]
+	fake.getServiceKeyParametersArgsForCall = append(fake.getServiceKeyParametersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceKeyParameters", []interface{}{arg1})
+	fake.getServiceKeyParametersMutex.Unlock()
+	if fake.GetServiceKeyParametersStub != nil {
+		return fake.GetServiceKeyParametersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceKeyParametersReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersCallCount() int {
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	return len(fake.getServiceKeyParametersArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersCalls(stub func(string) (map[string]interface{}, ccv2.Warnings, error)) {
+	fake.getServiceKeyParametersMutex.Lock()
+	defer fake.getServiceKeyParametersMutex.Unlock()
+	fake.GetServiceKeyParametersStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersArgsForCall(i int) string {
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	argsForCall := fake.getServiceKeyParametersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersReturns(result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceKeyParametersMutex.Lock()
+	defer fake.getServiceKeyParametersMutex.Unlock()
+	fake.GetServiceKeyParametersStub = nil
+	fake.getServiceKeyParametersReturns = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeyParametersReturnsOnCall(i int, result1 map[string]interface{}, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceKeyParametersMutex.Lock()
+	defer fake.getServiceKeyParametersMutex.Unlock()
+	fake.GetServiceKeyParametersStub = nil
+	if fake.getServiceKeyParametersReturnsOnCall == nil {
+		fake.getServiceKeyParametersReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeyParametersReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeys(arg1 ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		arg1 []ccv2.Filter
+	}{arg1})
+	fake.recordInvocation("GetServiceKeys", []interface{}{arg1})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceKeysReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCalls(stub func(...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error)) {
+	fake.getServiceKeysMutex.Lock()
+	defer fake.getServiceKeysMutex.Unlock()
+	fake.GetServiceKeysStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Filter {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	argsForCall := fake.getServiceKeysArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceKeysMutex.Lock()
+	defer fake.getServiceKeysMutex.Unlock()
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.getServiceKeysMutex.Lock()
+	defer fake.getServiceKeysMutex.Unlock()
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServicePlan(arg1 string) (ccv2.ServicePlan, ccv2.Warnings, error) {
 	fake.getServicePlanMutex.Lock()
 	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
@@ -5881,6 +6810,73 @@ func (fake *FakeCloudControllerClient) GetSpaceSummaryReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(arg1 constant.SpaceRole, arg2 string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		arg1 constant.SpaceRole
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{arg1, arg2})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceUsersByRoleReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCalls(stub func(constant.SpaceRole, string) ([]ccv2.User, ccv2.Warnings, error)) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	defer fake.getSpaceUsersByRoleMutex.Unlock()
+	fake.GetSpaceUsersByRoleStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (constant.SpaceRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	argsForCall := fake.getSpaceUsersByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	defer fake.getSpaceUsersByRoleMutex.Unlock()
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	defer fake.getSpaceUsersByRoleMutex.Unlock()
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(arg1 ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -6378,6 +7374,70 @@ func (fake *FakeCloudControllerClient) RoutingEndpointReturnsOnCall(i int, resul
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) SetOrganizationQuota(arg1 string, arg2 string) (ccv2.Warnings, error) {
+	fake.setOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.setOrganizationQuotaReturnsOnCall[len(fake.setOrganizationQuotaArgsForCall)]
+	fake.setOrganizationQuotaArgsForCall = append(fake.setOrganizationQuotaArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetOrganizationQuota", []interface{}{arg1, arg2})
+	fake.setOrganizationQuotaMutex.Unlock()
+	if fake.SetOrganizationQuotaStub != nil {
+		return fake.SetOrganizationQuotaStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setOrganizationQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) SetOrganizationQuotaCallCount() int {
+	fake.setOrganizationQuotaMutex.RLock()
+	defer fake.setOrganizationQuotaMutex.RUnlock()
+	return len(fake.setOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) SetOrganizationQuotaCalls(stub func(string, string) (ccv2.Warnings, error)) {
+	fake.setOrganizationQuotaMutex.Lock()
+	defer fake.setOrganizationQuotaMutex.Unlock()
+	fake.SetOrganizationQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) SetOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.setOrganizationQuotaMutex.RLock()
+	defer fake.setOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.setOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) SetOrganizationQuotaReturns(result1 ccv2.Warnings, result2 error) {
+	fake.setOrganizationQuotaMutex.Lock()
+	defer fake.setOrganizationQuotaMutex.Unlock()
+	fake.SetOrganizationQuotaStub = nil
+	fake.setOrganizationQuotaReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) SetOrganizationQuotaReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.setOrganizationQuotaMutex.Lock()
+	defer fake.setOrganizationQuotaMutex.Unlock()
+	fake.SetOrganizationQuotaStub = nil
+	if fake.setOrganizationQuotaReturnsOnCall == nil {
+		fake.setOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.setOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) SetSpaceQuota(arg1 string, arg2 string) (ccv2.Warnings, error) {
 	fake.setSpaceQuotaMutex.Lock()
 	ret, specificReturn := fake.setSpaceQuotaReturnsOnCall[len(fake.setSpaceQuotaArgsForCall)]
@@ -6689,6 +7749,69 @@ func (fake *FakeCloudControllerClient) UpdateBuildpackReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateConfigFeatureFlag(arg1 ccv2.FeatureFlag) (ccv2.Warnings, error) {
+	fake.updateConfigFeatureFlagMutex.Lock()
+	ret, specificReturn := fake.updateConfigFeatureFlagReturnsOnCall[len(fake.updateConfigFeatureFlagArgsForCall)]
+	fake.updateConfigFeatureFlagArgsForCall = append(fake.updateConfigFeatureFlagArgsForCall, struct {
+		arg1 ccv2.FeatureFlag
+	}{arg1})
+	fake.recordInvocation("UpdateConfigFeatureFlag", []interface{}{arg1})
+	fake.updateConfigFeatureFlagMutex.Unlock()
+	if fake.UpdateConfigFeatureFlagStub != nil {
+		return fake.UpdateConfigFeatureFlagStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateConfigFeatureFlagReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateConfigFeatureFlagCallCount() int {
+	fake.updateConfigFeatureFlagMutex.RLock()
+	defer fake.updateConfigFeatureFlagMutex.RUnlock()
+	return len(fake.updateConfigFeatureFlagArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateConfigFeatureFlagCalls(stub func(ccv2.FeatureFlag) (ccv2.Warnings, error)) {
+	fake.updateConfigFeatureFlagMutex.Lock()
+	defer fake.updateConfigFeatureFlagMutex.Unlock()
+	fake.UpdateConfigFeatureFlagStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateConfigFeatureFlagArgsForCall(i int) ccv2.FeatureFlag {
+	fake.updateConfigFeatureFlagMutex.RLock()
+	defer fake.updateConfigFeatureFlagMutex.RUnlock()
+	argsForCall := fake.updateConfigFeatureFlagArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateConfigFeatureFlagReturns(result1 ccv2.Warnings, result2 error) {
+	fake.updateConfigFeatureFlagMutex.Lock()
+	defer fake.updateConfigFeatureFlagMutex.Unlock()
+	fake.UpdateConfigFeatureFlagStub = nil
+	fake.updateConfigFeatureFlagReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateConfigFeatureFlagReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.updateConfigFeatureFlagMutex.Lock()
+	defer fake.updateConfigFeatureFlagMutex.Unlock()
+	fake.UpdateConfigFeatureFlagStub = nil
+	if fake.updateConfigFeatureFlagReturnsOnCall == nil {
+		fake.updateConfigFeatureFlagReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateConfigFeatureFlagReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateOrganizationManager(arg1 string, arg2 string) (ccv2.Warnings, error) {
 	fake.updateOrganizationManagerMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationManagerReturnsOnCall[len(fake.updateOrganizationManagerArgsForCall)]
@@ -6817,6 +7940,72 @@ func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsernameReturn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(arg1 ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		arg1 ccv2.OrganizationQuota
+	}{arg1})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{arg1})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateOrganizationQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCalls(stub func(ccv2.OrganizationQuota) (ccv2.OrganizationQuota, ccv2.Warnings, error)) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	defer fake.updateOrganizationQuotaMutex.Unlock()
+	fake.UpdateOrganizationQuotaStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) ccv2.OrganizationQuota {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	argsForCall := fake.updateOrganizationQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	defer fake.updateOrganizationQuotaMutex.Unlock()
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.OrganizationQuota, result2 ccv2.Warnings, result3 error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	defer fake.updateOrganizationQuotaMutex.Unlock()
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.OrganizationQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.OrganizationQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateOrganizationUser(arg1 string, arg2 string) (ccv2.Warnings, error) {
 	fake.updateOrganizationUserMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationUserReturnsOnCall[len(fake.updateOrganizationUserArgsForCall)]
@@ -6881,6 +8070,71 @@ func (fake *FakeCloudControllerClient) UpdateOrganizationUserReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRole(arg1 constant.OrganizationRole, arg2 string, arg3 string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleReturnsOnCall[len(fake.updateOrganizationUserByRoleArgsForCall)]
+	fake.updateOrganizationUserByRoleArgsForCall = append(fake.updateOrganizationUserByRoleArgsForCall, struct {
+		arg1 constant.OrganizationRole
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateOrganizationUserByRole", []interface{}{arg1, arg2, arg3})
+	fake.updateOrganizationUserByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleStub != nil {
+		return fake.UpdateOrganizationUserByRoleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateOrganizationUserByRoleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCallCount() int {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCalls(stub func(constant.OrganizationRole, string, string) (ccv2.Warnings, error)) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	defer fake.updateOrganizationUserByRoleMutex.Unlock()
+	fake.UpdateOrganizationUserByRoleStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleArgsForCall(i int) (constant.OrganizationRole, string, string) {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	argsForCall := fake.updateOrganizationUserByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	defer fake.updateOrganizationUserByRoleMutex.Unlock()
+	fake.UpdateOrganizationUserByRoleStub = nil
+	fake.updateOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	defer fake.updateOrganizationUserByRoleMutex.Unlock()
+	fake.UpdateOrganizationUserByRoleStub = nil
+	if fake.updateOrganizationUserByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateOrganizationUserByUsername(arg1 string, arg2 string) (ccv2.Warnings, error) {
 	fake.updateOrganizationUserByUsernameMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationUserByUsernameReturnsOnCall[len(fake.updateOrganizationUserByUsernameArgsForCall)]
@@ -7275,6 +8529,70 @@ func (fake *FakeCloudControllerClient) UpdateServicePlanReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpaceAllowSSH(arg1 string, arg2 bool) (ccv2.Warnings, error) {
+	fake.updateSpaceAllowSSHMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAllowSSHReturnsOnCall[len(fake.updateSpaceAllowSSHArgsForCall)]
+	fake.updateSpaceAllowSSHArgsForCall = append(fake.updateSpaceAllowSSHArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateSpaceAllowSSH", []interface{}{arg1, arg2})
+	fake.updateSpaceAllowSSHMutex.Unlock()
+	if fake.UpdateSpaceAllowSSHStub != nil {
+		return fake.UpdateSpaceAllowSSHStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateSpaceAllowSSHReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceAllowSSHCallCount() int {
+	fake.updateSpaceAllowSSHMutex.RLock()
+	defer fake.updateSpaceAllowSSHMutex.RUnlock()
+	return len(fake.updateSpaceAllowSSHArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceAllowSSHCalls(stub func(string, bool) (ccv2.Warnings, error)) {
+	fake.updateSpaceAllowSSHMutex.Lock()
+	defer fake.updateSpaceAllowSSHMutex.Unlock()
+	fake.UpdateSpaceAllowSSHStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceAllowSSHArgsForCall(i int) (string, bool) {
+	fake.updateSpaceAllowSSHMutex.RLock()
+	defer fake.updateSpaceAllowSSHMutex.RUnlock()
+	argsForCall := fake.updateSpaceAllowSSHArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceAllowSSHReturns(result1 ccv2.Warnings, result2 error) {
+	fake.updateSpaceAllowSSHMutex.Lock()
+	defer fake.updateSpaceAllowSSHMutex.Unlock()
+	fake.UpdateSpaceAllowSSHStub = nil
+	fake.updateSpaceAllowSSHReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceAllowSSHReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.updateSpaceAllowSSHMutex.Lock()
+	defer fake.updateSpaceAllowSSHMutex.Unlock()
+	fake.UpdateSpaceAllowSSHStub = nil
+	if fake.updateSpaceAllowSSHReturnsOnCall == nil {
+		fake.updateSpaceAllowSSHReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceAllowSSHReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpaceDeveloper(arg1 string, arg2 string) (ccv2.Warnings, error) {
 	fake.updateSpaceDeveloperMutex.Lock()
 	ret, specificReturn := fake.updateSpaceDeveloperReturnsOnCall[len(fake.updateSpaceDeveloperArgsForCall)]
@@ -7531,6 +8849,137 @@ func (fake *FakeCloudControllerClient) UpdateSpaceManagerByUsernameReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinition(arg1 ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.updateSpaceQuotaDefinitionMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaDefinitionReturnsOnCall[len(fake.updateSpaceQuotaDefinitionArgsForCall)]
+	fake.updateSpaceQuotaDefinitionArgsForCall = append(fake.updateSpaceQuotaDefinitionArgsForCall, struct {
+		arg1 ccv2.SpaceQuota
+	}{arg1})
+	fake.recordInvocation("UpdateSpaceQuotaDefinition", []interface{}{arg1})
+	fake.updateSpaceQuotaDefinitionMutex.Unlock()
+	if fake.UpdateSpaceQuotaDefinitionStub != nil {
+		return fake.UpdateSpaceQuotaDefinitionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateSpaceQuotaDefinitionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionCallCount() int {
+	fake.updateSpaceQuotaDefinitionMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionMutex.RUnlock()
+	return len(fake.updateSpaceQuotaDefinitionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionCalls(stub func(ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)) {
+	fake.updateSpaceQuotaDefinitionMutex.Lock()
+	defer fake.updateSpaceQuotaDefinitionMutex.Unlock()
+	fake.UpdateSpaceQuotaDefinitionStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionArgsForCall(i int) ccv2.SpaceQuota {
+	fake.updateSpaceQuotaDefinitionMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionMutex.RUnlock()
+	argsForCall := fake.updateSpaceQuotaDefinitionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.updateSpaceQuotaDefinitionMutex.Lock()
+	defer fake.updateSpaceQuotaDefinitionMutex.Unlock()
+	fake.UpdateSpaceQuotaDefinitionStub = nil
+	fake.updateSpaceQuotaDefinitionReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.updateSpaceQuotaDefinitionMutex.Lock()
+	defer fake.updateSpaceQuotaDefinitionMutex.Unlock()
+	fake.UpdateSpaceQuotaDefinitionStub = nil
+	if fake.updateSpaceQuotaDefinitionReturnsOnCall == nil {
+		fake.updateSpaceQuotaDefinitionReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceQuotaDefinitionReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRole(arg1 constant.SpaceRole, arg2 string, arg3 string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleReturnsOnCall[len(fake.updateSpaceUserByRoleArgsForCall)]
+	fake.updateSpaceUserByRoleArgsForCall = append(fake.updateSpaceUserByRoleArgsForCall, struct {
+		arg1 constant.SpaceRole
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateSpaceUserByRole", []interface{}{arg1, arg2, arg3})
+	fake.updateSpaceUserByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleStub != nil {
+		return fake.UpdateSpaceUserByRoleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateSpaceUserByRoleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCallCount() int {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCalls(stub func(constant.SpaceRole, string, string) (ccv2.Warnings, error)) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	defer fake.updateSpaceUserByRoleMutex.Unlock()
+	fake.UpdateSpaceUserByRoleStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleArgsForCall(i int) (constant.SpaceRole, string, string) {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	argsForCall := fake.updateSpaceUserByRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	defer fake.updateSpaceUserByRoleMutex.Unlock()
+	fake.UpdateSpaceUserByRoleStub = nil
+	fake.updateSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	defer fake.updateSpaceUserByRoleMutex.Unlock()
+	fake.UpdateSpaceUserByRoleStub = nil
+	if fake.updateSpaceUserByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UploadApplicationPackage(arg1 string, arg2 []ccv2.Resource, arg3 ccv2.Reader, arg4 int64) (ccv2.Job, ccv2.Warnings, error) {
 	var arg2Copy []ccv2.Resource
 	if arg2 != nil {
@@ -7756,6 +9205,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createBuildpackMutex.RUnlock()
 	fake.createOrganizationMutex.RLock()
 	defer fake.createOrganizationMutex.RUnlock()
+	fake.createOrganizationQuotaMutex.RLock()
+	defer fake.createOrganizationQuotaMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
@@ -7772,6 +9223,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createSharedDomainMutex.RUnlock()
 	fake.createSpaceMutex.RLock()
 	defer fake.createSpaceMutex.RUnlock()
+	fake.createSpaceQuotaDefinitionMutex.RLock()
+	defer fake.createSpaceQuotaDefinitionMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.deleteOrganizationJobMutex.RLock()
@@ -7788,6 +9241,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteServiceMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
 	fake.deleteServicePlanVisibilityMutex.RLock()
 	defer fake.deleteServicePlanVisibilityMutex.RUnlock()
 	fake.deleteSpaceJobMutex.RLock()
@@ -7810,8 +9265,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getConfigFeatureFlagsMutex.RLock()
 	defer fake.getConfigFeatureFlagsMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
+	fake.getNewestEventsMutex.RLock()
+	defer fake.getNewestEventsMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
 	defer fake.getOrganizationMutex.RUnlock()
 	fake.getOrganizationPrivateDomainsMutex.RLock()
@@ -7820,6 +9279,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationQuotaMutex.RUnlock()
 	fake.getOrganizationQuotasMutex.RLock()
 	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
@@ -7836,6 +9297,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getServiceBindingMutex.RLock()
+	defer fake.getServiceBindingMutex.RUnlock()
+	fake.getServiceBindingParametersMutex.RLock()
+	defer fake.getServiceBindingParametersMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
 	defer fake.getServiceBindingsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
@@ -7850,6 +9315,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
+	fake.getServiceKeyParametersMutex.RLock()
+	defer fake.getServiceKeyParametersMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
 	fake.getServicePlanMutex.RLock()
 	defer fake.getServicePlanMutex.RUnlock()
 	fake.getServicePlanVisibilitiesMutex.RLock()
@@ -7878,6 +9347,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceStagingSecurityGroupsMutex.RUnlock()
 	fake.getSpaceSummaryMutex.RLock()
 	defer fake.getSpaceSummaryMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getStackMutex.RLock()
@@ -7894,6 +9365,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.restageApplicationMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
 	defer fake.routingEndpointMutex.RUnlock()
+	fake.setOrganizationQuotaMutex.RLock()
+	defer fake.setOrganizationQuotaMutex.RUnlock()
 	fake.setSpaceQuotaMutex.RLock()
 	defer fake.setSpaceQuotaMutex.RUnlock()
 	fake.targetCFMutex.RLock()
@@ -7904,12 +9377,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateBuildpackMutex.RLock()
 	defer fake.updateBuildpackMutex.RUnlock()
+	fake.updateConfigFeatureFlagMutex.RLock()
+	defer fake.updateConfigFeatureFlagMutex.RUnlock()
 	fake.updateOrganizationManagerMutex.RLock()
 	defer fake.updateOrganizationManagerMutex.RUnlock()
 	fake.updateOrganizationManagerByUsernameMutex.RLock()
 	defer fake.updateOrganizationManagerByUsernameMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
 	fake.updateOrganizationUserMutex.RLock()
 	defer fake.updateOrganizationUserMutex.RUnlock()
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
 	fake.updateOrganizationUserByUsernameMutex.RLock()
 	defer fake.updateOrganizationUserByUsernameMutex.RUnlock()
 	fake.updateResourceMatchMutex.RLock()
@@ -7922,6 +9401,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateSecurityGroupStagingSpaceMutex.RUnlock()
 	fake.updateServicePlanMutex.RLock()
 	defer fake.updateServicePlanMutex.RUnlock()
+	fake.updateSpaceAllowSSHMutex.RLock()
+	defer fake.updateSpaceAllowSSHMutex.RUnlock()
 	fake.updateSpaceDeveloperMutex.RLock()
 	defer fake.updateSpaceDeveloperMutex.RUnlock()
 	fake.updateSpaceDeveloperByUsernameMutex.RLock()
@@ -7930,6 +9411,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateSpaceManagerMutex.RUnlock()
 	fake.updateSpaceManagerByUsernameMutex.RLock()
 	defer fake.updateSpaceManagerByUsernameMutex.RUnlock()
+	fake.updateSpaceQuotaDefinitionMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionMutex.RUnlock()
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.uploadBuildpackMutex.RLock()
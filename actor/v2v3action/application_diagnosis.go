@@ -0,0 +1,314 @@
+package v2v3action
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	ccv3constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+const (
+	// maxDiagnosedCrashes is the number of most recent crashes that are
+	// analyzed.
+	maxDiagnosedCrashes = 10
+
+	// crashLogsBefore and crashLogsAfter define the window of log messages
+	// that are considered to be related to a crash.
+	crashLogsBefore = 30 * time.Second
+	crashLogsAfter  = 5 * time.Second
+
+	// highMemoryUsage is the fraction of the memory quota above which a running
+	// instance is considered to be close to running out of memory.
+	highMemoryUsage = 0.9
+)
+
+var (
+	healthCheckFailurePattern = regexp.MustCompile(`(?i)never healthy|health check|healthcheck`)
+	outOfMemoryPattern        = regexp.MustCompile(`(?i)out of memory|OutOfMemoryError|Cannot allocate memory|memory quota exceeded`)
+	exitStatusPattern         = regexp.MustCompile(`(?i)exited with status (\d+)`)
+	healthCheckPortPattern    = regexp.MustCompile(`(?i)port (\d+)`)
+	listeningPortPattern      = regexp.MustCompile(`(?i)listening (?:on|at)\b[^\n]*?(?:port\s+|:)(\d+)`)
+)
+
+// CrashCause is a likely reason for application instances crashing.
+type CrashCause string
+
+const (
+	CrashCauseOutOfMemory        CrashCause = "out of memory"
+	CrashCauseHealthCheckTimeout CrashCause = "health check timeout"
+	CrashCausePortMismatch       CrashCause = "port mismatch"
+	CrashCauseStartCommandExit   CrashCause = "start command exited"
+)
+
+// Crash is a single crash of an application instance, built from a crash
+// event.
+type Crash struct {
+	Index           int
+	Timestamp       time.Time
+	ExitStatus      int
+	ExitDescription string
+	Reason          string
+
+	// Logs are the recent log messages that were written shortly before and
+	// after the crash.
+	Logs []v2action.LogMessage
+}
+
+// LikelyCause is a possible cause of the crashes. Score ranks the causes
+// against each other; Crashes is the number of crashes that point to the
+// cause.
+type LikelyCause struct {
+	Cause    CrashCause
+	Score    int
+	Crashes  int
+	Evidence []string
+}
+
+// ApplicationDiagnosis contains the state of an application's processes, its
+// current droplet and its recent crashes, together with the likely causes of
+// those crashes, most likely first.
+type ApplicationDiagnosis struct {
+	v3action.ApplicationSummary
+	Crashes      []Crash
+	LikelyCauses []LikelyCause
+}
+
+// GetApplicationDiagnosisByNameAndSpace gathers the process instances, health
+// checks, current droplet, crash events and recent logs of an application and
+// ranks the likely causes of its crashes.
+func (actor Actor) GetApplicationDiagnosisByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) (ApplicationDiagnosis, Warnings, error) {
+	var allWarnings Warnings
+
+	summary, warnings, err := actor.V3Actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID, true)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationDiagnosis{}, allWarnings, err
+	}
+	summary.ProcessSummaries.Sort()
+
+	events, eventWarnings, err := actor.V2Actor.GetEvents(v2action.EventFilter{
+		ActeeGUID: summary.GUID,
+		Types: []string{
+			string(constant.EventTypeApplicationCrash),
+			string(constant.EventTypeAuditApplicationProcessCrash),
+		},
+		Limit: maxDiagnosedCrashes,
+	})
+	allWarnings = append(allWarnings, eventWarnings...)
+	if err != nil {
+		return ApplicationDiagnosis{}, allWarnings, err
+	}
+
+	var logs []v2action.LogMessage
+	if len(events) > 0 {
+		var logWarnings v2action.Warnings
+		logs, logWarnings, err = actor.V2Actor.GetRecentLogsForApplicationByNameAndSpace(appName, spaceGUID, client)
+		allWarnings = append(allWarnings, logWarnings...)
+		if err != nil {
+			return ApplicationDiagnosis{}, allWarnings, err
+		}
+	}
+
+	diagnosis := ApplicationDiagnosis{ApplicationSummary: summary}
+	diagnosis.Crashes = newCrashes(events, logs)
+	diagnosis.LikelyCauses = rankCrashCauses(summary, diagnosis.Crashes)
+
+	return diagnosis, allWarnings, nil
+}
+
+// newCrashes converts the most recent crash events into crashes, newest
+// first, and attaches the log messages written around each crash.
+func newCrashes(events []v2action.Event, logs []v2action.LogMessage) []Crash {
+	var crashes []Crash
+	for i := len(events) - 1; i >= 0 && len(crashes) < maxDiagnosedCrashes; i-- {
+		event := events[i]
+		crash := Crash{
+			Index:           metadataInt(event.Metadata, "index"),
+			Timestamp:       event.Timestamp,
+			ExitStatus:      metadataInt(event.Metadata, "exit_status"),
+			ExitDescription: metadataString(event.Metadata, "exit_description"),
+			Reason:          metadataString(event.Metadata, "reason"),
+		}
+
+		for _, log := range logs {
+			if log.Timestamp().Before(crash.Timestamp.Add(-crashLogsBefore)) || log.Timestamp().After(crash.Timestamp.Add(crashLogsAfter)) {
+				continue
+			}
+			instanceLog := strings.HasPrefix(log.SourceType(), "APP") || log.SourceType() == "CELL"
+			if instanceLog && log.SourceInstance() != "" && log.SourceInstance() != strconv.Itoa(crash.Index) {
+				continue
+			}
+			crash.Logs = append(crash.Logs, log)
+		}
+
+		crashes = append(crashes, crash)
+	}
+	return crashes
+}
+
+// rankCrashCauses scores every cause by the crashes and logs that point to
+// it and returns the causes with a positive score, highest score first.
+func rankCrashCauses(summary v3action.ApplicationSummary, crashes []Crash) []LikelyCause {
+	causes := map[CrashCause]*LikelyCause{}
+	addEvidence := func(cause CrashCause, score int, evidence string) {
+		likelyCause, ok := causes[cause]
+		if !ok {
+			likelyCause = &LikelyCause{Cause: cause}
+			causes[cause] = likelyCause
+		}
+		likelyCause.Score += score
+		for _, existing := range likelyCause.Evidence {
+			if existing == evidence {
+				return
+			}
+		}
+		likelyCause.Evidence = append(likelyCause.Evidence, evidence)
+	}
+
+	process := processForCrash(summary)
+	for _, crash := range crashes {
+		description := crash.ExitDescription
+		crashEvidence := fmt.Sprintf("instance #%d: %s", crash.Index, description)
+
+		var attributed []CrashCause
+		switch {
+		case outOfMemoryPattern.MatchString(description):
+			addEvidence(CrashCauseOutOfMemory, 3, crashEvidence)
+			attributed = append(attributed, CrashCauseOutOfMemory)
+		case healthCheckFailurePattern.MatchString(description):
+			addEvidence(CrashCauseHealthCheckTimeout, 2, crashEvidence)
+			attributed = append(attributed, CrashCauseHealthCheckTimeout)
+
+			if process.HealthCheckType == ccv3constant.Port || process.HealthCheckType == ccv3constant.HTTP {
+				if mismatch, ok := findPortMismatch(description, crash.Logs); ok {
+					addEvidence(CrashCausePortMismatch, 4, mismatch)
+					attributed = append(attributed, CrashCausePortMismatch)
+				} else if strings.Contains(strings.ToLower(description), "connection refused") {
+					addEvidence(CrashCausePortMismatch, 1, crashEvidence)
+					attributed = append(attributed, CrashCausePortMismatch)
+				}
+			}
+		case exitStatusPattern.MatchString(description) || crash.ExitStatus != 0:
+			addEvidence(CrashCauseStartCommandExit, 2, crashEvidence)
+			attributed = append(attributed, CrashCauseStartCommandExit)
+		}
+
+		for _, log := range crash.Logs {
+			if outOfMemoryPattern.MatchString(log.Message()) {
+				addEvidence(CrashCauseOutOfMemory, 1, fmt.Sprintf("log from instance #%d: %s", crash.Index, strings.TrimSpace(log.Message())))
+				attributed = append(attributed, CrashCauseOutOfMemory)
+				break
+			}
+		}
+
+		for _, cause := range uniqueCauses(attributed) {
+			causes[cause].Crashes++
+		}
+	}
+
+	if likelyCause, ok := causes[CrashCauseOutOfMemory]; ok {
+		for _, processSummary := range summary.ProcessSummaries {
+			for _, instance := range processSummary.InstanceDetails {
+				if instance.MemoryQuota > 0 && float64(instance.MemoryUsage) >= highMemoryUsage*float64(instance.MemoryQuota) {
+					likelyCause.Score++
+					likelyCause.Evidence = append(likelyCause.Evidence, fmt.Sprintf("%s instance #%d is using %d%% of its memory quota", processSummary.Type, instance.Index, instance.MemoryUsage*100/instance.MemoryQuota))
+				}
+			}
+		}
+	}
+
+	if likelyCause, ok := causes[CrashCauseHealthCheckTimeout]; ok {
+		likelyCause.Evidence = append(likelyCause.Evidence, fmt.Sprintf("%s health check type is %s with an invocation timeout of %ds and a start timeout of %ds", process.Type, process.HealthCheckType, process.HealthCheckInvocationTimeout, process.HealthCheckTimeout))
+	}
+
+	if likelyCause, ok := causes[CrashCauseStartCommandExit]; ok {
+		if process.Command.IsSet {
+			likelyCause.Evidence = append(likelyCause.Evidence, fmt.Sprintf("%s start command is %s", process.Type, process.Command.Value))
+		}
+	}
+
+	var ranked []LikelyCause
+	for _, likelyCause := range causes {
+		if likelyCause.Score > 0 {
+			ranked = append(ranked, *likelyCause)
+		}
+	}
+	sort.Slice(ranked, func(i int, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Cause < ranked[j].Cause
+	})
+	return ranked
+}
+
+// findPortMismatch returns evidence when the health check failed on one port
+// while the logs show the app listening on another.
+func findPortMismatch(description string, logs []v2action.LogMessage) (string, bool) {
+	healthCheckPort := healthCheckPortPattern.FindStringSubmatch(description)
+	if healthCheckPort == nil {
+		return "", false
+	}
+
+	for _, log := range logs {
+		listeningPort := listeningPortPattern.FindStringSubmatch(log.Message())
+		if listeningPort != nil && listeningPort[1] != healthCheckPort[1] {
+			return fmt.Sprintf("health check used port %s but the app logged that it is listening on port %s", healthCheckPort[1], listeningPort[1]), true
+		}
+	}
+	return "", false
+}
+
+// processForCrash returns the process that crash events are reported for. The
+// events do not include the process type, so the web process is used, or the
+// first process when there is no web process.
+func processForCrash(summary v3action.ApplicationSummary) v3action.ProcessSummary {
+	for _, process := range summary.ProcessSummaries {
+		if process.Type == ccv3constant.ProcessTypeWeb {
+			return process
+		}
+	}
+	if len(summary.ProcessSummaries) > 0 {
+		return summary.ProcessSummaries[0]
+	}
+	return v3action.ProcessSummary{}
+}
+
+func uniqueCauses(causes []CrashCause) []CrashCause {
+	seen := map[CrashCause]bool{}
+	var unique []CrashCause
+	for _, cause := range causes {
+		if !seen[cause] {
+			seen[cause] = true
+			unique = append(unique, cause)
+		}
+	}
+	return unique
+}
+
+func metadataString(metadata map[string]interface{}, key string) string {
+	if value, ok := metadata[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func metadataInt(metadata map[string]interface{}, key string) int {
+	switch value := metadata[key].(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	case string:
+		i, _ := strconv.Atoi(value)
+		return i
+	}
+	return 0
+}
@@ -0,0 +1,226 @@
+package v2v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	ccv2constant "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+	"github.com/cloudfoundry/sonde-go/events"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Diagnosis Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+		crashTime   time.Time
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+		crashTime = time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+	})
+
+	crashEvent := func(index int, exitStatus int, description string, timestamp time.Time) v2action.Event {
+		return v2action.Event{
+			Type:      ccv2constant.EventTypeAuditApplicationProcessCrash,
+			Timestamp: timestamp,
+			Metadata: map[string]interface{}{
+				"index":            float64(index),
+				"exit_status":      float64(exitStatus),
+				"exit_description": description,
+				"reason":           "CRASHED",
+			},
+		}
+	}
+
+	logMessage := func(message string, instance string, timestamp time.Time) v2action.LogMessage {
+		return *v2action.NewLogMessage(message, int(events.LogMessage_OUT), timestamp, "APP/PROC/WEB", instance)
+	}
+
+	Describe("GetApplicationDiagnosisByNameAndSpace", func() {
+		var (
+			summary    v3action.ApplicationSummary
+			diagnosis  ApplicationDiagnosis
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			summary = v3action.ApplicationSummary{
+				Application: v3action.Application{GUID: "some-app-guid", Name: "some-app"},
+				ProcessSummaries: v3action.ProcessSummaries{
+					{
+						Process: v3action.Process{
+							Type:                         constant.ProcessTypeWeb,
+							Command:                      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+							HealthCheckType:              constant.Port,
+							HealthCheckInvocationTimeout: 1,
+							HealthCheckTimeout:           60,
+						},
+						InstanceDetails: []v3action.ProcessInstance{
+							{Index: 0, State: constant.ProcessInstanceCrashed, MemoryQuota: 100, MemoryUsage: 50},
+						},
+					},
+				},
+			}
+			fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(summary, v3action.Warnings{"summary-warning"}, nil)
+			fakeV2Actor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+				[]v2action.LogMessage{
+					logMessage("Listening on port 3000", "0", crashTime.Add(-10*time.Second)),
+					logMessage("too early", "0", crashTime.Add(-time.Hour)),
+					logMessage("other instance", "1", crashTime),
+				},
+				v2action.Warnings{"logs-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			diagnosis, warnings, executeErr = actor.GetApplicationDiagnosisByNameAndSpace("some-app", "some-space-guid", nil)
+		})
+
+		When("the app has crashed", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetEventsReturns(
+					[]v2action.Event{
+						crashEvent(0, 137, "APP/PROC/WEB: Exited with status 137 (out of memory)", crashTime.Add(-time.Minute)),
+						crashEvent(0, 0, "Instance never healthy after 1m0s: Failed to make TCP connection to port 8080: connection refused", crashTime),
+					},
+					v2action.Warnings{"events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the crashes newest first with the logs around them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("summary-warning", "events-warning", "logs-warning"))
+
+				Expect(diagnosis.Name).To(Equal("some-app"))
+				Expect(diagnosis.Crashes).To(HaveLen(2))
+				Expect(diagnosis.Crashes[0].Timestamp).To(Equal(crashTime))
+				Expect(diagnosis.Crashes[0].Index).To(Equal(0))
+				Expect(diagnosis.Crashes[0].Reason).To(Equal("CRASHED"))
+				Expect(diagnosis.Crashes[0].Logs).To(HaveLen(1))
+				Expect(diagnosis.Crashes[0].Logs[0].Message()).To(Equal("Listening on port 3000"))
+				Expect(diagnosis.Crashes[1].ExitStatus).To(Equal(137))
+
+				appName, spaceGUID, withObfuscatedValues := fakeV3Actor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(withObfuscatedValues).To(BeTrue())
+
+				Expect(fakeV2Actor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+					ActeeGUID: "some-app-guid",
+					Types:     []string{"app.crash", "audit.app.process.crash"},
+					Limit:     10,
+				}))
+			})
+
+			It("ranks the likely causes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var causes []CrashCause
+				for _, likelyCause := range diagnosis.LikelyCauses {
+					causes = append(causes, likelyCause.Cause)
+				}
+				Expect(causes).To(Equal([]CrashCause{
+					CrashCausePortMismatch,
+					CrashCauseOutOfMemory,
+					CrashCauseHealthCheckTimeout,
+				}))
+
+				Expect(diagnosis.LikelyCauses[0].Evidence).To(ConsistOf("health check used port 8080 but the app logged that it is listening on port 3000"))
+				Expect(diagnosis.LikelyCauses[1].Crashes).To(Equal(1))
+				Expect(diagnosis.LikelyCauses[2].Evidence).To(ContainElement("web health check type is port with an invocation timeout of 1s and a start timeout of 60s"))
+			})
+
+			When("an instance is close to its memory quota", func() {
+				BeforeEach(func() {
+					summary.ProcessSummaries[0].InstanceDetails[0].MemoryUsage = 95
+					fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(summary, nil, nil)
+				})
+
+				It("adds the memory usage to the evidence", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(diagnosis.LikelyCauses[0].Cause).To(Equal(CrashCauseOutOfMemory))
+					Expect(diagnosis.LikelyCauses[0].Evidence).To(ContainElement("web instance #0 is using 95% of its memory quota"))
+				})
+			})
+		})
+
+		When("the start command exits", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetEventsReturns(
+					[]v2action.Event{crashEvent(1, 1, "APP/PROC/WEB: Exited with status 1", crashTime)},
+					nil,
+					nil,
+				)
+			})
+
+			It("reports the start command", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diagnosis.LikelyCauses).To(HaveLen(1))
+				Expect(diagnosis.LikelyCauses[0].Cause).To(Equal(CrashCauseStartCommandExit))
+				Expect(diagnosis.LikelyCauses[0].Evidence).To(ConsistOf(
+					"instance #1: APP/PROC/WEB: Exited with status 1",
+					"web start command is bundle exec rackup",
+				))
+			})
+		})
+
+		When("the app has not crashed", func() {
+			It("does not fetch the logs or report any causes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(diagnosis.Crashes).To(BeEmpty())
+				Expect(diagnosis.LikelyCauses).To(BeEmpty())
+				Expect(fakeV2Actor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the app summary fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, v3action.Warnings{"summary-warning"}, errors.New("summary-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("summary-error"))
+				Expect(warnings).To(ConsistOf("summary-warning"))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetEventsReturns(nil, v2action.Warnings{"events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("events-error"))
+				Expect(warnings).To(ConsistOf("summary-warning", "events-warning"))
+			})
+		})
+
+		When("getting the recent logs fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetEventsReturns([]v2action.Event{crashEvent(0, 1, "APP/PROC/WEB: Exited with status 1", crashTime)}, nil, nil)
+				fakeV2Actor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, v2action.Warnings{"logs-warning"}, errors.New("logs-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("logs-error"))
+				Expect(warnings).To(ConsistOf("summary-warning", "logs-warning"))
+			})
+		})
+	})
+})
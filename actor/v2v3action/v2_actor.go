@@ -8,7 +8,9 @@ type V2Actor interface {
 	ManifestV2Actor
 	GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
 	GetApplicationRoutes(appGUID string) (v2action.Routes, v2action.Warnings, error)
+	GetEvents(filter v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)
 	GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetService(serviceGUID string) (v2action.Service, v2action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
 	GetServiceInstanceSharedTosByServiceInstance(serviceInstanceGUID string) ([]v2action.ServiceInstanceSharedTo, v2action.Warnings, error)
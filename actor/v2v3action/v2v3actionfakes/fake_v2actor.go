@@ -56,6 +56,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetEventsStub        func(v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 v2action.EventFilter
+	}
+	getEventsReturns struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetFeatureFlagsStub        func() ([]v2action.FeatureFlag, v2action.Warnings, error)
 	getFeatureFlagsMutex       sync.RWMutex
 	getFeatureFlagsArgsForCall []struct {
@@ -70,6 +85,23 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.NOAAClient
+	}
+	getRecentLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetServiceStub        func(string) (v2action.Service, v2action.Warnings, error)
 	getServiceMutex       sync.RWMutex
 	getServiceArgsForCall []struct {
@@ -335,6 +367,72 @@ func (fake *FakeV2Actor) GetApplicationRoutesReturnsOnCall(i int, result1 v2acti
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetEvents(arg1 v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 v2action.EventFilter
+	}{arg1})
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV2Actor) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetEventsCalls(stub func(v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeV2Actor) GetEventsArgsForCall(i int) v2action.EventFilter {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV2Actor) GetEventsReturns(result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetEventsReturnsOnCall(i int, result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Event
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error) {
 	fake.getFeatureFlagsMutex.Lock()
 	ret, specificReturn := fake.getFeatureFlagsReturnsOnCall[len(fake.getFeatureFlagsArgsForCall)]
@@ -393,6 +491,74 @@ func (fake *FakeV2Actor) GetFeatureFlagsReturnsOnCall(i int, result1 []v2action.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.NOAAClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetRecentLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRecentLogsForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV2Actor) GetRecentLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeV2Actor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV2Actor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
+	fake.getRecentLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetRecentLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetService(arg1 string) (v2action.Service, v2action.Warnings, error) {
 	fake.getServiceMutex.Lock()
 	ret, specificReturn := fake.getServiceReturnsOnCall[len(fake.getServiceArgsForCall)]
@@ -668,8 +834,12 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
 	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
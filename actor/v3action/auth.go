@@ -21,10 +21,7 @@ func (actor Actor) Authenticate(credentials map[string]string, origin string, gr
 		return err
 	}
 
-	accessToken = fmt.Sprintf("bearer %s", accessToken)
-	actor.Config.SetTokenInformation(accessToken, refreshToken, "")
-
-	actor.Config.SetUAAGrantType(string(grantType))
+	actor.saveTokens(accessToken, refreshToken, grantType)
 	if grantType == constant.GrantTypeClientCredentials {
 		actor.Config.SetUAAClientCredentials(credentials["client_id"], credentials["client_secret"])
 	}
@@ -32,6 +29,15 @@ func (actor Actor) Authenticate(credentials map[string]string, origin string, gr
 	return nil
 }
 
+// saveTokens stores the tokens of a successful login in the config, together
+// with the grant type used to refresh them.
+func (actor Actor) saveTokens(accessToken string, refreshToken string, grantType constant.GrantType) {
+	accessToken = fmt.Sprintf("bearer %s", accessToken)
+	actor.Config.SetTokenInformation(accessToken, refreshToken, "")
+
+	actor.Config.SetUAAGrantType(string(grantType))
+}
+
 func (actor Actor) GetLoginPrompts() map[string]coreconfig.AuthPrompt {
 	rawPrompts := actor.UAAClient.LoginPrompts()
 	prompts := make(map[string]coreconfig.AuthPrompt)
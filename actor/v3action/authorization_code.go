@@ -0,0 +1,75 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// AuthorizationCodeLogin is a login in which the user authorizes the CLI in a
+// web browser. The login server then redirects the browser to a listener on
+// the loopback interface with an authorization code.
+type AuthorizationCodeLogin struct {
+	// URL is the page on which the user authorizes the CLI.
+	URL string
+
+	listener *uaa.RedirectListener
+	pkce     uaa.PKCE
+}
+
+// StartAuthorizationCodeLogin starts listening for the redirect of an
+// authorization code login and returns the page the user should open.
+func (actor Actor) StartAuthorizationCodeLogin() (AuthorizationCodeLogin, error) {
+	pkce, err := uaa.NewPKCE()
+	if err != nil {
+		return AuthorizationCodeLogin{}, err
+	}
+
+	listener, err := uaa.NewRedirectListener()
+	if err != nil {
+		return AuthorizationCodeLogin{}, err
+	}
+
+	authorizationURL, err := actor.UAAClient.AuthorizationCodeURL(listener.RedirectURI(), listener.State(), pkce.Challenge)
+	if err != nil {
+		_ = listener.Close()
+		return AuthorizationCodeLogin{}, err
+	}
+
+	return AuthorizationCodeLogin{
+		URL:      authorizationURL,
+		listener: listener,
+		pkce:     pkce,
+	}, nil
+}
+
+// AuthenticateWithAuthorizationCode waits up to timeout for the user to
+// authorize the CLI, then exchanges the authorization code for tokens and
+// stores them in the config.
+func (actor Actor) AuthenticateWithAuthorizationCode(login AuthorizationCodeLogin, timeout time.Duration) error {
+	defer login.listener.Close()
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	code, err := login.listener.WaitForCode(timeout)
+	switch err.(type) {
+	case nil:
+	case uaa.RedirectTimeoutError:
+		return actionerror.LoginTimeoutError{Timeout: timeout}
+	case uaa.AccessDeniedError:
+		return actionerror.LoginDeniedError{}
+	default:
+		return err
+	}
+
+	accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithAuthorizationCode(code, login.listener.RedirectURI(), login.pkce.Verifier)
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	actor.saveTokens(accessToken, refreshToken, constant.GrantTypeAuthorizationCode)
+	return nil
+}
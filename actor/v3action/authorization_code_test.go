@@ -0,0 +1,125 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorization Code Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v3actionfakes.FakeUAAClient
+		fakeConfig    *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v3actionfakes.FakeUAAClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeConfig, nil, fakeUAAClient)
+	})
+
+	Describe("StartAuthorizationCodeLogin and AuthenticateWithAuthorizationCode", func() {
+		var (
+			login       AuthorizationCodeLogin
+			redirectURI string
+			state       string
+			timeout     time.Duration
+			executeErr  error
+		)
+
+		redirect := func(query url.Values) {
+			response, err := http.Get(redirectURI + "?" + query.Encode())
+			Expect(err).ToNot(HaveOccurred())
+			response.Body.Close()
+		}
+
+		BeforeEach(func() {
+			timeout = time.Second
+			fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?some-query", nil)
+			fakeUAAClient.AuthenticateWithAuthorizationCodeReturns("some-access-token", "some-refresh-token", nil)
+
+			var err error
+			login, err = actor.StartAuthorizationCodeLogin()
+			Expect(err).ToNot(HaveOccurred())
+
+			var challenge string
+			redirectURI, state, challenge = fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(strings.HasPrefix(redirectURI, "http://127.0.0.1:")).To(BeTrue())
+			Expect(state).ToNot(BeEmpty())
+			Expect(challenge).ToNot(BeEmpty())
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.AuthenticateWithAuthorizationCode(login, timeout)
+		})
+
+		It("returns the page on which the user authorizes the CLI", func() {
+			Expect(login.URL).To(Equal("https://login.example.com/oauth/authorize?some-query"))
+		})
+
+		When("the browser is redirected with an authorization code", func() {
+			BeforeEach(func() {
+				redirect(url.Values{"code": {"some-code"}, "state": {state}})
+			})
+
+			It("exchanges the code for tokens and stores them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				code, actualRedirectURI, verifier := fakeUAAClient.AuthenticateWithAuthorizationCodeArgsForCall(0)
+				Expect(code).To(Equal("some-code"))
+				Expect(actualRedirectURI).To(Equal(redirectURI))
+				Expect(verifier).ToNot(BeEmpty())
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("authorization_code"))
+			})
+
+			When("exchanging the code fails", func() {
+				BeforeEach(func() {
+					fakeUAAClient.AuthenticateWithAuthorizationCodeReturns("", "", errors.New("exchange-error"))
+				})
+
+				It("clears the tokens and returns the error", func() {
+					Expect(executeErr).To(MatchError("exchange-error"))
+					accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+					Expect(accessToken).To(BeEmpty())
+					Expect(refreshToken).To(BeEmpty())
+				})
+			})
+		})
+
+		When("the user denies access", func() {
+			BeforeEach(func() {
+				redirect(url.Values{"error": {"access_denied"}, "state": {state}})
+			})
+
+			It("returns a LoginDeniedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginDeniedError{}))
+				Expect(fakeUAAClient.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the browser is not redirected in time", func() {
+			BeforeEach(func() {
+				timeout = 10 * time.Millisecond
+			})
+
+			It("returns a LoginTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginTimeoutError{Timeout: timeout}))
+			})
+		})
+	})
+})
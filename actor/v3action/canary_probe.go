@@ -0,0 +1,79 @@
+package v3action
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	log "github.com/sirupsen/logrus"
+)
+
+// CanaryProbe is a user-supplied check that decides whether a paused canary
+// deployment is healthy. Exactly one of URL and Command is expected to be
+// set.
+type CanaryProbe struct {
+	// URL is requested with a GET; any 2xx response passes.
+	URL string
+	// Command is run by the system shell; a zero exit status passes.
+	Command string
+}
+
+// RunCanaryProbe runs the probe and returns a CanaryProbeFailedError if it
+// fails.
+func (actor Actor) RunCanaryProbe(probe CanaryProbe) error {
+	switch {
+	case probe.URL != "":
+		return actor.runCanaryURLProbe(probe.URL)
+	case probe.Command != "":
+		return runCanaryCommandProbe(probe.Command)
+	}
+	return nil
+}
+
+func (actor Actor) runCanaryURLProbe(url string) error {
+	client := http.Client{
+		Timeout: actor.Config.DialTimeout(),
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: actor.Config.SkipSSLValidation(),
+			},
+		},
+	}
+
+	log.WithField("url", url).Info("running canary probe")
+	response, err := client.Get(url)
+	if err != nil {
+		return actionerror.CanaryProbeFailedError{Probe: url, Reason: err.Error()}
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return actionerror.CanaryProbeFailedError{Probe: url, Reason: response.Status}
+	}
+	return nil
+}
+
+func runCanaryCommandProbe(command string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	log.WithField("command", command).Info("running canary probe")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		reason := err.Error()
+		if trimmed := strings.TrimSpace(string(output)); trimmed != "" {
+			reason = fmt.Sprintf("%s: %s", reason, trimmed)
+		}
+		return actionerror.CanaryProbeFailedError{Probe: command, Reason: reason}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package v3action_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Canary Probe Actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *v3actionfakes.FakeConfig
+		probe      CanaryProbe
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeConfig, nil, nil)
+		probe = CanaryProbe{}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = actor.RunCanaryProbe(probe)
+	})
+
+	Describe("RunCanaryProbe", func() {
+		When("the probe is a URL", func() {
+			var server *ghttp.Server
+
+			BeforeEach(func() {
+				server = ghttp.NewServer()
+				probe.URL = server.URL() + "/health"
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			When("the URL responds with a 2xx status", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, "/health"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					))
+				})
+
+				It("passes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+				})
+			})
+
+			When("the URL responds with any other status", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(ghttp.RespondWith(http.StatusServiceUnavailable, nil))
+				})
+
+				It("returns a CanaryProbeFailedError", func() {
+					Expect(executeErr).To(MatchError(actionerror.CanaryProbeFailedError{
+						Probe:  probe.URL,
+						Reason: "503 Service Unavailable",
+					}))
+				})
+			})
+		})
+
+		When("the probe is a command", func() {
+			When("the command succeeds", func() {
+				BeforeEach(func() {
+					probe.Command = "exit 0"
+				})
+
+				It("passes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+				})
+			})
+
+			When("the command fails", func() {
+				BeforeEach(func() {
+					probe.Command = "echo unhealthy && exit 3"
+				})
+
+				It("returns a CanaryProbeFailedError with the command output", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(actionerror.CanaryProbeFailedError{}))
+					Expect(executeErr.(actionerror.CanaryProbeFailedError).Probe).To(Equal("echo unhealthy && exit 3"))
+					Expect(executeErr.(actionerror.CanaryProbeFailedError).Reason).To(ContainSubstring("unhealthy"))
+				})
+			})
+		})
+
+		When("no probe is provided", func() {
+			It("passes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
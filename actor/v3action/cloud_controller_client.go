@@ -15,7 +15,9 @@ type CloudControllerClient interface {
 	CancelDeployment(deploymentGUID string) (ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	ContinueDeployment(deploymentGUID string) (ccv3.Warnings, error)
 	CreateApplicationDeployment(appGUID string, dropletGUID string) (string, ccv3.Warnings, error)
+	CreateApplicationDeploymentWithStrategy(dep ccv3.Deployment) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
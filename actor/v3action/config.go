@@ -19,6 +19,7 @@ type Config interface {
 	StartupTimeout() time.Duration
 	StagingTimeout() time.Duration
 	Target() string
+	TaskSchedulesFilePath() string
 	UAAGrantType() string
 	UnsetOrganizationAndSpaceInformation()
 }
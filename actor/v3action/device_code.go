@@ -0,0 +1,84 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+const (
+	// DefaultDeviceCodeInterval is how long to wait between polls for the
+	// tokens of a device code login when the UAA does not say.
+	DefaultDeviceCodeInterval = 5 * time.Second
+
+	deviceCodeSlowDownIncrement = 5 * time.Second
+)
+
+// DeviceCodeLogin is a login in which the user approves the CLI on another
+// device by entering a user code on a verification page.
+type DeviceCodeLogin struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresIn               time.Duration
+	Interval                time.Duration
+}
+
+// StartDeviceCodeLogin starts a device code login.
+func (actor Actor) StartDeviceCodeLogin() (DeviceCodeLogin, error) {
+	authorization, err := actor.UAAClient.StartDeviceAuthorization()
+	if err != nil {
+		return DeviceCodeLogin{}, err
+	}
+
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = DefaultDeviceCodeInterval
+	}
+
+	return DeviceCodeLogin{
+		DeviceCode:              authorization.DeviceCode,
+		UserCode:                authorization.UserCode,
+		VerificationURI:         authorization.VerificationURI,
+		VerificationURIComplete: authorization.VerificationURIComplete,
+		ExpiresIn:               time.Duration(authorization.ExpiresIn) * time.Second,
+		Interval:                interval,
+	}, nil
+}
+
+// AuthenticateWithDeviceCode polls for the tokens of a device code login until
+// the user approves or denies it, or the device code expires, and stores the
+// tokens in the config.
+func (actor Actor) AuthenticateWithDeviceCode(login DeviceCodeLogin) error {
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	interval := login.Interval
+	deadline := time.Now().Add(login.ExpiresIn)
+
+	for {
+		accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithDeviceCode(login.DeviceCode)
+		switch err.(type) {
+		case nil:
+			actor.saveTokens(accessToken, refreshToken, constant.GrantTypeDeviceCode)
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			interval += deviceCodeSlowDownIncrement
+		case uaa.AccessDeniedError:
+			return actionerror.LoginDeniedError{}
+		case uaa.ExpiredDeviceCodeError:
+			return actionerror.LoginTimeoutError{Timeout: login.ExpiresIn}
+		default:
+			actor.Config.SetTokenInformation("", "", "")
+			return err
+		}
+
+		if login.ExpiresIn > 0 && time.Now().Add(interval).After(deadline) {
+			return actionerror.LoginTimeoutError{Timeout: login.ExpiresIn}
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,152 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device Code Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v3actionfakes.FakeUAAClient
+		fakeConfig    *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v3actionfakes.FakeUAAClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeConfig, nil, fakeUAAClient)
+	})
+
+	Describe("StartDeviceCodeLogin", func() {
+		It("returns the device code login", func() {
+			fakeUAAClient.StartDeviceAuthorizationReturns(uaa.DeviceAuthorization{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://login.example.com/device",
+				ExpiresIn:       600,
+				Interval:        2,
+			}, nil)
+
+			login, err := actor.StartDeviceCodeLogin()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(login).To(Equal(DeviceCodeLogin{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://login.example.com/device",
+				ExpiresIn:       10 * time.Minute,
+				Interval:        2 * time.Second,
+			}))
+		})
+
+		When("the UAA does not provide an interval", func() {
+			It("uses the default interval", func() {
+				fakeUAAClient.StartDeviceAuthorizationReturns(uaa.DeviceAuthorization{DeviceCode: "some-device-code"}, nil)
+
+				login, err := actor.StartDeviceCodeLogin()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(login.Interval).To(Equal(DefaultDeviceCodeInterval))
+			})
+		})
+
+		When("starting the login fails", func() {
+			It("returns the error", func() {
+				fakeUAAClient.StartDeviceAuthorizationReturns(uaa.DeviceAuthorization{}, errors.New("start-error"))
+
+				_, err := actor.StartDeviceCodeLogin()
+				Expect(err).To(MatchError("start-error"))
+			})
+		})
+	})
+
+	Describe("AuthenticateWithDeviceCode", func() {
+		var (
+			login      DeviceCodeLogin
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			login = DeviceCodeLogin{
+				DeviceCode: "some-device-code",
+				ExpiresIn:  time.Minute,
+				Interval:   time.Millisecond,
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.AuthenticateWithDeviceCode(login)
+		})
+
+		When("the user approves the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(1, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(2, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("polls until the tokens are granted and stores them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeCallCount()).To(Equal(3))
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeArgsForCall(0)).To(Equal("some-device-code"))
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("urn:ietf:params:oauth:grant-type:device_code"))
+			})
+		})
+
+		When("the user denies the login", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.AccessDeniedError{})
+			})
+
+			It("returns a LoginDeniedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginDeniedError{}))
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.ExpiredDeviceCodeError{})
+			})
+
+			It("returns a LoginTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginTimeoutError{Timeout: time.Minute}))
+			})
+		})
+
+		When("the UAA asks to slow down past the expiry of the device code", func() {
+			BeforeEach(func() {
+				login.ExpiresIn = time.Second
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.SlowDownError{})
+			})
+
+			It("returns a LoginTimeoutError without polling again", func() {
+				Expect(executeErr).To(MatchError(actionerror.LoginTimeoutError{Timeout: time.Second}))
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeCallCount()).To(Equal(1))
+			})
+		})
+
+		When("polling fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", errors.New("poll-error"))
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(executeErr).To(MatchError("poll-error"))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+			})
+		})
+	})
+})
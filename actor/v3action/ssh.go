@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 )
 
 type SSHAuthentication struct {
@@ -78,3 +79,66 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 		Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex),
 	}, warnings, err
 }
+
+// GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType
+// returns the SSH endpoint and host key fingerprint, and the instances of the
+// process that are running. The passcode is left empty, since each connection
+// needs its own passcode from GetSSHPasscode.
+func (actor Actor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType(
+	appName string, spaceGUID string, processType string,
+) (SSHAuthentication, []sharedaction.SSHInstance, Warnings, error) {
+	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
+	if endpoint == "" {
+		return SSHAuthentication{}, nil, nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return SSHAuthentication{}, nil, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	appSummary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID, false)
+	if err != nil {
+		return SSHAuthentication{}, nil, warnings, err
+	}
+
+	var processSummary ProcessSummary
+	for _, appProcessSummary := range appSummary.ProcessSummaries {
+		if appProcessSummary.Type == processType {
+			processSummary = appProcessSummary
+			break
+		}
+	}
+	if processSummary.GUID == "" {
+		return SSHAuthentication{}, nil, warnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	}
+
+	if !appSummary.Application.Started() {
+		return SSHAuthentication{}, nil, warnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	var instances []sharedaction.SSHInstance
+	for _, instance := range processSummary.InstanceDetails {
+		if instance.Running() {
+			instances = append(instances, sharedaction.SSHInstance{
+				Index:    int(instance.Index),
+				Username: fmt.Sprintf("cf:%s/%d", processSummary.GUID, instance.Index),
+			})
+		}
+	}
+
+	if len(instances) == 0 {
+		return SSHAuthentication{}, nil, warnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+	}
+
+	return SSHAuthentication{
+		Endpoint:           endpoint,
+		HostKeyFingerprint: fingerprint,
+	}, instances, warnings, nil
+}
+
+// GetSSHPasscode returns a one-time passcode for connecting to an application
+// instance.
+func (actor Actor) GetSSHPasscode() (string, error) {
+	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -177,4 +178,82 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType", func() {
+		var (
+			sshAuth   SSHAuthentication
+			instances []sharedaction.SSHInstance
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceRunning, Index: 0},
+				{State: constant.ProcessInstanceDown, Index: 1},
+				{State: constant.ProcessInstanceRunning, Index: 2},
+			}, ccv3.Warnings{"some-instance-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuth, instances, warnings, executeErr = actor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType("some-app", "some-space-guid", "some-process-type")
+		})
+
+		It("returns the endpoint and the running instances without getting a passcode", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("some-app-warnings"))
+
+			Expect(sshAuth).To(Equal(SSHAuthentication{
+				Endpoint:           "some-app-ssh-endpoint",
+				HostKeyFingerprint: "some-app-ssh-fingerprint",
+			}))
+			Expect(instances).To(Equal([]sharedaction.SSHInstance{
+				{Index: 0, Username: "cf:some-process-guid/0"},
+				{Index: 2, Username: "cf:some-process-guid/2"},
+			}))
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{}, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+			})
+		})
+
+		When("no instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceCrashed, Index: 0},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns a NoRunningProcessInstancesError", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+			})
+		})
+	})
+
+	Describe("GetSSHPasscode", func() {
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-ssh-oauth-client")
+			fakeUAAClient.GetSSHPasscodeReturns("some-ssh-passcode", nil)
+		})
+
+		It("gets a passcode with the config credentials", func() {
+			passcode, err := actor.GetSSHPasscode()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(passcode).To(Equal("some-ssh-passcode"))
+
+			accessToken, client := fakeUAAClient.GetSSHPasscodeArgsForCall(0)
+			Expect(accessToken).To(Equal("some-access-token"))
+			Expect(client).To(Equal("some-ssh-oauth-client"))
+		})
+	})
 })
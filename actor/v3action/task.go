@@ -2,12 +2,14 @@ package v3action
 
 import (
 	"strconv"
+	"time"
 
 	"sort"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 // Task represents a V3 actor Task.
@@ -65,6 +67,33 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return Task(tasks[0]), Warnings(warnings), nil
 }
 
+// PollTask polls the state of the provided task of the application until it
+// has finished. It returns a TaskFailedError if the task failed.
+func (actor Actor) PollTask(appGUID string, task Task) (Task, Warnings, error) {
+	var allWarnings Warnings
+
+	for {
+		polledTask, warnings, err := actor.GetTaskBySequenceIDAndApplication(int(task.SequenceID), appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+
+		switch polledTask.State {
+		case constant.TaskSucceeded:
+			return polledTask, allWarnings, nil
+		case constant.TaskFailed:
+			failedErr := actionerror.TaskFailedError{Name: polledTask.Name, SequenceID: polledTask.SequenceID}
+			if polledTask.Result != nil {
+				failedErr.Reason = polledTask.Result.FailureReason
+			}
+			return polledTask, allWarnings, failedErr
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return Task(task), Warnings(warnings), err
@@ -0,0 +1,221 @@
+package v3action
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/cron"
+)
+
+// TaskSchedule is a task that is run on an application whenever its cron
+// schedule is due. Task schedules are stored locally, together with the API
+// endpoint they were created for, and run by RunTaskSchedule.
+type TaskSchedule struct {
+	API        string    `json:"api"`
+	Name       string    `json:"name"`
+	Schedule   string    `json:"schedule"`
+	Command    string    `json:"command"`
+	DiskInMB   uint64    `json:"disk_in_mb,omitempty"`
+	MemoryInMB uint64    `json:"memory_in_mb,omitempty"`
+	AppGUID    string    `json:"app_guid"`
+	AppName    string    `json:"app_name"`
+	SpaceGUID  string    `json:"space_guid"`
+	SpaceName  string    `json:"space_name"`
+	OrgName    string    `json:"org_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastRunAt  time.Time `json:"last_run_at"`
+}
+
+// NextRunAt returns the first time the schedule is due after it was last run,
+// or after it was created if it has never run. Schedules are evaluated in
+// UTC. It returns the zero time if the schedule is never due.
+func (taskSchedule TaskSchedule) NextRunAt() time.Time {
+	schedule, err := cron.Parse(taskSchedule.Schedule)
+	if err != nil {
+		return time.Time{}
+	}
+
+	after := taskSchedule.LastRunAt
+	if after.IsZero() {
+		after = taskSchedule.CreatedAt
+	}
+	return schedule.Next(after.UTC())
+}
+
+// IsDue returns true if the schedule has been due since it last ran.
+func (taskSchedule TaskSchedule) IsDue(now time.Time) bool {
+	next := taskSchedule.NextRunAt()
+	return !next.IsZero() && !next.After(now)
+}
+
+func (taskSchedule TaskSchedule) matches(api string, spaceGUID string, appName string, name string) bool {
+	return taskSchedule.API == api && taskSchedule.SpaceGUID == spaceGUID && taskSchedule.AppName == appName && taskSchedule.Name == name
+}
+
+type taskSchedulesFile struct {
+	Schedules []TaskSchedule `json:"schedules"`
+}
+
+// CreateTaskSchedule stores the task schedule for the targeted API endpoint,
+// replacing the schedule with the same name for the same application if there
+// is one.
+func (actor Actor) CreateTaskSchedule(taskSchedule TaskSchedule) error {
+	_, err := cron.Parse(taskSchedule.Schedule)
+	if err != nil {
+		return actionerror.InvalidTaskScheduleError{Schedule: taskSchedule.Schedule, Reason: err.Error()}
+	}
+	taskSchedule.API = actor.Config.Target()
+
+	schedules, err := actor.loadTaskSchedules()
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, existing := range schedules {
+		if existing.matches(taskSchedule.API, taskSchedule.SpaceGUID, taskSchedule.AppName, taskSchedule.Name) {
+			schedules[i] = taskSchedule
+			replaced = true
+		}
+	}
+	if !replaced {
+		schedules = append(schedules, taskSchedule)
+	}
+
+	return actor.saveTaskSchedules(schedules)
+}
+
+// GetTaskSchedulesBySpace returns the task schedules of the applications in
+// the space of the targeted API endpoint, sorted by application and schedule
+// name.
+func (actor Actor) GetTaskSchedulesBySpace(spaceGUID string) ([]TaskSchedule, error) {
+	schedules, err := actor.loadTaskSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	api := actor.Config.Target()
+	var spaceSchedules []TaskSchedule
+	for _, schedule := range schedules {
+		if schedule.API == api && schedule.SpaceGUID == spaceGUID {
+			spaceSchedules = append(spaceSchedules, schedule)
+		}
+	}
+
+	sort.Slice(spaceSchedules, func(i int, j int) bool {
+		if spaceSchedules[i].AppName != spaceSchedules[j].AppName {
+			return spaceSchedules[i].AppName < spaceSchedules[j].AppName
+		}
+		return spaceSchedules[i].Name < spaceSchedules[j].Name
+	})
+	return spaceSchedules, nil
+}
+
+// DeleteTaskSchedule removes the task schedule with the given name from the
+// application in the space of the targeted API endpoint.
+func (actor Actor) DeleteTaskSchedule(spaceGUID string, appName string, name string) error {
+	schedules, err := actor.loadTaskSchedules()
+	if err != nil {
+		return err
+	}
+
+	api := actor.Config.Target()
+	for i, schedule := range schedules {
+		if schedule.matches(api, spaceGUID, appName, name) {
+			return actor.saveTaskSchedules(append(schedules[:i], schedules[i+1:]...))
+		}
+	}
+
+	return actionerror.TaskScheduleNotFoundError{AppName: appName, Name: name}
+}
+
+// GetDueTaskSchedules returns the task schedules of every space of the
+// targeted API endpoint that are due at the given time, sorted by the time
+// they became due. Schedules created for other API endpoints are skipped, as
+// their applications do not exist on the targeted one.
+func (actor Actor) GetDueTaskSchedules(now time.Time) ([]TaskSchedule, error) {
+	schedules, err := actor.loadTaskSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	api := actor.Config.Target()
+	var due []TaskSchedule
+	for _, schedule := range schedules {
+		if schedule.API == api && schedule.IsDue(now) {
+			due = append(due, schedule)
+		}
+	}
+
+	sort.SliceStable(due, func(i int, j int) bool { return due[i].NextRunAt().Before(due[j].NextRunAt()) })
+	return due, nil
+}
+
+// RunTaskSchedule runs the task of the schedule and records that the
+// schedule ran at the given time. A schedule whose task could not be created
+// is not recorded as run, so it stays due. Schedules of other API endpoints
+// are refused with a TaskScheduleNotFoundError.
+func (actor Actor) RunTaskSchedule(taskSchedule TaskSchedule, now time.Time) (Task, Warnings, error) {
+	if taskSchedule.API != actor.Config.Target() {
+		return Task{}, nil, actionerror.TaskScheduleNotFoundError{AppName: taskSchedule.AppName, Name: taskSchedule.Name}
+	}
+
+	task, warnings, err := actor.RunTask(taskSchedule.AppGUID, Task{
+		Name:       taskSchedule.Name,
+		Command:    taskSchedule.Command,
+		DiskInMB:   taskSchedule.DiskInMB,
+		MemoryInMB: taskSchedule.MemoryInMB,
+	})
+	if err != nil {
+		return Task{}, warnings, err
+	}
+
+	schedules, err := actor.loadTaskSchedules()
+	if err != nil {
+		return task, warnings, err
+	}
+	for i, schedule := range schedules {
+		if schedule.matches(taskSchedule.API, taskSchedule.SpaceGUID, taskSchedule.AppName, taskSchedule.Name) {
+			schedules[i].LastRunAt = now
+		}
+	}
+
+	return task, warnings, actor.saveTaskSchedules(schedules)
+}
+
+func (actor Actor) loadTaskSchedules() ([]TaskSchedule, error) {
+	raw, err := ioutil.ReadFile(actor.Config.TaskSchedulesFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file taskSchedulesFile
+	err = json.Unmarshal(raw, &file)
+	return file.Schedules, err
+}
+
+func (actor Actor) saveTaskSchedules(schedules []TaskSchedule) error {
+	path := actor.Config.TaskSchedulesFilePath()
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	if schedules == nil {
+		schedules = []TaskSchedule{}
+	}
+	raw, err := json.MarshalIndent(taskSchedulesFile{Schedules: schedules}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
@@ -0,0 +1,238 @@
+package v3action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task Schedule Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+		tmpDir                    string
+		created                   time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "task-schedules")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.TaskSchedulesFilePathReturns(filepath.Join(tmpDir, ".cf", "task-schedules.json"))
+		fakeConfig.TargetReturns("https://api.example.com")
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+
+		created = time.Date(2019, time.March, 13, 10, 30, 0, 0, time.UTC)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("TaskSchedule", func() {
+		It("is next due after it last ran, or after it was created", func() {
+			schedule := TaskSchedule{Schedule: "0 * * * *", CreatedAt: created}
+			Expect(schedule.NextRunAt()).To(Equal(time.Date(2019, time.March, 13, 11, 0, 0, 0, time.UTC)))
+			Expect(schedule.IsDue(created.Add(29 * time.Minute))).To(BeFalse())
+			Expect(schedule.IsDue(created.Add(30 * time.Minute))).To(BeTrue())
+
+			schedule.LastRunAt = time.Date(2019, time.March, 13, 11, 0, 5, 0, time.UTC)
+			Expect(schedule.NextRunAt()).To(Equal(time.Date(2019, time.March, 13, 12, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe("CreateTaskSchedule", func() {
+		When("the schedule is invalid", func() {
+			It("returns an InvalidTaskScheduleError", func() {
+				err := actor.CreateTaskSchedule(TaskSchedule{Name: "some-schedule", Schedule: "* * *"})
+				Expect(err).To(MatchError(actionerror.InvalidTaskScheduleError{
+					Schedule: "* * *",
+					Reason:   "expected 5 fields (minute hour day-of-month month day-of-week), got 3",
+				}))
+			})
+		})
+
+		When("the schedule is valid", func() {
+			It("stores the schedule, replacing a schedule with the same name for the same app", func() {
+				Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "b", Schedule: "@daily", AppName: "app", SpaceGUID: "space-1"})).To(Succeed())
+				Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "a", Schedule: "@daily", AppName: "app", SpaceGUID: "space-1"})).To(Succeed())
+				Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "a", Schedule: "@daily", AppName: "app", SpaceGUID: "space-2"})).To(Succeed())
+				Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "b", Schedule: "@hourly", AppName: "app", SpaceGUID: "space-1"})).To(Succeed())
+
+				schedules, err := actor.GetTaskSchedulesBySpace("space-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(schedules).To(Equal([]TaskSchedule{
+					{API: "https://api.example.com", Name: "a", Schedule: "@daily", AppName: "app", SpaceGUID: "space-1"},
+					{API: "https://api.example.com", Name: "b", Schedule: "@hourly", AppName: "app", SpaceGUID: "space-1"},
+				}))
+			})
+
+			It("keeps the schedules of other API endpoints apart", func() {
+				Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "a", Schedule: "@daily", AppName: "app", SpaceGUID: "space-1"})).To(Succeed())
+				fakeConfig.TargetReturns("https://api.other.com")
+				Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "a", Schedule: "@hourly", AppName: "app", SpaceGUID: "space-1"})).To(Succeed())
+
+				schedules, err := actor.GetTaskSchedulesBySpace("space-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(schedules).To(Equal([]TaskSchedule{
+					{API: "https://api.other.com", Name: "a", Schedule: "@hourly", AppName: "app", SpaceGUID: "space-1"},
+				}))
+
+				Expect(actor.DeleteTaskSchedule("space-1", "app", "a")).To(Succeed())
+				fakeConfig.TargetReturns("https://api.example.com")
+				schedules, err = actor.GetTaskSchedulesBySpace("space-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(schedules).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("DeleteTaskSchedule", func() {
+		BeforeEach(func() {
+			Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "a", Schedule: "@daily", AppName: "app", SpaceGUID: "space-1"})).To(Succeed())
+		})
+
+		It("removes the schedule", func() {
+			Expect(actor.DeleteTaskSchedule("space-1", "app", "a")).To(Succeed())
+			schedules, err := actor.GetTaskSchedulesBySpace("space-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedules).To(BeEmpty())
+		})
+
+		When("the schedule does not exist", func() {
+			It("returns a TaskScheduleNotFoundError", func() {
+				Expect(actor.DeleteTaskSchedule("space-2", "app", "a")).To(MatchError(actionerror.TaskScheduleNotFoundError{AppName: "app", Name: "a"}))
+			})
+		})
+	})
+
+	Describe("GetDueTaskSchedules", func() {
+		BeforeEach(func() {
+			Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "hourly", Schedule: "@hourly", SpaceGUID: "space-1", CreatedAt: created})).To(Succeed())
+			Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "quarterly", Schedule: "*/15 * * * *", SpaceGUID: "space-2", CreatedAt: created})).To(Succeed())
+			Expect(actor.CreateTaskSchedule(TaskSchedule{Name: "daily", Schedule: "@daily", SpaceGUID: "space-1", CreatedAt: created})).To(Succeed())
+		})
+
+		It("returns the schedules of every space that are due, earliest first", func() {
+			due, err := actor.GetDueTaskSchedules(time.Date(2019, time.March, 13, 11, 0, 0, 0, time.UTC))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(HaveLen(2))
+			Expect(due[0].Name).To(Equal("quarterly"))
+			Expect(due[1].Name).To(Equal("hourly"))
+		})
+
+		When("another API endpoint is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetReturns("https://api.other.com")
+			})
+
+			It("skips the schedules of the other API endpoint", func() {
+				due, err := actor.GetDueTaskSchedules(time.Date(2019, time.March, 13, 11, 0, 0, 0, time.UTC))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(due).To(BeEmpty())
+			})
+		})
+
+		When("no schedules have been stored", func() {
+			BeforeEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			It("returns no schedules", func() {
+				due, err := actor.GetDueTaskSchedules(time.Now())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(due).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("RunTaskSchedule", func() {
+		var (
+			taskSchedule TaskSchedule
+			now          time.Time
+		)
+
+		BeforeEach(func() {
+			taskSchedule = TaskSchedule{
+				Name:       "migrate",
+				Schedule:   "@hourly",
+				Command:    "some-command",
+				DiskInMB:   10,
+				MemoryInMB: 20,
+				AppGUID:    "app-guid",
+				AppName:    "app",
+				SpaceGUID:  "space-1",
+				CreatedAt:  created,
+			}
+			Expect(actor.CreateTaskSchedule(taskSchedule)).To(Succeed())
+			taskSchedule.API = "https://api.example.com"
+			now = time.Date(2019, time.March, 13, 11, 0, 30, 0, time.UTC)
+		})
+
+		When("the schedule belongs to another API endpoint", func() {
+			BeforeEach(func() {
+				taskSchedule.API = "https://api.other.com"
+			})
+
+			It("refuses to run the task", func() {
+				_, _, err := actor.RunTaskSchedule(taskSchedule, now)
+				Expect(err).To(MatchError(actionerror.TaskScheduleNotFoundError{AppName: "app", Name: "migrate"}))
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the task is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationTaskReturns(ccv3.Task{Name: "migrate", SequenceID: 4}, ccv3.Warnings{"task-warning"}, nil)
+			})
+
+			It("runs the task and records the run", func() {
+				task, warnings, err := actor.RunTaskSchedule(taskSchedule, now)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.SequenceID).To(BeEquivalentTo(4))
+				Expect(warnings).To(ConsistOf("task-warning"))
+
+				appGUID, ccTask := fakeCloudControllerClient.CreateApplicationTaskArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid"))
+				Expect(ccTask).To(Equal(ccv3.Task{Name: "migrate", Command: "some-command", DiskInMB: 10, MemoryInMB: 20}))
+
+				schedules, err := actor.GetTaskSchedulesBySpace("space-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(schedules[0].LastRunAt).To(Equal(now))
+
+				due, err := actor.GetDueTaskSchedules(now)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(due).To(BeEmpty())
+			})
+		})
+
+		When("creating the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationTaskReturns(ccv3.Task{}, ccv3.Warnings{"task-warning"}, errors.New("task error"))
+			})
+
+			It("returns the error and leaves the schedule due", func() {
+				_, warnings, err := actor.RunTaskSchedule(taskSchedule, now)
+				Expect(err).To(MatchError("task error"))
+				Expect(warnings).To(ConsistOf("task-warning"))
+
+				due, err := actor.GetDueTaskSchedules(now)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(due).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -263,6 +263,78 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("PollTask", func() {
+		var (
+			fakeConfig *v3actionfakes.FakeConfig
+			task       Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(0)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.PollTask("some-app-guid", Task{Name: "some-task", SequenceID: 3})
+		})
+
+		When("the task succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0,
+					[]ccv3.Task{{Name: "some-task", SequenceID: 3, State: constant.TaskPending}}, ccv3.Warnings{"poll-warning-1"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(1,
+					[]ccv3.Task{{Name: "some-task", SequenceID: 3, State: constant.TaskRunning}}, ccv3.Warnings{"poll-warning-2"}, nil)
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(2,
+					[]ccv3.Task{{Name: "some-task", SequenceID: 3, State: constant.TaskSucceeded}}, ccv3.Warnings{"poll-warning-3"}, nil)
+			})
+
+			It("polls until the task has finished and returns it with all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(task.State).To(Equal(constant.TaskSucceeded))
+				Expect(warnings).To(ConsistOf("poll-warning-1", "poll-warning-2", "poll-warning-3"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(3))
+				appGUID, queries := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(queries).To(ConsistOf(ccv3.Query{Key: ccv3.SequenceIDFilter, Values: []string{"3"}}))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{
+						Name:       "some-task",
+						SequenceID: 3,
+						State:      constant.TaskFailed,
+						Result:     &ccv3.TaskResult{FailureReason: "Exited with status 1"},
+					}},
+					ccv3.Warnings{"poll-warning"}, nil)
+			})
+
+			It("returns a TaskFailedError with the failure reason", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"}))
+				Expect(task.State).To(Equal(constant.TaskFailed))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+
+		When("getting the task returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"poll-warning"}, errors.New("poll error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("poll error"))
+				Expect(warnings).To(ConsistOf("poll-warning"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		When("the task exists", func() {
 			var returnedTask ccv3.Task
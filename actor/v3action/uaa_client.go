@@ -1,11 +1,18 @@
 package v3action
 
-import "code.cloudfoundry.org/cli/api/uaa/constant"
+import (
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
 
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) (string, string, error)
+	AuthenticateWithAuthorizationCode(code string, redirectURI string, codeVerifier string) (string, string, error)
+	AuthenticateWithDeviceCode(deviceCode string) (string, string, error)
+	AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	LoginPrompts() map[string][]string
+	StartDeviceAuthorization() (uaa.DeviceAuthorization, error)
 }
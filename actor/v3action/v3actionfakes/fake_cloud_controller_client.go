@@ -53,6 +53,19 @@ type FakeCloudControllerClient struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ContinueDeploymentStub        func(string) (ccv3.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	CreateApplicationStub        func(ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	createApplicationMutex       sync.RWMutex
 	createApplicationArgsForCall []struct {
@@ -84,6 +97,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentWithStrategyStub        func(ccv3.Deployment) (string, ccv3.Warnings, error)
+	createApplicationDeploymentWithStrategyMutex       sync.RWMutex
+	createApplicationDeploymentWithStrategyArgsForCall []struct {
+		arg1 ccv3.Deployment
+	}
+	createApplicationDeploymentWithStrategyReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentWithStrategyReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(string, ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -1065,6 +1093,69 @@ func (fake *FakeCloudControllerClient) CloudControllerAPIVersionReturnsOnCall(i
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) ContinueDeployment(arg1 string) (ccv3.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturns(result1 ccv3.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) ContinueDeploymentReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplication(arg1 ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.createApplicationMutex.Lock()
 	ret, specificReturn := fake.createApplicationReturnsOnCall[len(fake.createApplicationArgsForCall)]
@@ -1198,6 +1289,72 @@ func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentWithStrategy(arg1 ccv3.Deployment) (string, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentWithStrategyMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentWithStrategyReturnsOnCall[len(fake.createApplicationDeploymentWithStrategyArgsForCall)]
+	fake.createApplicationDeploymentWithStrategyArgsForCall = append(fake.createApplicationDeploymentWithStrategyArgsForCall, struct {
+		arg1 ccv3.Deployment
+	}{arg1})
+	fake.recordInvocation("CreateApplicationDeploymentWithStrategy", []interface{}{arg1})
+	fake.createApplicationDeploymentWithStrategyMutex.Unlock()
+	if fake.CreateApplicationDeploymentWithStrategyStub != nil {
+		return fake.CreateApplicationDeploymentWithStrategyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createApplicationDeploymentWithStrategyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentWithStrategyCallCount() int {
+	fake.createApplicationDeploymentWithStrategyMutex.RLock()
+	defer fake.createApplicationDeploymentWithStrategyMutex.RUnlock()
+	return len(fake.createApplicationDeploymentWithStrategyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentWithStrategyCalls(stub func(ccv3.Deployment) (string, ccv3.Warnings, error)) {
+	fake.createApplicationDeploymentWithStrategyMutex.Lock()
+	defer fake.createApplicationDeploymentWithStrategyMutex.Unlock()
+	fake.CreateApplicationDeploymentWithStrategyStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentWithStrategyArgsForCall(i int) ccv3.Deployment {
+	fake.createApplicationDeploymentWithStrategyMutex.RLock()
+	defer fake.createApplicationDeploymentWithStrategyMutex.RUnlock()
+	argsForCall := fake.createApplicationDeploymentWithStrategyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentWithStrategyReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationDeploymentWithStrategyMutex.Lock()
+	defer fake.createApplicationDeploymentWithStrategyMutex.Unlock()
+	fake.CreateApplicationDeploymentWithStrategyStub = nil
+	fake.createApplicationDeploymentWithStrategyReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentWithStrategyReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationDeploymentWithStrategyMutex.Lock()
+	defer fake.createApplicationDeploymentWithStrategyMutex.Unlock()
+	fake.CreateApplicationDeploymentWithStrategyStub = nil
+	if fake.createApplicationDeploymentWithStrategyReturnsOnCall == nil {
+		fake.createApplicationDeploymentWithStrategyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentWithStrategyReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(arg1 string, arg2 ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -4525,10 +4682,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createApplicationDeploymentMutex.RLock()
 	defer fake.createApplicationDeploymentMutex.RUnlock()
+	fake.createApplicationDeploymentWithStrategyMutex.RLock()
+	defer fake.createApplicationDeploymentWithStrategyMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
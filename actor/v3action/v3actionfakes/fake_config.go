@@ -118,6 +118,16 @@ type FakeConfig struct {
 	targetReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TaskSchedulesFilePathStub        func() string
+	taskSchedulesFilePathMutex       sync.RWMutex
+	taskSchedulesFilePathArgsForCall []struct {
+	}
+	taskSchedulesFilePathReturns struct {
+		result1 string
+	}
+	taskSchedulesFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAGrantTypeStub        func() string
 	uAAGrantTypeMutex       sync.RWMutex
 	uAAGrantTypeArgsForCall []struct {
@@ -685,6 +695,58 @@ func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) TaskSchedulesFilePath() string {
+	fake.taskSchedulesFilePathMutex.Lock()
+	ret, specificReturn := fake.taskSchedulesFilePathReturnsOnCall[len(fake.taskSchedulesFilePathArgsForCall)]
+	fake.taskSchedulesFilePathArgsForCall = append(fake.taskSchedulesFilePathArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TaskSchedulesFilePath", []interface{}{})
+	fake.taskSchedulesFilePathMutex.Unlock()
+	if fake.TaskSchedulesFilePathStub != nil {
+		return fake.TaskSchedulesFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.taskSchedulesFilePathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathCallCount() int {
+	fake.taskSchedulesFilePathMutex.RLock()
+	defer fake.taskSchedulesFilePathMutex.RUnlock()
+	return len(fake.taskSchedulesFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathCalls(stub func() string) {
+	fake.taskSchedulesFilePathMutex.Lock()
+	defer fake.taskSchedulesFilePathMutex.Unlock()
+	fake.TaskSchedulesFilePathStub = stub
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathReturns(result1 string) {
+	fake.taskSchedulesFilePathMutex.Lock()
+	defer fake.taskSchedulesFilePathMutex.Unlock()
+	fake.TaskSchedulesFilePathStub = nil
+	fake.taskSchedulesFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathReturnsOnCall(i int, result1 string) {
+	fake.taskSchedulesFilePathMutex.Lock()
+	defer fake.taskSchedulesFilePathMutex.Unlock()
+	fake.TaskSchedulesFilePathStub = nil
+	if fake.taskSchedulesFilePathReturnsOnCall == nil {
+		fake.taskSchedulesFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.taskSchedulesFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAGrantType() string {
 	fake.uAAGrantTypeMutex.Lock()
 	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
@@ -787,6 +849,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.startupTimeoutMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
+	fake.taskSchedulesFilePathMutex.RLock()
+	defer fake.taskSchedulesFilePathMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
 	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.unsetOrganizationAndSpaceInformationMutex.RLock()
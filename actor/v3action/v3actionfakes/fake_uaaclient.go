@@ -2,10 +2,11 @@
 package v3actionfakes
 
 import (
-	"sync"
+	sync "sync"
 
-	"code.cloudfoundry.org/cli/actor/v3action"
-	"code.cloudfoundry.org/cli/api/uaa/constant"
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	uaa "code.cloudfoundry.org/cli/api/uaa"
+	constant "code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
 type FakeUAAClient struct {
@@ -26,6 +27,53 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthenticateWithAuthorizationCodeStub        func(string, string, string) (string, string, error)
+	authenticateWithAuthorizationCodeMutex       sync.RWMutex
+	authenticateWithAuthorizationCodeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	authenticateWithAuthorizationCodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithAuthorizationCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	AuthenticateWithDeviceCodeStub        func(string) (string, string, error)
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		arg1 string
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	AuthorizationCodeURLStub        func(string, string, string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetSSHPasscodeStub        func(string, string) (string, error)
 	getSSHPasscodeMutex       sync.RWMutex
 	getSSHPasscodeArgsForCall []struct {
@@ -50,6 +98,18 @@ type FakeUAAClient struct {
 	loginPromptsReturnsOnCall map[int]struct {
 		result1 map[string][]string
 	}
+	StartDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -122,6 +182,205 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCode(arg1 string, arg2 string, arg3 string) (string, string, error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithAuthorizationCodeReturnsOnCall[len(fake.authenticateWithAuthorizationCodeArgsForCall)]
+	fake.authenticateWithAuthorizationCodeArgsForCall = append(fake.authenticateWithAuthorizationCodeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthenticateWithAuthorizationCode", []interface{}{arg1, arg2, arg3})
+	fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	if fake.AuthenticateWithAuthorizationCodeStub != nil {
+		return fake.AuthenticateWithAuthorizationCodeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.authenticateWithAuthorizationCodeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeCallCount() int {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	return len(fake.authenticateWithAuthorizationCodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeCalls(stub func(string, string, string) (string, string, error)) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = stub
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeArgsForCall(i int) (string, string, string) {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithAuthorizationCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeReturns(result1 string, result2 string, result3 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	fake.authenticateWithAuthorizationCodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	if fake.authenticateWithAuthorizationCodeReturnsOnCall == nil {
+		fake.authenticateWithAuthorizationCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithAuthorizationCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCode(arg1 string) (string, string, error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{arg1})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if fake.AuthenticateWithDeviceCodeStub != nil {
+		return fake.AuthenticateWithDeviceCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.authenticateWithDeviceCodeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeCalls(stub func(string) (string, string, error)) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = stub
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeArgsForCall(i int) string {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeReturns(result1 string, result2 string, result3 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURL(arg1 string, arg2 string, arg3 string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.authorizationCodeURLMutex.Unlock()
+	if fake.AuthorizationCodeURLStub != nil {
+		return fake.AuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.authorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCalls(stub func(string, string, string) (string, error)) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, string, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) GetSSHPasscode(arg1 string, arg2 string) (string, error) {
 	fake.getSSHPasscodeMutex.Lock()
 	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
@@ -238,15 +497,78 @@ func (fake *FakeUAAClient) LoginPromptsReturnsOnCall(i int, result1 map[string][
 	}{result1}
 }
 
+func (fake *FakeUAAClient) StartDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if fake.StartDeviceAuthorizationStub != nil {
+		return fake.StartDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) StartDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.loginPromptsMutex.RLock()
 	defer fake.loginPromptsMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -28,14 +28,78 @@ func (actor Actor) CancelDeploymentByAppNameAndSpace(appName string, spaceGUID s
 	return warnings, err
 }
 
+// ContinueDeploymentByAppNameAndSpace continues the current deployment of the
+// app after it has been paused.
+func (actor Actor) ContinueDeploymentByAppNameAndSpace(appName string, spaceGUID string) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	deploymentGUID, deploymentWarnings, err := actor.GetCurrentDeployment(app.GUID)
+	warnings = append(warnings, deploymentWarnings...)
+	if err != nil {
+		return warnings, err
+	}
+
+	apiWarnings, err := actor.ContinueDeployment(deploymentGUID)
+	warnings = append(warnings, apiWarnings...)
+
+	return warnings, err
+}
+
+// RollbackDeploymentByAppNameAndSpace cancels the current deployment of the
+// app, which makes Cloud Controller roll the app back to its previous
+// droplet. It returns the GUID of the canceled deployment so that the
+// rollback can be polled with PollDeploymentRollback.
+func (actor Actor) RollbackDeploymentByAppNameAndSpace(appName string, spaceGUID string) (string, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return "", warnings, err
+	}
+
+	deploymentGUID, deploymentWarnings, err := actor.GetCurrentDeployment(app.GUID)
+	warnings = append(warnings, deploymentWarnings...)
+	if err != nil {
+		return "", warnings, err
+	}
+
+	apiWarnings, err := actor.CancelDeployment(deploymentGUID)
+	warnings = append(warnings, apiWarnings...)
+
+	return deploymentGUID, warnings, err
+}
+
+func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
+
+func (actor Actor) ContinueDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.ContinueDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
+
+// CreateCanaryDeployment creates a deployment that pauses once maxInFlight
+// instances of the new droplet are running. When maxInFlight is zero, Cloud
+// Controller deploys a single canary instance.
+func (actor Actor) CreateCanaryDeployment(appGUID string, dropletGUID string, maxInFlight int) (string, Warnings, error) {
+	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentWithStrategy(ccv3.Deployment{
+		DropletGUID:   dropletGUID,
+		Strategy:      constant.DeploymentStrategyCanary,
+		MaxInFlight:   maxInFlight,
+		Relationships: ccv3.Relationships{constant.RelationshipTypeApplication: ccv3.Relationship{GUID: appGUID}},
+	})
+
+	return deploymentGUID, Warnings(warnings), err
+}
+
 func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
 	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
 
 	return deploymentGUID, Warnings(warnings), err
 }
 
-// TODO: add tests for get current deployment
-
 func (actor Actor) GetCurrentDeployment(appGUID string) (string, Warnings, error) {
 	var collectedWarnings Warnings
 	deployments, warnings, err := actor.CloudControllerClient.GetDeployments(
@@ -85,6 +149,55 @@ func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<-
 
 }
 
+// PollCanaryDeployment waits for a canary deployment to pause. It returns
+// false if the deployment finished without pausing, which happens when the
+// app has no more instances than the canary.
+func (actor Actor) PollCanaryDeployment(deploymentGUID string, warningsChannel chan<- Warnings) (bool, error) {
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deploymentState, warnings, err := actor.GetDeploymentState(deploymentGUID)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return false, err
+		}
+		switch deploymentState {
+		case constant.DeploymentPaused:
+			return true, nil
+		case constant.DeploymentDeployed:
+			return false, nil
+		case constant.DeploymentCanceling, constant.DeploymentCanceled:
+			return false, errors.New("Deployment has been canceled")
+		default:
+			time.Sleep(actor.Config.PollingInterval())
+		}
+	}
+
+	return false, actionerror.StartupTimeoutError{}
+}
+
+// PollDeploymentRollback waits for a canceled deployment to finish rolling
+// back.
+func (actor Actor) PollDeploymentRollback(deploymentGUID string, warningsChannel chan<- Warnings) error {
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deploymentState, warnings, err := actor.GetDeploymentState(deploymentGUID)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+		switch deploymentState {
+		case constant.DeploymentCanceled:
+			return nil
+		case constant.DeploymentDeployed:
+			return errors.New("Deployment has already completed")
+		default:
+			time.Sleep(actor.Config.PollingInterval())
+		}
+	}
+
+	return actionerror.StartupTimeoutError{}
+}
+
 func (actor Actor) ZeroDowntimePollStart(appGUID string, warningsChannel chan<- Warnings) error {
 	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
 	warningsChannel <- Warnings(warnings)
@@ -91,6 +91,80 @@ var _ = Describe("v3-zdt-push", func() {
 		})
 	})
 
+	Describe("ContinueDeploymentByAppNameAndSpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "app-guid"}}, ccv3.Warnings{"getapp-warning"}, nil)
+			fakeCloudControllerClient.GetDeploymentsReturns([]ccv3.Deployment{{GUID: "deployment-guid"}}, ccv3.Warnings{"getdep-warning"}, nil)
+			fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-warning"}, nil)
+		})
+
+		It("continues the current deployment", func() {
+			warnings, err := actor.ContinueDeploymentByAppNameAndSpace("app-name", "space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("getapp-warning", "getdep-warning", "continue-warning"))
+			Expect(fakeCloudControllerClient.ContinueDeploymentArgsForCall(0)).To(Equal("deployment-guid"))
+		})
+
+		Context("when continuing the deployment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-warning"}, errors.New("not paused"))
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.ContinueDeploymentByAppNameAndSpace("app-name", "space-guid")
+				Expect(err).To(MatchError("not paused"))
+				Expect(warnings).To(ConsistOf("getapp-warning", "getdep-warning", "continue-warning"))
+			})
+		})
+	})
+
+	Describe("RollbackDeploymentByAppNameAndSpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{GUID: "app-guid"}}, ccv3.Warnings{"getapp-warning"}, nil)
+			fakeCloudControllerClient.GetDeploymentsReturns([]ccv3.Deployment{{GUID: "deployment-guid"}}, ccv3.Warnings{"getdep-warning"}, nil)
+			fakeCloudControllerClient.CancelDeploymentReturns(ccv3.Warnings{"cancel-warning"}, nil)
+		})
+
+		It("cancels the current deployment and returns its GUID", func() {
+			deploymentGUID, warnings, err := actor.RollbackDeploymentByAppNameAndSpace("app-name", "space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deploymentGUID).To(Equal("deployment-guid"))
+			Expect(warnings).To(ConsistOf("getapp-warning", "getdep-warning", "cancel-warning"))
+			Expect(fakeCloudControllerClient.CancelDeploymentArgsForCall(0)).To(Equal("deployment-guid"))
+		})
+
+		Context("when no deployments are found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns([]ccv3.Deployment{}, nil, nil)
+			})
+
+			It("errors appropriately", func() {
+				_, _, err := actor.RollbackDeploymentByAppNameAndSpace("app-name", "space-guid")
+				Expect(err).To(MatchError("failed to find a deployment for that app"))
+				Expect(fakeCloudControllerClient.CancelDeploymentCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CreateCanaryDeployment", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateApplicationDeploymentWithStrategyReturns("some-deployment-guid", ccv3.Warnings{"create-deployment-warning"}, nil)
+		})
+
+		It("creates a deployment with the canary strategy", func() {
+			deploymentGUID, warnings, err := actor.CreateCanaryDeployment("some-app-guid", "some-droplet-guid", 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			Expect(warnings).To(ConsistOf("create-deployment-warning"))
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentWithStrategyArgsForCall(0)).To(Equal(ccv3.Deployment{
+				DropletGUID:   "some-droplet-guid",
+				Strategy:      constant.DeploymentStrategyCanary,
+				MaxInFlight:   2,
+				Relationships: ccv3.Relationships{constant.RelationshipTypeApplication: ccv3.Relationship{GUID: "some-app-guid"}},
+			}))
+		})
+	})
+
 	Describe("CreateApplicationDeployment", func() {
 
 		Context("When there is no error", func() {
@@ -123,6 +197,59 @@ var _ = Describe("v3-zdt-push", func() {
 
 	})
 
+	Describe("GetCurrentDeployment", func() {
+		var (
+			deploymentGUID string
+			warnings       Warnings
+			err            error
+		)
+
+		JustBeforeEach(func() {
+			deploymentGUID, warnings, err = actor.GetCurrentDeployment("some-app-guid")
+		})
+
+		When("the app has deployments", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns([]ccv3.Deployment{{GUID: "newest-deployment-guid"}}, ccv3.Warnings{"get-deployments-warning"}, nil)
+			})
+
+			It("returns the most recently created deployment", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deploymentGUID).To(Equal("newest-deployment-guid"))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+
+				Expect(fakeCloudControllerClient.GetDeploymentsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{"-created_at"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+				))
+			})
+		})
+
+		When("the app has no deployments", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, nil)
+			})
+
+			It("returns an error and the warnings", func() {
+				Expect(err).To(MatchError("failed to find a deployment for that app"))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+			})
+		})
+
+		When("getting the deployments fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentsReturns(nil, ccv3.Warnings{"get-deployments-warning"}, errors.New("get-deployments-error"))
+			})
+
+			It("returns the error and the warnings", func() {
+				Expect(err).To(MatchError("get-deployments-error"))
+				Expect(warnings).To(ConsistOf("get-deployments-warning"))
+			})
+		})
+	})
+
 	Describe("GetDeploymentState", func() {
 
 		Context("when there is no error", func() {
@@ -233,6 +360,132 @@ var _ = Describe("v3-zdt-push", func() {
 		})
 	})
 
+	Describe("PollCanaryDeployment", func() {
+		var (
+			warningsChannel chan Warnings
+			allWarnings     Warnings
+			funcDone        chan interface{}
+			states          []constant.DeploymentState
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+			warningsChannel = make(chan Warnings)
+			allWarnings = Warnings{}
+			funcDone = make(chan interface{})
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+
+			fakeCloudControllerClient.GetDeploymentStub = func(string) (ccv3.Deployment, ccv3.Warnings, error) {
+				callCount := fakeCloudControllerClient.GetDeploymentCallCount()
+				state := states[len(states)-1]
+				if callCount <= len(states) {
+					state = states[callCount-1]
+				}
+				return ccv3.Deployment{State: state}, ccv3.Warnings{fmt.Sprintf("get-deployment-warning-%d", callCount)}, nil
+			}
+		})
+
+		Context("when the deployment pauses", func() {
+			BeforeEach(func() {
+				states = []constant.DeploymentState{constant.DeploymentDeploying, constant.DeploymentPaused}
+			})
+
+			It("returns true and all warnings", func() {
+				paused, err := actor.PollCanaryDeployment("deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paused).To(BeTrue())
+				Expect(allWarnings).To(ConsistOf("get-deployment-warning-1", "get-deployment-warning-2"))
+			})
+		})
+
+		Context("when the deployment finishes without pausing", func() {
+			BeforeEach(func() {
+				states = []constant.DeploymentState{constant.DeploymentDeployed}
+			})
+
+			It("returns false", func() {
+				paused, err := actor.PollCanaryDeployment("deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paused).To(BeFalse())
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				states = []constant.DeploymentState{constant.DeploymentCanceling}
+			})
+
+			It("returns a deployment canceled error", func() {
+				_, err := actor.PollCanaryDeployment("deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).To(MatchError("Deployment has been canceled"))
+			})
+		})
+	})
+
+	Describe("PollDeploymentRollback", func() {
+		var (
+			warningsChannel chan Warnings
+			funcDone        chan interface{}
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+			warningsChannel = make(chan Warnings)
+			funcDone = make(chan interface{})
+			go func() {
+				for {
+					select {
+					case <-warningsChannel:
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+		})
+
+		Context("when the deployment finishes rolling back", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0, ccv3.Deployment{State: constant.DeploymentCanceling}, nil, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: constant.DeploymentCanceled}, nil, nil)
+			})
+
+			It("returns a nil error", func() {
+				err := actor.PollDeploymentRollback("deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the rollback times out", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(time.Millisecond)
+				fakeConfig.PollingIntervalReturns(time.Millisecond * 2)
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: constant.DeploymentCanceling}, nil, nil)
+			})
+
+			It("returns a timeout error", func() {
+				err := actor.PollDeploymentRollback("deployment-guid", warningsChannel)
+				funcDone <- nil
+				Expect(err).To(MatchError(actionerror.StartupTimeoutError{}))
+			})
+		})
+	})
+
 	Describe("ZeroDowntimePollStart", func() {
 		var warningsChannel chan Warnings
 		var allWarnings Warnings
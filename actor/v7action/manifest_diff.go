@@ -0,0 +1,223 @@
+package v7action
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"gopkg.in/yaml.v2"
+)
+
+// ManifestApplicationState is the subset of an application's configuration
+// that is compared when diffing a manifest.
+type ManifestApplicationState struct {
+	Instances  types.NullInt
+	MemoryInMB types.NullUint64
+	Env        map[string]string
+	Routes     []string
+	Services   []string
+	Buildpacks []string
+}
+
+// ManifestDiff describes how applying a manifest would change an application.
+// Desired is the state the application would be in after the manifest has
+// been applied: fields that the manifest does not specify keep their current
+// values, and env and services are merged into the current ones.
+type ManifestDiff struct {
+	AppName   string
+	AppExists bool
+	Current   ManifestApplicationState
+	Desired   ManifestApplicationState
+}
+
+// HasDrift returns true when applying the manifest would change the
+// application.
+func (diff ManifestDiff) HasDrift() bool {
+	return !diff.AppExists || !reflect.DeepEqual(diff.Current, diff.Desired)
+}
+
+type manifestDiffProcess struct {
+	Type      string `yaml:"type"`
+	Instances *int   `yaml:"instances"`
+	Memory    string `yaml:"memory"`
+}
+
+type manifestDiffRoute struct {
+	Route string `yaml:"route"`
+}
+
+type manifestDiffApplication struct {
+	Name       string                 `yaml:"name"`
+	Instances  *int                   `yaml:"instances"`
+	Memory     string                 `yaml:"memory"`
+	Env        map[string]interface{} `yaml:"env"`
+	Routes     []manifestDiffRoute    `yaml:"routes"`
+	NoRoute    bool                   `yaml:"no-route"`
+	Services   []interface{}          `yaml:"services"`
+	Buildpack  string                 `yaml:"buildpack"`
+	Buildpacks []string               `yaml:"buildpacks"`
+	Processes  []manifestDiffProcess  `yaml:"processes"`
+}
+
+type manifestDiffManifest struct {
+	Applications []manifestDiffApplication `yaml:"applications"`
+}
+
+// DiffApplicationManifest compares the provided single application manifest
+// with the manifest of the deployed application of the same name.
+func (actor Actor) DiffApplicationManifest(appName string, rawManifest []byte, spaceGUID string) (ManifestDiff, Warnings, error) {
+	diff := ManifestDiff{AppName: appName}
+
+	desiredApp, err := parseManifestDiffApplication(rawManifest, appName)
+	if err != nil {
+		return diff, nil, err
+	}
+
+	rawCurrentManifest, warnings, err := actor.GetRawApplicationManifestByNameAndSpace(appName, spaceGUID)
+	switch err.(type) {
+	case nil:
+		diff.AppExists = true
+	case actionerror.ApplicationNotFoundError:
+	default:
+		return diff, warnings, err
+	}
+
+	if diff.AppExists {
+		currentApp, parseErr := parseManifestDiffApplication(rawCurrentManifest, appName)
+		if parseErr != nil {
+			return diff, warnings, parseErr
+		}
+
+		diff.Current, err = currentApp.state()
+		if err != nil {
+			return diff, warnings, err
+		}
+	}
+
+	diff.Desired, err = desiredApp.applyTo(diff.Current)
+	return diff, warnings, err
+}
+
+func parseManifestDiffApplication(rawManifest []byte, appName string) (manifestDiffApplication, error) {
+	var manifest manifestDiffManifest
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return manifestDiffApplication{}, err
+	}
+
+	for _, app := range manifest.Applications {
+		if app.Name == appName {
+			return app, nil
+		}
+	}
+
+	return manifestDiffApplication{}, manifestparser.AppNotInManifestError{Name: appName}
+}
+
+// state returns the application state described by the manifest, as if it
+// was applied to an application without any configuration.
+func (app manifestDiffApplication) state() (ManifestApplicationState, error) {
+	return app.applyTo(ManifestApplicationState{})
+}
+
+// applyTo returns the application state after applying the manifest to an
+// application in the provided state.
+func (app manifestDiffApplication) applyTo(current ManifestApplicationState) (ManifestApplicationState, error) {
+	desired := ManifestApplicationState{
+		Instances:  current.Instances,
+		MemoryInMB: current.MemoryInMB,
+		Env:        map[string]string{},
+		Routes:     current.Routes,
+		Services:   append([]string{}, current.Services...),
+		Buildpacks: current.Buildpacks,
+	}
+
+	instances, memory := app.Instances, app.Memory
+	for _, process := range app.Processes {
+		if process.Type == "web" {
+			if process.Instances != nil {
+				instances = process.Instances
+			}
+			if process.Memory != "" {
+				memory = process.Memory
+			}
+		}
+	}
+
+	if instances != nil {
+		desired.Instances = types.NullInt{IsSet: true, Value: *instances}
+	}
+
+	if memory != "" {
+		memoryInMB, err := bytefmt.ToMegabytes(memory)
+		if err != nil {
+			return desired, err
+		}
+		desired.MemoryInMB = types.NullUint64{IsSet: true, Value: memoryInMB}
+	}
+
+	for key, value := range current.Env {
+		desired.Env[key] = value
+	}
+	for key, value := range app.Env {
+		desired.Env[key] = fmt.Sprint(value)
+	}
+
+	switch {
+	case app.NoRoute:
+		desired.Routes = nil
+	case app.Routes != nil:
+		desired.Routes = nil
+		for _, route := range app.Routes {
+			desired.Routes = append(desired.Routes, route.Route)
+		}
+		sort.Strings(desired.Routes)
+	}
+
+	for _, service := range app.Services {
+		var name string
+		switch typedService := service.(type) {
+		case string:
+			name = typedService
+		case map[interface{}]interface{}:
+			name = fmt.Sprint(typedService["name"])
+		}
+
+		if !containsString(desired.Services, name) {
+			desired.Services = append(desired.Services, name)
+		}
+	}
+	sort.Strings(desired.Services)
+
+	switch {
+	case app.Buildpacks != nil:
+		desired.Buildpacks = app.Buildpacks
+	case app.Buildpack != "":
+		desired.Buildpacks = []string{app.Buildpack}
+	}
+
+	if len(desired.Env) == 0 {
+		desired.Env = nil
+	}
+	if len(desired.Services) == 0 {
+		desired.Services = nil
+	}
+	if len(desired.Buildpacks) == 0 {
+		desired.Buildpacks = nil
+	}
+
+	return desired, nil
+}
+
+func containsString(list []string, str string) bool {
+	for _, item := range list {
+		if item == str {
+			return true
+		}
+	}
+	return false
+}
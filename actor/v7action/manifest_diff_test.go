@@ -0,0 +1,190 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Diff Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("DiffApplicationManifest", func() {
+		var (
+			rawManifest []byte
+
+			diff       ManifestDiff
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			rawManifest = []byte(`---
+applications:
+- name: some-app
+  instances: 3
+  memory: 1G
+  env:
+    FOO: new-foo
+    BAR: 42
+  routes:
+  - route: new.example.com
+  services:
+  - new-service
+  buildpacks:
+  - go_buildpack
+`)
+		})
+
+		JustBeforeEach(func() {
+			diff, warnings, executeErr = actor.DiffApplicationManifest("some-app", rawManifest, "some-space-guid")
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-application-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationManifestReturns(
+					[]byte(`---
+applications:
+- name: some-app
+  env:
+    FOO: old-foo
+    OTHER: other
+  routes:
+  - route: old.example.com
+  services:
+  - old-service
+  buildpacks:
+  - go_buildpack
+  processes:
+  - type: web
+    instances: 1
+    memory: 1024M
+`),
+					ccv3.Warnings{"get-manifest-warning"},
+					nil,
+				)
+			})
+
+			It("returns the current and desired state of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-application-warning", "get-manifest-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationManifestArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(diff.AppName).To(Equal("some-app"))
+				Expect(diff.AppExists).To(BeTrue())
+				Expect(diff.Current).To(Equal(ManifestApplicationState{
+					Instances:  types.NullInt{IsSet: true, Value: 1},
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 1024},
+					Env:        map[string]string{"FOO": "old-foo", "OTHER": "other"},
+					Routes:     []string{"old.example.com"},
+					Services:   []string{"old-service"},
+					Buildpacks: []string{"go_buildpack"},
+				}))
+				Expect(diff.Desired).To(Equal(ManifestApplicationState{
+					Instances:  types.NullInt{IsSet: true, Value: 3},
+					MemoryInMB: types.NullUint64{IsSet: true, Value: 1024},
+					Env:        map[string]string{"FOO": "new-foo", "BAR": "42", "OTHER": "other"},
+					Routes:     []string{"new.example.com"},
+					Services:   []string{"new-service", "old-service"},
+					Buildpacks: []string{"go_buildpack"},
+				}))
+				Expect(diff.HasDrift()).To(BeTrue())
+			})
+
+			When("the manifest matches the app", func() {
+				BeforeEach(func() {
+					rawManifest = []byte(`---
+applications:
+- name: some-app
+  instances: 1
+  env:
+    FOO: old-foo
+`)
+				})
+
+				It("reports no drift", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(diff.HasDrift()).To(BeFalse())
+				})
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-application-warning"}, nil)
+			})
+
+			It("returns the state the app would be created with", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-application-warning"))
+
+				Expect(diff.AppExists).To(BeFalse())
+				Expect(diff.Current).To(Equal(ManifestApplicationState{}))
+				Expect(diff.Desired.Instances).To(Equal(types.NullInt{IsSet: true, Value: 3}))
+				Expect(diff.HasDrift()).To(BeTrue())
+			})
+		})
+
+		When("getting the app manifest fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-application-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationManifestReturns(nil, ccv3.Warnings{"get-manifest-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-application-warning", "get-manifest-warning"))
+			})
+		})
+
+		When("the manifest does not contain the app", func() {
+			BeforeEach(func() {
+				rawManifest = []byte("applications:\n- name: some-other-app\n  instances: 3\n")
+			})
+
+			It("returns an AppNotInManifestError without diffing another app", func() {
+				Expect(executeErr).To(MatchError(manifestparser.AppNotInManifestError{Name: "some-app"}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the manifest memory is invalid", func() {
+			BeforeEach(func() {
+				rawManifest = []byte("applications:\n- name: some-app\n  memory: lots\n")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, nil, nil)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+})
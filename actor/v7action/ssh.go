@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 )
 
 type SSHAuthentication struct {
@@ -20,17 +21,12 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 ) (SSHAuthentication, Warnings, error) {
 	var allWarnings Warnings
 
-	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
-	if endpoint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHEndpointNotSetError{}
-	}
-
-	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
-	if fingerprint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	endpoint, fingerprint, err := actor.getSSHEndpointAndHostKeyFingerprint()
+	if err != nil {
+		return SSHAuthentication{}, nil, err
 	}
 
-	passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+	passcode, err := actor.GetSSHPasscode()
 	if err != nil {
 		return SSHAuthentication{}, Warnings{}, err
 	}
@@ -59,22 +55,95 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
-func (actor Actor) getUsername(application Application, processType string, processIndex uint) (string, Warnings, error) {
+// GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType
+// returns the SSH endpoint and host key fingerprint, and the instances of the
+// process that are running. The passcode is left empty, since each connection
+// needs its own passcode from GetSSHPasscode.
+func (actor Actor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType(
+	appName string, spaceGUID string, processType string,
+) (SSHAuthentication, []sharedaction.SSHInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	endpoint, fingerprint, err := actor.getSSHEndpointAndHostKeyFingerprint()
+	if err != nil {
+		return SSHAuthentication{}, nil, nil, err
+	}
+
+	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		return SSHAuthentication{}, nil, allWarnings, err
+	}
+
+	if !application.Started() {
+		return SSHAuthentication{}, nil, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return SSHAuthentication{}, nil, allWarnings, err
+	}
+
+	var instances []sharedaction.SSHInstance
+	for _, instance := range processSummary.InstanceDetails {
+		if instance.Running() {
+			instances = append(instances, sharedaction.SSHInstance{
+				Index:    int(instance.Index),
+				Username: fmt.Sprintf("cf:%s/%d", processSummary.GUID, instance.Index),
+			})
+		}
+	}
+
+	if len(instances) == 0 {
+		return SSHAuthentication{}, nil, allWarnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+	}
+
+	return SSHAuthentication{
+		Endpoint:           endpoint,
+		HostKeyFingerprint: fingerprint,
+	}, instances, allWarnings, nil
+}
+
+// GetSSHPasscode returns a one-time passcode for connecting to an application
+// instance.
+func (actor Actor) GetSSHPasscode() (string, error) {
+	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+}
+
+func (actor Actor) getSSHEndpointAndHostKeyFingerprint() (string, string, error) {
+	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
+	if endpoint == "" {
+		return "", "", actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return "", "", actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	return endpoint, fingerprint, nil
+}
+
+func (actor Actor) getProcessSummaryByType(application Application, processType string) (ProcessSummary, Warnings, error) {
 	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
 	if err != nil {
-		return "", processWarnings, err
+		return ProcessSummary{}, processWarnings, err
 	}
 
-	var processSummary ProcessSummary
 	for _, appProcessSummary := range processSummaries {
 		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
+			return appProcessSummary, processWarnings, nil
 		}
 	}
 
-	if processSummary.GUID == "" {
-		return "", processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+	return ProcessSummary{}, processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+}
+
+func (actor Actor) getUsername(application Application, processType string, processIndex uint) (string, Warnings, error) {
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	if err != nil {
+		return "", processWarnings, err
 	}
 
 	var processInstance ProcessInstance
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -205,4 +206,106 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType", func() {
+		var (
+			sshAuth   SSHAuthentication
+			instances []sharedaction.SSHInstance
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceRunning, Index: 0},
+				{State: constant.ProcessInstanceCrashed, Index: 1},
+				{State: constant.ProcessInstanceRunning, Index: 2},
+			}, ccv3.Warnings{"some-instance-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuth, instances, warnings, executeErr = actor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType("some-app", "some-space-guid", "some-process-type")
+		})
+
+		It("returns the endpoint and the running instances without getting a passcode", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+			Expect(sshAuth).To(Equal(SSHAuthentication{
+				Endpoint:           "some-app-ssh-endpoint",
+				HostKeyFingerprint: "some-app-ssh-fingerprint",
+			}))
+			Expect(instances).To(Equal([]sharedaction.SSHInstance{
+				{Index: 0, Username: "cf:some-process-guid/0"},
+				{Index: 2, Username: "cf:some-process-guid/2"},
+			}))
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+		})
+
+		When("the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHEndpointReturns("")
+			})
+
+			It("returns an ssh-endpoint-not-set error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+			})
+		})
+
+		When("the application is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns an ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{}, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+			})
+		})
+
+		When("no instances are running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceStarting, Index: 0},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("returns a NoRunningProcessInstancesError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+			})
+		})
+	})
+
+	Describe("GetSSHPasscode", func() {
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-ssh-oauth-client")
+			fakeUAAClient.GetSSHPasscodeReturns("some-ssh-passcode", nil)
+		})
+
+		It("gets a passcode with the config credentials", func() {
+			passcode, err := actor.GetSSHPasscode()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(passcode).To(Equal("some-ssh-passcode"))
+
+			Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(1))
+			accessToken, client := fakeUAAClient.GetSSHPasscodeArgsForCall(0)
+			Expect(accessToken).To(Equal("some-access-token"))
+			Expect(client).To(Equal("some-ssh-oauth-client"))
+		})
+	})
 })
@@ -0,0 +1,149 @@
+package v7pushaction
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AppEvent is a plan, event, set of warnings or error emitted while
+// actualizing the push plan of the named application. Exactly one of Plan,
+// Event, Warnings and Err is set.
+type AppEvent struct {
+	AppName  string
+	Plan     *PushPlan
+	Event    Event
+	Warnings Warnings
+	Err      error
+}
+
+// StartFunc starts the application of a plan that has been actualized. It is
+// called from the goroutine actualizing the plan, while the plan still holds
+// its in-flight slot.
+type StartFunc func(plan PushPlan) error
+
+// ActualizeConcurrently actualizes the provided push plans with at most
+// maxInFlight plans being actualized at the same time. The streams of all the
+// plans are interleaved on the returned stream, which is closed once every
+// started plan has finished.
+//
+// When start is not nil, it is called with the updated plan once a plan has
+// been actualized; an error it returns is emitted like an actualize error.
+// Starting counts against the same maxInFlight limit as actualizing.
+//
+// A failing plan does not stop the other plans. When failFast is set, plans
+// that have not been started when a plan or a start fails are not started,
+// and plans that finish actualizing afterwards are not started either; a
+// Skipped event is emitted for each of them instead.
+func (actor Actor) ActualizeConcurrently(plans []PushPlan, maxInFlight int, failFast bool, progressBar ProgressBar, start StartFunc) <-chan AppEvent {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	appEventStream := make(chan AppEvent)
+
+	go func() {
+		defer close(appEventStream)
+
+		var (
+			wg       sync.WaitGroup
+			failedMu sync.Mutex
+			failed   bool
+		)
+		hasFailed := func() bool {
+			failedMu.Lock()
+			defer failedMu.Unlock()
+			return failed
+		}
+		setFailed := func() {
+			failedMu.Lock()
+			defer failedMu.Unlock()
+			failed = true
+		}
+
+		inFlight := make(chan struct{}, maxInFlight)
+		for _, plan := range plans {
+			inFlight <- struct{}{}
+
+			if failFast && hasFailed() {
+				<-inFlight
+				appEventStream <- AppEvent{AppName: plan.Application.Name, Event: Skipped}
+				continue
+			}
+
+			wg.Add(1)
+			go func(plan PushPlan) {
+				defer wg.Done()
+				defer func() { <-inFlight }()
+
+				appName := plan.Application.Name
+				log.WithField("app_name", appName).Info("actualizing concurrently")
+				updatedPlan, succeeded := actor.forwardActualizeStreams(plan, progressBar, appEventStream)
+				if !succeeded {
+					setFailed()
+					return
+				}
+				if start == nil {
+					return
+				}
+
+				if failFast && hasFailed() {
+					appEventStream <- AppEvent{AppName: appName, Event: Skipped}
+					return
+				}
+
+				log.WithField("app_name", appName).Info("starting concurrently")
+				if err := start(updatedPlan); err != nil {
+					setFailed()
+					appEventStream <- AppEvent{AppName: appName, Err: err}
+				}
+			}(plan)
+		}
+
+		wg.Wait()
+	}()
+
+	return appEventStream
+}
+
+// forwardActualizeStreams actualizes the plan and forwards its streams to the
+// appEventStream until they are all closed. It returns the last updated plan,
+// and false when actualizing the plan failed.
+func (actor Actor) forwardActualizeStreams(plan PushPlan, progressBar ProgressBar, appEventStream chan<- AppEvent) (PushPlan, bool) {
+	appName := plan.Application.Name
+	planStream, eventStream, warningsStream, errorStream := actor.Actualize(plan, progressBar)
+
+	succeeded := true
+	for planStream != nil || eventStream != nil || warningsStream != nil || errorStream != nil {
+		select {
+		case updatedPlan, ok := <-planStream:
+			if !ok {
+				planStream = nil
+				break
+			}
+			plan = updatedPlan
+			appEventStream <- AppEvent{AppName: appName, Plan: &updatedPlan}
+		case event, ok := <-eventStream:
+			if !ok {
+				eventStream = nil
+				break
+			}
+			appEventStream <- AppEvent{AppName: appName, Event: event}
+		case warnings, ok := <-warningsStream:
+			if !ok {
+				warningsStream = nil
+				break
+			}
+			appEventStream <- AppEvent{AppName: appName, Warnings: warnings}
+		case err, ok := <-errorStream:
+			if !ok {
+				errorStream = nil
+				break
+			}
+			succeeded = false
+			appEventStream <- AppEvent{AppName: appName, Err: err}
+		}
+	}
+
+	return plan, succeeded
+}
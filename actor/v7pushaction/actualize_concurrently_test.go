@@ -0,0 +1,210 @@
+package v7pushaction_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualizeConcurrently", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		plans       []PushPlan
+		maxInFlight int
+		failFast    bool
+		start       StartFunc
+
+		appEvents []AppEvent
+	)
+
+	dockerPlan := func(appName string) PushPlan {
+		return PushPlan{
+			Application:                       v7action.Application{Name: appName, GUID: appName + "-guid"},
+			DockerImageCredentialsNeedsUpdate: true,
+			SkipRouteCreation:                 true,
+			NoStart:                           true,
+		}
+	}
+
+	eventsFor := func(appName string) []Event {
+		var events []Event
+		for _, appEvent := range appEvents {
+			if appEvent.AppName == appName && appEvent.Event != "" {
+				events = append(events, appEvent.Event)
+			}
+		}
+		return events
+	}
+
+	errorsFor := func(appName string) []error {
+		var errs []error
+		for _, appEvent := range appEvents {
+			if appEvent.AppName == appName && appEvent.Err != nil {
+				errs = append(errs, appEvent.Err)
+			}
+		}
+		return errs
+	}
+
+	BeforeEach(func() {
+		actor, _, fakeV7Actor, _ = getTestPushActor()
+
+		plans = []PushPlan{dockerPlan("app-1"), dockerPlan("app-2"), dockerPlan("app-3")}
+		maxInFlight = 2
+		failFast = false
+		start = nil
+		appEvents = nil
+	})
+
+	JustBeforeEach(func() {
+		appEventStream := actor.ActualizeConcurrently(plans, maxInFlight, failFast, &v7pushactionfakes.FakeProgressBar{}, start)
+		for appEvent := range appEventStream {
+			appEvents = append(appEvents, appEvent)
+		}
+	})
+
+	When("all the plans succeed", func() {
+		var (
+			lock        sync.Mutex
+			inFlight    int
+			maxObserved int
+		)
+
+		BeforeEach(func() {
+			inFlight, maxObserved = 0, 0
+			fakeV7Actor.CreateDockerPackageByApplicationStub = func(appGUID string, _ v7action.DockerImageCredentials) (v7action.Package, v7action.Warnings, error) {
+				lock.Lock()
+				inFlight++
+				if inFlight > maxObserved {
+					maxObserved = inFlight
+				}
+				lock.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+
+				lock.Lock()
+				inFlight--
+				lock.Unlock()
+				return v7action.Package{GUID: appGUID + "-package"}, v7action.Warnings{appGUID + "-warning"}, nil
+			}
+		})
+
+		It("actualizes every plan and tags the streams with the app name", func() {
+			for _, appName := range []string{"app-1", "app-2", "app-3"} {
+				Expect(eventsFor(appName)).To(Equal([]Event{SetDockerImage, SetDockerImageComplete, Complete}))
+				Expect(errorsFor(appName)).To(BeEmpty())
+			}
+
+			Expect(appEvents).To(ContainElement(AppEvent{AppName: "app-2", Warnings: Warnings{"app-2-guid-warning"}}))
+			Expect(fakeV7Actor.CreateDockerPackageByApplicationCallCount()).To(Equal(3))
+		})
+
+		It("never has more than maxInFlight plans in flight", func() {
+			Expect(maxObserved).To(Equal(2))
+		})
+	})
+
+	When("a plan fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.CreateDockerPackageByApplicationStub = func(appGUID string, _ v7action.DockerImageCredentials) (v7action.Package, v7action.Warnings, error) {
+				if appGUID == "app-1-guid" {
+					return v7action.Package{}, nil, errors.New("app-1-error")
+				}
+				return v7action.Package{GUID: appGUID + "-package"}, nil, nil
+			}
+		})
+
+		It("reports the error and continues with the other plans", func() {
+			Expect(errorsFor("app-1")).To(ConsistOf(MatchError("app-1-error")))
+			Expect(eventsFor("app-2")).To(ContainElement(Complete))
+			Expect(eventsFor("app-3")).To(ContainElement(Complete))
+		})
+
+		When("failFast is set", func() {
+			BeforeEach(func() {
+				maxInFlight = 1
+				failFast = true
+			})
+
+			It("skips the plans that have not been started", func() {
+				Expect(errorsFor("app-1")).To(ConsistOf(MatchError("app-1-error")))
+				Expect(eventsFor("app-2")).To(Equal([]Event{Skipped}))
+				Expect(eventsFor("app-3")).To(Equal([]Event{Skipped}))
+				Expect(fakeV7Actor.CreateDockerPackageByApplicationCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("a start function is provided", func() {
+		var (
+			lock        sync.Mutex
+			started     []string
+			inFlight    int
+			maxObserved int
+		)
+
+		track := func(delta int) {
+			lock.Lock()
+			defer lock.Unlock()
+			inFlight += delta
+			if inFlight > maxObserved {
+				maxObserved = inFlight
+			}
+		}
+
+		BeforeEach(func() {
+			started, inFlight, maxObserved = nil, 0, 0
+			fakeV7Actor.CreateDockerPackageByApplicationStub = func(appGUID string, _ v7action.DockerImageCredentials) (v7action.Package, v7action.Warnings, error) {
+				track(1)
+				time.Sleep(10 * time.Millisecond)
+				track(-1)
+				return v7action.Package{GUID: appGUID + "-package"}, nil, nil
+			}
+			start = func(plan PushPlan) error {
+				track(1)
+				defer track(-1)
+				time.Sleep(10 * time.Millisecond)
+
+				lock.Lock()
+				started = append(started, plan.Application.Name)
+				lock.Unlock()
+				if plan.Application.Name == "app-1" {
+					return errors.New("app-1-start-error")
+				}
+				return nil
+			}
+		})
+
+		It("starts every actualized plan and reports start errors", func() {
+			Expect(started).To(ConsistOf("app-1", "app-2", "app-3"))
+			Expect(errorsFor("app-1")).To(ConsistOf(MatchError("app-1-start-error")))
+			Expect(errorsFor("app-2")).To(BeEmpty())
+		})
+
+		It("counts starting against maxInFlight", func() {
+			Expect(maxObserved).To(Equal(2))
+		})
+
+		When("failFast is set and a start fails", func() {
+			BeforeEach(func() {
+				maxInFlight = 1
+				failFast = true
+			})
+
+			It("skips the remaining plans", func() {
+				Expect(started).To(Equal([]string{"app-1"}))
+				Expect(eventsFor("app-2")).To(Equal([]Event{Skipped}))
+				Expect(eventsFor("app-3")).To(Equal([]Event{Skipped}))
+			})
+		})
+	})
+})
@@ -28,6 +28,7 @@ const (
 	SetProcessConfigurationComplete Event = "completed setting configuration on the process"
 	SettingDroplet                  Event = "setting droplet"
 	SettingUpApplication            Event = "setting up application"
+	Skipped                         Event = "skipped"
 	SkippingApplicationCreation     Event = "skipping creation"
 	StagingComplete                 Event = "staging complete"
 	StartingStaging                 Event = "starting staging"
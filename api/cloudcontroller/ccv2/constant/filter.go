@@ -4,6 +4,8 @@ package constant
 type FilterType string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter FilterType = "actee"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter FilterType = "app_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
@@ -54,6 +56,12 @@ const (
 	// GreaterThanOperator is the query greater than operator.
 	GreaterThanOperator FilterOperator = ">"
 
+	// GreaterThanOrEqualOperator is the query greater than or equal operator.
+	GreaterThanOrEqualOperator FilterOperator = ">="
+
+	// LessThanOperator is the query less than operator.
+	LessThanOperator FilterOperator = "<"
+
 	// InOperator is the Filter's "IN" operator.
 	InOperator FilterOperator = " IN "
 )
@@ -0,0 +1,28 @@
+package constant
+
+// OrganizationRole is the collection of users holding a role in an
+// organization.
+type OrganizationRole string
+
+const (
+	// OrgManager is the organization manager role.
+	OrgManager OrganizationRole = "managers"
+	// OrgBillingManager is the organization billing manager role.
+	OrgBillingManager OrganizationRole = "billing_managers"
+	// OrgAuditor is the organization auditor role.
+	OrgAuditor OrganizationRole = "auditors"
+	// OrgUser is the organization user role.
+	OrgUser OrganizationRole = "users"
+)
+
+// SpaceRole is the collection of users holding a role in a space.
+type SpaceRole string
+
+const (
+	// SpaceManager is the space manager role.
+	SpaceManager SpaceRole = "managers"
+	// SpaceDeveloper is the space developer role.
+	SpaceDeveloper SpaceRole = "developers"
+	// SpaceAuditor is the space auditor role.
+	SpaceAuditor SpaceRole = "auditors"
+)
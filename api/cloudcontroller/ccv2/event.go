@@ -1,6 +1,8 @@
 package ccv2
 
 import (
+	"errors"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -105,3 +107,58 @@ func (client *Client) GetEvents(filters ...Filter) ([]Event, Warnings, error) {
 
 	return fullEventsList, warnings, err
 }
+
+// maxEventsPerPage is the largest page of Events the Cloud Controller
+// returns.
+const maxEventsPerPage = 100
+
+// errEnoughEvents stops paginating once GetNewestEvents has found enough
+// Events.
+var errEnoughEvents = errors.New("enough events")
+
+// GetNewestEvents returns back at most limit of the most recent Events based
+// off of the provided queries for which include returns true, newest first.
+// Pages are requested until limit Events are included or no pages are left.
+// A nil include includes every Event.
+func (client *Client) GetNewestEvents(limit int, include func(Event) bool, filters ...Filter) ([]Event, Warnings, error) {
+	perPage := limit
+	if perPage > maxEventsPerPage {
+		perPage = maxEventsPerPage
+	}
+
+	query := ConvertFilterParameters(filters)
+	query.Set("order-direction", "desc")
+	query.Set("results-per-page", strconv.Itoa(perPage))
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []Event
+	warnings, err := client.paginate(request, Event{}, func(item interface{}) error {
+		event, ok := item.(Event)
+		if !ok {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+
+		if include == nil || include(event) {
+			events = append(events, event)
+		}
+		if len(events) == limit {
+			return errEnoughEvents
+		}
+		return nil
+	})
+	if err == errEnoughEvents {
+		err = nil
+	}
+
+	return events, warnings, err
+}
@@ -223,4 +223,134 @@ var _ = Describe("Event", func() {
 			})
 		})
 	})
+
+	Describe("GetNewestEvents", func() {
+		var (
+			include    func(Event) bool
+			events     []Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			include = nil
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetNewestEvents(2, include, Filter{
+				Type:     constant.SpaceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-space-guid"},
+			})
+		})
+
+		When("getting events succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": "/v2/events?order-direction=desc&page=2&q=space_guid:some-space-guid&results-per-page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-event-guid-2"},
+							"entity": {"type": "audit.app.update", "timestamp": "2015-03-10T23:12:54Z"}
+						},
+						{
+							"metadata": {"guid": "some-event-guid-1"},
+							"entity": {"type": "audit.app.create", "timestamp": "2015-03-10T23:11:54Z"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "order-direction=desc&q=space_guid:some-space-guid&results-per-page=2"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("only requests the first page of events, newest first, and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("some-event-guid-2"))
+				Expect(events[1].GUID).To(Equal("some-event-guid-1"))
+			})
+		})
+
+		When("include skips some of the events", func() {
+			BeforeEach(func() {
+				include = func(event Event) bool {
+					return event.GUID != "some-event-guid-3"
+				}
+
+				response1 := `{
+					"next_url": "/v2/events?order-direction=desc&page=2&q=space_guid:some-space-guid&results-per-page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-event-guid-4"},
+							"entity": {"type": "audit.app.update", "timestamp": "2015-03-10T23:14:54Z"}
+						},
+						{
+							"metadata": {"guid": "some-event-guid-3"},
+							"entity": {"type": "audit.app.update", "timestamp": "2015-03-10T23:13:54Z"}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": "/v2/events?order-direction=desc&page=3&q=space_guid:some-space-guid&results-per-page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "some-event-guid-2"},
+							"entity": {"type": "audit.app.update", "timestamp": "2015-03-10T23:12:54Z"}
+						},
+						{
+							"metadata": {"guid": "some-event-guid-1"},
+							"entity": {"type": "audit.app.create", "timestamp": "2015-03-10T23:11:54Z"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "order-direction=desc&q=space_guid:some-space-guid&results-per-page=2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "order-direction=desc&page=2&q=space_guid:some-space-guid&results-per-page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("requests pages until enough events are included", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("some-event-guid-4"))
+				Expect(events[1].GUID).To(Equal("some-event-guid-2"))
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+
+		When("getting events errors", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusTeapot, `{"code": 1, "description": "some error description", "error_code": "CF-SomeError"}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V2UnexpectedResponseError{
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        1,
+						Description: "some error description",
+						ErrorCode:   "CF-SomeError",
+					},
+					ResponseCode: http.StatusTeapot,
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -34,3 +37,31 @@ func (client Client) GetConfigFeatureFlags() ([]FeatureFlag, Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return featureFlags, response.Warnings, err
 }
+
+type updateFeatureFlagRequestBody struct {
+	Enabled bool `json:"enabled"`
+}
+
+// UpdateConfigFeatureFlag enables or disables the given feature flag.
+func (client Client) UpdateConfigFeatureFlag(featureFlag FeatureFlag) (Warnings, error) {
+	body, err := json.Marshal(updateFeatureFlagRequestBody{
+		Enabled: featureFlag.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutConfigFeatureFlagRequest,
+		URIParams:   Params{"name": featureFlag.Name},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
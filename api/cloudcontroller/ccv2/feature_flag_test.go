@@ -84,4 +84,51 @@ var _ = Describe("Feature Flag", func() {
 			})
 		})
 	})
+
+	Describe("UpdateConfigFeatureFlag", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.UpdateConfigFeatureFlag(FeatureFlag{Name: "diego_docker", Enabled: true})
+		})
+
+		When("the update succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/diego_docker"),
+						VerifyJSON(`{"enabled": true}`),
+						RespondWith(http.StatusOK, `{"name": "diego_docker", "enabled": true}`, http.Header{"X-Cf-Warnings": {"warning"}}),
+					))
+			})
+
+			It("returns all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/config/feature_flags/diego_docker"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
 })
@@ -21,6 +21,7 @@ const (
 	DeleteSecurityGroupSpaceRequest                      = "DeleteSecurityGroupSpace"
 	DeleteSecurityGroupStagingSpaceRequest               = "DeleteSecurityGroupStagingSpace"
 	DeleteServiceBindingRequest                          = "DeleteServiceBinding"
+	DeleteServiceKeyRequest                              = "DeleteServiceKey"
 	DeleteServicePlanVisibilityRequest                   = "DeleteServicePlanVisibility"
 	DeleteServiceRequest                                 = "DeleteService"
 	DeleteSpaceRequest                                   = "DeleteSpace"
@@ -39,6 +40,7 @@ const (
 	GetOrganizationQuotaDefinitionsRequest               = "GetOrganizationQuotaDefinitions"
 	GetOrganizationQuotaDefinitionRequest                = "GetOrganizationQuotaDefinition"
 	GetOrganizationRequest                               = "GetOrganization"
+	GetOrganizationUsersByRoleRequest                    = "GetOrganizationUsersByRole"
 	GetOrganizationsRequest                              = "GetOrganizations"
 	GetPrivateDomainRequest                              = "GetPrivateDomain"
 	GetPrivateDomainsRequest                             = "GetPrivateDomains"
@@ -54,6 +56,7 @@ const (
 	GetSecurityGroupsRequest                             = "GetSecurityGroups"
 	GetSecurityGroupStagingSpacesRequest                 = "GetSecurityGroupStagingSpaces"
 	GetServiceBindingRequest                             = "GetServiceBinding"
+	GetServiceBindingParametersRequest                   = "GetServiceBindingParameters"
 	GetServiceBindingsRequest                            = "GetServiceBindings"
 	GetServiceBrokersRequest                             = "GetServiceBrokers"
 	GetServiceInstanceRequest                            = "GetServiceInstance"
@@ -75,6 +78,7 @@ const (
 	GetSpaceServicesRequest                              = "GetSpaceServices"
 	GetSpaceServiceInstancesRequest                      = "GetSpaceServiceInstances"
 	GetSpaceSummaryRequest                               = "GetSpaceSummary"
+	GetSpaceUsersByRoleRequest                           = "GetSpaceUsersByRole"
 	GetSpacesRequest                                     = "GetSpaces"
 	GetSpaceStagingSecurityGroupsRequest                 = "GetSpaceStagingSecurityGroups"
 	GetStackRequest                                      = "GetStack"
@@ -85,29 +89,40 @@ const (
 	PostAppRequest                                       = "PostApp"
 	PostAppRestageRequest                                = "PostAppRestage"
 	PostBuildpackRequest                                 = "PostBuildpack"
+	PostOrganizationQuotaDefinitionRequest               = "PostOrganizationQuotaDefinition"
 	PostOrganizationRequest                              = "PostOrganization"
 	PostRouteRequest                                     = "PostRoute"
 	PostServiceBindingRequest                            = "PostServiceBinding"
 	PostServiceInstancesRequest                          = "PostServiceInstance"
 	PostSharedDomainRequest                              = "PostSharedDomain"
 	PostServiceBrokerRequest                             = "PostServiceBroker"
+	GetServiceKeyParametersRequest                       = "GetServiceKeyParameters"
+	GetServiceKeysRequest                                = "GetServiceKeys"
 	PostServiceKeyRequest                                = "PostServiceKey"
 	PostServicePlanVisibilityRequest                     = "PostServicePlanVisibility"
 	PostSpaceRequest                                     = "PostSpace"
+	PostSpaceQuotaDefinitionRequest                      = "PostSpaceQuotaDefinition"
 	PostUserRequest                                      = "PostUser"
 	PutAppBitsRequest                                    = "PutAppBits"
 	PutAppRequest                                        = "PutApp"
 	PutBuildpackRequest                                  = "PutBuildpack"
+	PutConfigFeatureFlagRequest                          = "PutConfigFeatureFlag"
 	PutBuildpackBitsRequest                              = "PutBuildpackBits"
 	PutDropletRequest                                    = "PutDroplet"
 	PutOrganizationManagerByUsernameRequest              = "PutOrganizationManagerByUsername"
 	PutOrganizationManagerRequest                        = "PutOrganizationManager"
 	PutOrganizationUserRequest                           = "PutOrganizationUser"
 	PutOrganizationUserByUsernameRequest                 = "PutOrganizationUserByUsername"
+	PutOrganizationRequest                               = "PutOrganization"
+	PutOrganizationQuotaDefinitionRequest                = "PutOrganizationQuotaDefinition"
+	PutOrganizationUserByRoleRequest                     = "PutOrganizationUserByRole"
 	PutResourceMatchRequest                              = "PutResourceMatch"
 	PutRouteAppRequest                                   = "PutRouteApp"
 	PutServicePlanRequest                                = "PutServicePlan"
 	PutSpaceQuotaRequest                                 = "PutSpaceQuotaRequest"
+	PutSpaceRequest                                      = "PutSpace"
+	PutSpaceQuotaDefinitionRequest                       = "PutSpaceQuotaDefinition"
+	PutSpaceUserByRoleRequest                            = "PutSpaceUserByRole"
 	PutSpaceDeveloperRequest                             = "PutSpaceDeveloper"
 	PutSpaceDeveloperByUsernameRequest                   = "PutSpaceDeveloperByUsername"
 	PutSpaceManagerRequest                               = "PutSpaceManager"
@@ -134,6 +149,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodPut, Name: PutBuildpackRequest},
 	{Path: "/v2/buildpacks/:buildpack_guid/bits", Method: http.MethodPut, Name: PutBuildpackBitsRequest},
 	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
+	{Path: "/v2/config/feature_flags/:name", Method: http.MethodPut, Name: PutConfigFeatureFlagRequest},
 	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
@@ -141,6 +157,9 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodGet, Name: GetOrganizationUsersByRoleRequest},
+	{Path: "/v2/organizations/:organization_guid/:role", Method: http.MethodPut, Name: PutOrganizationUserByRoleRequest},
 	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodPut, Name: PutOrganizationManagerByUsernameRequest},
 	{Path: "/v2/organizations/:organization_guid/managers/:manager_guid", Method: http.MethodPut, Name: PutOrganizationManagerRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
@@ -150,6 +169,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
+	{Path: "/v2/quota_definitions", Method: http.MethodPost, Name: PostOrganizationQuotaDefinitionRequest},
+	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodPut, Name: PutOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/resource_match", Method: http.MethodPut, Name: PutResourceMatchRequest},
 	{Path: "/v2/route_mappings", Method: http.MethodGet, Name: GetRouteMappingsRequest},
 	{Path: "/v2/route_mappings/:route_mapping_guid", Method: http.MethodGet, Name: GetRouteMappingRequest},
@@ -174,6 +195,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodGet, Name: GetServiceBindingRequest},
+	{Path: "/v2/service_bindings/:service_binding_guid/parameters", Method: http.MethodGet, Name: GetServiceBindingParametersRequest},
 	{Path: "/v2/service_brokers", Method: http.MethodGet, Name: GetServiceBrokersRequest},
 	{Path: "/v2/service_brokers", Method: http.MethodPost, Name: PostServiceBrokerRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
@@ -182,7 +204,10 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_instances/:service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
 	{Path: "/v2/service_keys", Method: http.MethodPost, Name: PostServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
+	{Path: "/v2/service_keys/:service_key_guid/parameters", Method: http.MethodGet, Name: GetServiceKeyParametersRequest},
 	{Path: "/v2/service_plan_visibilities", Method: http.MethodGet, Name: GetServicePlanVisibilitiesRequest},
 	{Path: "/v2/service_plan_visibilities", Method: http.MethodPost, Name: PostServicePlanVisibilityRequest},
 	{Path: "/v2/service_plan_visibilities/:service_plan_visibility_guid", Method: http.MethodDelete, Name: DeleteServicePlanVisibilityRequest},
@@ -198,6 +223,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotasRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceQuotaRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions", Method: http.MethodPost, Name: PostSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionRequest},
 	{Path: "/v2/spaces/:space_guid/summary", Method: http.MethodGet, Name: GetSpaceSummaryRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
 	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
@@ -206,6 +233,9 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid/services", Method: http.MethodGet, Name: GetSpaceServicesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodGet, Name: GetSpaceUsersByRoleRequest},
+	{Path: "/v2/spaces/:space_guid/:role", Method: http.MethodPut, Name: PutSpaceUserByRoleRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -251,3 +252,84 @@ func (client Client) UpdateOrganizationUserByUsername(orgGUID string, username s
 
 	return response.Warnings, err
 }
+
+// GetOrganizationUsersByRole returns the users holding the given role in the
+// organization.
+func (client *Client) GetOrganizationUsersByRole(role constant.OrganizationRole, guid string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationUsersByRoleRequest,
+		URIParams:   Params{"organization_guid": guid, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			users = append(users, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return users, warnings, err
+}
+
+// UpdateOrganizationUserByRole assigns the given organization role to the
+// user with the provided name.
+func (client *Client) UpdateOrganizationUserByRole(role constant.OrganizationRole, guid string, username string) (Warnings, error) {
+	body, err := json.Marshal(updateOrgUserByUsernameRequestBody{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationUserByRoleRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   Params{"organization_guid": guid, "role": string(role)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+type updateOrganizationQuotaRequestBody struct {
+	QuotaDefinitionGUID string `json:"quota_definition_guid"`
+}
+
+// SetOrganizationQuota assigns the organization quota with the given GUID to
+// the organization.
+func (client *Client) SetOrganizationQuota(guid string, quotaGUID string) (Warnings, error) {
+	body, err := json.Marshal(updateOrganizationQuotaRequestBody{
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   Params{"organization_guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
@@ -14,14 +17,38 @@ type OrganizationQuota struct {
 
 	// Name is the name of the OrganizationQuota.
 	Name string
+
+	// TotalPrivateDomains is the maximum number of private domains. A value
+	// of -1 means unlimited.
+	TotalPrivateDomains int
+
+	QuotaLimits
+}
+
+// MarshalJSON converts an organization quota into a Cloud Controller
+// organization quota definition.
+func (orgQuota OrganizationQuota) MarshalJSON() ([]byte, error) {
+	ccOrgQuota := struct {
+		Name                string `json:"name"`
+		TotalPrivateDomains int    `json:"total_private_domains"`
+		ccQuotaLimits
+	}{
+		Name:                orgQuota.Name,
+		TotalPrivateDomains: orgQuota.TotalPrivateDomains,
+		ccQuotaLimits:       orgQuota.QuotaLimits.toCC(),
+	}
+
+	return json.Marshal(ccOrgQuota)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
-func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
+func (orgQuota *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                string `json:"name"`
+			TotalPrivateDomains int    `json:"total_private_domains"`
+			ccQuotaLimits
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccOrgQuota)
@@ -29,12 +56,64 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	application.GUID = ccOrgQuota.Metadata.GUID
-	application.Name = ccOrgQuota.Entity.Name
+	orgQuota.GUID = ccOrgQuota.Metadata.GUID
+	orgQuota.Name = ccOrgQuota.Entity.Name
+	orgQuota.TotalPrivateDomains = ccOrgQuota.Entity.TotalPrivateDomains
+	orgQuota.QuotaLimits = ccOrgQuota.Entity.ccQuotaLimits.fromCC()
 
 	return nil
 }
 
+// CreateOrganizationQuota creates an organization quota definition.
+func (client *Client) CreateOrganizationQuota(orgQuota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	body, err := json.Marshal(orgQuota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostOrganizationQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var createdOrgQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &createdOrgQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdOrgQuota, response.Warnings, err
+}
+
+// UpdateOrganizationQuota updates the organization quota definition with the
+// GUID of the provided organization quota.
+func (client *Client) UpdateOrganizationQuota(orgQuota OrganizationQuota) (OrganizationQuota, Warnings, error) {
+	body, err := json.Marshal(orgQuota)
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationQuotaDefinitionRequest,
+		URIParams:   Params{"organization_quota_guid": orgQuota.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return OrganizationQuota{}, nil, err
+	}
+
+	var updatedOrgQuota OrganizationQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &updatedOrgQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedOrgQuota, response.Warnings, err
+}
+
 // GetOrganizationQuota returns an Organization Quota associated with the
 // provided GUID.
 func (client *Client) GetOrganizationQuota(guid string) (OrganizationQuota, Warnings, error) {
@@ -202,4 +202,111 @@ var _ = Describe("OrganizationQuota", func() {
 			})
 		})
 	})
+
+	Describe("CreateOrganizationQuota", func() {
+		It("posts the quota definition and returns the created quota", func() {
+			response := `{
+				"metadata": {"guid": "some-org-quota-guid"},
+				"entity": {
+					"name": "some-org-quota",
+					"non_basic_services_allowed": true,
+					"total_services": 10,
+					"total_service_keys": -1,
+					"total_routes": 100,
+					"total_reserved_route_ports": 0,
+					"total_private_domains": 5,
+					"memory_limit": 10240,
+					"instance_memory_limit": 1024,
+					"app_instance_limit": -1,
+					"app_task_limit": 5
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/quota_definitions"),
+					VerifyJSON(`{
+						"name": "some-org-quota",
+						"non_basic_services_allowed": true,
+						"total_services": 10,
+						"total_service_keys": -1,
+						"total_routes": 100,
+						"total_reserved_route_ports": 0,
+						"total_private_domains": 5,
+						"memory_limit": 10240,
+						"instance_memory_limit": 1024,
+						"app_instance_limit": -1,
+						"app_task_limit": 5
+					}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+
+			limits := QuotaLimits{
+				NonBasicServicesAllowed: true,
+				TotalServices:           10,
+				TotalServiceKeys:        -1,
+				TotalRoutes:             100,
+				MemoryLimit:             10240,
+				InstanceMemoryLimit:     1024,
+				AppInstanceLimit:        -1,
+				AppTaskLimit:            5,
+			}
+			quota, warnings, err := client.CreateOrganizationQuota(OrganizationQuota{
+				Name:                "some-org-quota",
+				TotalPrivateDomains: 5,
+				QuotaLimits:         limits,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(quota).To(Equal(OrganizationQuota{
+				GUID:                "some-org-quota-guid",
+				Name:                "some-org-quota",
+				TotalPrivateDomains: 5,
+				QuotaLimits:         limits,
+			}))
+		})
+	})
+
+	Describe("UpdateOrganizationQuota", func() {
+		When("the quota definition does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"description": "Quota Definition could not be found: some-org-quota-guid",
+					"error_code": "CF-QuotaDefinitionNotFound",
+					"code": 240001
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/quota_definitions/some-org-quota-guid"),
+						VerifyJSON(`{
+							"name": "some-org-quota",
+							"non_basic_services_allowed": false,
+							"total_services": 0,
+							"total_service_keys": 0,
+							"total_routes": 0,
+							"total_reserved_route_ports": 0,
+							"total_private_domains": 0,
+							"memory_limit": 2048,
+							"instance_memory_limit": 0,
+							"app_instance_limit": 0,
+							"app_task_limit": 0
+						}`),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.UpdateOrganizationQuota(OrganizationQuota{
+					GUID:        "some-org-quota-guid",
+					Name:        "some-org-quota",
+					QuotaLimits: QuotaLimits{MemoryLimit: 2048},
+				})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "Quota Definition could not be found: some-org-quota-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
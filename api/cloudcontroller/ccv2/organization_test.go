@@ -551,4 +551,88 @@ var _ = Describe("Organization", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		When("there are no errors", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/billing_managers?page=2",
+					"resources": [
+						{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "user-1"}}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{"metadata": {"guid": "client-guid"}, "entity": {}}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/billing_managers"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/billing_managers", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the users holding the role and all warnings", func() {
+				users, warnings, err := client.GetOrganizationUsersByRole(constant.OrgBillingManager, "some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(users).To(Equal([]User{
+					{GUID: "user-guid-1", Username: "user-1"},
+					{GUID: "client-guid"},
+				}))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/auditors"),
+					VerifyJSON(`{"username": "some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("assigns the role to the user and returns all warnings", func() {
+			warnings, err := client.UpdateOrganizationUserByRole(constant.OrgAuditor, "some-org-guid", "some-user")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("SetOrganizationQuota", func() {
+		When("the organization does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						VerifyJSON(`{"quota_definition_guid": "some-quota-guid"}`),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := client.SetOrganizationQuota("some-org-guid", "some-quota-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The organization could not be found: some-org-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
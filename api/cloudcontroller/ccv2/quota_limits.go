@@ -0,0 +1,56 @@
+package ccv2
+
+// QuotaLimits are the resource limits shared by organization and space
+// quotas. A value of -1 means unlimited.
+type QuotaLimits struct {
+	// NonBasicServicesAllowed determines whether paid service plans can be
+	// provisioned.
+	NonBasicServicesAllowed bool
+
+	// TotalServices is the maximum number of service instances.
+	TotalServices int
+
+	// TotalServiceKeys is the maximum number of service keys.
+	TotalServiceKeys int
+
+	// TotalRoutes is the maximum number of routes.
+	TotalRoutes int
+
+	// TotalReservedRoutePorts is the maximum number of routes with reserved
+	// ports.
+	TotalReservedRoutePorts int
+
+	// MemoryLimit is the maximum amount of memory in megabytes used by all
+	// application instances.
+	MemoryLimit int
+
+	// InstanceMemoryLimit is the maximum amount of memory in megabytes a
+	// single application instance can use.
+	InstanceMemoryLimit int
+
+	// AppInstanceLimit is the maximum number of application instances.
+	AppInstanceLimit int
+
+	// AppTaskLimit is the maximum number of concurrently running tasks.
+	AppTaskLimit int
+}
+
+type ccQuotaLimits struct {
+	NonBasicServicesAllowed bool `json:"non_basic_services_allowed"`
+	TotalServices           int  `json:"total_services"`
+	TotalServiceKeys        int  `json:"total_service_keys"`
+	TotalRoutes             int  `json:"total_routes"`
+	TotalReservedRoutePorts int  `json:"total_reserved_route_ports"`
+	MemoryLimit             int  `json:"memory_limit"`
+	InstanceMemoryLimit     int  `json:"instance_memory_limit"`
+	AppInstanceLimit        int  `json:"app_instance_limit"`
+	AppTaskLimit            int  `json:"app_task_limit"`
+}
+
+func (limits QuotaLimits) toCC() ccQuotaLimits {
+	return ccQuotaLimits(limits)
+}
+
+func (limits ccQuotaLimits) fromCC() QuotaLimits {
+	return QuotaLimits(limits)
+}
@@ -410,7 +410,8 @@ var _ = Describe("Route", func() {
 							"path": "path",
 							"port": null,
 							"domain_guid": "some-http-domain",
-							"space_guid": "some-space-guid-1"
+							"space_guid": "some-space-guid-1",
+							"service_instance_guid": "some-route-service-guid"
 						}
 					},
 					{
@@ -482,12 +483,13 @@ var _ = Describe("Route", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(routes).To(ConsistOf([]Route{
 					{
-						GUID:       "route-guid-1",
-						Host:       "host-1",
-						Path:       "path",
-						Port:       types.NullInt{IsSet: false},
-						DomainGUID: "some-http-domain",
-						SpaceGUID:  "some-space-guid-1",
+						GUID:                "route-guid-1",
+						Host:                "host-1",
+						Path:                "path",
+						Port:                types.NullInt{IsSet: false},
+						DomainGUID:          "some-http-domain",
+						SpaceGUID:           "some-space-guid-1",
+						ServiceInstanceGUID: "some-route-service-guid",
 					},
 					{
 						GUID:       "route-guid-2",
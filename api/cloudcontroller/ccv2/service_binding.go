@@ -136,6 +136,27 @@ func (client *Client) GetServiceBinding(guid string) (ServiceBinding, Warnings,
 	return serviceBinding, response.Warnings, err
 }
 
+// GetServiceBindingParameters returns the configuration parameters the
+// service binding with the provided GUID was created with, as reported by the
+// service broker.
+func (client *Client) GetServiceBindingParameters(serviceBindingGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceBindingParametersRequest,
+		URIParams:   Params{"service_binding_guid": serviceBindingGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
+
 // GetServiceBindings returns back a list of Service Bindings based off of the
 // provided filters.
 func (client *Client) GetServiceBindings(filters ...Filter) ([]ServiceBinding, Warnings, error) {
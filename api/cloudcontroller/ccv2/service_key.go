@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -81,3 +82,65 @@ func (client *Client) CreateServiceKey(serviceInstanceGUID string, keyName strin
 
 	return serviceKey, response.Warnings, err
 }
+
+// GetServiceKeys returns back a list of Service Keys based off of the
+// provided filters.
+func (client *Client) GetServiceKeys(filters ...Filter) ([]ServiceKey, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeysRequest,
+		Query:       ConvertFilterParameters(filters),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServiceKeysList []ServiceKey
+	warnings, err := client.paginate(request, ServiceKey{}, func(item interface{}) error {
+		if serviceKey, ok := item.(ServiceKey); ok {
+			fullServiceKeysList = append(fullServiceKeysList, serviceKey)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceKey{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServiceKeysList, warnings, err
+}
+
+// DeleteServiceKey deletes the service key with the provided GUID.
+func (client *Client) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceKeyRequest,
+		URIParams:   Params{"service_key_guid": serviceKeyGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// GetServiceKeyParameters returns the configuration parameters the service key
+// with the provided GUID was created with, as reported by the service broker.
+func (client *Client) GetServiceKeyParameters(serviceKeyGUID string) (map[string]interface{}, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceKeyParametersRequest,
+		URIParams:   Params{"service_key_guid": serviceKeyGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parameters map[string]interface{}
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &parameters,
+	}
+
+	err = client.connection.Make(request, &response)
+	return parameters, response.Warnings, err
+}
@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 var _ = Describe("Service Key", func() {
@@ -26,126 +27,307 @@ var _ = Describe("Service Key", func() {
 		parameters = map[string]interface{}{"the-service-broker": "wants this object"}
 	})
 
-	JustBeforeEach(func() {
-		serviceKey, warnings, executeErr = client.CreateServiceKey("some-service-instance-guid", "some-service-key-name", parameters)
+	Describe("CreateServiceKey", func() {
+		JustBeforeEach(func() {
+			serviceKey, warnings, executeErr = client.CreateServiceKey("some-service-instance-guid", "some-service-key-name", parameters)
+		})
+
+		When("the create is successful", func() {
+			BeforeEach(func() {
+				expectedRequestBody := map[string]interface{}{
+					"service_instance_guid": "some-service-instance-guid",
+					"name":                  "some-service-key-name",
+					"parameters": map[string]interface{}{
+						"the-service-broker": "wants this object",
+					},
+				}
+				response := `
+							{
+								"metadata": {
+									"guid": "some-service-key-guid"
+								},
+								"entity": {
+									"name": "some-service-key-name",
+									"service_instance_guid": "some-service-instance-guid",
+									"credentials": { "some-username" : "some-password", "port": 31023 }
+								}
+							}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_keys"),
+						VerifyJSONRepresenting(expectedRequestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the created object and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(serviceKey).To(BeEquivalentTo(ServiceKey{
+					GUID:                "some-service-key-guid",
+					Name:                "some-service-key-name",
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Credentials:         map[string]interface{}{"some-username": "some-password", "port": json.Number("31023")},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning"}))
+			})
+
+			When("The request cannot be serialized", func() {
+				BeforeEach(func() {
+					parameters = make(map[string]interface{})
+					parameters["data"] = make(chan bool)
+				})
+
+				It("returns the serialization error", func() {
+					Expect(executeErr).To(MatchError("json: unsupported type: chan bool"))
+				})
+			})
+		})
+
+		When("the create is not successful", func() {
+			When("the create returns a ServiceKeyNameTaken error", func() {
+				BeforeEach(func() {
+					response := `
+					{
+						"description": "The service key name is taken: some-service-key-name",
+						"error_code": "CF-ServiceKeyNameTaken",
+						"code": 360001
+					}`
+
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/service_keys"),
+							RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(ccerror.ServiceKeyTakenError{Message: "The service key name is taken: some-service-key-name"}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+
+			When("the create returns a generic error", func() {
+				BeforeEach(func() {
+					response := `
+					{
+						"description": "Something went wrong",
+						"error_code": "CF-SomeErrorCode",
+						"code": 2108219482
+					}`
+
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/service_keys"),
+							RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(ccerror.BadRequestError{Message: "Something went wrong"}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+
+			When("the create returns a invalid JSON", func() {
+				BeforeEach(func() {
+					response := `{"entity": {"name": 4}}`
+
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/service_keys"),
+							RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(executeErr).To(BeAssignableToTypeOf(&json.UnmarshalTypeError{}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				})
+			})
+		})
 	})
 
-	When("the create is successful", func() {
-		BeforeEach(func() {
-			expectedRequestBody := map[string]interface{}{
-				"service_instance_guid": "some-service-instance-guid",
-				"name":                  "some-service-key-name",
-				"parameters": map[string]interface{}{
-					"the-service-broker": "wants this object",
-				},
-			}
-			response := `
+	Describe("GetServiceKeys", func() {
+		When("there are service keys", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/service_keys?q=service_instance_guid:some-service-instance-guid&page=2",
+					"resources": [
 						{
 							"metadata": {
-								"guid": "some-service-key-guid"
+								"guid": "some-service-key-guid-1"
 							},
 							"entity": {
-								"name": "some-service-key-name",
-								"service_instance_guid": "some-service-instance-guid",
-								"credentials": { "some-username" : "some-password", "port": 31023 }
+								"name": "some-service-key-name-1",
+								"service_instance_guid": "some-service-instance-guid"
 							}
-						}`
-			server.AppendHandlers(
-				CombineHandlers(
-					VerifyRequest(http.MethodPost, "/v2/service_keys"),
-					VerifyJSONRepresenting(expectedRequestBody),
-					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning"}}),
-				),
-			)
-		})
-
-		It("returns the created object and warnings", func() {
-			Expect(executeErr).NotTo(HaveOccurred())
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-service-key-guid-2"
+							},
+							"entity": {
+								"name": "some-service-key-name-2",
+								"service_instance_guid": "some-service-instance-guid"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-service-instance-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
 
-			Expect(serviceKey).To(BeEquivalentTo(ServiceKey{
-				GUID:                "some-service-key-guid",
-				Name:                "some-service-key-name",
-				ServiceInstanceGUID: "some-service-instance-guid",
-				Credentials:         map[string]interface{}{"some-username": "some-password", "port": json.Number("31023")},
-			}))
-			Expect(warnings).To(ConsistOf(Warnings{"warning"}))
+			It("returns all the service keys and all warnings", func() {
+				serviceKeys, warnings, err := client.GetServiceKeys(Filter{
+					Type:     constant.ServiceInstanceGUIDFilter,
+					Operator: constant.EqualOperator,
+					Values:   []string{"some-service-instance-guid"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceKeys).To(Equal([]ServiceKey{
+					{GUID: "some-service-key-guid-1", Name: "some-service-key-name-1", ServiceInstanceGUID: "some-service-instance-guid"},
+					{GUID: "some-service-key-guid-2", Name: "some-service-key-name-2", ServiceInstanceGUID: "some-service-instance-guid"},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
 		})
 
-		When("The request cannot be serialized", func() {
+		When("the cloud controller returns an error", func() {
 			BeforeEach(func() {
-				parameters = make(map[string]interface{})
-				parameters["data"] = make(chan bool)
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
 			})
 
-			It("returns the serialization error", func() {
-				Expect(executeErr).To(MatchError("json: unsupported type: chan bool"))
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServiceKeys()
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
 			})
 		})
 	})
 
-	When("the create is not successful", func() {
-		When("the create returns a ServiceKeyNameTaken error", func() {
+	Describe("DeleteServiceKey", func() {
+		When("the delete is successful", func() {
 			BeforeEach(func() {
-				response := `
-				{
-					"description": "The service key name is taken: some-service-key-name",
-					"error_code": "CF-ServiceKeyNameTaken",
-					"code": 360001
-				}`
-
 				server.AppendHandlers(
 					CombineHandlers(
-						VerifyRequest(http.MethodPost, "/v2/service_keys"),
-						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
 					),
 				)
 			})
 
-			It("returns the error and warnings", func() {
-				Expect(executeErr).To(MatchError(ccerror.ServiceKeyTakenError{Message: "The service key name is taken: some-service-key-name"}))
-				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			It("deletes the service key and returns warnings", func() {
+				warnings, err := client.DeleteServiceKey("some-service-key-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
 			})
 		})
 
-		When("the create returns a generic error", func() {
+		When("the cloud controller returns an error", func() {
 			BeforeEach(func() {
-				response := `
-				{
-					"description": "Something went wrong",
-					"error_code": "CF-SomeErrorCode",
-					"code": 2108219482
+				response := `{
+					"code": 360003,
+					"description": "The service key could not be found: some-service-key-guid",
+					"error_code": "CF-ServiceKeyNotFound"
 				}`
-
 				server.AppendHandlers(
 					CombineHandlers(
-						VerifyRequest(http.MethodPost, "/v2/service_keys"),
-						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
 					),
 				)
 			})
 
 			It("returns the error and warnings", func() {
-				Expect(executeErr).To(MatchError(ccerror.BadRequestError{Message: "Something went wrong"}))
-				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				warnings, err := client.DeleteServiceKey("some-service-key-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service key could not be found: some-service-key-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
 			})
 		})
+	})
 
-		When("the create returns a invalid JSON", func() {
+	Describe("GetServiceKeyParameters", func() {
+		When("the service broker returns the parameters", func() {
 			BeforeEach(func() {
-				response := `{"entity": {"name": 4}}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid/parameters"),
+						RespondWith(http.StatusOK, `{"permissions": "read-only"}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
 
+			It("returns the parameters and warnings", func() {
+				parameters, warnings, err := client.GetServiceKeyParameters("some-service-key-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
 				server.AppendHandlers(
 					CombineHandlers(
-						VerifyRequest(http.MethodPost, "/v2/service_keys"),
-						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						VerifyRequest(http.MethodGet, "/v2/service_keys/some-service-key-guid/parameters"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
 					),
 				)
 			})
 
 			It("returns the error and warnings", func() {
-				Expect(executeErr).To(HaveOccurred())
-				Expect(executeErr).To(BeAssignableToTypeOf(&json.UnmarshalTypeError{}))
-				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+				_, warnings, err := client.GetServiceKeyParameters("some-service-key-guid")
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
 			})
 		})
 	})
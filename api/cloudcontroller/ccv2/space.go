@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -289,3 +290,82 @@ func (client *Client) UpdateSpaceManagerByUsername(spaceGUID string, username st
 
 	return response.Warnings, err
 }
+
+// GetSpaceUsersByRole returns the users holding the given role in the space.
+func (client *Client) GetSpaceUsersByRole(role constant.SpaceRole, spaceGUID string) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceUsersByRoleRequest,
+		URIParams:   map[string]string{"space_guid": spaceGUID, "role": string(role)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			users = append(users, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return users, warnings, err
+}
+
+// UpdateSpaceUserByRole grants the given username the given space role.
+func (client *Client) UpdateSpaceUserByRole(role constant.SpaceRole, spaceGUID string, username string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(updateRoleRequestBody{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceUserByRoleRequest,
+		URIParams:   map[string]string{"space_guid": spaceGUID, "role": string(role)},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
+type updateSpaceAllowSSHRequestBody struct {
+	AllowSSH bool `json:"allow_ssh"`
+}
+
+// UpdateSpaceAllowSSH enables or disables SSH access to the applications in
+// the space.
+func (client *Client) UpdateSpaceAllowSSH(spaceGUID string, allowSSH bool) (Warnings, error) {
+	bodyBytes, err := json.Marshal(updateSpaceAllowSSHRequestBody{
+		AllowSSH: allowSSH,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceRequest,
+		URIParams:   map[string]string{"space_guid": spaceGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
@@ -15,6 +18,28 @@ type SpaceQuota struct {
 
 	// Name is the name given to the space quota.
 	Name string
+
+	// OrganizationGUID is the unique identifier of the organization that owns
+	// the space quota.
+	OrganizationGUID string
+
+	QuotaLimits
+}
+
+// MarshalJSON converts a space quota into a Cloud Controller space quota
+// definition.
+func (spaceQuota SpaceQuota) MarshalJSON() ([]byte, error) {
+	ccSpaceQuota := struct {
+		Name             string `json:"name"`
+		OrganizationGUID string `json:"organization_guid,omitempty"`
+		ccQuotaLimits
+	}{
+		Name:             spaceQuota.Name,
+		OrganizationGUID: spaceQuota.OrganizationGUID,
+		ccQuotaLimits:    spaceQuota.QuotaLimits.toCC(),
+	}
+
+	return json.Marshal(ccSpaceQuota)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -22,7 +47,9 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name             string `json:"name"`
+			OrganizationGUID string `json:"organization_guid"`
+			ccQuotaLimits
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccSpaceQuota)
@@ -32,9 +59,63 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.OrganizationGUID = ccSpaceQuota.Entity.OrganizationGUID
+	spaceQuota.QuotaLimits = ccSpaceQuota.Entity.ccQuotaLimits.fromCC()
 	return nil
 }
 
+// CreateSpaceQuotaDefinition creates a space quota definition in the
+// organization given by the space quota's OrganizationGUID.
+func (client *Client) CreateSpaceQuotaDefinition(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	body, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var createdSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &createdSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdSpaceQuota, response.Warnings, err
+}
+
+// UpdateSpaceQuotaDefinition updates the space quota definition with the
+// GUID of the provided space quota.
+func (client *Client) UpdateSpaceQuotaDefinition(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	spaceQuota.OrganizationGUID = ""
+	body, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionRequest,
+		URIParams:   Params{"space_quota_guid": spaceQuota.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var updatedSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &updatedSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedSpaceQuota, response.Warnings, err
+}
+
 // GetSpaceQuotaDefinition returns a Space Quota.
 func (client *Client) GetSpaceQuotaDefinition(guid string) (SpaceQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
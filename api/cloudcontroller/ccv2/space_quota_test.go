@@ -132,8 +132,8 @@ var _ = Describe("Space Quotas", func() {
 			It("returns all the space quotas for the org guid", func() {
 				spaceQuotas, warnings, err := client.GetSpaceQuotas("some-org-guid")
 				Expect(spaceQuotas).To(ConsistOf([]SpaceQuota{
-					SpaceQuota{GUID: "some-space-quota-guid-1", Name: "some-quota-1"},
-					SpaceQuota{GUID: "some-space-quota-guid-2", Name: "some-quota-2"},
+					SpaceQuota{GUID: "some-space-quota-guid-1", Name: "some-quota-1", OrganizationGUID: "some-org-guid"},
+					SpaceQuota{GUID: "some-space-quota-guid-2", Name: "some-quota-2", OrganizationGUID: "some-org-guid"},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(err).ToNot(HaveOccurred())
@@ -194,4 +194,84 @@ var _ = Describe("Space Quotas", func() {
 		})
 
 	})
+
+	Describe("CreateSpaceQuotaDefinition", func() {
+		It("posts the quota definition in the organization", func() {
+			response := `{
+				"metadata": {"guid": "some-space-quota-guid"},
+				"entity": {
+					"name": "some-space-quota",
+					"organization_guid": "some-org-guid",
+					"memory_limit": 1024,
+					"app_instance_limit": -1
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+					VerifyJSON(`{
+						"name": "some-space-quota",
+						"organization_guid": "some-org-guid",
+						"non_basic_services_allowed": false,
+						"total_services": 0,
+						"total_service_keys": 0,
+						"total_routes": 0,
+						"total_reserved_route_ports": 0,
+						"memory_limit": 1024,
+						"instance_memory_limit": 0,
+						"app_instance_limit": -1,
+						"app_task_limit": 0
+					}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+
+			quota, warnings, err := client.CreateSpaceQuotaDefinition(SpaceQuota{
+				Name:             "some-space-quota",
+				OrganizationGUID: "some-org-guid",
+				QuotaLimits:      QuotaLimits{MemoryLimit: 1024, AppInstanceLimit: -1},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(quota).To(Equal(SpaceQuota{
+				GUID:             "some-space-quota-guid",
+				Name:             "some-space-quota",
+				OrganizationGUID: "some-org-guid",
+				QuotaLimits:      QuotaLimits{MemoryLimit: 1024, AppInstanceLimit: -1},
+			}))
+		})
+	})
+
+	Describe("UpdateSpaceQuotaDefinition", func() {
+		It("puts the quota definition without the organization", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid"),
+					VerifyJSON(`{
+						"name": "some-space-quota",
+						"non_basic_services_allowed": true,
+						"total_services": 0,
+						"total_service_keys": 0,
+						"total_routes": 0,
+						"total_reserved_route_ports": 0,
+						"memory_limit": 2048,
+						"instance_memory_limit": 0,
+						"app_instance_limit": 0,
+						"app_task_limit": 0
+					}`),
+					RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-space-quota-guid"}, "entity": {"name": "some-space-quota"}}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+
+			quota, warnings, err := client.UpdateSpaceQuotaDefinition(SpaceQuota{
+				GUID:             "some-space-quota-guid",
+				Name:             "some-space-quota",
+				OrganizationGUID: "some-org-guid",
+				QuotaLimits:      QuotaLimits{MemoryLimit: 2048, NonBasicServicesAllowed: true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(quota.GUID).To(Equal("some-space-quota-guid"))
+		})
+	})
 })
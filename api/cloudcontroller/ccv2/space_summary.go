@@ -7,8 +7,15 @@ import (
 
 // SpaceSummaryApplication represents an application inside a space
 type SpaceSummaryApplication struct {
-	Name         string   `json:"name"`
-	ServiceNames []string `json:"service_names"`
+	DiskQuota        uint64   `json:"disk_quota"`
+	GUID             string   `json:"guid"`
+	Instances        int      `json:"instances"`
+	Memory           uint64   `json:"memory"`
+	Name             string   `json:"name"`
+	RunningInstances int      `json:"running_instances"`
+	ServiceNames     []string `json:"service_names"`
+	State            string   `json:"state"`
+	URLs             []string `json:"urls"`
 }
 
 // SpaceSummaryApplication represents a service inside a space
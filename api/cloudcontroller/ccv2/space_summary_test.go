@@ -37,7 +37,14 @@ var _ = Describe("SpaceSummary", func() {
 							 "service_names": [
 									"service-instance-name"
 							 ],
-							 "name": "app-name"
+							 "name": "app-name",
+							 "guid": "app-guid",
+							 "state": "STARTED",
+							 "instances": 2,
+							 "running_instances": 1,
+							 "memory": 256,
+							 "disk_quota": 1024,
+							 "urls": ["app-name.example.com"]
 						}
 				 ],
 				 "services": [
@@ -75,8 +82,15 @@ var _ = Describe("SpaceSummary", func() {
 					Name: "space-name",
 					Applications: []SpaceSummaryApplication{
 						{
-							Name:         "app-name",
-							ServiceNames: []string{"service-instance-name"},
+							DiskQuota:        1024,
+							GUID:             "app-guid",
+							Instances:        2,
+							Memory:           256,
+							Name:             "app-name",
+							RunningInstances: 1,
+							ServiceNames:     []string{"service-instance-name"},
+							State:            "STARTED",
+							URLs:             []string{"app-name.example.com"},
 						},
 					},
 					ServiceInstances: []SpaceSummaryServiceInstance{
@@ -839,4 +839,68 @@ var _ = Describe("Space", func() {
 
 		})
 	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "user-1"}},
+					{"metadata": {"guid": "user-guid-2"}, "entity": {"username": "user-2"}}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("returns the users holding the role and all warnings", func() {
+			users, warnings, err := client.GetSpaceUsersByRole(constant.SpaceDeveloper, "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(users).To(Equal([]User{
+				{GUID: "user-guid-1", Username: "user-1"},
+				{GUID: "user-guid-2", Username: "user-2"},
+			}))
+		})
+	})
+
+	Describe("UpdateSpaceUserByRole", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/auditors"),
+					VerifyJSON(`{"username": "some-user"}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("grants the role to the user and returns all warnings", func() {
+			warnings, err := client.UpdateSpaceUserByRole(constant.SpaceAuditor, "some-space-guid", "some-user")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	Describe("UpdateSpaceAllowSSH", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid"),
+					VerifyJSON(`{"allow_ssh": false}`),
+					RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+				),
+			)
+		})
+
+		It("updates allow_ssh and returns all warnings", func() {
+			warnings, err := client.UpdateSpaceAllowSSH("some-space-guid", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
 })
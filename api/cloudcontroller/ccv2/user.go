@@ -12,12 +12,18 @@ import (
 type User struct {
 	// GUID is the unique user identifier.
 	GUID string
+
+	// Username is the name of the user in UAA. It is empty for clients.
+	Username string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller User response.
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccUser)
 	if err != nil {
@@ -25,6 +31,7 @@ func (user *User) UnmarshalJSON(data []byte) error {
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -7,9 +7,30 @@ const (
 	// Deployment is in state 'DEPLOYING'
 	DeploymentDeploying DeploymentState = "DEPLOYING"
 
+	// Deployment is in state 'PAUSED'. Only canary deployments pause, which
+	// requires Cloud Controller API 3.173.0 or later.
+	DeploymentPaused DeploymentState = "PAUSED"
+
+	// Deployment is in state 'CANCELING'
+	DeploymentCanceling DeploymentState = "CANCELING"
+
 	// Deployment is in state 'CANCELED'
 	DeploymentCanceled DeploymentState = "CANCELED"
 
 	// Deployment is in state 'DEPLOYED'
 	DeploymentDeployed DeploymentState = "DEPLOYED"
 )
+
+// DeploymentStrategy is the way a deployment replaces the instances of an
+// app.
+type DeploymentStrategy string
+
+const (
+	// DeploymentStrategyRolling replaces all the instances without pausing.
+	DeploymentStrategyRolling DeploymentStrategy = "rolling"
+
+	// DeploymentStrategyCanary pauses once a single instance of the new
+	// droplet is running, until the deployment is continued or canceled. It
+	// requires Cloud Controller API 3.173.0 or later.
+	DeploymentStrategyCanary DeploymentStrategy = "canary"
+)
@@ -17,6 +17,13 @@ type Deployment struct {
 	CreatedAt     string
 	UpdatedAt     string
 	Relationships Relationships
+
+	// Strategy is the way the deployment replaces the app's instances. When
+	// empty, Cloud Controller uses the rolling strategy.
+	Strategy constant.DeploymentStrategy
+	// MaxInFlight is the maximum number of instances replaced at a time. When
+	// zero, Cloud Controller replaces one instance at a time.
+	MaxInFlight int
 }
 
 // MarshalJSON converts a Deployment into a Cloud Controller Deployment.
@@ -25,15 +32,26 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		GUID string `json:"guid,omitempty"`
 	}
 
+	type Options struct {
+		MaxInFlight int `json:"max_in_flight,omitempty"`
+	}
+
 	var ccDeployment struct {
-		Droplet       *Droplet      `json:"droplet,omitempty"`
-		Relationships Relationships `json:"relationships,omitempty"`
+		Droplet       *Droplet                    `json:"droplet,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
+		Options       *Options                    `json:"options,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
 	}
 
 	if d.DropletGUID != "" {
 		ccDeployment.Droplet = &Droplet{d.DropletGUID}
 	}
 
+	ccDeployment.Strategy = d.Strategy
+	if d.MaxInFlight > 0 {
+		ccDeployment.Options = &Options{MaxInFlight: d.MaxInFlight}
+	}
+
 	ccDeployment.Relationships = d.Relationships
 
 	return json.Marshal(ccDeployment)
@@ -42,11 +60,15 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
 func (d *Deployment) UnmarshalJSON(data []byte) error {
 	var ccDeployment struct {
-		GUID          string                   `json:"guid,omitempty"`
-		CreatedAt     string                   `json:"created_at,omitempty"`
-		Relationships Relationships            `json:"relationships,omitempty"`
-		State         constant.DeploymentState `json:"state,omitempty"`
-		Droplet       Droplet                  `json:"droplet,omitempty"`
+		GUID          string                      `json:"guid,omitempty"`
+		CreatedAt     string                      `json:"created_at,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
+		State         constant.DeploymentState    `json:"state,omitempty"`
+		Droplet       Droplet                     `json:"droplet,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
+		Options       struct {
+			MaxInFlight int `json:"max_in_flight,omitempty"`
+		} `json:"options,omitempty"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
 	if err != nil {
@@ -58,6 +80,8 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 	d.Relationships = ccDeployment.Relationships
 	d.State = ccDeployment.State
 	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.Strategy = ccDeployment.Strategy
+	d.MaxInFlight = ccDeployment.Options.MaxInFlight
 
 	return nil
 }
@@ -79,11 +103,35 @@ func (client *Client) CancelDeployment(deploymentGUID string) (Warnings, error)
 	return response.Warnings, err
 }
 
+// ContinueDeployment resumes a paused canary deployment. It requires Cloud
+// Controller API 3.173.0 or later.
+func (client *Client) ContinueDeployment(deploymentGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostApplicationDeploymentActionContinueRequest,
+		URIParams:   map[string]string{"deployment_guid": deploymentGUID},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
+
 func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
-	dep := Deployment{
+	return client.CreateApplicationDeploymentWithStrategy(Deployment{
 		DropletGUID:   dropletGUID,
 		Relationships: Relationships{constant.RelationshipTypeApplication: Relationship{GUID: appGUID}},
-	}
+	})
+}
+
+// CreateApplicationDeploymentWithStrategy creates the provided deployment,
+// including its strategy and options.
+func (client *Client) CreateApplicationDeploymentWithStrategy(dep Deployment) (string, Warnings, error) {
 	bodyBytes, err := json.Marshal(dep)
 
 	if err != nil {
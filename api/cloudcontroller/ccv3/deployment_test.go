@@ -118,6 +118,68 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("ContinueDeployment", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.ContinueDeployment("some-deployment-guid")
+		})
+
+		Context("when continuing the deployment succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("continues the deployment with no errors and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationDeploymentWithStrategy", func() {
+		var (
+			deploymentGUID string
+			warnings       Warnings
+			executeErr     error
+		)
+
+		JustBeforeEach(func() {
+			deploymentGUID, warnings, executeErr = client.CreateApplicationDeploymentWithStrategy(Deployment{
+				DropletGUID:   "some-droplet-guid",
+				Strategy:      constant.DeploymentStrategyCanary,
+				MaxInFlight:   2,
+				Relationships: Relationships{constant.RelationshipTypeApplication: Relationship{GUID: "some-app-guid"}},
+			})
+		})
+
+		Context("when creating the deployment succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{"droplet":{"guid":"some-droplet-guid"}, "strategy":"canary", "options":{"max_in_flight":2}, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+						RespondWith(http.StatusCreated, `{"guid": "some-deployment-guid", "strategy": "canary", "options": {"max_in_flight": 2}}`, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("sends the strategy and options and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationDeployment", func() {
 		var (
 			deploymentGUID string
@@ -30,6 +30,14 @@ type Task struct {
 	SequenceID int64 `json:"sequence_id,omitempty"`
 	// State represents the task state.
 	State constant.TaskState `json:"state,omitempty"`
+	// Result represents the outcome of a task that has finished.
+	Result *TaskResult `json:"result,omitempty"`
+}
+
+// TaskResult represents the outcome of a Cloud Controller V3 Task.
+type TaskResult struct {
+	// FailureReason is the reason a failed task failed.
+	FailureReason string `json:"failure_reason,omitempty"`
 }
 
 // CreateApplicationTask runs a command in the Application environment
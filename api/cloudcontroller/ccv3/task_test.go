@@ -217,6 +217,9 @@ var _ = Describe("Task", func() {
 							"name": "task-2",
 							"command": "some-command",
 							"state": "FAILED",
+							"result": {
+								"failure_reason": "Exited with status 1"
+							},
 							"created_at": "2016-11-07T06:59:01Z"
 						}
 					]
@@ -269,6 +272,7 @@ var _ = Describe("Task", func() {
 						SequenceID: 2,
 						Name:       "task-2",
 						State:      constant.TaskFailed,
+						Result:     &TaskResult{FailureReason: "Exited with status 1"},
 						CreatedAt:  "2016-11-07T06:59:01Z",
 						Command:    "some-command",
 					},
@@ -1,37 +1,83 @@
 package wrapper
 
 import (
+	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryPolicy configures how and when the RetryRequest wrapper retries a
+// failed request.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried after the initial
+	// attempt.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry. Every subsequent retry
+	// doubles the previous delay. A zero BaseDelay retries immediately.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, including the delay
+	// requested by a Retry-After header; a longer Retry-After waits MaxDelay
+	// before retrying. A zero MaxDelay does not cap the delay.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomized to keep concurrent clients from retrying in lockstep.
+	Jitter float64
+
+	// RetrySafePOSTs allows POST requests to be retried when the Cloud
+	// Controller rejected them without processing them (429 and 503).
+	RetrySafePOSTs bool
+
+	// Sleep waits for the delay between two attempts. It defaults to
+	// time.Sleep.
+	Sleep func(time.Duration)
+}
+
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 429 or 5XX status code.
 type RetryRequest struct {
-	maxRetries int
+	policy     RetryPolicy
 	connection cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper that retries
+// immediately.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithPolicy(RetryPolicy{MaxRetries: maxRetries})
+}
+
+// NewRetryRequestWithPolicy returns a pointer to a RetryRequest wrapper that
+// retries according to the provided policy.
+func NewRetryRequestWithPolicy(policy RetryPolicy) *RetryRequest {
+	if policy.Sleep == nil {
+		policy.Sleep = time.Sleep
+	}
+
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy: policy,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 429 or 5XX status code.
+// Between attempts it waits for an exponentially increasing delay, or for the
+// duration requested by the Retry-After header.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
-	for i := 0; i < retry.maxRetries+1; i++ {
+	for i := 0; i < retry.policy.MaxRetries+1; i++ {
 		err = retry.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if i == retry.policy.MaxRetries || retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
 			break
 		}
 
@@ -43,6 +89,8 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			}
 			return resetErr
 		}
+
+		retry.policy.Sleep(retry.delay(i, passedResponse.HTTPResponse))
 	}
 	return err
 }
@@ -53,13 +101,79 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	return retry
 }
 
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+// delay returns how long to wait before the next attempt.
+func (retry *RetryRequest) delay(attempt int, response *http.Response) time.Duration {
+	if retryAfter, ok := parseRetryAfter(response); ok {
+		if retry.policy.MaxDelay > 0 && retryAfter > retry.policy.MaxDelay {
+			return retry.policy.MaxDelay
+		}
+		return retryAfter
+	}
+
+	if retry.policy.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := float64(retry.policy.BaseDelay) * math.Pow(2, float64(attempt))
+	if retry.policy.MaxDelay > 0 && delay > float64(retry.policy.MaxDelay) {
+		delay = float64(retry.policy.MaxDelay)
+	}
+
+	if jitter := math.Min(math.Max(retry.policy.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// skipRetry will skip retry if the response contains a status code that is not
+// one of following http status codes: 429, 500, 502, 503, 504. POST requests
+// are only retried on 429 and 503 when RetrySafePOSTs is set.
+func (retry *RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
+	if httpMethod == http.MethodPost {
+		return !retry.policy.RetrySafePOSTs ||
+			response == nil ||
+			response.StatusCode != http.StatusTooManyRequests &&
+				response.StatusCode != http.StatusServiceUnavailable
+	}
+
+	return response != nil &&
+		response.StatusCode != http.StatusTooManyRequests &&
+		response.StatusCode != http.StatusInternalServerError &&
+		response.StatusCode != http.StatusBadGateway &&
+		response.StatusCode != http.StatusServiceUnavailable &&
+		response.StatusCode != http.StatusGatewayTimeout
+}
+
+// parseRetryAfter returns the delay requested by the Retry-After header of a
+// 429 or 503 response. The header may contain either a number of seconds or an
+// HTTP date.
+func parseRetryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil ||
+		response.StatusCode != http.StatusTooManyRequests &&
+			response.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
 }
@@ -11,10 +11,15 @@ type PluginRepository struct {
 	Plugins []Plugin `json:"plugins"`
 }
 
+// PluginBinary is the binary of a plugin for one platform. Checksum is the
+// SHA1 of the binary; SHA256 and Signature are optional. Signature is a
+// base64-encoded ed25519 signature of the binary by the plugin publisher.
 type PluginBinary struct {
-	Platform string `json:"platform"`
-	URL      string `json:"url"`
-	Checksum string `json:"checksum"`
+	Platform  string `json:"platform"`
+	URL       string `json:"url"`
+	Checksum  string `json:"checksum"`
+	SHA256    string `json:"sha256,omitempty"`
+	Signature string `json:"signature,omitempty"`
 }
 
 type Plugin struct {
@@ -58,7 +58,7 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 		return "", "", err
 	}
 
-	if grantType == constant.GrantTypePassword {
+	if grantType != constant.GrantTypeClientCredentials {
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
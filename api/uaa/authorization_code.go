@@ -0,0 +1,76 @@
+package uaa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// PKCE is a Proof Key for Code Exchange (RFC 7636). The challenge is sent when
+// the user is asked to authorize the CLI and the verifier when the
+// authorization code is exchanged for tokens, so that an intercepted
+// authorization code is of no use to anyone else.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCE returns a PKCE with a random verifier and its S256 challenge.
+func NewPKCE() (PKCE, error) {
+	verifier, err := randomURLSafeString(32)
+	if err != nil {
+		return PKCE{}, err
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+// AuthorizationCodeURL returns the URL of the login server page on which the
+// user authorizes the CLI. Once authorized, the login server redirects the
+// browser to redirectURI with the provided state and an authorization code.
+func (client Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetOAuthAuthorizeRequest,
+		Query: url.Values{
+			"client_id":             {client.config.UAAOAuthClient()},
+			"code_challenge":        {codeChallenge},
+			"code_challenge_method": {"S256"},
+			"redirect_uri":          {redirectURI},
+			"response_type":         {"code"},
+			"state":                 {state},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
+
+// AuthenticateWithAuthorizationCode exchanges an authorization code for an
+// access token and a refresh token. The redirect URI and the PKCE verifier
+// must be the ones used to obtain the code.
+func (client Client) AuthenticateWithAuthorizationCode(code string, redirectURI string, codeVerifier string) (string, string, error) {
+	return client.Authenticate(map[string]string{
+		"code":          code,
+		"code_verifier": codeVerifier,
+		"redirect_uri":  redirectURI,
+	}, "", constant.GrantTypeAuthorizationCode)
+}
+
+func randomURLSafeString(numBytes int) (string, error) {
+	bytes := make([]byte, numBytes)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -4,9 +4,18 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for a user who authorizes the CLI in
+	// a web browser.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeDeviceCode is used for a user who authorizes the CLI on another
+	// device.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// GrantTypeJWTBearer is used for a workload that authenticates with a
+	// signed identity token issued by a trusted identity provider.
+	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
@@ -0,0 +1,64 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization is a device code login (RFC 8628) waiting for the user
+// to approve it on another device.
+type DeviceAuthorization struct {
+	// DeviceCode identifies the login when polling for tokens.
+	DeviceCode string `json:"device_code"`
+	// UserCode is the code the user enters on the verification page.
+	UserCode string `json:"user_code"`
+	// VerificationURI is the page on which the user enters the user code.
+	VerificationURI string `json:"verification_uri"`
+	// VerificationURIComplete is the verification page with the user code
+	// already filled in. It is optional.
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the number of seconds the device code is valid for.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the minimum number of seconds between polls for tokens.
+	Interval int `json:"interval"`
+}
+
+// StartDeviceAuthorization starts a device code login.
+func (client Client) StartDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthDeviceAuthorizeRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	var authorization DeviceAuthorization
+	response := Response{
+		Result: &authorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return authorization, err
+}
+
+// AuthenticateWithDeviceCode requests an access token and a refresh token for
+// a device code login. Until the user approves the login it returns an
+// AuthorizationPendingError, or a SlowDownError when polled too often.
+func (client Client) AuthenticateWithDeviceCode(deviceCode string) (string, string, error) {
+	return client.Authenticate(map[string]string{
+		"client_id":   client.config.UAAOAuthClient(),
+		"device_code": deviceCode,
+	}, "", constant.GrantTypeDeviceCode)
+}
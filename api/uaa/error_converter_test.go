@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -83,6 +84,19 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+
+				DescribeTable("device code login errors",
+					func(errorType string, expectedErr error) {
+						fakeConnectionErr.RawResponse = []byte(`{"error":"` + errorType + `","error_description":"some-description"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+
+						Expect(wrapper.Make(request, response)).To(MatchError(expectedErr))
+					},
+					Entry("authorization pending", "authorization_pending", AuthorizationPendingError{Message: "some-description"}),
+					Entry("slow down", "slow_down", SlowDownError{Message: "some-description"}),
+					Entry("access denied", "access_denied", AccessDeniedError{Message: "some-description"}),
+					Entry("expired token", "expired_token", ExpiredDeviceCodeError{Message: "some-description"}),
+				)
 			})
 
 			Context("(401) Unauthorized", func() {
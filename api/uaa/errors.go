@@ -88,3 +88,51 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned while the user has not yet approved
+// or denied a device code login.
+type AuthorizationPendingError struct {
+	Message string
+}
+
+func (e AuthorizationPendingError) Error() string {
+	return e.Message
+}
+
+// SlowDownError is returned when a device code login is polled more often
+// than the UAA allows.
+type SlowDownError struct {
+	Message string
+}
+
+func (e SlowDownError) Error() string {
+	return e.Message
+}
+
+// AccessDeniedError is returned when the user denies the CLI access to their
+// account.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	return e.Message
+}
+
+// ExpiredDeviceCodeError is returned when the device code of a device code
+// login has expired before the user approved it.
+type ExpiredDeviceCodeError struct {
+	Message string
+}
+
+func (e ExpiredDeviceCodeError) Error() string {
+	return e.Message
+}
+
+// RedirectTimeoutError is returned when the login server does not redirect
+// the browser back to the CLI in time.
+type RedirectTimeoutError struct{}
+
+func (RedirectTimeoutError) Error() string {
+	return "timed out waiting for the login server to redirect to the CLI"
+}
@@ -5,14 +5,18 @@ import (
 )
 
 const (
-	GetSSHPasscodeRequest = "GetSSHPasscode"
-	PostOAuthTokenRequest = "PostOAuthToken"
-	PostUserRequest       = "PostUser"
+	GetOAuthAuthorizeRequest        = "GetOAuthAuthorize"
+	GetSSHPasscodeRequest           = "GetSSHPasscode"
+	PostOAuthDeviceAuthorizeRequest = "PostOAuthDeviceAuthorize"
+	PostOAuthTokenRequest           = "PostOAuthToken"
+	PostUserRequest                 = "PostUser"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest, Resource: UAAResource},
+	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetOAuthAuthorizeRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest, Resource: UAAResource},
+	{Path: "/oauth/device_authorize", Method: http.MethodPost, Name: PostOAuthDeviceAuthorizeRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
 }
@@ -0,0 +1,108 @@
+package uaa
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// RedirectListener is an HTTP server on the loopback interface that receives
+// the redirect from the login server at the end of an authorization code
+// login.
+type RedirectListener struct {
+	listener net.Listener
+	server   *http.Server
+	state    string
+	results  chan redirectResult
+}
+
+type redirectResult struct {
+	code string
+	err  error
+}
+
+// NewRedirectListener starts a RedirectListener on a random port of
+// 127.0.0.1.
+func NewRedirectListener() (*RedirectListener, error) {
+	state, err := randomURLSafeString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	redirectListener := &RedirectListener{
+		listener: listener,
+		state:    state,
+		results:  make(chan redirectResult, 1),
+	}
+	redirectListener.server = &http.Server{Handler: http.HandlerFunc(redirectListener.handleRedirect)}
+	go redirectListener.server.Serve(listener)
+
+	return redirectListener, nil
+}
+
+// RedirectURI is the URI the login server should redirect the browser to.
+func (l *RedirectListener) RedirectURI() string {
+	return fmt.Sprintf("http://%s/callback", l.listener.Addr().String())
+}
+
+// State is the value the login server must pass back with the redirect. It
+// ensures the authorization code belongs to the login the CLI started.
+func (l *RedirectListener) State() string {
+	return l.state
+}
+
+// WaitForCode waits for the login server to redirect the browser to the
+// listener and returns the authorization code. It returns an
+// AccessDeniedError when the user denied the CLI access and a
+// RedirectTimeoutError when there was no redirect within the timeout.
+func (l *RedirectListener) WaitForCode(timeout time.Duration) (string, error) {
+	select {
+	case result := <-l.results:
+		return result.code, result.err
+	case <-time.After(timeout):
+		return "", RedirectTimeoutError{}
+	}
+}
+
+// Close stops the listener.
+func (l *RedirectListener) Close() error {
+	return l.server.Close()
+}
+
+func (l *RedirectListener) handleRedirect(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if r.URL.Path != "/callback" || query.Get("state") != l.state {
+		http.Error(w, "This login is not the one the CLI is waiting for.", http.StatusBadRequest)
+		return
+	}
+
+	var result redirectResult
+	switch {
+	case query.Get("error") == "access_denied":
+		result.err = AccessDeniedError{Message: query.Get("error_description")}
+	case query.Get("error") != "":
+		result.err = UAAErrorResponse{Type: query.Get("error"), Description: query.Get("error_description")}
+	case query.Get("code") == "":
+		http.Error(w, "The login server did not provide an authorization code.", http.StatusBadRequest)
+		return
+	default:
+		result.code = query.Get("code")
+	}
+
+	select {
+	case l.results <- result:
+	default:
+	}
+
+	if result.err != nil {
+		fmt.Fprintln(w, "Login failed. You can close this window and return to the CLI.")
+		return
+	}
+	fmt.Fprintln(w, "Login successful. You can close this window and return to the CLI.")
+}
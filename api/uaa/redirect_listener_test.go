@@ -0,0 +1,71 @@
+package uaa_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RedirectListener", func() {
+	var (
+		listener *RedirectListener
+
+		redirect func(query url.Values) int
+	)
+
+	BeforeEach(func() {
+		var err error
+		listener, err = NewRedirectListener()
+		Expect(err).ToNot(HaveOccurred())
+
+		redirect = func(query url.Values) int {
+			response, err := http.Get(listener.RedirectURI() + "?" + query.Encode())
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+			return response.StatusCode
+		}
+	})
+
+	AfterEach(func() {
+		Expect(listener.Close()).To(Succeed())
+	})
+
+	It("listens on the loopback interface", func() {
+		Expect(strings.HasPrefix(listener.RedirectURI(), "http://127.0.0.1:")).To(BeTrue())
+		Expect(listener.RedirectURI()).To(HaveSuffix("/callback"))
+		Expect(listener.State()).ToNot(BeEmpty())
+	})
+
+	When("the login server redirects with a code", func() {
+		It("returns the code", func() {
+			Expect(redirect(url.Values{"code": {"some-code"}, "state": {listener.State()}})).To(Equal(http.StatusOK))
+
+			code, err := listener.WaitForCode(time.Second)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(code).To(Equal("some-code"))
+		})
+	})
+
+	When("the state does not match", func() {
+		It("ignores the redirect", func() {
+			Expect(redirect(url.Values{"code": {"some-code"}, "state": {"some-other-state"}})).To(Equal(http.StatusBadRequest))
+
+			_, err := listener.WaitForCode(10 * time.Millisecond)
+			Expect(err).To(MatchError(RedirectTimeoutError{}))
+		})
+	})
+
+	When("the user denies access", func() {
+		It("returns an AccessDeniedError", func() {
+			Expect(redirect(url.Values{"error": {"access_denied"}, "error_description": {"user denied"}, "state": {listener.State()}})).To(Equal(http.StatusOK))
+
+			_, err := listener.WaitForCode(time.Second)
+			Expect(err).To(MatchError(AccessDeniedError{Message: "user denied"}))
+		})
+	})
+})
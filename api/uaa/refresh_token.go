@@ -31,6 +31,15 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens,
 		values = client.clientCredentialRefreshBody()
 	case "", string(constant.GrantTypePassword): // CLI used to write empty string for grant type in the case of password; preserve compatibility with old config.json files
 		values = client.refreshTokenBody(refreshToken)
+	case string(constant.GrantTypeAuthorizationCode), string(constant.GrantTypeDeviceCode):
+		values = client.refreshTokenBody(refreshToken)
+	case string(constant.GrantTypeJWTBearer):
+		// An assertion is short lived and is not kept, so without a refresh
+		// token the only way to get a new access token is to log in again.
+		if refreshToken == "" {
+			return RefreshedTokens{}, InvalidAuthTokenError{Message: "The access token expired and cannot be refreshed without a new assertion"}
+		}
+		values = client.refreshTokenBody(refreshToken)
 	}
 
 	body := strings.NewReader(values.Encode())
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -50,11 +51,13 @@ var _ = Describe("Retry Request", func() {
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("1 for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 1),
 
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
@@ -75,4 +78,154 @@ var _ = Describe("Retry Request", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Describe("retry policy", func() {
+		var (
+			request        *http.Request
+			response       *uaa.Response
+			fakeConnection *uaafakes.FakeConnection
+			delays         []time.Duration
+			sleep          func(time.Duration)
+		)
+
+		newRequest := func(method string) *http.Request {
+			req, err := http.NewRequest(method, "https://foo.bar.com/banana", strings.NewReader("banana pants"))
+			Expect(err).NotTo(HaveOccurred())
+			return req
+		}
+
+		BeforeEach(func() {
+			request = newRequest(http.MethodGet)
+			response = &uaa.Response{}
+			fakeConnection = new(uaafakes.FakeConnection)
+			delays = nil
+			sleep = func(delay time.Duration) {
+				delays = append(delays, delay)
+			}
+		})
+
+		failWith := func(statusCode int, header http.Header) {
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: statusCode, Header: header}
+				return uaa.RawHTTPStatusError{StatusCode: statusCode}
+			}
+		}
+
+		When("no delay is configured", func() {
+			It("retries immediately", func() {
+				failWith(http.StatusBadGateway, nil)
+				wrapper := NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries: 2,
+					Sleep:      sleep,
+				}).Wrap(fakeConnection)
+
+				err := wrapper.Make(request, response)
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(delays).To(Equal([]time.Duration{0, 0}))
+			})
+		})
+
+		When("a base delay is configured", func() {
+			It("waits an exponentially increasing delay between attempts", func() {
+				failWith(http.StatusBadGateway, nil)
+				wrapper := NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries: 3,
+					BaseDelay:  20 * time.Millisecond,
+					Sleep:      sleep,
+				}).Wrap(fakeConnection)
+
+				err := wrapper.Make(request, response)
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(4))
+				Expect(delays).To(Equal([]time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 80 * time.Millisecond}))
+			})
+
+			It("caps the delay at the max delay", func() {
+				failWith(http.StatusBadGateway, nil)
+				wrapper := NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries: 2,
+					BaseDelay:  time.Hour,
+					MaxDelay:   10 * time.Second,
+					Sleep:      sleep,
+				}).Wrap(fakeConnection)
+
+				err := wrapper.Make(request, response)
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(delays).To(Equal([]time.Duration{10 * time.Second, 10 * time.Second}))
+			})
+
+			It("randomizes up to the jitter fraction of each delay", func() {
+				failWith(http.StatusBadGateway, nil)
+				wrapper := NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries: 2,
+					BaseDelay:  time.Second,
+					Jitter:     0.5,
+					Sleep:      sleep,
+				}).Wrap(fakeConnection)
+
+				Expect(wrapper.Make(request, response)).ToNot(Succeed())
+				Expect(delays).To(HaveLen(2))
+				Expect(delays[0]).To(BeNumerically(">", 500*time.Millisecond))
+				Expect(delays[0]).To(BeNumerically("<=", time.Second))
+				Expect(delays[1]).To(BeNumerically(">", time.Second))
+				Expect(delays[1]).To(BeNumerically("<=", 2*time.Second))
+			})
+		})
+
+		When("the response contains a Retry-After header", func() {
+			It("waits for the requested number of seconds", func() {
+				failWith(http.StatusTooManyRequests, http.Header{"Retry-After": {"3"}})
+				wrapper := NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries: 1,
+					BaseDelay:  time.Millisecond,
+					Sleep:      sleep,
+				}).Wrap(fakeConnection)
+
+				err := wrapper.Make(request, response)
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(delays).To(Equal([]time.Duration{3 * time.Second}))
+			})
+
+			It("waits for the max delay when the requested delay exceeds it", func() {
+				failWith(http.StatusServiceUnavailable, http.Header{"Retry-After": {"120"}})
+				wrapper := NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries: 2,
+					MaxDelay:   time.Second,
+					Sleep:      sleep,
+				}).Wrap(fakeConnection)
+
+				err := wrapper.Make(request, response)
+				Expect(err).To(MatchError(uaa.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				Expect(delays).To(Equal([]time.Duration{time.Second, time.Second}))
+			})
+		})
+
+		When("safe POSTs are retried", func() {
+			var wrapper uaa.Connection
+
+			BeforeEach(func() {
+				request = newRequest(http.MethodPost)
+				wrapper = NewRetryRequestWithPolicy(RetryPolicy{
+					MaxRetries:     2,
+					RetrySafePOSTs: true,
+				}).Wrap(fakeConnection)
+			})
+
+			It("retries POSTs rejected with a 429", func() {
+				failWith(http.StatusTooManyRequests, nil)
+				Expect(wrapper.Make(request, response)).ToNot(Succeed())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+
+			It("does not retry POSTs that may have been processed", func() {
+				failWith(http.StatusBadGateway, nil)
+				Expect(wrapper.Make(request, response)).ToNot(Succeed())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
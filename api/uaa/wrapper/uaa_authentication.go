@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
 //go:generate counterfeiter . UAAClient
@@ -100,14 +102,37 @@ func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection
 	return t
 }
 
-// The authentication header is not added to token refresh requests or login
-// requests.
+// tokenGrantTypes are the grant types of token refresh requests and login
+// requests, which carry their own credentials.
+var tokenGrantTypes = []constant.GrantType{
+	constant.GrantTypeAuthorizationCode,
+	constant.GrantTypeClientCredentials,
+	constant.GrantTypeDeviceCode,
+	constant.GrantTypeJWTBearer,
+	constant.GrantTypePassword,
+	constant.GrantTypeRefreshToken,
+}
+
+// The authentication header is not added to token refresh requests, login
+// requests or device authorization requests.
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
-	stringBody := string(body)
+	if request.Method != http.MethodPost {
+		return false
+	}
+
+	if strings.Contains(request.URL.String(), "/oauth/device_authorize") {
+		return true
+	}
 
-	return strings.Contains(request.URL.String(), "/oauth/token") &&
-		request.Method == http.MethodPost &&
-		(strings.Contains(stringBody, "grant_type=refresh_token") ||
-			strings.Contains(stringBody, "grant_type=password") ||
-			strings.Contains(stringBody, "grant_type=client_credentials"))
+	if !strings.Contains(request.URL.String(), "/oauth/token") {
+		return false
+	}
+
+	stringBody := string(body)
+	for _, grantType := range tokenGrantTypes {
+		if strings.Contains(stringBody, "grant_type="+url.QueryEscape(string(grantType))) {
+			return true
+		}
+	}
+	return false
 }
@@ -214,6 +214,32 @@ var _ = Describe("UAA Authentication", func() {
 					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 				})
 			})
+			Context("with jwt-bearer grant_type", func() {
+				var originalAuthHeader string
+				BeforeEach(func() {
+					body := strings.NewReader(url.Values{
+						"assertion":  {"some-assertion"},
+						"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+					}.Encode())
+
+					request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+					Expect(err).NotTo(HaveOccurred())
+					request.SetBasicAuth("some-user", "some-password")
+					originalAuthHeader = request.Header.Get("Authorization")
+
+					inMemoryCache.SetAccessToken("some-access-token")
+
+					err = wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not change the 'Authorization' header", func() {
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+					request, _ := fakeConnection.MakeArgsForCall(0)
+					Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
+				})
+			})
 			Context("with client_credentials grant_type", func() {
 				var originalAuthHeader string
 				BeforeEach(func() {
@@ -188,7 +188,7 @@ func (uaa UAARepository) RefreshAuthToken() (string, error) {
 		data.Add("client_id", uaa.config.UAAOAuthClient())
 		data.Add("client_secret", uaa.config.UAAOAuthClientSecret())
 		data.Add("grant_type", "client_credentials")
-	case "", "password": // CLI used to leave field blank for password; preserve compatibility with old files
+	case "", "password", "authorization_code", "urn:ietf:params:oauth:grant-type:device_code", "urn:ietf:params:oauth:grant-type:jwt-bearer": // CLI used to leave field blank for password; preserve compatibility with old files
 		data.Add("grant_type", "refresh_token")
 		data.Add("refresh_token", uaa.config.RefreshToken())
 		data.Add("scope", "")
@@ -229,6 +229,17 @@ var _ = Describe("AuthenticationRepository", func() {
 				})
 			})
 
+			Context("when the user is authenticated with authorization code grant", func() {
+				BeforeEach(func() {
+					config.SetUAAGrantType("authorization_code")
+					setupTestServer(successfulPasswordRefreshTokenRequest)
+				})
+
+				It("uses the refresh token to refresh the access token", func() {
+					Expect(accessToken).To(Equal("BEARER my_new_access_token"))
+				})
+			})
+
 			Context("when the refresh token has expired", func() {
 				BeforeEach(func() {
 					setupTestServer(refreshTokenExpiredRequestError)
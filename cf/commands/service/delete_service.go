@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -18,6 +19,8 @@ type DeleteService struct {
 	config             coreconfig.Reader
 	serviceRepo        api.ServiceRepository
 	serviceInstanceReq requirements.ServiceInstanceRequirement
+
+	PollingInterval time.Duration
 }
 
 func init() {
@@ -27,13 +30,15 @@ func init() {
 func (cmd *DeleteService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the service broker to finish deleting the service instance")}
+	fs["wait-timeout"] = &flags.IntFlag{Name: "wait-timeout", Usage: T("Maximum time (in seconds) to wait with --wait (Default: no limit)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "delete-service",
 		ShortName:   "ds",
 		Description: T("Delete a service instance"),
 		Usage: []string{
-			T("CF_NAME delete-service SERVICE_INSTANCE [-f]"),
+			T("CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait [--wait-timeout SECONDS]]"),
 		},
 		Flags: fs,
 	}
@@ -45,6 +50,16 @@ func (cmd *DeleteService) Requirements(requirementsFactory requirements.Factory,
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.IsSet("wait-timeout") && fc.Int("wait-timeout") < 1 {
+		cmd.ui.Failed(T("Incorrect Usage. --wait-timeout must be greater than or equal to 1\n\n") + commandregistry.Commands.CommandUsage("delete-service"))
+		return nil, fmt.Errorf("Incorrect usage: --wait-timeout must be greater than or equal to 1")
+	}
+
+	if fc.IsSet("wait-timeout") && !fc.Bool("wait") {
+		cmd.ui.Failed(T("Incorrect Usage. --wait-timeout requires --wait\n\n") + commandregistry.Commands.CommandUsage("delete-service"))
+		return nil, fmt.Errorf("Incorrect usage: --wait-timeout requires --wait")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewTargetedSpaceRequirement(),
@@ -57,6 +72,7 @@ func (cmd *DeleteService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.PollingInterval = DefaultServicePollingInterval
 	return cmd
 }
 
@@ -94,6 +110,11 @@ func (cmd *DeleteService) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	if c.Bool("wait") {
+		timeout := time.Duration(c.Int("wait-timeout")) * time.Second
+		return waitForServiceInstance(serviceName, timeout, cmd.PollingInterval, cmd.serviceRepo, cmd.ui)
+	}
+
 	err = printSuccessMessageForServiceInstance(serviceName, cmd.serviceRepo, cmd.ui)
 	if err != nil {
 		cmd.ui.Ok()
@@ -1,8 +1,11 @@
 package service_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/service"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -31,7 +34,9 @@ var _ = Describe("delete-service command", func() {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.Config = configRepo
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("delete-service").SetDependency(deps, pluginCall))
+		cmd := commandregistry.Commands.FindCommand("delete-service").SetDependency(deps, pluginCall).(*service.DeleteService)
+		cmd.PollingInterval = time.Millisecond
+		commandregistry.Commands.SetCommand(cmd)
 	}
 
 	BeforeEach(func() {
@@ -85,6 +90,20 @@ var _ = Describe("delete-service command", func() {
 				))
 			})
 
+			It("fails with usage when --wait-timeout is passed without --wait", func() {
+				Expect(runCommand("--wait-timeout", "60", "my-service")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--wait-timeout requires --wait"},
+				))
+			})
+
+			It("fails with usage when --wait-timeout is not a positive integer", func() {
+				Expect(runCommand("--wait", "--wait-timeout", "-5", "my-service")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--wait-timeout must be greater than or equal to 1"},
+				))
+			})
+
 			Context("when the service exists", func() {
 				Context("and the service deletion is asynchronous", func() {
 					BeforeEach(func() {
@@ -123,6 +142,49 @@ var _ = Describe("delete-service command", func() {
 							[]string{"Delete in progress. Use 'cf services' or 'cf service foo.com' to check operation status."},
 						))
 					})
+
+					Context("when the wait flag is given", func() {
+						// findInstanceReturnsOnCall makes the nth lookup of the
+						// service instance return instance and err and every other
+						// lookup return the in progress service instance.
+						findInstanceReturnsOnCall := func(n int, instance models.ServiceInstance, err error) {
+							inProgress := serviceInstance
+							serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+								if serviceRepo.FindInstanceByNameCallCount() == n+1 {
+									return instance, err
+								}
+								return inProgress, nil
+							}
+						}
+
+						It("waits until the service instance is gone", func() {
+							findInstanceReturnsOnCall(2, models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", "my-service"))
+
+							Expect(runCommand("-f", "--wait", "my-service")).To(BeTrue())
+
+							Expect(ui.Outputs()).To(ContainSubstrings(
+								[]string{"Deleting service", "my-service"},
+								[]string{"delete in progress: delete"},
+								[]string{"delete succeeded"},
+								[]string{"OK"},
+							))
+							Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Delete in progress. Use"}))
+						})
+
+						It("fails with the broker's description when the delete fails", func() {
+							failed := serviceInstance
+							failed.LastOperation.State = "failed"
+							failed.LastOperation.Description = "instance has bindings"
+							findInstanceReturnsOnCall(2, failed, nil)
+
+							Expect(runCommand("-f", "--wait", "my-service")).To(BeFalse())
+
+							Expect(ui.Outputs()).To(ContainSubstrings(
+								[]string{"FAILED"},
+								[]string{"Service delete failed: instance has bindings"},
+							))
+						})
+					})
 				})
 
 				Context("and the service deletion is synchronous", func() {
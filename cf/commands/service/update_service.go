@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/actors/planbuilder"
 	"code.cloudfoundry.org/cli/cf/api"
@@ -23,6 +24,8 @@ type UpdateService struct {
 	config      coreconfig.Reader
 	serviceRepo api.ServiceRepository
 	planBuilder planbuilder.PlanBuilder
+
+	PollingInterval time.Duration
 }
 
 func init() {
@@ -30,7 +33,7 @@ func init() {
 }
 
 func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
-	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait [--wait-timeout SECONDS]]")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line.
    CF_NAME update-service -c '{"name":"value","name":"value"}'
 
@@ -51,6 +54,8 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Change service plan for a service instance")}
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["wait"] = &flags.BoolFlag{Name: "wait", Usage: T("Wait for the service broker to finish updating the service instance")}
+	fs["wait-timeout"] = &flags.IntFlag{Name: "wait-timeout", Usage: T("Maximum time (in seconds) to wait with --wait (Default: no limit)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "update-service",
@@ -67,6 +72,7 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 			`CF_NAME update-service mydb -c '{"ram_gb":4}'`,
 			`CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json`,
 			`CF_NAME update-service mydb -t "list,of, tags"`,
+			`CF_NAME update-service mydb -p gold --wait --wait-timeout 600`,
 		},
 		Flags: fs,
 	}
@@ -78,6 +84,16 @@ func (cmd *UpdateService) Requirements(requirementsFactory requirements.Factory,
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.IsSet("wait-timeout") && fc.Int("wait-timeout") < 1 {
+		cmd.ui.Failed(T("Incorrect Usage. --wait-timeout must be greater than or equal to 1\n\n") + commandregistry.Commands.CommandUsage("update-service"))
+		return nil, fmt.Errorf("Incorrect usage: --wait-timeout must be greater than or equal to 1")
+	}
+
+	if fc.IsSet("wait-timeout") && !fc.Bool("wait") {
+		cmd.ui.Failed(T("Incorrect Usage. --wait-timeout requires --wait\n\n") + commandregistry.Commands.CommandUsage("update-service"))
+		return nil, fmt.Errorf("Incorrect usage: --wait-timeout requires --wait")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewTargetedSpaceRequirement(),
@@ -91,6 +107,7 @@ func (cmd *UpdateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 	cmd.planBuilder = deps.PlanBuilder
+	cmd.PollingInterval = DefaultServicePollingInterval
 	return cmd
 }
 
@@ -134,6 +151,11 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 	if err != nil {
 		return err
 	}
+	if c.Bool("wait") {
+		timeout := time.Duration(c.Int("wait-timeout")) * time.Second
+		return waitForServiceInstance(serviceInstanceName, timeout, cmd.PollingInterval, cmd.serviceRepo, cmd.ui)
+	}
+
 	err = printSuccessMessageForServiceInstance(serviceInstanceName, cmd.serviceRepo, cmd.ui)
 	if err != nil {
 		return err
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"time"
 
 	planbuilderfakes "code.cloudfoundry.org/cli/cf/actors/planbuilder/planbuilderfakes"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/service"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
@@ -37,7 +39,9 @@ var _ = Describe("update-service command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.Config = config
 		deps.PlanBuilder = planBuilder
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall))
+		cmd := commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall).(*service.UpdateService)
+		cmd.PollingInterval = time.Millisecond
+		commandregistry.Commands.SetCommand(cmd)
 	}
 
 	BeforeEach(func() {
@@ -88,6 +92,20 @@ var _ = Describe("update-service command", func() {
 			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Failing{Message: "not targeting space"})
 			Expect(callUpdateService([]string{"cleardb", "spark", "my-cleardb-service"})).To(BeFalse())
 		})
+
+		It("fails with usage when --wait-timeout is passed without --wait", func() {
+			Expect(callUpdateService([]string{"--wait-timeout", "60", "cleardb"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--wait-timeout requires --wait"},
+			))
+		})
+
+		It("fails with usage when --wait-timeout is not a positive integer", func() {
+			Expect(callUpdateService([]string{"--wait", "--wait-timeout", "0", "cleardb"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "--wait-timeout must be greater than or equal to 1"},
+			))
+		})
 	})
 
 	Context("when no flags are passed", func() {
@@ -321,6 +339,70 @@ var _ = Describe("update-service command", func() {
 				Expect(planGUID).To(Equal("murkydb-flare-guid"))
 			})
 
+			Context("when the wait flag is passed", func() {
+				var inProgress, succeeded models.ServiceInstance
+
+				// findInstanceReturnsOnCall makes the nth lookup of the service
+				// instance return instance and every other lookup return
+				// inProgress.
+				findInstanceReturnsOnCall := func(n int, instance models.ServiceInstance) {
+					serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+						if serviceRepo.FindInstanceByNameCallCount() == n+1 {
+							return instance, nil
+						}
+						return inProgress, nil
+					}
+				}
+
+				BeforeEach(func() {
+					inProgress = models.ServiceInstance{}
+					inProgress.GUID = "my-service-instance-guid"
+					inProgress.ServiceOffering.GUID = "murkydb-guid"
+					inProgress.LastOperation = models.LastOperationFields{Type: "update", State: "in progress", Description: "fake service instance description"}
+
+					succeeded = inProgress
+					succeeded.LastOperation = models.LastOperationFields{Type: "update", State: "succeeded"}
+				})
+
+				It("waits for the update to finish, printing each state", func() {
+					findInstanceReturnsOnCall(3, succeeded)
+
+					Expect(callUpdateService([]string{"-p", "flare", "--wait", "my-service-instance"})).To(BeTrue())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Updating service", "my-service"},
+						[]string{"update in progress: fake service instance description"},
+						[]string{"update succeeded"},
+						[]string{"OK"},
+					))
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Update in progress. Use"}))
+					Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(4))
+				})
+
+				It("fails with the broker's description when the update fails", func() {
+					failed := succeeded
+					failed.LastOperation = models.LastOperationFields{Type: "update", State: "failed", Description: "plan change not supported"}
+					findInstanceReturnsOnCall(2, failed)
+
+					Expect(callUpdateService([]string{"-p", "flare", "--wait", "my-service-instance"})).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"update failed: plan change not supported"},
+						[]string{"FAILED"},
+						[]string{"Service update failed: plan change not supported"},
+					))
+				})
+
+				It("fails when the update is still in progress after the timeout", func() {
+					Expect(callUpdateService([]string{"-p", "flare", "--wait", "--wait-timeout", "1", "my-service-instance"})).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Timed out after 1s waiting for service update to complete"},
+					))
+				})
+			})
+
 			Context("when there is an err finding the instance", func() {
 				It("returns an error", func() {
 					serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, errors.New("Error finding instance"))
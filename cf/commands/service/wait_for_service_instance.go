@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+const DefaultServicePollingInterval = 3 * time.Second
+
+// waitForServiceInstance polls the last operation of the service instance,
+// printing every change of its state, until it is no longer in progress. A
+// service instance that no longer exists has been deleted successfully. A
+// timeout of 0 waits forever.
+func waitForServiceInstance(serviceInstanceName string, timeout time.Duration, pollingInterval time.Duration, serviceRepo api.ServiceRepository, ui terminal.UI) error {
+	var (
+		previous models.LastOperationFields
+		polled   bool
+	)
+	startTime := time.Now()
+
+	for {
+		instance, err := serviceRepo.FindInstanceByName(serviceInstanceName)
+		if _, ok := err.(*cferrors.ModelNotFoundError); ok {
+			sayLastOperation(models.LastOperationFields{Type: "delete", State: "succeeded"}, ui)
+			ui.Ok()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		lastOperation := instance.ServiceInstanceFields.LastOperation
+		if !polled || lastOperation.State != previous.State || lastOperation.Description != previous.Description {
+			sayLastOperation(lastOperation, ui)
+		}
+		polled = true
+		previous = lastOperation
+
+		switch lastOperation.State {
+		case "in progress":
+		case "failed":
+			return errors.New(T("Service {{.Operation}} failed: {{.Description}}",
+				map[string]interface{}{
+					"Operation":   lastOperation.Type,
+					"Description": lastOperation.Description,
+				}))
+		default:
+			ui.Ok()
+			return nil
+		}
+
+		if timeout > 0 && time.Since(startTime) >= timeout {
+			return errors.New(T("Timed out after {{.Timeout}} waiting for service {{.Operation}} to complete. The operation may still be running on the service broker.",
+				map[string]interface{}{
+					"Timeout":   timeout.String(),
+					"Operation": lastOperation.Type,
+				}))
+		}
+
+		time.Sleep(pollingInterval)
+	}
+}
+
+func sayLastOperation(lastOperation models.LastOperationFields, ui terminal.UI) {
+	if lastOperation.Description == "" {
+		ui.Say(T("{{.Type}} {{.State}}", map[string]interface{}{
+			"Type":  lastOperation.Type,
+			"State": lastOperation.State,
+		}))
+		return
+	}
+
+	ui.Say(T("{{.Type}} {{.State}}: {{.Description}}", map[string]interface{}{
+		"Type":        lastOperation.Type,
+		"State":       lastOperation.State,
+		"Description": lastOperation.Description,
+	}))
+}
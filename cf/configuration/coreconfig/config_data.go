@@ -2,8 +2,11 @@ package coreconfig
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
 
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 )
 
 type AuthPromptType string
@@ -25,13 +28,20 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	CredentialHelper         string `json:",omitempty"`
+	CurrentProfile           string `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
 	OrganizationFields       models.OrganizationFields
 	PluginRepos              []models.PluginRepo
+	Profiles                 map[string]json.RawMessage `json:",omitempty"`
 	RefreshToken             string
+	RetryBaseDelay           string   `json:",omitempty"`
+	RetryJitter              *float64 `json:",omitempty"`
+	RetryMaxDelay            string   `json:",omitempty"`
+	RetrySafePOSTs           bool     `json:",omitempty"`
 	RoutingAPIEndpoint       string
 	SpaceFields              models.SpaceFields
 	SSHOAuthClient           string
@@ -42,6 +52,38 @@ type Data struct {
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+
+	profileOverride string
+	defaultProfile  profileData
+
+	// credentialsErr is the error that prevented the credentials from being
+	// loaded from the credential helper.
+	credentialsErr error
+
+	// knownCredentials are the credentials of each target and profile as of
+	// the last load or write, used to erase those that are no longer in use.
+	knownCredentials map[credentialhelper.Key]credentialhelper.Credentials
+}
+
+// profileData holds the fields of Data that are stored in a named profile.
+type profileData struct {
+	Target                   string
+	APIVersion               string
+	AuthorizationEndpoint    string
+	DopplerEndPoint          string
+	UaaEndpoint              string
+	RoutingAPIEndpoint       string
+	AccessToken              string
+	RefreshToken             string
+	SSHOAuthClient           string
+	UAAOAuthClient           string
+	UAAOAuthClientSecret     string
+	UAAGrantType             string
+	OrganizationFields       models.OrganizationFields
+	SpaceFields              models.SpaceFields
+	SSLDisabled              bool
+	MinCLIVersion            string
+	MinRecommendedCLIVersion string
 }
 
 func NewData() *Data {
@@ -55,7 +97,28 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
-	return json.MarshalIndent(d, "", "  ")
+	persisted := *d
+
+	if d.profileOverride != "" {
+		rawProfile, err := json.Marshal(d.profile())
+		if err != nil {
+			return nil, err
+		}
+
+		persisted.Profiles = map[string]json.RawMessage{}
+		for name, profile := range d.Profiles {
+			persisted.Profiles[name] = profile
+		}
+		persisted.Profiles[d.profileOverride] = rawProfile
+		persisted.applyProfile(d.defaultProfile)
+	}
+
+	err := d.storeCredentials(&persisted)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(persisted, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -69,5 +132,76 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	err = d.loadCredentials()
+	if err != nil {
+		return err
+	}
+
+	if profileName := os.Getenv("CF_PROFILE"); profileName != "" {
+		return d.overrideProfile(profileName)
+	}
+
+	return nil
+}
+
+// overrideProfile targets the named profile instead of the default target.
+// Changes to the targeting information are written back to the profile.
+func (d *Data) overrideProfile(name string) error {
+	rawProfile, found := d.Profiles[name]
+	if !found {
+		return fmt.Errorf("Profile %s does not exist.", name)
+	}
+
+	var profile profileData
+	err := json.Unmarshal(rawProfile, &profile)
+	if err != nil {
+		return err
+	}
+
+	d.defaultProfile = d.profile()
+	d.profileOverride = name
+	d.applyProfile(profile)
 	return nil
 }
+
+func (d *Data) profile() profileData {
+	return profileData{
+		Target:                   d.Target,
+		APIVersion:               d.APIVersion,
+		AuthorizationEndpoint:    d.AuthorizationEndpoint,
+		DopplerEndPoint:          d.DopplerEndPoint,
+		UaaEndpoint:              d.UaaEndpoint,
+		RoutingAPIEndpoint:       d.RoutingAPIEndpoint,
+		AccessToken:              d.AccessToken,
+		RefreshToken:             d.RefreshToken,
+		SSHOAuthClient:           d.SSHOAuthClient,
+		UAAOAuthClient:           d.UAAOAuthClient,
+		UAAOAuthClientSecret:     d.UAAOAuthClientSecret,
+		UAAGrantType:             d.UAAGrantType,
+		OrganizationFields:       d.OrganizationFields,
+		SpaceFields:              d.SpaceFields,
+		SSLDisabled:              d.SSLDisabled,
+		MinCLIVersion:            d.MinCLIVersion,
+		MinRecommendedCLIVersion: d.MinRecommendedCLIVersion,
+	}
+}
+
+func (d *Data) applyProfile(profile profileData) {
+	d.Target = profile.Target
+	d.APIVersion = profile.APIVersion
+	d.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	d.DopplerEndPoint = profile.DopplerEndPoint
+	d.UaaEndpoint = profile.UaaEndpoint
+	d.RoutingAPIEndpoint = profile.RoutingAPIEndpoint
+	d.AccessToken = profile.AccessToken
+	d.RefreshToken = profile.RefreshToken
+	d.SSHOAuthClient = profile.SSHOAuthClient
+	d.UAAOAuthClient = profile.UAAOAuthClient
+	d.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
+	d.UAAGrantType = profile.UAAGrantType
+	d.OrganizationFields = profile.OrganizationFields
+	d.SpaceFields = profile.SpaceFields
+	d.SSLDisabled = profile.SSLDisabled
+	d.MinCLIVersion = profile.MinCLIVersion
+	d.MinRecommendedCLIVersion = profile.MinRecommendedCLIVersion
+}
@@ -1,8 +1,16 @@
 package coreconfig_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -156,6 +164,28 @@ var _ = Describe("V3 Config files", func() {
 
 			Expect(jsonData).To(MatchJSON(exampleV3JSON))
 		})
+
+		It("keeps the public keys trusted for plugin repositories", func() {
+			repoJSON := `{"ConfigVersion": 3, "PluginRepos": [{"Name": "repo1", "URL": "http://repo.com", "PublicKeys": ["some-key", "other-key"]}]}`
+
+			data := coreconfig.NewData()
+			Expect(data.JSONUnmarshalV3([]byte(repoJSON))).To(Succeed())
+			Expect(data.PluginRepos).To(ConsistOf(models.PluginRepo{
+				Name:       "repo1",
+				URL:        "http://repo.com",
+				PublicKeys: []string{"some-key", "other-key"},
+			}))
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			var written struct {
+				PluginRepos []map[string]interface{}
+			}
+			Expect(json.Unmarshal(jsonData, &written)).To(Succeed())
+			Expect(written.PluginRepos).To(HaveLen(1))
+			Expect(written.PluginRepos[0]).To(HaveKeyWithValue("PublicKeys", []interface{}{"some-key", "other-key"}))
+		})
 	})
 
 	Describe("JSONUnmarshalV3", func() {
@@ -216,5 +246,207 @@ var _ = Describe("V3 Config files", func() {
 
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
+
+		When("CF_PROFILE is set", func() {
+			var profileJSON string
+
+			BeforeEach(func() {
+				profileJSON = `{
+					"ConfigVersion": 3,
+					"Target": "api.dev.com",
+					"AccessToken": "dev-token",
+					"Profiles": {
+						"prod": {
+							"Target": "api.prod.com",
+							"AccessToken": "prod-token",
+							"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"}
+						}
+					}
+				}`
+				Expect(os.Setenv("CF_PROFILE", "prod")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_PROFILE")).To(Succeed())
+			})
+
+			It("targets the profile and writes changes back to it", func() {
+				data := coreconfig.NewData()
+				Expect(data.JSONUnmarshalV3([]byte(profileJSON))).To(Succeed())
+				Expect(data.Target).To(Equal("api.prod.com"))
+				Expect(data.SpaceFields.Name).To(Equal("prod-space"))
+
+				data.AccessToken = "new-prod-token"
+				jsonData, err := data.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				var written struct {
+					Target      string
+					AccessToken string
+					Profiles    map[string]struct{ AccessToken string }
+				}
+				Expect(json.Unmarshal(jsonData, &written)).To(Succeed())
+				Expect(written.Target).To(Equal("api.dev.com"))
+				Expect(written.AccessToken).To(Equal("dev-token"))
+				Expect(written.Profiles["prod"].AccessToken).To(Equal("new-prod-token"))
+			})
+
+			It("reads and writes the same profile fields as configv3", func() {
+				rawProfile, err := json.Marshal(configv3.Profile{
+					Target:                   "api.prod.com",
+					APIVersion:               "2.100.0",
+					AuthorizationEndpoint:    "https://login.prod.com",
+					DopplerEndpoint:          "wss://doppler.prod.com",
+					UAAEndpoint:              "https://uaa.prod.com",
+					RoutingEndpoint:          "https://routing.prod.com",
+					AccessToken:              "prod-token",
+					RefreshToken:             "prod-refresh-token",
+					SSHOAuthClient:           "ssh-proxy",
+					UAAOAuthClient:           "cf",
+					UAAOAuthClientSecret:     "secret",
+					UAAGrantType:             "password",
+					SkipSSLValidation:        true,
+					MinCLIVersion:            "6.0.0",
+					MinRecommendedCLIVersion: "6.1.0",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				profileJSON = fmt.Sprintf(`{"ConfigVersion": 3, "Profiles": {"prod": %s}}`, rawProfile)
+
+				data := coreconfig.NewData()
+				Expect(data.JSONUnmarshalV3([]byte(profileJSON))).To(Succeed())
+				jsonData, err := data.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				var written struct {
+					Profiles map[string]map[string]interface{}
+				}
+				Expect(json.Unmarshal(jsonData, &written)).To(Succeed())
+				var expected map[string]interface{}
+				Expect(json.Unmarshal(rawProfile, &expected)).To(Succeed())
+
+				for key, value := range expected {
+					Expect(written.Profiles["prod"]).To(HaveKey(key))
+					if _, isObject := value.(map[string]interface{}); !isObject {
+						Expect(written.Profiles["prod"][key]).To(Equal(value), key)
+					}
+				}
+				Expect(written.Profiles["prod"]).To(HaveLen(len(expected)))
+			})
+
+			It("returns an error when the profile does not exist", func() {
+				Expect(os.Setenv("CF_PROFILE", "staging")).To(Succeed())
+				data := coreconfig.NewData()
+				Expect(data.JSONUnmarshalV3([]byte(profileJSON))).To(MatchError("Profile staging does not exist."))
+			})
+		})
+	})
+
+	When("a credential helper is configured", func() {
+		var homeDir string
+
+		BeforeEach(func() {
+			var err error
+			homeDir, err = ioutil.TempDir("", "cf-credential-helper")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Setenv("CF_HOME", homeDir)).To(Succeed())
+			Expect(os.Setenv("CF_CREDENTIAL_HELPER", "encrypted-file")).To(Succeed())
+			Expect(os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+			Expect(os.Unsetenv("CF_CREDENTIAL_HELPER")).To(Succeed())
+			Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+			Expect(os.RemoveAll(homeDir)).To(Succeed())
+		})
+
+		It("keeps tokens out of the JSON and reads them back from the helper", func() {
+			data := coreconfig.NewData()
+			data.Target = "api.example.com"
+			data.AccessToken = "some-access-token"
+			data.RefreshToken = "some-refresh-token"
+			data.Profiles = map[string]json.RawMessage{
+				"prod": json.RawMessage(`{"Target": "api.prod.com", "AccessToken": "prod-token"}`),
+			}
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(jsonData)).NotTo(ContainSubstring("some-access-token"))
+			Expect(string(jsonData)).NotTo(ContainSubstring("some-refresh-token"))
+			Expect(string(jsonData)).NotTo(ContainSubstring("prod-token"))
+			Expect(data.AccessToken).To(Equal("some-access-token"))
+
+			loaded := coreconfig.NewData()
+			Expect(loaded.JSONUnmarshalV3(jsonData)).To(Succeed())
+			Expect(loaded.AccessToken).To(Equal("some-access-token"))
+			Expect(loaded.RefreshToken).To(Equal("some-refresh-token"))
+
+			var prod struct{ AccessToken string }
+			Expect(json.Unmarshal(loaded.Profiles["prod"], &prod)).To(Succeed())
+			Expect(prod.AccessToken).To(Equal("prod-token"))
+		})
+
+		It("erases the tokens stored for the old target when the target changes", func() {
+			data := coreconfig.NewData()
+			data.Target = "api.example.com"
+			data.AccessToken = "some-access-token"
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			loaded := coreconfig.NewData()
+			Expect(loaded.JSONUnmarshalV3(jsonData)).To(Succeed())
+			loaded.Target = "api.other.com"
+			loaded.AccessToken = "other-access-token"
+			_, err = loaded.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			helper := credentialhelper.New(credentialhelper.EncryptedFileHelperName, filepath.Join(homeDir, ".cf"))
+			credentials, err := helper.Get(credentialhelper.Key{ServerURL: "api.example.com"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.Empty()).To(BeTrue())
+
+			credentials, err = helper.Get(credentialhelper.Key{ServerURL: "api.other.com"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.AccessToken).To(Equal("other-access-token"))
+		})
+
+		When("the passphrase is missing and the JSON still holds plaintext tokens", func() {
+			BeforeEach(func() {
+				Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+			})
+
+			It("writes the config and leaves the tokens in the JSON", func() {
+				loaded := coreconfig.NewData()
+				Expect(loaded.JSONUnmarshalV3([]byte(`{"ConfigVersion": 3, "Target": "api.example.com", "AccessToken": "plaintext-token"}`))).To(Succeed())
+
+				jsonData, err := loaded.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(jsonData)).To(ContainSubstring("plaintext-token"))
+			})
+		})
+
+		When("the passphrase is missing when loading the config", func() {
+			It("loads the config without tokens and keeps the stored tokens", func() {
+				data := coreconfig.NewData()
+				data.Target = "api.example.com"
+				data.AccessToken = "some-access-token"
+				jsonData, err := data.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+				loaded := coreconfig.NewData()
+				Expect(loaded.JSONUnmarshalV3(jsonData)).To(Succeed())
+				Expect(loaded.Target).To(Equal("api.example.com"))
+				Expect(loaded.AccessToken).To(BeEmpty())
+
+				jsonData, err = loaded.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")).To(Succeed())
+				loaded = coreconfig.NewData()
+				Expect(loaded.JSONUnmarshalV3(jsonData)).To(Succeed())
+				Expect(loaded.AccessToken).To(Equal("some-access-token"))
+			})
+		})
 	})
 })
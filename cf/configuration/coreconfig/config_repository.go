@@ -80,6 +80,7 @@ type Reader interface {
 	UserGUID() string
 	UserEmail() string
 	IsLoggedIn() bool
+	CredentialsError() error
 	IsSSLDisabled() bool
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
@@ -308,6 +309,15 @@ func (c *ConfigRepository) IsLoggedIn() (loggedIn bool) {
 	return
 }
 
+// CredentialsError returns the error that prevented the credentials from
+// being loaded from the credential helper, such as a missing passphrase.
+func (c *ConfigRepository) CredentialsError() (err error) {
+	c.read(func() {
+		err = c.data.credentialsErr
+	})
+	return
+}
+
 func (c *ConfigRepository) HasOrganization() (hasOrg bool) {
 	c.read(func() {
 		hasOrg = c.data.OrganizationFields.GUID != "" && c.data.OrganizationFields.Name != ""
@@ -190,6 +190,15 @@ type FakeReadWriter struct {
 	isLoggedInReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	CredentialsErrorStub        func() error
+	credentialsErrorMutex       sync.RWMutex
+	credentialsErrorArgsForCall []struct{}
+	credentialsErrorReturns     struct {
+		result1 error
+	}
+	credentialsErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IsSSLDisabledStub        func() bool
 	isSSLDisabledMutex       sync.RWMutex
 	isSSLDisabledArgsForCall []struct{}
@@ -1207,6 +1216,8 @@ func (fake *FakeReadWriter) IsLoggedIn() bool {
 func (fake *FakeReadWriter) IsLoggedInCallCount() int {
 	fake.isLoggedInMutex.RLock()
 	defer fake.isLoggedInMutex.RUnlock()
+	fake.credentialsErrorMutex.RLock()
+	defer fake.credentialsErrorMutex.RUnlock()
 	return len(fake.isLoggedInArgsForCall)
 }
 
@@ -1229,6 +1240,46 @@ func (fake *FakeReadWriter) IsLoggedInReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) CredentialsError() error {
+	fake.credentialsErrorMutex.Lock()
+	ret, specificReturn := fake.credentialsErrorReturnsOnCall[len(fake.credentialsErrorArgsForCall)]
+	fake.credentialsErrorArgsForCall = append(fake.credentialsErrorArgsForCall, struct{}{})
+	fake.recordInvocation("CredentialsError", []interface{}{})
+	fake.credentialsErrorMutex.Unlock()
+	if fake.CredentialsErrorStub != nil {
+		return fake.CredentialsErrorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.credentialsErrorReturns.result1
+}
+
+func (fake *FakeReadWriter) CredentialsErrorCallCount() int {
+	fake.credentialsErrorMutex.RLock()
+	defer fake.credentialsErrorMutex.RUnlock()
+	return len(fake.credentialsErrorArgsForCall)
+}
+
+func (fake *FakeReadWriter) CredentialsErrorReturns(result1 error) {
+	fake.CredentialsErrorStub = nil
+	fake.credentialsErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReadWriter) CredentialsErrorReturnsOnCall(i int, result1 error) {
+	fake.CredentialsErrorStub = nil
+	if fake.credentialsErrorReturnsOnCall == nil {
+		fake.credentialsErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.credentialsErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReadWriter) IsSSLDisabled() bool {
 	fake.isSSLDisabledMutex.Lock()
 	ret, specificReturn := fake.isSSLDisabledReturnsOnCall[len(fake.isSSLDisabledArgsForCall)]
@@ -0,0 +1,214 @@
+package coreconfig
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"code.cloudfoundry.org/cli/cf/configuration/confighelpers"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+)
+
+// profileCredentials are the fields of a raw profile that are kept by the
+// credential helper.
+var profileCredentials = []string{"AccessToken", "RefreshToken", "UAAOAuthClientSecret"}
+
+// credentialHelper returns the helper configured through
+// $CF_CREDENTIAL_HELPER or the CredentialHelper config value, or nil when
+// credentials are stored in config.json.
+func (d *Data) credentialHelper() (credentialhelper.Helper, error) {
+	name := os.Getenv("CF_CREDENTIAL_HELPER")
+	if name == "" {
+		name = d.CredentialHelper
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	configPath, err := confighelpers.DefaultFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return credentialhelper.New(name, filepath.Dir(configPath)), nil
+}
+
+// loadCredentials fills in the credentials of the default target and of
+// every profile from the credential helper. A missing passphrase is kept in
+// credentialsErr instead of failing, so that commands that do not need
+// credentials still work.
+func (d *Data) loadCredentials() error {
+	helper, err := d.credentialHelper()
+	if err != nil || helper == nil {
+		return err
+	}
+
+	names := sortedProfileNames(d.Profiles)
+	profiles := map[string]map[string]json.RawMessage{}
+	keys := []credentialhelper.Key{{ServerURL: d.Target}}
+	for _, name := range names {
+		var fields map[string]json.RawMessage
+		err = json.Unmarshal(d.Profiles[name], &fields)
+		if err != nil {
+			return err
+		}
+		profiles[name] = fields
+		keys = append(keys, credentialhelper.Key{ServerURL: profileTarget(fields), Profile: name})
+	}
+
+	allCredentials, err := credentialhelper.GetAll(helper, keys)
+	if _, ok := err.(credentialhelper.MissingPassphraseError); ok {
+		d.credentialsErr = err
+		d.knownCredentials, err = d.allCredentials()
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	credentials := allCredentials[keys[0]]
+	if credentials.AccessToken != "" {
+		d.AccessToken = credentials.AccessToken
+	}
+	if credentials.RefreshToken != "" {
+		d.RefreshToken = credentials.RefreshToken
+	}
+	if credentials.UAAOAuthClientSecret != "" {
+		d.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	}
+
+	for i, name := range names {
+		fields := profiles[name]
+		credentials = allCredentials[keys[i+1]]
+		for field, value := range map[string]string{
+			"AccessToken":          credentials.AccessToken,
+			"RefreshToken":         credentials.RefreshToken,
+			"UAAOAuthClientSecret": credentials.UAAOAuthClientSecret,
+		} {
+			if value != "" {
+				fields[field], _ = json.Marshal(value)
+			}
+		}
+
+		d.Profiles[name], err = json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.knownCredentials, err = d.allCredentials()
+	return err
+}
+
+// storeCredentials hands the credentials of the default target and of every
+// profile in persisted to the credential helper and removes them from
+// persisted. Profiles are copied so the in-memory config keeps its tokens.
+// Credentials of targets and profiles that are no longer in persisted are
+// erased. When the credentials could not be loaded, only changed credentials
+// are stored; unchanged ones stay in persisted since the helper cannot be
+// reached without the passphrase.
+func (d *Data) storeCredentials(persisted *Data) error {
+	helper, err := d.credentialHelper()
+	if err != nil || helper == nil {
+		return err
+	}
+
+	current, err := persisted.allCredentials()
+	if err != nil {
+		return err
+	}
+
+	if persisted.Profiles != nil {
+		profiles := persisted.Profiles
+		persisted.Profiles = map[string]json.RawMessage{}
+		for name, profile := range profiles {
+			persisted.Profiles[name] = profile
+		}
+	}
+
+	changes := credentialhelper.Changes(d.knownCredentials, current, d.credentialsErr == nil)
+	for key := range changes {
+		err = persisted.clearCredentials(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = credentialhelper.StoreAll(helper, changes)
+	if err != nil {
+		return err
+	}
+
+	if d.credentialsErr == nil {
+		d.knownCredentials = current
+	}
+	return nil
+}
+
+// allCredentials returns the credentials of the default target and of every
+// profile.
+func (d *Data) allCredentials() (map[credentialhelper.Key]credentialhelper.Credentials, error) {
+	allCredentials := map[credentialhelper.Key]credentialhelper.Credentials{
+		{ServerURL: d.Target}: {
+			AccessToken:          d.AccessToken,
+			RefreshToken:         d.RefreshToken,
+			UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+		},
+	}
+
+	for name, rawProfile := range d.Profiles {
+		var fields map[string]json.RawMessage
+		err := json.Unmarshal(rawProfile, &fields)
+		if err != nil {
+			return nil, err
+		}
+
+		var credentials credentialhelper.Credentials
+		_ = json.Unmarshal(fields["AccessToken"], &credentials.AccessToken)
+		_ = json.Unmarshal(fields["RefreshToken"], &credentials.RefreshToken)
+		_ = json.Unmarshal(fields["UAAOAuthClientSecret"], &credentials.UAAOAuthClientSecret)
+		allCredentials[credentialhelper.Key{ServerURL: profileTarget(fields), Profile: name}] = credentials
+	}
+	return allCredentials, nil
+}
+
+// clearCredentials removes the credentials of key.
+func (d *Data) clearCredentials(key credentialhelper.Key) error {
+	if key.Profile == "" {
+		if key.ServerURL == d.Target {
+			d.AccessToken, d.RefreshToken, d.UAAOAuthClientSecret = "", "", ""
+		}
+		return nil
+	}
+
+	rawProfile, found := d.Profiles[key.Profile]
+	if !found {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(rawProfile, &fields)
+	if err != nil || profileTarget(fields) != key.ServerURL {
+		return err
+	}
+
+	for _, field := range profileCredentials {
+		fields[field] = json.RawMessage(`""`)
+	}
+	d.Profiles[key.Profile], err = json.Marshal(fields)
+	return err
+}
+
+func profileTarget(fields map[string]json.RawMessage) string {
+	var target string
+	_ = json.Unmarshal(fields["Target"], &target)
+	return target
+}
+
+func sortedProfileNames(profiles map[string]json.RawMessage) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,6 +1,7 @@
 package models
 
 type PluginRepo struct {
-	Name string
-	URL  string
+	Name       string
+	URL        string
+	PublicKeys []string `json:",omitempty"`
 }
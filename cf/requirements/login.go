@@ -23,6 +23,9 @@ func (req LoginRequirement) Execute() error {
 	}
 
 	if !req.config.IsLoggedIn() {
+		if err := req.config.CredentialsError(); err != nil {
+			return err
+		}
 		return errors.New(terminal.NotLoggedInText())
 	}
 
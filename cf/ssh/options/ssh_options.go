@@ -29,6 +29,8 @@ type SSHOptions struct {
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
 	ForwardSpecs        []ForwardSpec
+	RemoteForwardSpecs  []ForwardSpec
+	DynamicForwardSpecs []string
 }
 
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
@@ -50,6 +52,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseRemoteForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			listenAddress, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardSpecs = append(sshOptions.DynamicForwardSpecs, listenAddress)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -66,17 +88,22 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 }
 
 func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
-	arg = strings.TrimSpace(arg)
+	return parseForwardingSpec(arg, "local")
+}
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
-		if err != nil {
-			return nil, err
-		}
+// parseRemoteForwardingSpec parses the same form as a local forward, but the
+// listen address is on the application container and the connect address is
+// reached from the local machine.
+func (o *SSHOptions) parseRemoteForwardingSpec(arg string) (*ForwardSpec, error) {
+	return parseForwardingSpec(arg, "remote")
+}
 
-		parts = append(parts, part)
-		remainder = r
+func parseForwardingSpec(arg string, direction string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardSpec(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +118,49 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse %s forwarding argument: %q", direction, arg)
 	}
 
 	return forwardSpec, nil
 }
 
+// parseDynamicForwardingSpec parses [bind_address:]port and returns the local
+// address to serve SOCKS connections on.
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (string, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := tokenizeForwardSpec(arg)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
+		}
+		return fmt.Sprintf("%s:%s", parts[0], parts[1]), nil
+	case 1:
+		return fmt.Sprintf("localhost:%s", parts[0]), nil
+	default:
+		return "", fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
+}
+
+func tokenizeForwardSpec(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
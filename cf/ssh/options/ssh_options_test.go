@@ -20,6 +20,8 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("R", "", "")
+			fc.NewStringSliceFlag("D", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
@@ -281,6 +283,85 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"}))
+				})
+			})
+
+			Context("with * as the bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "*:9999:localhost:8888", "-R", "[::1]:8080:[2001:db8::1]:80")
+				})
+
+				It("sets the remote forward specs", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(
+						options.ForwardSpec{ListenAddress: ":9999", ConnectAddress: "localhost:8888"},
+						options.ForwardSpec{ListenAddress: "[::1]:8080", ConnectAddress: "[2001:db8::1]:80"},
+					))
+				})
+			})
+
+			Context("when the spec cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999:localhost"`))
+				})
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("with only a port", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080")
+				})
+
+				It("listens on localhost", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf("localhost:1080"))
+				})
+			})
+
+			Context("with explicit bind addresses", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "*:1080", "-D", "[::1]:1081", "-D", "0.0.0.0:1082")
+				})
+
+				It("listens on the bind addresses", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf(":1080", "[::1]:1081", "0.0.0.0:1082"))
+				})
+			})
+
+			Context("when the spec cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "localhost:1080:remote")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "localhost:1080:remote"`))
+				})
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
@@ -23,6 +23,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/util/clissh/socks"
 	"github.com/moby/moby/pkg/term"
 )
 
@@ -38,6 +39,8 @@ type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
 	Wait() error
 	Close() error
 }
@@ -54,6 +57,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -90,7 +94,8 @@ type secureShell struct {
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	localListeners  []net.Listener
+	remoteListeners []net.Listener
 }
 
 func NewSecureShell(
@@ -113,6 +118,7 @@ func NewSecureShell(
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
 		localListeners:         []net.Listener{},
+		remoteListeners:        []net.Listener{},
 	}
 }
 
@@ -144,6 +150,9 @@ func (c *secureShell) Close() error {
 	for _, listener := range c.localListeners {
 		_ = listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		_ = listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -161,7 +170,44 @@ func (c *secureShell) LocalPortForward() error {
 	return nil
 }
 
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+func (c *secureShell) DynamicPortForward() error {
+	for _, listenAddress := range c.opts.DynamicForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", listenAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go acceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
 func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func acceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,7 +220,7 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -183,7 +229,25 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 
 	target, err := c.secureClient.Dial("tcp", targetAddr)
 	if err != nil {
-		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		fmt.Fprintf(os.Stderr, "connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
+func (c *secureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
@@ -196,6 +260,13 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	wg.Wait()
 }
 
+func (c *secureShell) handleDynamicForwardConnection(conn net.Conn) {
+	err := socks.Serve(conn, c.secureClient.Dial)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dynamic forward failed: %s\n", err.Error())
+	}
+}
+
 func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
 	_, _ = io.Copy(dest, src)
 	_ = dest.Close()
@@ -473,6 +544,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -1150,6 +1150,173 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+		)
+
+		BeforeEach(func() {
+			echoListener = startEchoServer()
+
+			var err error
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: echoListener.Addr().String(),
+				}},
+			}
+
+			currentApp.State = "STARTED"
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+		})
+
+		It("listens on the remote address through the secure client", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between remote connections and the local connect address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			expectEcho(conn, "Hello from the app instance\n")
+		})
+
+		It("closes the remote listener when the shell is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+
+			_, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when listening on the remote address fails", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardError).To(MatchError("tcpip-forward request denied"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts                *options.SSHOptions
+			dynamicForwardError error
+
+			echoListener  net.Listener
+			socksListener net.Listener
+		)
+
+		BeforeEach(func() {
+			echoListener = startEchoServer()
+
+			var err error
+			socksListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(socksListener, nil)
+			fakeSecureClient.DialStub = net.Dial
+
+			opts = &options.SSHOptions{
+				AppName:             "app-1",
+				DynamicForwardSpecs: []string{"localhost:1080"},
+			}
+
+			currentApp.State = "STARTED"
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			dynamicForwardError = secureShell.DynamicPortForward()
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+		})
+
+		It("listens on the local address", func() {
+			Expect(dynamicForwardError).NotTo(HaveOccurred())
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the address requested by the SOCKS client through the secure client", func() {
+			conn, err := net.Dial("tcp", socksListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			echoAddr := echoListener.Addr().(*net.TCPAddr)
+			_, err = conn.Write([]byte{5, 1, 0})
+			Expect(err).NotTo(HaveOccurred())
+			reply := make([]byte, 2)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply).To(Equal([]byte{5, 0}))
+
+			request := append([]byte{5, 1, 0, 1}, echoAddr.IP.To4()...)
+			request = append(request, byte(echoAddr.Port>>8), byte(echoAddr.Port))
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+			reply = make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply[1]).To(BeEquivalentTo(0))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			expectEcho(conn, "Hello through SOCKS\n")
+		})
+
+		Context("when listening fails", func() {
+			BeforeEach(func() {
+				socksListener.Close()
+				fakeListenerFactory.ListenReturns(nil, errors.New("address in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(dynamicForwardError).To(MatchError("address in use"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
@@ -1247,3 +1414,33 @@ var _ = Describe("SSH", func() {
 		})
 	})
 })
+
+func startEchoServer() net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	return listener
+}
+
+func expectEcho(conn net.Conn, msg string) {
+	_, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+
+	response := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(string(response)).To(Equal(msg))
+}
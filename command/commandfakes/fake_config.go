@@ -2,11 +2,11 @@
 package commandfakes
 
 import (
-	"sync"
-	"time"
+	sync "sync"
+	time "time"
 
-	"code.cloudfoundry.org/cli/command"
-	"code.cloudfoundry.org/cli/util/configv3"
+	command "code.cloudfoundry.org/cli/command"
+	configv3 "code.cloudfoundry.org/cli/util/configv3"
 )
 
 type FakeConfig struct {
@@ -41,6 +41,12 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	AddPluginRepositoryPublicKeysStub        func(string, []string)
+	addPluginRepositoryPublicKeysMutex       sync.RWMutex
+	addPluginRepositoryPublicKeysArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
 	binaryNameArgsForCall []struct {
@@ -61,6 +67,16 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	BitsCacheDirStub        func() string
+	bitsCacheDirMutex       sync.RWMutex
+	bitsCacheDirArgsForCall []struct {
+	}
+	bitsCacheDirReturns struct {
+		result1 string
+	}
+	bitsCacheDirReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CFPasswordStub        func() string
 	cFPasswordMutex       sync.RWMutex
 	cFPasswordArgsForCall []struct {
@@ -91,6 +107,26 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CredentialsErrorStub        func() error
+	credentialsErrorMutex       sync.RWMutex
+	credentialsErrorArgsForCall []struct {
+	}
+	credentialsErrorReturns struct {
+		result1 error
+	}
+	credentialsErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CurrentProfileStub        func() string
+	currentProfileMutex       sync.RWMutex
+	currentProfileArgsForCall []struct {
+	}
+	currentProfileReturns struct {
+		result1 string
+	}
+	currentProfileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -115,6 +151,11 @@ type FakeConfig struct {
 		result1 string
 		result2 error
 	}
+	DeleteProfileStub        func(string)
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		arg1 string
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct {
@@ -181,6 +222,19 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	GetProfileStub        func(string) (configv3.Profile, bool)
+	getProfileMutex       sync.RWMutex
+	getProfileArgsForCall []struct {
+		arg1 string
+	}
+	getProfileReturns struct {
+		result1 configv3.Profile
+		result2 bool
+	}
+	getProfileReturnsOnCall map[int]struct {
+		result1 configv3.Profile
+		result2 bool
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -261,6 +315,19 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginVersionPinStub        func(string) (string, bool)
+	pluginVersionPinMutex       sync.RWMutex
+	pluginVersionPinArgsForCall []struct {
+		arg1 string
+	}
+	pluginVersionPinReturns struct {
+		result1 string
+		result2 bool
+	}
+	pluginVersionPinReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct {
@@ -281,6 +348,16 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	ProfilesStub        func() []configv3.Profile
+	profilesMutex       sync.RWMutex
+	profilesArgsForCall []struct {
+	}
+	profilesReturns struct {
+		result1 []configv3.Profile
+	}
+	profilesReturnsOnCall map[int]struct {
+		result1 []configv3.Profile
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -296,6 +373,21 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemovePluginVersionPinStub        func(string)
+	removePluginVersionPinMutex       sync.RWMutex
+	removePluginVersionPinArgsForCall []struct {
+		arg1 string
+	}
+	RequestRetryBaseDelayStub        func() time.Duration
+	requestRetryBaseDelayMutex       sync.RWMutex
+	requestRetryBaseDelayArgsForCall []struct {
+	}
+	requestRetryBaseDelayReturns struct {
+		result1 time.Duration
+	}
+	requestRetryBaseDelayReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct {
@@ -306,6 +398,36 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RequestRetryJitterStub        func() float64
+	requestRetryJitterMutex       sync.RWMutex
+	requestRetryJitterArgsForCall []struct {
+	}
+	requestRetryJitterReturns struct {
+		result1 float64
+	}
+	requestRetryJitterReturnsOnCall map[int]struct {
+		result1 float64
+	}
+	RequestRetryMaxDelayStub        func() time.Duration
+	requestRetryMaxDelayMutex       sync.RWMutex
+	requestRetryMaxDelayArgsForCall []struct {
+	}
+	requestRetryMaxDelayReturns struct {
+		result1 time.Duration
+	}
+	requestRetryMaxDelayReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	RequestRetrySafePOSTsStub        func() bool
+	requestRetrySafePOSTsMutex       sync.RWMutex
+	requestRetrySafePOSTsArgsForCall []struct {
+	}
+	requestRetrySafePOSTsReturns struct {
+		result1 bool
+	}
+	requestRetrySafePOSTsReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RoutingEndpointStub        func() string
 	routingEndpointMutex       sync.RWMutex
 	routingEndpointArgsForCall []struct {
@@ -326,6 +448,11 @@ type FakeConfig struct {
 	sSHOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SaveProfileStub        func(string)
+	saveProfileMutex       sync.RWMutex
+	saveProfileArgsForCall []struct {
+		arg1 string
+	}
 	SetAccessTokenStub        func(string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -337,6 +464,12 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetPluginVersionPinStub        func(string, string)
+	setPluginVersionPinMutex       sync.RWMutex
+	setPluginVersionPinArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
@@ -413,6 +546,11 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	SwitchProfileStub        func(string)
+	switchProfileMutex       sync.RWMutex
+	switchProfileArgsForCall []struct {
+		arg1 string
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct {
@@ -453,6 +591,16 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TaskSchedulesFilePathStub        func() string
+	taskSchedulesFilePathMutex       sync.RWMutex
+	taskSchedulesFilePathArgsForCall []struct {
+	}
+	taskSchedulesFilePathReturns struct {
+		result1 string
+	}
+	taskSchedulesFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct {
@@ -704,6 +852,43 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) AddPluginRepositoryPublicKeys(arg1 string, arg2 []string) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	fake.addPluginRepositoryPublicKeysArgsForCall = append(fake.addPluginRepositoryPublicKeysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddPluginRepositoryPublicKeys", []interface{}{arg1, arg2Copy})
+	fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	if fake.AddPluginRepositoryPublicKeysStub != nil {
+		fake.AddPluginRepositoryPublicKeysStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) AddPluginRepositoryPublicKeysCallCount() int {
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
+	return len(fake.addPluginRepositoryPublicKeysArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginRepositoryPublicKeysCalls(stub func(string, []string)) {
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	defer fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	fake.AddPluginRepositoryPublicKeysStub = stub
+}
+
+func (fake *FakeConfig) AddPluginRepositoryPublicKeysArgsForCall(i int) (string, []string) {
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
+	argsForCall := fake.addPluginRepositoryPublicKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) BinaryName() string {
 	fake.binaryNameMutex.Lock()
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
@@ -808,6 +993,58 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) BitsCacheDir() string {
+	fake.bitsCacheDirMutex.Lock()
+	ret, specificReturn := fake.bitsCacheDirReturnsOnCall[len(fake.bitsCacheDirArgsForCall)]
+	fake.bitsCacheDirArgsForCall = append(fake.bitsCacheDirArgsForCall, struct {
+	}{})
+	fake.recordInvocation("BitsCacheDir", []interface{}{})
+	fake.bitsCacheDirMutex.Unlock()
+	if fake.BitsCacheDirStub != nil {
+		return fake.BitsCacheDirStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.bitsCacheDirReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) BitsCacheDirCallCount() int {
+	fake.bitsCacheDirMutex.RLock()
+	defer fake.bitsCacheDirMutex.RUnlock()
+	return len(fake.bitsCacheDirArgsForCall)
+}
+
+func (fake *FakeConfig) BitsCacheDirCalls(stub func() string) {
+	fake.bitsCacheDirMutex.Lock()
+	defer fake.bitsCacheDirMutex.Unlock()
+	fake.BitsCacheDirStub = stub
+}
+
+func (fake *FakeConfig) BitsCacheDirReturns(result1 string) {
+	fake.bitsCacheDirMutex.Lock()
+	defer fake.bitsCacheDirMutex.Unlock()
+	fake.BitsCacheDirStub = nil
+	fake.bitsCacheDirReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) BitsCacheDirReturnsOnCall(i int, result1 string) {
+	fake.bitsCacheDirMutex.Lock()
+	defer fake.bitsCacheDirMutex.Unlock()
+	fake.BitsCacheDirStub = nil
+	if fake.bitsCacheDirReturnsOnCall == nil {
+		fake.bitsCacheDirReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.bitsCacheDirReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CFPassword() string {
 	fake.cFPasswordMutex.Lock()
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
@@ -964,6 +1201,110 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CredentialsError() error {
+	fake.credentialsErrorMutex.Lock()
+	ret, specificReturn := fake.credentialsErrorReturnsOnCall[len(fake.credentialsErrorArgsForCall)]
+	fake.credentialsErrorArgsForCall = append(fake.credentialsErrorArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialsError", []interface{}{})
+	fake.credentialsErrorMutex.Unlock()
+	if fake.CredentialsErrorStub != nil {
+		return fake.CredentialsErrorStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialsErrorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CredentialsErrorCallCount() int {
+	fake.credentialsErrorMutex.RLock()
+	defer fake.credentialsErrorMutex.RUnlock()
+	return len(fake.credentialsErrorArgsForCall)
+}
+
+func (fake *FakeConfig) CredentialsErrorCalls(stub func() error) {
+	fake.credentialsErrorMutex.Lock()
+	defer fake.credentialsErrorMutex.Unlock()
+	fake.CredentialsErrorStub = stub
+}
+
+func (fake *FakeConfig) CredentialsErrorReturns(result1 error) {
+	fake.credentialsErrorMutex.Lock()
+	defer fake.credentialsErrorMutex.Unlock()
+	fake.CredentialsErrorStub = nil
+	fake.credentialsErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CredentialsErrorReturnsOnCall(i int, result1 error) {
+	fake.credentialsErrorMutex.Lock()
+	defer fake.credentialsErrorMutex.Unlock()
+	fake.CredentialsErrorStub = nil
+	if fake.credentialsErrorReturnsOnCall == nil {
+		fake.credentialsErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.credentialsErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentProfile() string {
+	fake.currentProfileMutex.Lock()
+	ret, specificReturn := fake.currentProfileReturnsOnCall[len(fake.currentProfileArgsForCall)]
+	fake.currentProfileArgsForCall = append(fake.currentProfileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentProfile", []interface{}{})
+	fake.currentProfileMutex.Unlock()
+	if fake.CurrentProfileStub != nil {
+		return fake.CurrentProfileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.currentProfileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CurrentProfileCallCount() int {
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
+	return len(fake.currentProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentProfileCalls(stub func() string) {
+	fake.currentProfileMutex.Lock()
+	defer fake.currentProfileMutex.Unlock()
+	fake.CurrentProfileStub = stub
+}
+
+func (fake *FakeConfig) CurrentProfileReturns(result1 string) {
+	fake.currentProfileMutex.Lock()
+	defer fake.currentProfileMutex.Unlock()
+	fake.CurrentProfileStub = nil
+	fake.currentProfileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentProfileReturnsOnCall(i int, result1 string) {
+	fake.currentProfileMutex.Lock()
+	defer fake.currentProfileMutex.Unlock()
+	fake.CurrentProfileStub = nil
+	if fake.currentProfileReturnsOnCall == nil {
+		fake.currentProfileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentProfileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1074,6 +1415,37 @@ func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) DeleteProfile(arg1 string) {
+	fake.deleteProfileMutex.Lock()
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteProfile", []interface{}{arg1})
+	fake.deleteProfileMutex.Unlock()
+	if fake.DeleteProfileStub != nil {
+		fake.DeleteProfileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteProfileCalls(stub func(string)) {
+	fake.deleteProfileMutex.Lock()
+	defer fake.deleteProfileMutex.Unlock()
+	fake.DeleteProfileStub = stub
+}
+
+func (fake *FakeConfig) DeleteProfileArgsForCall(i int) string {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	argsForCall := fake.deleteProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -1408,6 +1780,69 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) GetProfile(arg1 string) (configv3.Profile, bool) {
+	fake.getProfileMutex.Lock()
+	ret, specificReturn := fake.getProfileReturnsOnCall[len(fake.getProfileArgsForCall)]
+	fake.getProfileArgsForCall = append(fake.getProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetProfile", []interface{}{arg1})
+	fake.getProfileMutex.Unlock()
+	if fake.GetProfileStub != nil {
+		return fake.GetProfileStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getProfileReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) GetProfileCallCount() int {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	return len(fake.getProfileArgsForCall)
+}
+
+func (fake *FakeConfig) GetProfileCalls(stub func(string) (configv3.Profile, bool)) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = stub
+}
+
+func (fake *FakeConfig) GetProfileArgsForCall(i int) string {
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
+	argsForCall := fake.getProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) GetProfileReturns(result1 configv3.Profile, result2 bool) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	fake.getProfileReturns = struct {
+		result1 configv3.Profile
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetProfileReturnsOnCall(i int, result1 configv3.Profile, result2 bool) {
+	fake.getProfileMutex.Lock()
+	defer fake.getProfileMutex.Unlock()
+	fake.GetProfileStub = nil
+	if fake.getProfileReturnsOnCall == nil {
+		fake.getProfileReturnsOnCall = make(map[int]struct {
+			result1 configv3.Profile
+			result2 bool
+		})
+	}
+	fake.getProfileReturnsOnCall[i] = struct {
+		result1 configv3.Profile
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -1824,6 +2259,69 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginVersionPin(arg1 string) (string, bool) {
+	fake.pluginVersionPinMutex.Lock()
+	ret, specificReturn := fake.pluginVersionPinReturnsOnCall[len(fake.pluginVersionPinArgsForCall)]
+	fake.pluginVersionPinArgsForCall = append(fake.pluginVersionPinArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PluginVersionPin", []interface{}{arg1})
+	fake.pluginVersionPinMutex.Unlock()
+	if fake.PluginVersionPinStub != nil {
+		return fake.PluginVersionPinStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pluginVersionPinReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) PluginVersionPinCallCount() int {
+	fake.pluginVersionPinMutex.RLock()
+	defer fake.pluginVersionPinMutex.RUnlock()
+	return len(fake.pluginVersionPinArgsForCall)
+}
+
+func (fake *FakeConfig) PluginVersionPinCalls(stub func(string) (string, bool)) {
+	fake.pluginVersionPinMutex.Lock()
+	defer fake.pluginVersionPinMutex.Unlock()
+	fake.PluginVersionPinStub = stub
+}
+
+func (fake *FakeConfig) PluginVersionPinArgsForCall(i int) string {
+	fake.pluginVersionPinMutex.RLock()
+	defer fake.pluginVersionPinMutex.RUnlock()
+	argsForCall := fake.pluginVersionPinArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) PluginVersionPinReturns(result1 string, result2 bool) {
+	fake.pluginVersionPinMutex.Lock()
+	defer fake.pluginVersionPinMutex.Unlock()
+	fake.PluginVersionPinStub = nil
+	fake.pluginVersionPinReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) PluginVersionPinReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.pluginVersionPinMutex.Lock()
+	defer fake.pluginVersionPinMutex.Unlock()
+	fake.PluginVersionPinStub = nil
+	if fake.pluginVersionPinReturnsOnCall == nil {
+		fake.pluginVersionPinReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.pluginVersionPinReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -1928,6 +2426,58 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) Profiles() []configv3.Profile {
+	fake.profilesMutex.Lock()
+	ret, specificReturn := fake.profilesReturnsOnCall[len(fake.profilesArgsForCall)]
+	fake.profilesArgsForCall = append(fake.profilesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Profiles", []interface{}{})
+	fake.profilesMutex.Unlock()
+	if fake.ProfilesStub != nil {
+		return fake.ProfilesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.profilesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ProfilesCallCount() int {
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
+	return len(fake.profilesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfilesCalls(stub func() []configv3.Profile) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = stub
+}
+
+func (fake *FakeConfig) ProfilesReturns(result1 []configv3.Profile) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = nil
+	fake.profilesReturns = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
+func (fake *FakeConfig) ProfilesReturnsOnCall(i int, result1 []configv3.Profile) {
+	fake.profilesMutex.Lock()
+	defer fake.profilesMutex.Unlock()
+	fake.ProfilesStub = nil
+	if fake.profilesReturnsOnCall == nil {
+		fake.profilesReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Profile
+		})
+	}
+	fake.profilesReturnsOnCall[i] = struct {
+		result1 []configv3.Profile
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -2011,6 +2561,89 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) RemovePluginVersionPin(arg1 string) {
+	fake.removePluginVersionPinMutex.Lock()
+	fake.removePluginVersionPinArgsForCall = append(fake.removePluginVersionPinArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RemovePluginVersionPin", []interface{}{arg1})
+	fake.removePluginVersionPinMutex.Unlock()
+	if fake.RemovePluginVersionPinStub != nil {
+		fake.RemovePluginVersionPinStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) RemovePluginVersionPinCallCount() int {
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	return len(fake.removePluginVersionPinArgsForCall)
+}
+
+func (fake *FakeConfig) RemovePluginVersionPinCalls(stub func(string)) {
+	fake.removePluginVersionPinMutex.Lock()
+	defer fake.removePluginVersionPinMutex.Unlock()
+	fake.RemovePluginVersionPinStub = stub
+}
+
+func (fake *FakeConfig) RemovePluginVersionPinArgsForCall(i int) string {
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	argsForCall := fake.removePluginVersionPinArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelay() time.Duration {
+	fake.requestRetryBaseDelayMutex.Lock()
+	ret, specificReturn := fake.requestRetryBaseDelayReturnsOnCall[len(fake.requestRetryBaseDelayArgsForCall)]
+	fake.requestRetryBaseDelayArgsForCall = append(fake.requestRetryBaseDelayArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetryBaseDelay", []interface{}{})
+	fake.requestRetryBaseDelayMutex.Unlock()
+	if fake.RequestRetryBaseDelayStub != nil {
+		return fake.RequestRetryBaseDelayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetryBaseDelayReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayCallCount() int {
+	fake.requestRetryBaseDelayMutex.RLock()
+	defer fake.requestRetryBaseDelayMutex.RUnlock()
+	return len(fake.requestRetryBaseDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayCalls(stub func() time.Duration) {
+	fake.requestRetryBaseDelayMutex.Lock()
+	defer fake.requestRetryBaseDelayMutex.Unlock()
+	fake.RequestRetryBaseDelayStub = stub
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayReturns(result1 time.Duration) {
+	fake.requestRetryBaseDelayMutex.Lock()
+	defer fake.requestRetryBaseDelayMutex.Unlock()
+	fake.RequestRetryBaseDelayStub = nil
+	fake.requestRetryBaseDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayReturnsOnCall(i int, result1 time.Duration) {
+	fake.requestRetryBaseDelayMutex.Lock()
+	defer fake.requestRetryBaseDelayMutex.Unlock()
+	fake.RequestRetryBaseDelayStub = nil
+	if fake.requestRetryBaseDelayReturnsOnCall == nil {
+		fake.requestRetryBaseDelayReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.requestRetryBaseDelayReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -2063,6 +2696,162 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RequestRetryJitter() float64 {
+	fake.requestRetryJitterMutex.Lock()
+	ret, specificReturn := fake.requestRetryJitterReturnsOnCall[len(fake.requestRetryJitterArgsForCall)]
+	fake.requestRetryJitterArgsForCall = append(fake.requestRetryJitterArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetryJitter", []interface{}{})
+	fake.requestRetryJitterMutex.Unlock()
+	if fake.RequestRetryJitterStub != nil {
+		return fake.RequestRetryJitterStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetryJitterReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryJitterCallCount() int {
+	fake.requestRetryJitterMutex.RLock()
+	defer fake.requestRetryJitterMutex.RUnlock()
+	return len(fake.requestRetryJitterArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryJitterCalls(stub func() float64) {
+	fake.requestRetryJitterMutex.Lock()
+	defer fake.requestRetryJitterMutex.Unlock()
+	fake.RequestRetryJitterStub = stub
+}
+
+func (fake *FakeConfig) RequestRetryJitterReturns(result1 float64) {
+	fake.requestRetryJitterMutex.Lock()
+	defer fake.requestRetryJitterMutex.Unlock()
+	fake.RequestRetryJitterStub = nil
+	fake.requestRetryJitterReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryJitterReturnsOnCall(i int, result1 float64) {
+	fake.requestRetryJitterMutex.Lock()
+	defer fake.requestRetryJitterMutex.Unlock()
+	fake.RequestRetryJitterStub = nil
+	if fake.requestRetryJitterReturnsOnCall == nil {
+		fake.requestRetryJitterReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.requestRetryJitterReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelay() time.Duration {
+	fake.requestRetryMaxDelayMutex.Lock()
+	ret, specificReturn := fake.requestRetryMaxDelayReturnsOnCall[len(fake.requestRetryMaxDelayArgsForCall)]
+	fake.requestRetryMaxDelayArgsForCall = append(fake.requestRetryMaxDelayArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetryMaxDelay", []interface{}{})
+	fake.requestRetryMaxDelayMutex.Unlock()
+	if fake.RequestRetryMaxDelayStub != nil {
+		return fake.RequestRetryMaxDelayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetryMaxDelayReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayCallCount() int {
+	fake.requestRetryMaxDelayMutex.RLock()
+	defer fake.requestRetryMaxDelayMutex.RUnlock()
+	return len(fake.requestRetryMaxDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayCalls(stub func() time.Duration) {
+	fake.requestRetryMaxDelayMutex.Lock()
+	defer fake.requestRetryMaxDelayMutex.Unlock()
+	fake.RequestRetryMaxDelayStub = stub
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayReturns(result1 time.Duration) {
+	fake.requestRetryMaxDelayMutex.Lock()
+	defer fake.requestRetryMaxDelayMutex.Unlock()
+	fake.RequestRetryMaxDelayStub = nil
+	fake.requestRetryMaxDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayReturnsOnCall(i int, result1 time.Duration) {
+	fake.requestRetryMaxDelayMutex.Lock()
+	defer fake.requestRetryMaxDelayMutex.Unlock()
+	fake.RequestRetryMaxDelayStub = nil
+	if fake.requestRetryMaxDelayReturnsOnCall == nil {
+		fake.requestRetryMaxDelayReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.requestRetryMaxDelayReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetrySafePOSTs() bool {
+	fake.requestRetrySafePOSTsMutex.Lock()
+	ret, specificReturn := fake.requestRetrySafePOSTsReturnsOnCall[len(fake.requestRetrySafePOSTsArgsForCall)]
+	fake.requestRetrySafePOSTsArgsForCall = append(fake.requestRetrySafePOSTsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetrySafePOSTs", []interface{}{})
+	fake.requestRetrySafePOSTsMutex.Unlock()
+	if fake.RequestRetrySafePOSTsStub != nil {
+		return fake.RequestRetrySafePOSTsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetrySafePOSTsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetrySafePOSTsCallCount() int {
+	fake.requestRetrySafePOSTsMutex.RLock()
+	defer fake.requestRetrySafePOSTsMutex.RUnlock()
+	return len(fake.requestRetrySafePOSTsArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetrySafePOSTsCalls(stub func() bool) {
+	fake.requestRetrySafePOSTsMutex.Lock()
+	defer fake.requestRetrySafePOSTsMutex.Unlock()
+	fake.RequestRetrySafePOSTsStub = stub
+}
+
+func (fake *FakeConfig) RequestRetrySafePOSTsReturns(result1 bool) {
+	fake.requestRetrySafePOSTsMutex.Lock()
+	defer fake.requestRetrySafePOSTsMutex.Unlock()
+	fake.RequestRetrySafePOSTsStub = nil
+	fake.requestRetrySafePOSTsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetrySafePOSTsReturnsOnCall(i int, result1 bool) {
+	fake.requestRetrySafePOSTsMutex.Lock()
+	defer fake.requestRetrySafePOSTsMutex.Unlock()
+	fake.RequestRetrySafePOSTsStub = nil
+	if fake.requestRetrySafePOSTsReturnsOnCall == nil {
+		fake.requestRetrySafePOSTsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.requestRetrySafePOSTsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) RoutingEndpoint() string {
 	fake.routingEndpointMutex.Lock()
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
@@ -2167,6 +2956,37 @@ func (fake *FakeConfig) SSHOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SaveProfile(arg1 string) {
+	fake.saveProfileMutex.Lock()
+	fake.saveProfileArgsForCall = append(fake.saveProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SaveProfile", []interface{}{arg1})
+	fake.saveProfileMutex.Unlock()
+	if fake.SaveProfileStub != nil {
+		fake.SaveProfileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SaveProfileCallCount() int {
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	return len(fake.saveProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SaveProfileCalls(stub func(string)) {
+	fake.saveProfileMutex.Lock()
+	defer fake.saveProfileMutex.Unlock()
+	fake.SaveProfileStub = stub
+}
+
+func (fake *FakeConfig) SaveProfileArgsForCall(i int) string {
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
+	argsForCall := fake.saveProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetAccessToken(arg1 string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -2230,6 +3050,38 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetPluginVersionPin(arg1 string, arg2 string) {
+	fake.setPluginVersionPinMutex.Lock()
+	fake.setPluginVersionPinArgsForCall = append(fake.setPluginVersionPinArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetPluginVersionPin", []interface{}{arg1, arg2})
+	fake.setPluginVersionPinMutex.Unlock()
+	if fake.SetPluginVersionPinStub != nil {
+		fake.SetPluginVersionPinStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) SetPluginVersionPinCallCount() int {
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	return len(fake.setPluginVersionPinArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginVersionPinCalls(stub func(string, string)) {
+	fake.setPluginVersionPinMutex.Lock()
+	defer fake.setPluginVersionPinMutex.Unlock()
+	fake.SetPluginVersionPinStub = stub
+}
+
+func (fake *FakeConfig) SetPluginVersionPinArgsForCall(i int) (string, string) {
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	argsForCall := fake.setPluginVersionPinArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -2614,6 +3466,37 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchProfile(arg1 string) {
+	fake.switchProfileMutex.Lock()
+	fake.switchProfileArgsForCall = append(fake.switchProfileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SwitchProfile", []interface{}{arg1})
+	fake.switchProfileMutex.Unlock()
+	if fake.SwitchProfileStub != nil {
+		fake.SwitchProfileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SwitchProfileCallCount() int {
+	fake.switchProfileMutex.RLock()
+	defer fake.switchProfileMutex.RUnlock()
+	return len(fake.switchProfileArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchProfileCalls(stub func(string)) {
+	fake.switchProfileMutex.Lock()
+	defer fake.switchProfileMutex.Unlock()
+	fake.SwitchProfileStub = stub
+}
+
+func (fake *FakeConfig) SwitchProfileArgsForCall(i int) string {
+	fake.switchProfileMutex.RLock()
+	defer fake.switchProfileMutex.RUnlock()
+	argsForCall := fake.switchProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -2822,6 +3705,58 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TaskSchedulesFilePath() string {
+	fake.taskSchedulesFilePathMutex.Lock()
+	ret, specificReturn := fake.taskSchedulesFilePathReturnsOnCall[len(fake.taskSchedulesFilePathArgsForCall)]
+	fake.taskSchedulesFilePathArgsForCall = append(fake.taskSchedulesFilePathArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TaskSchedulesFilePath", []interface{}{})
+	fake.taskSchedulesFilePathMutex.Unlock()
+	if fake.TaskSchedulesFilePathStub != nil {
+		return fake.TaskSchedulesFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.taskSchedulesFilePathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathCallCount() int {
+	fake.taskSchedulesFilePathMutex.RLock()
+	defer fake.taskSchedulesFilePathMutex.RUnlock()
+	return len(fake.taskSchedulesFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathCalls(stub func() string) {
+	fake.taskSchedulesFilePathMutex.Lock()
+	defer fake.taskSchedulesFilePathMutex.Unlock()
+	fake.TaskSchedulesFilePathStub = stub
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathReturns(result1 string) {
+	fake.taskSchedulesFilePathMutex.Lock()
+	defer fake.taskSchedulesFilePathMutex.Unlock()
+	fake.TaskSchedulesFilePathStub = nil
+	fake.taskSchedulesFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TaskSchedulesFilePathReturnsOnCall(i int, result1 string) {
+	fake.taskSchedulesFilePathMutex.Lock()
+	defer fake.taskSchedulesFilePathMutex.Unlock()
+	fake.TaskSchedulesFilePathStub = nil
+	if fake.taskSchedulesFilePathReturnsOnCall == nil {
+		fake.taskSchedulesFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.taskSchedulesFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -3249,20 +4184,30 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.bitsCacheDirMutex.RLock()
+	defer fake.bitsCacheDirMutex.RUnlock()
 	fake.cFPasswordMutex.RLock()
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.credentialsErrorMutex.RLock()
+	defer fake.credentialsErrorMutex.RUnlock()
+	fake.currentProfileMutex.RLock()
+	defer fake.currentProfileMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
 	defer fake.currentUserNameMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -3275,6 +4220,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
+	fake.getProfileMutex.RLock()
+	defer fake.getProfileMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
@@ -3291,24 +4238,42 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
+	fake.pluginVersionPinMutex.RLock()
+	defer fake.pluginVersionPinMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	fake.requestRetryBaseDelayMutex.RLock()
+	defer fake.requestRetryBaseDelayMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.requestRetryJitterMutex.RLock()
+	defer fake.requestRetryJitterMutex.RUnlock()
+	fake.requestRetryMaxDelayMutex.RLock()
+	defer fake.requestRetryMaxDelayMutex.RUnlock()
+	fake.requestRetrySafePOSTsMutex.RLock()
+	defer fake.requestRetrySafePOSTsMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
 	defer fake.sSHOAuthClientMutex.RUnlock()
+	fake.saveProfileMutex.RLock()
+	defer fake.saveProfileMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
@@ -3329,6 +4294,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.switchProfileMutex.RLock()
+	defer fake.switchProfileMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
@@ -3337,6 +4304,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationNameMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.taskSchedulesFilePathMutex.RLock()
+	defer fake.taskSchedulesFilePathMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
@@ -5,6 +5,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	"code.cloudfoundry.org/cli/command/v6"
 )
@@ -16,12 +17,15 @@ type V2CommandList struct {
 }
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	Output           flag.OutputFormat `long:"output" description:"Output format for display commands: json, yaml, or table"`
+	Profile          string            `long:"profile" description:"Run the command against the named profile instead of the current target"`
 
 	App                                v6.V3AppCommand                              `command:"app" description:"Display health and status for an app"`
 	V3Apps                             v6.V3AppsCommand                             `command:"v3-apps" description:"List all apps in the target space"`
 	V3ApplyManifest                    v6.V3ApplyManifestCommand                    `command:"v3-apply-manifest" description:"Applies manifest properties to an application"`
 	V3CancelZdtPush                    v6.V3CancelZdtPushCommand                    `command:"v3-cancel-zdt-push" description:"Cancel the most recent deployment for an app"`
+	V3ContinueZdtPush                  v6.V3ContinueZdtPushCommand                  `command:"v3-continue-zdt-push" description:"Continue the paused deployment of an app"`
 	V3CreateApp                        v6.V3CreateAppCommand                        `command:"v3-create-app" description:"Create a V3 App"`
 	V3CreatePackage                    v6.V3CreatePackageCommand                    `command:"v3-create-package" description:"Uploads a V3 Package"`
 	V3DeleteApp                        v6.V3DeleteCommand                           `command:"v3-delete" description:"Delete a V3 App"`
@@ -33,6 +37,7 @@ type commandList struct {
 	V3ZdtPush                          v6.V3ZeroDowntimePushCommand                 `command:"v3-zdt-push" description:"Update an app with zero down time"`
 	V3Restart                          v6.V3RestartCommand                          `command:"v3-restart" description:"Stop all instances of the app, then start them again. This causes downtime."`
 	V3RestartAppInstance               v6.V3RestartAppInstanceCommand               `command:"v3-restart-app-instance" description:"Terminate, then instantiate an app instance"`
+	V3RollbackZdtPush                  v6.V3RollbackZdtPushCommand                  `command:"v3-rollback-zdt-push" description:"Roll back the most recent deployment for an app and wait for it to finish"`
 	V3ZdtRestart                       v6.V3ZeroDowntimeRestartCommand              `command:"v3-zdt-restart" description:"Sequentially restart each instance of an app."`
 	V3Scale                            v6.V3ScaleCommand                            `command:"v3-scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
 	V3SetDroplet                       v6.V3SetDropletCommand                       `command:"v3-set-droplet" description:"Set the droplet used to run an app"`
@@ -63,6 +68,7 @@ type commandList struct {
 	CreateDomain                       v6.CreateDomainCommand                       `command:"create-domain" description:"Create a domain in an org for later use"`
 	CreateIsolationSegment             v6.CreateIsolationSegmentCommand             `command:"create-isolation-segment" description:"Create an isolation segment"`
 	CreateOrg                          v6.CreateOrgCommand                          `command:"create-org" alias:"co" description:"Create an org"`
+	CreateProfile                      v6.CreateProfileCommand                      `command:"create-profile" description:"Save the current target as a named profile"`
 	CreateQuota                        v6.CreateQuotaCommand                        `command:"create-quota" description:"Define a new resource quota"`
 	CreateRoute                        v6.CreateRouteCommand                        `command:"create-route" description:"Create a url route in a space for later use"`
 	CreateSecurityGroup                v6.CreateSecurityGroupCommand                `command:"create-security-group" description:"Create a security group"`
@@ -81,6 +87,7 @@ type commandList struct {
 	DeleteIsolationSegment             v6.DeleteIsolationSegmentCommand             `command:"delete-isolation-segment" description:"Delete an isolation segment"`
 	DeleteOrg                          v6.DeleteOrgCommand                          `command:"delete-org" description:"Delete an org"`
 	DeleteOrphanedRoutes               v6.DeleteOrphanedRoutesCommand               `command:"delete-orphaned-routes" description:"Delete all orphaned routes (i.e. those that are not mapped to an app)"`
+	DeleteProfile                      v6.DeleteProfileCommand                      `command:"delete-profile" description:"Delete a named profile"`
 	DeleteQuota                        v6.DeleteQuotaCommand                        `command:"delete-quota" description:"Delete a quota"`
 	DeleteRoute                        v6.DeleteRouteCommand                        `command:"delete-route" description:"Delete a route"`
 	DeleteSecurityGroup                v6.DeleteSecurityGroupCommand                `command:"delete-security-group" description:"Deletes a security group"`
@@ -91,8 +98,10 @@ type commandList struct {
 	DeleteSharedDomain                 v6.DeleteSharedDomainCommand                 `command:"delete-shared-domain" description:"Delete a shared domain"`
 	DeleteSpaceQuota                   v6.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota definition and unassign the space quota from all spaces"`
 	DeleteSpace                        v6.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
+	DeleteTaskSchedule                 v6.DeleteTaskScheduleCommand                 `command:"delete-task-schedule" description:"Delete a task schedule of an app"`
 	DeleteUser                         v6.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
 	Delete                             v6.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
+	Diagnose                           v6.DiagnoseCommand                           `command:"diagnose" description:"Diagnose why an app is crashing"`
 	DisableFeatureFlag                 v6.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v6.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableServiceAccess               v6.DisableServiceAccessCommand               `command:"disable-service-access" description:"Disable access to a service or service plan for one or all orgs"`
@@ -104,12 +113,14 @@ type commandList struct {
 	EnableServiceAccess                v6.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service or service plan for one or all orgs"`
 	EnableSSH                          v6.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v6.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
-	Events                             v6.EventsCommand                             `command:"events" description:"Show recent app events"`
+	Events                             v6.EventsCommand                             `command:"events" description:"Show recent events for an app, space or org"`
+	ExportOrg                          v6.ExportOrgCommand                          `command:"export-org" description:"Export the configuration of an org as a YAML document"`
 	FeatureFlags                       v6.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	FeatureFlag                        v6.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v6.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v6.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	ImportOrg                          v6.ImportOrgCommand                          `command:"import-org" description:"Create or update an org to match a configuration document"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v6.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	NetworkPolicies                    v6.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
@@ -125,7 +136,9 @@ type commandList struct {
 	OrgUsers                           v6.OrgUsersCommand                           `command:"org-users" description:"Show org users by role"`
 	Org                                v6.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v6.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
+	PluginRepoIndex                    plugin.PluginRepoIndexCommand                `command:"plugin-repo-index" description:"Generate or serve the index of a directory of plugin binaries as a plugin repository"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	Profiles                           v6.ProfilesCommand                           `command:"profiles" description:"List all named profiles"`
 	PurgeServiceInstance               v6.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v6.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v6.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -145,17 +158,22 @@ type commandList struct {
 	Restage                            v6.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.). This action will cause app downtime."`
 	RestartAppInstance                 v6.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate, then restart an app instance"`
 	Restart                            v6.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This causes downtime."`
+	RotateServiceKey                   v6.RotateServiceKeyCommand                   `command:"rotate-service-key" description:"Replace a service key with a new one, then delete the old key"`
 	RouterGroups                       v6.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v6.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v6.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
 	RunningSecurityGroups              v6.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups in the set of security groups for running applications"`
+	RunDueTasks                        v6.RunDueTasksCommand                        `command:"run-due-tasks" description:"Run the scheduled tasks that are due"`
 	RunTask                            v6.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	Scale                              v6.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
+	ScheduleTask                       v6.ScheduleTaskCommand                       `command:"schedule-task" description:"Schedule a recurring task on an app"`
+	SCP                                v6.SCPCommand                                `command:"scp" description:"Copy files to or from an application container instance over SSH"`
 	SecurityGroups                     v6.SecurityGroupsCommand                     `command:"security-groups" description:"List all security groups"`
 	SecurityGroup                      v6.SecurityGroupCommand                      `command:"security-group" description:"Show a single security group"`
 	ServiceAccess                      v6.ServiceAccessCommand                      `command:"service-access" description:"List service access settings"`
 	ServiceAuthTokens                  v6.ServiceAuthTokensCommand                  `command:"service-auth-tokens" description:"List service auth tokens"`
 	ServiceBrokers                     v6.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
+	ServiceGraph                       v6.ServiceGraphCommand                       `command:"service-graph" description:"Show the apps, service keys, spaces and routes that depend on each service instance"`
 	ServiceKeys                        v6.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
 	ServiceKey                         v6.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
 	Services                           v6.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
@@ -187,7 +205,9 @@ type commandList struct {
 	StagingSecurityGroups              v6.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups in the staging set for applications"`
 	Start                              v6.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v6.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
+	SwitchProfile                      v6.SwitchProfileCommand                      `command:"switch-profile" description:"Target a named profile"`
 	Target                             v6.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	TaskSchedules                      v6.TaskSchedulesCommand                      `command:"task-schedules" description:"List task schedules in the target space"`
 	Tasks                              v6.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v6.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
 	UnbindRouteService                 v6.UnbindRouteServiceCommand                 `command:"unbind-route-service" alias:"urs" description:"Unbind a service instance from an HTTP route"`
@@ -211,6 +231,7 @@ type commandList struct {
 	UpdateService                      v6.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v6.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v6.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UpgradePlugins                     UpgradePluginsCommand                        `command:"upgrade-plugins" description:"Upgrade installed plugins to the newest versions in the plugin repositories"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
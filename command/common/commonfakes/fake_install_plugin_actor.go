@@ -144,6 +144,31 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ValidateFileSHA256ChecksumStub        func(string, string) bool
+	validateFileSHA256ChecksumMutex       sync.RWMutex
+	validateFileSHA256ChecksumArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateFileSHA256ChecksumReturns struct {
+		result1 bool
+	}
+	validateFileSHA256ChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	ValidateFileSignatureStub        func(string, string, []string) bool
+	validateFileSignatureMutex       sync.RWMutex
+	validateFileSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	validateFileSignatureReturns struct {
+		result1 bool
+	}
+	validateFileSignatureReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -782,6 +807,134 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) ValidateFileSHA256Checksum(arg1 string, arg2 string) bool {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileSHA256ChecksumReturnsOnCall[len(fake.validateFileSHA256ChecksumArgsForCall)]
+	fake.validateFileSHA256ChecksumArgsForCall = append(fake.validateFileSHA256ChecksumArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ValidateFileSHA256Checksum", []interface{}{arg1, arg2})
+	fake.validateFileSHA256ChecksumMutex.Unlock()
+	if fake.ValidateFileSHA256ChecksumStub != nil {
+		return fake.ValidateFileSHA256ChecksumStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileSHA256ChecksumReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumCallCount() int {
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	return len(fake.validateFileSHA256ChecksumArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumCalls(stub func(string, string) bool) {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	defer fake.validateFileSHA256ChecksumMutex.Unlock()
+	fake.ValidateFileSHA256ChecksumStub = stub
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	argsForCall := fake.validateFileSHA256ChecksumArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumReturns(result1 bool) {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	defer fake.validateFileSHA256ChecksumMutex.Unlock()
+	fake.ValidateFileSHA256ChecksumStub = nil
+	fake.validateFileSHA256ChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumReturnsOnCall(i int, result1 bool) {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	defer fake.validateFileSHA256ChecksumMutex.Unlock()
+	fake.ValidateFileSHA256ChecksumStub = nil
+	if fake.validateFileSHA256ChecksumReturnsOnCall == nil {
+		fake.validateFileSHA256ChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileSHA256ChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignature(arg1 string, arg2 string, arg3 []string) bool {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.validateFileSignatureMutex.Lock()
+	ret, specificReturn := fake.validateFileSignatureReturnsOnCall[len(fake.validateFileSignatureArgsForCall)]
+	fake.validateFileSignatureArgsForCall = append(fake.validateFileSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("ValidateFileSignature", []interface{}{arg1, arg2, arg3Copy})
+	fake.validateFileSignatureMutex.Unlock()
+	if fake.ValidateFileSignatureStub != nil {
+		return fake.ValidateFileSignatureStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileSignatureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureCallCount() int {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	return len(fake.validateFileSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureCalls(stub func(string, string, []string) bool) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = stub
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureArgsForCall(i int) (string, string, []string) {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	argsForCall := fake.validateFileSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureReturns(result1 bool) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = nil
+	fake.validateFileSignatureReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureReturnsOnCall(i int, result1 bool) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = nil
+	if fake.validateFileSignatureReturnsOnCall == nil {
+		fake.validateFileSignatureReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileSignatureReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -805,6 +958,10 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,837 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commonfakes
+
+import (
+	sync "sync"
+
+	pluginaction "code.cloudfoundry.org/cli/actor/pluginaction"
+	plugin "code.cloudfoundry.org/cli/api/plugin"
+	common "code.cloudfoundry.org/cli/command/common"
+	configv3 "code.cloudfoundry.org/cli/util/configv3"
+)
+
+type FakeUpgradePluginsActor struct {
+	CreateExecutableCopyStub        func(string, string) (string, error)
+	createExecutableCopyMutex       sync.RWMutex
+	createExecutableCopyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createExecutableCopyReturns struct {
+		result1 string
+		result2 error
+	}
+	createExecutableCopyReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DownloadExecutableBinaryFromURLStub        func(string, string, plugin.ProxyReader) (string, error)
+	downloadExecutableBinaryFromURLMutex       sync.RWMutex
+	downloadExecutableBinaryFromURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 plugin.ProxyReader
+	}
+	downloadExecutableBinaryFromURLReturns struct {
+		result1 string
+		result2 error
+	}
+	downloadExecutableBinaryFromURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetPlatformStringStub        func(string, string) string
+	getPlatformStringMutex       sync.RWMutex
+	getPlatformStringArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPlatformStringReturns struct {
+		result1 string
+	}
+	getPlatformStringReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetPluginUpgradesStub        func([]configv3.Plugin, []configv3.PluginRepository, string) ([]pluginaction.PluginUpgrade, error)
+	getPluginUpgradesMutex       sync.RWMutex
+	getPluginUpgradesArgsForCall []struct {
+		arg1 []configv3.Plugin
+		arg2 []configv3.PluginRepository
+		arg3 string
+	}
+	getPluginUpgradesReturns struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}
+	getPluginUpgradesReturnsOnCall map[int]struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}
+	RemovePluginVersionPinStub        func(string) error
+	removePluginVersionPinMutex       sync.RWMutex
+	removePluginVersionPinArgsForCall []struct {
+		arg1 string
+	}
+	removePluginVersionPinReturns struct {
+		result1 error
+	}
+	removePluginVersionPinReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetPluginVersionPinStub        func(string, string) error
+	setPluginVersionPinMutex       sync.RWMutex
+	setPluginVersionPinArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setPluginVersionPinReturns struct {
+		result1 error
+	}
+	setPluginVersionPinReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpgradePluginStub        func(pluginaction.PluginMetadata, pluginaction.PluginUninstaller, pluginaction.CommandList, configv3.Plugin, string, string) (configv3.Plugin, error)
+	upgradePluginMutex       sync.RWMutex
+	upgradePluginArgsForCall []struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 pluginaction.PluginUninstaller
+		arg3 pluginaction.CommandList
+		arg4 configv3.Plugin
+		arg5 string
+		arg6 string
+	}
+	upgradePluginReturns struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	upgradePluginReturnsOnCall map[int]struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	ValidateFileChecksumStub        func(string, string) bool
+	validateFileChecksumMutex       sync.RWMutex
+	validateFileChecksumArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateFileChecksumReturns struct {
+		result1 bool
+	}
+	validateFileChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	ValidateFileSHA256ChecksumStub        func(string, string) bool
+	validateFileSHA256ChecksumMutex       sync.RWMutex
+	validateFileSHA256ChecksumArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateFileSHA256ChecksumReturns struct {
+		result1 bool
+	}
+	validateFileSHA256ChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	ValidateFileSignatureStub        func(string, string, []string) bool
+	validateFileSignatureMutex       sync.RWMutex
+	validateFileSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	validateFileSignatureReturns struct {
+		result1 bool
+	}
+	validateFileSignatureReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopy(arg1 string, arg2 string) (string, error) {
+	fake.createExecutableCopyMutex.Lock()
+	ret, specificReturn := fake.createExecutableCopyReturnsOnCall[len(fake.createExecutableCopyArgsForCall)]
+	fake.createExecutableCopyArgsForCall = append(fake.createExecutableCopyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateExecutableCopy", []interface{}{arg1, arg2})
+	fake.createExecutableCopyMutex.Unlock()
+	if fake.CreateExecutableCopyStub != nil {
+		return fake.CreateExecutableCopyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createExecutableCopyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyCallCount() int {
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	return len(fake.createExecutableCopyArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyCalls(stub func(string, string) (string, error)) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyArgsForCall(i int) (string, string) {
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	argsForCall := fake.createExecutableCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyReturns(result1 string, result2 error) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = nil
+	fake.createExecutableCopyReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = nil
+	if fake.createExecutableCopyReturnsOnCall == nil {
+		fake.createExecutableCopyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createExecutableCopyReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURL(arg1 string, arg2 string, arg3 plugin.ProxyReader) (string, error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	ret, specificReturn := fake.downloadExecutableBinaryFromURLReturnsOnCall[len(fake.downloadExecutableBinaryFromURLArgsForCall)]
+	fake.downloadExecutableBinaryFromURLArgsForCall = append(fake.downloadExecutableBinaryFromURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 plugin.ProxyReader
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DownloadExecutableBinaryFromURL", []interface{}{arg1, arg2, arg3})
+	fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	if fake.DownloadExecutableBinaryFromURLStub != nil {
+		return fake.DownloadExecutableBinaryFromURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.downloadExecutableBinaryFromURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLCallCount() int {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return len(fake.downloadExecutableBinaryFromURLArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLCalls(stub func(string, string, plugin.ProxyReader) (string, error)) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLArgsForCall(i int) (string, string, plugin.ProxyReader) {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	argsForCall := fake.downloadExecutableBinaryFromURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLReturns(result1 string, result2 error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	fake.downloadExecutableBinaryFromURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	if fake.downloadExecutableBinaryFromURLReturnsOnCall == nil {
+		fake.downloadExecutableBinaryFromURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.downloadExecutableBinaryFromURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformString(arg1 string, arg2 string) string {
+	fake.getPlatformStringMutex.Lock()
+	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
+	fake.getPlatformStringArgsForCall = append(fake.getPlatformStringArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetPlatformString", []interface{}{arg1, arg2})
+	fake.getPlatformStringMutex.Unlock()
+	if fake.GetPlatformStringStub != nil {
+		return fake.GetPlatformStringStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.getPlatformStringReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringCallCount() int {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return len(fake.getPlatformStringArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringCalls(stub func(string, string) string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringArgsForCall(i int) (string, string) {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	argsForCall := fake.getPlatformStringArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringReturns(result1 string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = nil
+	fake.getPlatformStringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringReturnsOnCall(i int, result1 string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = nil
+	if fake.getPlatformStringReturnsOnCall == nil {
+		fake.getPlatformStringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getPlatformStringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgrades(arg1 []configv3.Plugin, arg2 []configv3.PluginRepository, arg3 string) ([]pluginaction.PluginUpgrade, error) {
+	var arg1Copy []configv3.Plugin
+	if arg1 != nil {
+		arg1Copy = make([]configv3.Plugin, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy []configv3.PluginRepository
+	if arg2 != nil {
+		arg2Copy = make([]configv3.PluginRepository, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getPluginUpgradesMutex.Lock()
+	ret, specificReturn := fake.getPluginUpgradesReturnsOnCall[len(fake.getPluginUpgradesArgsForCall)]
+	fake.getPluginUpgradesArgsForCall = append(fake.getPluginUpgradesArgsForCall, struct {
+		arg1 []configv3.Plugin
+		arg2 []configv3.PluginRepository
+		arg3 string
+	}{arg1Copy, arg2Copy, arg3})
+	fake.recordInvocation("GetPluginUpgrades", []interface{}{arg1Copy, arg2Copy, arg3})
+	fake.getPluginUpgradesMutex.Unlock()
+	if fake.GetPluginUpgradesStub != nil {
+		return fake.GetPluginUpgradesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPluginUpgradesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesCallCount() int {
+	fake.getPluginUpgradesMutex.RLock()
+	defer fake.getPluginUpgradesMutex.RUnlock()
+	return len(fake.getPluginUpgradesArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesCalls(stub func([]configv3.Plugin, []configv3.PluginRepository, string) ([]pluginaction.PluginUpgrade, error)) {
+	fake.getPluginUpgradesMutex.Lock()
+	defer fake.getPluginUpgradesMutex.Unlock()
+	fake.GetPluginUpgradesStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesArgsForCall(i int) ([]configv3.Plugin, []configv3.PluginRepository, string) {
+	fake.getPluginUpgradesMutex.RLock()
+	defer fake.getPluginUpgradesMutex.RUnlock()
+	argsForCall := fake.getPluginUpgradesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesReturns(result1 []pluginaction.PluginUpgrade, result2 error) {
+	fake.getPluginUpgradesMutex.Lock()
+	defer fake.getPluginUpgradesMutex.Unlock()
+	fake.GetPluginUpgradesStub = nil
+	fake.getPluginUpgradesReturns = struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesReturnsOnCall(i int, result1 []pluginaction.PluginUpgrade, result2 error) {
+	fake.getPluginUpgradesMutex.Lock()
+	defer fake.getPluginUpgradesMutex.Unlock()
+	fake.GetPluginUpgradesStub = nil
+	if fake.getPluginUpgradesReturnsOnCall == nil {
+		fake.getPluginUpgradesReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.PluginUpgrade
+			result2 error
+		})
+	}
+	fake.getPluginUpgradesReturnsOnCall[i] = struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) RemovePluginVersionPin(arg1 string) error {
+	fake.removePluginVersionPinMutex.Lock()
+	ret, specificReturn := fake.removePluginVersionPinReturnsOnCall[len(fake.removePluginVersionPinArgsForCall)]
+	fake.removePluginVersionPinArgsForCall = append(fake.removePluginVersionPinArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RemovePluginVersionPin", []interface{}{arg1})
+	fake.removePluginVersionPinMutex.Unlock()
+	if fake.RemovePluginVersionPinStub != nil {
+		return fake.RemovePluginVersionPinStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.removePluginVersionPinReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) RemovePluginVersionPinCallCount() int {
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	return len(fake.removePluginVersionPinArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) RemovePluginVersionPinCalls(stub func(string) error) {
+	fake.removePluginVersionPinMutex.Lock()
+	defer fake.removePluginVersionPinMutex.Unlock()
+	fake.RemovePluginVersionPinStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) RemovePluginVersionPinArgsForCall(i int) string {
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	argsForCall := fake.removePluginVersionPinArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpgradePluginsActor) RemovePluginVersionPinReturns(result1 error) {
+	fake.removePluginVersionPinMutex.Lock()
+	defer fake.removePluginVersionPinMutex.Unlock()
+	fake.RemovePluginVersionPinStub = nil
+	fake.removePluginVersionPinReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) RemovePluginVersionPinReturnsOnCall(i int, result1 error) {
+	fake.removePluginVersionPinMutex.Lock()
+	defer fake.removePluginVersionPinMutex.Unlock()
+	fake.RemovePluginVersionPinStub = nil
+	if fake.removePluginVersionPinReturnsOnCall == nil {
+		fake.removePluginVersionPinReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removePluginVersionPinReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) SetPluginVersionPin(arg1 string, arg2 string) error {
+	fake.setPluginVersionPinMutex.Lock()
+	ret, specificReturn := fake.setPluginVersionPinReturnsOnCall[len(fake.setPluginVersionPinArgsForCall)]
+	fake.setPluginVersionPinArgsForCall = append(fake.setPluginVersionPinArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetPluginVersionPin", []interface{}{arg1, arg2})
+	fake.setPluginVersionPinMutex.Unlock()
+	if fake.SetPluginVersionPinStub != nil {
+		return fake.SetPluginVersionPinStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPluginVersionPinReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) SetPluginVersionPinCallCount() int {
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	return len(fake.setPluginVersionPinArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) SetPluginVersionPinCalls(stub func(string, string) error) {
+	fake.setPluginVersionPinMutex.Lock()
+	defer fake.setPluginVersionPinMutex.Unlock()
+	fake.SetPluginVersionPinStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) SetPluginVersionPinArgsForCall(i int) (string, string) {
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	argsForCall := fake.setPluginVersionPinArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) SetPluginVersionPinReturns(result1 error) {
+	fake.setPluginVersionPinMutex.Lock()
+	defer fake.setPluginVersionPinMutex.Unlock()
+	fake.SetPluginVersionPinStub = nil
+	fake.setPluginVersionPinReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) SetPluginVersionPinReturnsOnCall(i int, result1 error) {
+	fake.setPluginVersionPinMutex.Lock()
+	defer fake.setPluginVersionPinMutex.Unlock()
+	fake.SetPluginVersionPinStub = nil
+	if fake.setPluginVersionPinReturnsOnCall == nil {
+		fake.setPluginVersionPinReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPluginVersionPinReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) UpgradePlugin(arg1 pluginaction.PluginMetadata, arg2 pluginaction.PluginUninstaller, arg3 pluginaction.CommandList, arg4 configv3.Plugin, arg5 string, arg6 string) (configv3.Plugin, error) {
+	fake.upgradePluginMutex.Lock()
+	ret, specificReturn := fake.upgradePluginReturnsOnCall[len(fake.upgradePluginArgsForCall)]
+	fake.upgradePluginArgsForCall = append(fake.upgradePluginArgsForCall, struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 pluginaction.PluginUninstaller
+		arg3 pluginaction.CommandList
+		arg4 configv3.Plugin
+		arg5 string
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("UpgradePlugin", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.upgradePluginMutex.Unlock()
+	if fake.UpgradePluginStub != nil {
+		return fake.UpgradePluginStub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.upgradePluginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) UpgradePluginCallCount() int {
+	fake.upgradePluginMutex.RLock()
+	defer fake.upgradePluginMutex.RUnlock()
+	return len(fake.upgradePluginArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) UpgradePluginCalls(stub func(pluginaction.PluginMetadata, pluginaction.PluginUninstaller, pluginaction.CommandList, configv3.Plugin, string, string) (configv3.Plugin, error)) {
+	fake.upgradePluginMutex.Lock()
+	defer fake.upgradePluginMutex.Unlock()
+	fake.UpgradePluginStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) UpgradePluginArgsForCall(i int) (pluginaction.PluginMetadata, pluginaction.PluginUninstaller, pluginaction.CommandList, configv3.Plugin, string, string) {
+	fake.upgradePluginMutex.RLock()
+	defer fake.upgradePluginMutex.RUnlock()
+	argsForCall := fake.upgradePluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeUpgradePluginsActor) UpgradePluginReturns(result1 configv3.Plugin, result2 error) {
+	fake.upgradePluginMutex.Lock()
+	defer fake.upgradePluginMutex.Unlock()
+	fake.UpgradePluginStub = nil
+	fake.upgradePluginReturns = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) UpgradePluginReturnsOnCall(i int, result1 configv3.Plugin, result2 error) {
+	fake.upgradePluginMutex.Lock()
+	defer fake.upgradePluginMutex.Unlock()
+	fake.UpgradePluginStub = nil
+	if fake.upgradePluginReturnsOnCall == nil {
+		fake.upgradePluginReturnsOnCall = make(map[int]struct {
+			result1 configv3.Plugin
+			result2 error
+		})
+	}
+	fake.upgradePluginReturnsOnCall[i] = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksum(arg1 string, arg2 string) bool {
+	fake.validateFileChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
+	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ValidateFileChecksum", []interface{}{arg1, arg2})
+	fake.validateFileChecksumMutex.Unlock()
+	if fake.ValidateFileChecksumStub != nil {
+		return fake.ValidateFileChecksumStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileChecksumReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumCallCount() int {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return len(fake.validateFileChecksumArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumCalls(stub func(string, string) bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	argsForCall := fake.validateFileChecksumArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumReturns(result1 bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = nil
+	fake.validateFileChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = nil
+	if fake.validateFileChecksumReturnsOnCall == nil {
+		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSHA256Checksum(arg1 string, arg2 string) bool {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileSHA256ChecksumReturnsOnCall[len(fake.validateFileSHA256ChecksumArgsForCall)]
+	fake.validateFileSHA256ChecksumArgsForCall = append(fake.validateFileSHA256ChecksumArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ValidateFileSHA256Checksum", []interface{}{arg1, arg2})
+	fake.validateFileSHA256ChecksumMutex.Unlock()
+	if fake.ValidateFileSHA256ChecksumStub != nil {
+		return fake.ValidateFileSHA256ChecksumStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileSHA256ChecksumReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSHA256ChecksumCallCount() int {
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	return len(fake.validateFileSHA256ChecksumArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSHA256ChecksumCalls(stub func(string, string) bool) {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	defer fake.validateFileSHA256ChecksumMutex.Unlock()
+	fake.ValidateFileSHA256ChecksumStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSHA256ChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	argsForCall := fake.validateFileSHA256ChecksumArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSHA256ChecksumReturns(result1 bool) {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	defer fake.validateFileSHA256ChecksumMutex.Unlock()
+	fake.ValidateFileSHA256ChecksumStub = nil
+	fake.validateFileSHA256ChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSHA256ChecksumReturnsOnCall(i int, result1 bool) {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	defer fake.validateFileSHA256ChecksumMutex.Unlock()
+	fake.ValidateFileSHA256ChecksumStub = nil
+	if fake.validateFileSHA256ChecksumReturnsOnCall == nil {
+		fake.validateFileSHA256ChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileSHA256ChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSignature(arg1 string, arg2 string, arg3 []string) bool {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.validateFileSignatureMutex.Lock()
+	ret, specificReturn := fake.validateFileSignatureReturnsOnCall[len(fake.validateFileSignatureArgsForCall)]
+	fake.validateFileSignatureArgsForCall = append(fake.validateFileSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("ValidateFileSignature", []interface{}{arg1, arg2, arg3Copy})
+	fake.validateFileSignatureMutex.Unlock()
+	if fake.ValidateFileSignatureStub != nil {
+		return fake.ValidateFileSignatureStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileSignatureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSignatureCallCount() int {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	return len(fake.validateFileSignatureArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSignatureCalls(stub func(string, string, []string) bool) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSignatureArgsForCall(i int) (string, string, []string) {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	argsForCall := fake.validateFileSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSignatureReturns(result1 bool) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = nil
+	fake.validateFileSignatureReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileSignatureReturnsOnCall(i int, result1 bool) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = nil
+	if fake.validateFileSignatureReturnsOnCall == nil {
+		fake.validateFileSignatureReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileSignatureReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	fake.getPluginUpgradesMutex.RLock()
+	defer fake.getPluginUpgradesMutex.RUnlock()
+	fake.removePluginVersionPinMutex.RLock()
+	defer fake.removePluginVersionPinMutex.RUnlock()
+	fake.setPluginVersionPinMutex.RLock()
+	defer fake.setPluginVersionPinMutex.RUnlock()
+	fake.upgradePluginMutex.RLock()
+	defer fake.upgradePluginMutex.RUnlock()
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUpgradePluginsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ common.UpgradePluginsActor = new(FakeUpgradePluginsActor)
@@ -309,9 +309,16 @@ func (cmd HelpCommand) displayCommand() error {
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CREDENTIAL_HELPER=name", cmd.UI.TranslateText("Store tokens with a credential helper, such as encrypted-file, instead of in config.json")},
+		{"CF_CREDENTIAL_PASSPHRASE=secret", cmd.UI.TranslateText("Passphrase used by the encrypted-file credential helper")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_PROFILE=name", cmd.UI.TranslateText("Run commands against the named profile instead of the current target")},
+		{"CF_RETRY_BASE_DELAY=250ms", cmd.UI.TranslateText("Delay before the first retry of a failed API request, doubled on every retry")},
+		{"CF_RETRY_MAX_DELAY=30s", cmd.UI.TranslateText("Max delay between retries of a failed API request")},
+		{"CF_RETRY_JITTER=0.5", cmd.UI.TranslateText("Fraction of each retry delay that is randomized")},
+		{"CF_RETRY_SAFE_POSTS=true", cmd.UI.TranslateText("Retry POST requests rejected by the server without being processed")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
@@ -607,9 +607,13 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
+				Expect(testUI.Out).To(Say("   CF_CREDENTIAL_HELPER=name          Store tokens with a credential helper, such as encrypted-file, instead of in config.json"))
+				Expect(testUI.Out).To(Say("   CF_CREDENTIAL_PASSPHRASE=secret    Passphrase used by the encrypted-file credential helper"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_BASE_DELAY=250ms          Delay before the first retry of a failed API request, doubled on every retry"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_SAFE_POSTS=true           Retry POST requests rejected by the server without being processed"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
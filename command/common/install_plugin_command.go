@@ -32,6 +32,8 @@ type InstallPluginActor interface {
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signature string, publicKeys []string) bool
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -56,7 +58,8 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	RequireSignature     bool                   `long:"require-signature" description:"Only install the plugin if it is signed by a key trusted for its repository"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--require-signature]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo\n   CF_NAME install-plugin -r My-Repo plugin-echo --require-signature"`
 	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
@@ -207,10 +210,16 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 
 	case cmd.Actor.FileExists(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified file")
+		if cmd.RequireSignature {
+			return "", 0, translatableerror.ArgumentCombinationError{Args: []string{"--require-signature", "LOCAL-PATH/TO/PLUGIN"}}
+		}
 		return cmd.getPluginFromLocalFile(pluginNameOrLocation)
 
 	case util.IsHTTPScheme(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified URL")
+		if cmd.RequireSignature {
+			return "", 0, translatableerror.ArgumentCombinationError{Args: []string{"--require-signature", "URL"}}
+		}
 		return cmd.getPluginFromURL(pluginNameOrLocation, tempPluginDir)
 
 	case util.IsUnsupportedURLScheme(pluginNameOrLocation):
@@ -350,11 +359,12 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, err
 	}
 
-	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
-		return "", 0, translatableerror.InvalidChecksumError{}
+	err = verifyDownloadedPlugin(cmd.UI, cmd.Actor, tempPath, pluginInfo, repos, repoList[0], cmd.RequireSignature)
+	if err != nil {
+		return "", 0, err
 	}
 
-	return tempPath, PluginFromRepository, err
+	return tempPath, PluginFromRepository, nil
 }
 
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
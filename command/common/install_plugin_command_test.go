@@ -86,6 +86,17 @@ var _ = Describe("install-plugin command", func() {
 				fakeActor.FileExistsReturns(true)
 			})
 
+			When("--require-signature is provided", func() {
+				BeforeEach(func() {
+					cmd.RequireSignature = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--require-signature", "LOCAL-PATH/TO/PLUGIN"}}))
+					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+				})
+			})
+
 			When("the -f argument is given", func() {
 				BeforeEach(func() {
 					cmd.Force = true
@@ -435,6 +446,17 @@ var _ = Describe("install-plugin command", func() {
 			Expect(testUI.Out).To(Say(`Install and use plugins at your own risk\.`))
 		})
 
+		When("--require-signature is provided", func() {
+			BeforeEach(func() {
+				cmd.RequireSignature = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--require-signature", "URL"}}))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
 		When("the -f argument is given", func() {
 			BeforeEach(func() {
 				cmd.Force = true
@@ -457,6 +457,107 @@ var _ = Describe("install-plugin command", func() {
 								})
 							})
 
+							When("the plugin has a SHA256 checksum", func() {
+								BeforeEach(func() {
+									fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, SHA256: "some-sha256"}, []string{repoName}, nil)
+									fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+								})
+
+								It("validates the SHA256 checksum instead of the SHA1 checksum", func() {
+									Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(0))
+									Expect(fakeActor.ValidateFileSHA256ChecksumCallCount()).To(Equal(1))
+									pathArg, checksumArg := fakeActor.ValidateFileSHA256ChecksumArgsForCall(0)
+									Expect(pathArg).To(Equal("some-path"))
+									Expect(checksumArg).To(Equal("some-sha256"))
+								})
+
+								When("the SHA256 checksum fails", func() {
+									BeforeEach(func() {
+										fakeActor.ValidateFileSHA256ChecksumReturns(false)
+									})
+
+									It("returns the checksum error", func() {
+										Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+										Expect(testUI.Out).ToNot(Say("Installing plugin"))
+									})
+								})
+							})
+
+							When("the plugin is signed and the repository trusts public keys", func() {
+								BeforeEach(func() {
+									fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: repoName, URL: repoURL, PublicKeys: []string{"some-public-key"}}, nil)
+									fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "some-signature"}, []string{repoName}, nil)
+									fakeActor.ValidateFileChecksumReturns(true)
+									fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+									fakeActor.GetAndValidatePluginReturns(configv3.Plugin{
+										Name:    pluginName,
+										Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+									}, nil)
+								})
+
+								When("the signature is valid", func() {
+									BeforeEach(func() {
+										fakeActor.ValidateFileSignatureReturns(true)
+									})
+
+									It("installs the plugin", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+
+										Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(1))
+										pathArg, signatureArg, publicKeysArg := fakeActor.ValidateFileSignatureArgsForCall(0)
+										Expect(pathArg).To(Equal("some-path"))
+										Expect(signatureArg).To(Equal("some-signature"))
+										Expect(publicKeysArg).To(Equal([]string{"some-public-key"}))
+
+										Expect(testUI.Out).To(Say(`Plugin signature verified\.`))
+										Expect(testUI.Out).To(Say(`Installing plugin %s\.\.\.`, pluginName))
+										Expect(testUI.Out).To(Say(`%s 1\.2\.3 successfully installed`, pluginName))
+									})
+								})
+
+								When("the signature is invalid", func() {
+									BeforeEach(func() {
+										fakeActor.ValidateFileSignatureReturns(false)
+									})
+
+									It("returns an InvalidPluginSignatureError", func() {
+										Expect(executeErr).To(MatchError(translatableerror.InvalidPluginSignatureError{RepositoryName: repoName}))
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+										Expect(testUI.Out).ToNot(Say("Installing plugin"))
+									})
+								})
+							})
+
+							When("--require-signature is provided", func() {
+								BeforeEach(func() {
+									cmd.RequireSignature = true
+									fakeActor.ValidateFileChecksumReturns(true)
+								})
+
+								When("the plugin is not signed", func() {
+									BeforeEach(func() {
+										fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: repoName, URL: repoURL, PublicKeys: []string{"some-public-key"}}, nil)
+									})
+
+									It("returns a PluginSignatureRequiredError", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{PluginName: pluginName, RepositoryName: repoName}))
+										Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(0))
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+									})
+								})
+
+								When("the repository does not trust any public keys", func() {
+									BeforeEach(func() {
+										fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "some-signature"}, []string{repoName}, nil)
+									})
+
+									It("returns a PluginSignatureRequiredError", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PluginSignatureRequiredError{PluginName: pluginName, RepositoryName: repoName}))
+										Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(0))
+									})
+								})
+							})
+
 							When("the checksum succeeds", func() {
 								BeforeEach(func() {
 									fakeActor.ValidateFileChecksumReturns(true)
@@ -8,6 +8,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"profiles", "create-profile", "switch-profile", "delete-profile"},
 		},
 	},
 	{
@@ -17,19 +18,20 @@ var HelpCategoryList = []HelpCategory{
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
-			{"events", "files", "logs"},
+			{"schedule-task", "task-schedules", "delete-task-schedule", "run-due-tasks"},
+			{"events", "files", "logs", "diagnose"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
 		CategoryName: "SERVICES:",
 		CommandList: [][]string{
-			{"marketplace", "services", "service"},
+			{"marketplace", "services", "service", "service-graph"},
 			{"create-service", "update-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
 			{"bind-service", "unbind-service"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
@@ -41,6 +43,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"orgs", "org"},
 			{"create-org", "delete-org", "rename-org"},
+			{"export-org", "import-org"},
 		},
 	},
 	{
@@ -144,13 +147,13 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN REPOSITORY:",
 		CommandList: [][]string{
-			{"add-plugin-repo", "remove-plugin-repo", "list-plugin-repos", "repo-plugins"},
+			{"add-plugin-repo", "remove-plugin-repo", "list-plugin-repos", "repo-plugins", "plugin-repo-index"},
 		},
 	},
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "upgrade-plugins", "uninstall-plugin"},
 		},
 	},
 }
@@ -0,0 +1,39 @@
+package common
+
+import "strings"
+
+// legacyGlobalFlags maps the global flags that the legacy commands do not
+// parse to the environment variables that pass their values on.
+var legacyGlobalFlags = map[string]string{
+	"--output":  "CF_OUTPUT",
+	"--profile": "CF_PROFILE",
+}
+
+// RemoveLegacyGlobalFlags removes the global flags that the legacy code does
+// not parse from the passed arguments. It returns the remaining arguments and
+// the values of the removed flags keyed by the environment variable that
+// passes them on to the legacy code.
+func RemoveLegacyGlobalFlags(args []string) ([]string, map[string]string) {
+	newArgs := []string{}
+	env := map[string]string{}
+	for i := 0; i < len(args); i++ {
+		name, value := args[i], ""
+		hasValue := false
+		if index := strings.Index(name, "="); strings.HasPrefix(name, "--") && index != -1 {
+			name, value, hasValue = name[:index], name[index+1:], true
+		}
+
+		envName, isGlobal := legacyGlobalFlags[name]
+		if !isGlobal {
+			newArgs = append(newArgs, args[i])
+			continue
+		}
+
+		if !hasValue && i+1 < len(args) {
+			i++
+			value = args[i]
+		}
+		env[envName] = value
+	}
+	return newArgs, env
+}
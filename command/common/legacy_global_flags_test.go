@@ -0,0 +1,33 @@
+package common_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/commands/application"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/command/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RemoveLegacyGlobalFlags", func() {
+	It("removes --output and --profile and returns their values", func() {
+		args, env := RemoveLegacyGlobalFlags([]string{"cf", "--profile", "staging", "apps", "--output=json"})
+		Expect(args).To(Equal([]string{"cf", "apps"}))
+		Expect(env).To(Equal(map[string]string{
+			"CF_OUTPUT":  "json",
+			"CF_PROFILE": "staging",
+		}))
+	})
+
+	It("leaves the other arguments alone", func() {
+		args, env := RemoveLegacyGlobalFlags([]string{"cf", "push", "some-app", "-p", "--output"})
+		Expect(args).To(Equal([]string{"cf", "push", "some-app", "-p"}))
+		Expect(env).To(Equal(map[string]string{"CF_OUTPUT": ""}))
+	})
+
+	It("leaves arguments that a legacy command can parse", func() {
+		args, _ := RemoveLegacyGlobalFlags([]string{"cf", "apps", "--output", "table"})
+
+		flagContext := flags.NewFlagContext(new(application.ListApps).MetaData().Flags)
+		Expect(flagContext.Parse(args[2:]...)).To(Succeed())
+	})
+})
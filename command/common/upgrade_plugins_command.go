@@ -0,0 +1,246 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UpgradePluginsActor
+
+type UpgradePluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginUpgrades(plugins []configv3.Plugin, pluginRepos []configv3.PluginRepository, platform string) ([]pluginaction.PluginUpgrade, error)
+	RemovePluginVersionPin(pluginName string) error
+	SetPluginVersionPin(pluginName string, versionRange string) error
+	UpgradePlugin(metadata pluginaction.PluginMetadata, uninstaller pluginaction.PluginUninstaller, commands pluginaction.CommandList, installed configv3.Plugin, path string, tempPluginDir string) (configv3.Plugin, error)
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signature string, publicKeys []string) bool
+}
+
+type UpgradePluginsCommand struct {
+	OptionalArgs      flag.UpgradePluginsArgs `positional-args:"yes"`
+	Force             bool                    `short:"f" description:"Force upgrade without confirmation"`
+	Pin               string                  `long:"pin" description:"Pin the plugins to a semantic version range that they are only upgraded within, e.g. '>=1.2.0 <2.0.0'"`
+	Unpin             bool                    `long:"unpin" description:"Remove the version range the plugins are pinned to"`
+	RequireSignature  bool                    `long:"require-signature" description:"Only upgrade plugins that are signed by a key trusted for their repository"`
+	SkipSSLValidation bool                    `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	usage             interface{}             `usage:"CF_NAME upgrade-plugins [PLUGIN_NAME...] [-f] [--require-signature]\n   CF_NAME upgrade-plugins PLUGIN_NAME... --pin VERSION_RANGE | --unpin\n\n   Upgrades installed plugins to the newest versions in the registered plugin repositories.\n   A plugin pinned to a version range is only upgraded to versions in that range. If an\n   upgraded plugin cannot be installed or validated, its previous version is restored.\n\nEXAMPLES:\n   CF_NAME upgrade-plugins\n   CF_NAME upgrade-plugins my-plugin -f\n   CF_NAME upgrade-plugins my-plugin --pin '>=1.2.0 <2.0.0'"`
+	relatedCommands   interface{}             `related_commands:"install-plugin, plugins, repo-plugins"`
+
+	UI          command.UI
+	Config      command.Config
+	Actor       UpgradePluginsActor
+	ProgressBar plugin.ProxyReader
+}
+
+func (cmd *UpgradePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) Execute([]string) error {
+	if cmd.Pin != "" && cmd.Unpin {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--pin", "--unpin"}}
+	}
+	if (cmd.Pin != "" || cmd.Unpin) && len(cmd.OptionalArgs.PluginNames) == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "PLUGIN_NAME"}
+	}
+
+	plugins, err := cmd.installedPlugins()
+	if err != nil {
+		return err
+	}
+
+	if cmd.Pin != "" || cmd.Unpin {
+		return cmd.updateVersionPins(plugins)
+	}
+
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		})
+
+	upgrades, err := cmd.Actor.GetPluginUpgrades(plugins, repos, cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH))
+	if err != nil {
+		return err
+	}
+
+	availableUpgrades := cmd.displayUpgrades(upgrades)
+	if len(availableUpgrades) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("All plugins are up to date.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+	if !cmd.Force {
+		really, promptErr := cmd.UI.DisplayBoolPrompt(false, "Do you want to upgrade these plugins?")
+		if promptErr != nil {
+			return promptErr
+		}
+		if !really {
+			cmd.UI.DisplayText("Plugin upgrade cancelled.")
+			return nil
+		}
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	for _, upgrade := range availableUpgrades {
+		err = cmd.upgradePlugin(upgrade, repos, rpcService, tempPluginDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// installedPlugins returns the named plugins, or all installed plugins when
+// none are named.
+func (cmd UpgradePluginsCommand) installedPlugins() ([]configv3.Plugin, error) {
+	if len(cmd.OptionalArgs.PluginNames) == 0 {
+		return cmd.Config.Plugins(), nil
+	}
+
+	var plugins []configv3.Plugin
+	for _, pluginName := range cmd.OptionalArgs.PluginNames {
+		plugin, installed := cmd.Config.GetPlugin(pluginName)
+		if !installed {
+			return nil, actionerror.PluginNotFoundError{PluginName: pluginName}
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
+
+func (cmd UpgradePluginsCommand) updateVersionPins(plugins []configv3.Plugin) error {
+	for _, plugin := range plugins {
+		switch {
+		case cmd.Pin != "":
+			err := cmd.Actor.SetPluginVersionPin(plugin.Name, cmd.Pin)
+			if err != nil {
+				return err
+			}
+			cmd.UI.DisplayText("Pinned plugin {{.PluginName}} to version range '{{.VersionRange}}'.", map[string]interface{}{
+				"PluginName":   plugin.Name,
+				"VersionRange": cmd.Pin,
+			})
+		case cmd.Unpin:
+			err := cmd.Actor.RemovePluginVersionPin(plugin.Name)
+			if err != nil {
+				return err
+			}
+			cmd.UI.DisplayText("Unpinned plugin {{.PluginName}}.", map[string]interface{}{
+				"PluginName": plugin.Name,
+			})
+		}
+	}
+	return nil
+}
+
+// displayUpgrades displays the upgrades that are available and the plugins
+// that are held back by their version pins, and returns the available
+// upgrades.
+func (cmd UpgradePluginsCommand) displayUpgrades(upgrades []pluginaction.PluginUpgrade) []pluginaction.PluginUpgrade {
+	var availableUpgrades []pluginaction.PluginUpgrade
+	table := [][]string{{"plugin", "version", "new version", "repository"}}
+	for _, upgrade := range upgrades {
+		if !upgrade.Available() {
+			cmd.UI.DisplayText("Plugin {{.PluginName}} {{.Version}} is pinned to '{{.VersionRange}}', not upgrading to {{.LatestVersion}}.", map[string]interface{}{
+				"PluginName":    upgrade.Plugin.Name,
+				"Version":       upgrade.Plugin.Version.String(),
+				"VersionRange":  upgrade.VersionRange,
+				"LatestVersion": upgrade.LatestVersion,
+			})
+			continue
+		}
+
+		availableUpgrades = append(availableUpgrades, upgrade)
+		table = append(table, []string{upgrade.Plugin.Name, upgrade.Plugin.Version.String(), upgrade.Info.Version, upgrade.RepositoryName})
+	}
+
+	if len(availableUpgrades) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+	return availableUpgrades
+}
+
+func (cmd UpgradePluginsCommand) upgradePlugin(upgrade pluginaction.PluginUpgrade, repos []configv3.PluginRepository, rpcService *shared.RPCService, tempPluginDir string) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Upgrading plugin {{.PluginName}} from {{.Version}} to {{.NewVersion}}...", map[string]interface{}{
+		"PluginName": upgrade.Plugin.Name,
+		"Version":    upgrade.Plugin.Version.String(),
+		"NewVersion": upgrade.Info.Version,
+	})
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": upgrade.RepositoryName,
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(upgrade.Info.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	err = verifyDownloadedPlugin(cmd.UI, cmd.Actor, tempPath, upgrade.Info, repos, upgrade.RepositoryName, cmd.RequireSignature)
+	if err != nil {
+		return err
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	upgraded, err := cmd.Actor.UpgradePlugin(rpcService, rpcService, Commands, upgrade.Plugin, executablePath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Plugin {{.PluginName}} {{.Version}} successfully upgraded.", map[string]interface{}{
+		"PluginName": upgraded.Name,
+		"Version":    upgraded.Version.String(),
+	})
+	return nil
+}
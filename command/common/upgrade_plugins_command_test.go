@@ -0,0 +1,298 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upgrade-plugins command", func() {
+	var (
+		cmd             UpgradePluginsCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpgradePluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+
+		installedPlugin configv3.Plugin
+		upgrade         pluginaction.PluginUpgrade
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpgradePluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpgradePluginsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.PluginHomeReturns(pluginHome)
+
+		installedPlugin = configv3.Plugin{
+			Name:     "some-plugin",
+			Location: "some-location",
+			Version:  configv3.PluginVersion{Major: 1},
+		}
+		fakeConfig.PluginsReturns([]configv3.Plugin{installedPlugin})
+		fakeConfig.GetPluginReturns(installedPlugin, true)
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1"},
+			{Name: "repo-2", URL: "https://repo-2"},
+		})
+		fakeActor.GetPlatformStringReturns("some-platform")
+
+		upgrade = pluginaction.PluginUpgrade{
+			Plugin: installedPlugin,
+			Info: pluginaction.PluginInfo{
+				Name:     "some-plugin",
+				Version:  "2.0.0",
+				URL:      "https://repo-2/some-plugin",
+				Checksum: "some-checksum",
+			},
+			RepositoryName: "repo-2",
+			LatestVersion:  "2.0.0",
+		}
+		fakeActor.GetPluginUpgradesReturns([]pluginaction.PluginUpgrade{upgrade}, nil)
+		fakeActor.DownloadExecutableBinaryFromURLReturns("some-temp-path", nil)
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.CreateExecutableCopyReturns("some-executable-path", nil)
+		fakeActor.UpgradePluginReturns(configv3.Plugin{
+			Name:    "some-plugin",
+			Version: configv3.PluginVersion{Major: 2},
+		}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--pin and --unpin are both provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"some-plugin"}
+			cmd.Pin = "1.x"
+			cmd.Unpin = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--pin", "--unpin"}}))
+		})
+	})
+
+	When("--pin is provided without plugin names", func() {
+		BeforeEach(func() {
+			cmd.Pin = "1.x"
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "PLUGIN_NAME"}))
+			Expect(fakeActor.SetPluginVersionPinCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a named plugin is not installed", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"some-other-plugin"}
+			fakeConfig.GetPluginReturns(configv3.Plugin{}, false)
+		})
+
+		It("returns a PluginNotFoundError", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginNotFoundError{PluginName: "some-other-plugin"}))
+			Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no plugin repositories", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+		})
+	})
+
+	When("--pin is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"some-plugin"}
+			cmd.Pin = "<2.0.0"
+			fakeActor.GetPluginUpgradesReturns(nil, nil)
+		})
+
+		It("pins the plugin without upgrading it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetPluginVersionPinCallCount()).To(Equal(1))
+			pluginName, versionRange := fakeActor.SetPluginVersionPinArgsForCall(0)
+			Expect(pluginName).To(Equal("some-plugin"))
+			Expect(versionRange).To(Equal("<2.0.0"))
+			Expect(testUI.Out).To(Say(`Pinned plugin some-plugin to version range '<2\.0\.0'\.`))
+			Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(0))
+		})
+
+		When("the version range is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.SetPluginVersionPinReturns(actionerror.InvalidPluginVersionRangeError{VersionRange: "<2.0.0"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidPluginVersionRangeError{VersionRange: "<2.0.0"}))
+				Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--unpin is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"some-plugin"}
+			cmd.Unpin = true
+			fakeActor.GetPluginUpgradesReturns(nil, nil)
+		})
+
+		It("unpins the plugin without upgrading it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RemovePluginVersionPinArgsForCall(0)).To(Equal("some-plugin"))
+			Expect(testUI.Out).To(Say(`Unpinned plugin some-plugin\.`))
+			Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("every plugin is up to date", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginUpgradesReturns(nil, nil)
+		})
+
+		It("displays that there is nothing to upgrade", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(1))
+			plugins, repos, platform := fakeActor.GetPluginUpgradesArgsForCall(0)
+			Expect(plugins).To(Equal([]configv3.Plugin{installedPlugin}))
+			Expect(repos).To(HaveLen(2))
+			Expect(platform).To(Equal("some-platform"))
+
+			Expect(testUI.Out).To(Say(`Searching repo-1, repo-2 for newer versions of installed plugins\.\.\.`))
+			Expect(testUI.Out).To(Say("All plugins are up to date."))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a plugin is held back by its version pin", func() {
+		BeforeEach(func() {
+			upgrade.Info = pluginaction.PluginInfo{}
+			upgrade.RepositoryName = ""
+			upgrade.VersionRange = "1.x"
+			fakeActor.GetPluginUpgradesReturns([]pluginaction.PluginUpgrade{upgrade}, nil)
+		})
+
+		It("displays the pin without upgrading the plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Plugin some-plugin 1\.0\.0 is pinned to '1\.x', not upgrading to 2\.0\.0\.`))
+			Expect(testUI.Out).To(Say("All plugins are up to date."))
+			Expect(fakeActor.UpgradePluginCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user declines the upgrade", func() {
+		BeforeEach(func() {
+			input.Write([]byte("n\n"))
+		})
+
+		It("cancels the upgrade", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+new version\s+repository`))
+			Expect(testUI.Out).To(Say(`some-plugin\s+1\.0\.0\s+2\.0\.0\s+repo-2`))
+			Expect(testUI.Out).To(Say(`Do you want to upgrade these plugins\?`))
+			Expect(testUI.Out).To(Say("Plugin upgrade cancelled."))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("upgrades the plugin without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say(`Do you want to upgrade these plugins\?`))
+			Expect(testUI.Out).To(Say(`Upgrading plugin some-plugin from 1\.0\.0 to 2\.0\.0\.\.\.`))
+			Expect(testUI.Out).To(Say(`Starting download of plugin binary from repository repo-2\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Plugin some-plugin 2\.0\.0 successfully upgraded\.`))
+
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(1))
+			url, _, proxyReader := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("https://repo-2/some-plugin"))
+			Expect(proxyReader).To(Equal(fakeProgressBar))
+
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+			Expect(path).To(Equal("some-temp-path"))
+			Expect(checksum).To(Equal("some-checksum"))
+
+			Expect(fakeActor.UpgradePluginCallCount()).To(Equal(1))
+			_, _, commands, installed, executablePath, _ := fakeActor.UpgradePluginArgsForCall(0)
+			Expect(commands).To(Equal(Commands))
+			Expect(installed).To(Equal(installedPlugin))
+			Expect(executablePath).To(Equal("some-executable-path"))
+		})
+
+		When("the checksum of the downloaded binary is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError without upgrading", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+				Expect(fakeActor.UpgradePluginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("upgrading the plugin fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = actionerror.PluginUpgradeRolledBackError{
+					PluginName:      "some-plugin",
+					PreviousVersion: "1.0.0",
+					Err:             errors.New("some-error"),
+				}
+				fakeActor.UpgradePluginReturns(configv3.Plugin{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Out).ToNot(Say("successfully upgraded"))
+			})
+		})
+	})
+})
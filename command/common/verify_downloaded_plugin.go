@@ -0,0 +1,55 @@
+package common
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// pluginVerifier validates plugin binaries downloaded from a repository.
+type pluginVerifier interface {
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signature string, publicKeys []string) bool
+}
+
+// verifyDownloadedPlugin checks the checksum of a plugin binary downloaded
+// from a repository, using SHA256 when the repository provides it, and its
+// signature against the keys trusted for the repository. A signature that
+// does not verify is always an error; a missing signature, or one that
+// cannot be verified because no keys are trusted, is only an error when
+// requireSignature is true.
+func verifyDownloadedPlugin(ui command.UI, verifier pluginVerifier, path string, pluginInfo pluginaction.PluginInfo, repos []configv3.PluginRepository, repoName string, requireSignature bool) error {
+	if pluginInfo.SHA256 != "" {
+		if !verifier.ValidateFileSHA256Checksum(path, pluginInfo.SHA256) {
+			return translatableerror.InvalidChecksumError{}
+		}
+	} else if !verifier.ValidateFileChecksum(path, pluginInfo.Checksum) {
+		return translatableerror.InvalidChecksumError{}
+	}
+
+	var publicKeys []string
+	for _, repo := range repos {
+		if repo.Name == repoName {
+			publicKeys = repo.PublicKeys
+		}
+	}
+
+	if pluginInfo.Signature != "" && len(publicKeys) > 0 {
+		if !verifier.ValidateFileSignature(path, pluginInfo.Signature, publicKeys) {
+			return translatableerror.InvalidPluginSignatureError{RepositoryName: repoName}
+		}
+
+		ui.DisplayText("Plugin signature verified.")
+		return nil
+	}
+
+	if requireSignature {
+		return translatableerror.PluginSignatureRequiredError{
+			PluginName:     pluginInfo.Name,
+			RepositoryName: repoName,
+		}
+	}
+	return nil
+}
@@ -13,20 +13,26 @@ type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	AddPluginRepositoryPublicKeys(repoName string, publicKeys []string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	BitsCacheDir() string
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	CredentialsError() error
+	CurrentProfile() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
+	DeleteProfile(name string)
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
 	ExperimentalLogin() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
+	GetProfile(name string) (configv3.Profile, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	Locale() string
@@ -36,13 +42,22 @@ type Config interface {
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
+	PluginVersionPin(pluginName string) (string, bool)
 	PollingInterval() time.Duration
+	Profiles() []configv3.Profile
 	RefreshToken() string
 	RemovePlugin(string)
+	RemovePluginVersionPin(pluginName string)
+	RequestRetryBaseDelay() time.Duration
 	RequestRetryCount() int
+	RequestRetryJitter() float64
+	RequestRetryMaxDelay() time.Duration
+	RequestRetrySafePOSTs() bool
 	RoutingEndpoint() string
+	SaveProfile(name string)
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
+	SetPluginVersionPin(pluginName string, versionRange string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	V7SetSpaceInformation(guid string, name string)
@@ -55,10 +70,12 @@ type Config interface {
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	SwitchProfile(name string)
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedOrganizationName() string
 	TargetedSpace() configv3.Space
+	TaskSchedulesFilePath() string
 	UAADisableKeepAlives() bool
 	UAAGrantType() string
 	UAAOAuthClient() string
@@ -0,0 +1,120 @@
+// Package document contains the structured representations of resources that
+// display commands render when they are run with JSON or YAML output. They
+// are shared by the v6 and v7 commands so that the same resource is rendered
+// the same way by both.
+package document
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// App is the structured representation of an application.
+type App struct {
+	Name             string    `json:"name" yaml:"name"`
+	GUID             string    `json:"guid" yaml:"guid"`
+	State            string    `json:"state" yaml:"state"`
+	IsolationSegment string    `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Routes           []string  `json:"routes" yaml:"routes"`
+	LastUploaded     string    `json:"last_uploaded,omitempty" yaml:"last_uploaded,omitempty"`
+	Stack            string    `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpacks       []string  `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	DockerImage      string    `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	Processes        []Process `json:"processes" yaml:"processes"`
+}
+
+// Process is the structured representation of an application process.
+type Process struct {
+	Type             string     `json:"type" yaml:"type"`
+	Instances        int        `json:"instances" yaml:"instances"`
+	RunningInstances int        `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64     `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64     `json:"disk_in_mb" yaml:"disk_in_mb"`
+	HealthCheckType  string     `json:"health_check_type,omitempty" yaml:"health_check_type,omitempty"`
+	InstanceDetails  []Instance `json:"instance_details,omitempty" yaml:"instance_details,omitempty"`
+}
+
+// Instance is the structured representation of a process instance.
+type Instance struct {
+	Index       int64   `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Since       string  `json:"since,omitempty" yaml:"since,omitempty"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage uint64  `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota uint64  `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   uint64  `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   uint64  `json:"disk_quota" yaml:"disk_quota"`
+	Details     string  `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+// RouteURLs converts routes into the URLs listed in an App.
+func RouteURLs(routes v2action.Routes) []string {
+	urls := []string{}
+	for _, route := range routes {
+		urls = append(urls, route.String())
+	}
+	return urls
+}
+
+// NewApp converts an application, its current droplet, its routes and the
+// documents of its processes into an App. The droplet is empty when it is not
+// known.
+func NewApp(name string, guid string, state constant.ApplicationState, lifecycleType constant.AppLifecycleType, droplet ccv3.Droplet, routes v2action.Routes, processes []Process) App {
+	doc := App{
+		Name:         name,
+		GUID:         guid,
+		State:        strings.ToLower(string(state)),
+		Routes:       RouteURLs(routes),
+		LastUploaded: droplet.CreatedAt,
+		Stack:        droplet.Stack,
+		Processes:    processes,
+	}
+
+	if lifecycleType == constant.AppLifecycleTypeDocker {
+		doc.DockerImage = droplet.Image
+	} else {
+		for _, buildpack := range droplet.Buildpacks {
+			if buildpack.DetectOutput != "" {
+				doc.Buildpacks = append(doc.Buildpacks, buildpack.DetectOutput)
+			} else {
+				doc.Buildpacks = append(doc.Buildpacks, buildpack.Name)
+			}
+		}
+	}
+
+	return doc
+}
+
+// NewProcess converts a process and its instances into a Process.
+func NewProcess(process ccv3.Process, instances []ccv3.ProcessInstance) Process {
+	doc := Process{
+		Type:            process.Type,
+		Instances:       len(instances),
+		MemoryInMB:      process.MemoryInMB.Value,
+		DiskInMB:        process.DiskInMB.Value,
+		HealthCheckType: string(process.HealthCheckType),
+	}
+
+	for _, instance := range instances {
+		if instance.State == constant.ProcessInstanceRunning {
+			doc.RunningInstances++
+		}
+		doc.InstanceDetails = append(doc.InstanceDetails, Instance{
+			Index:       instance.Index,
+			State:       strings.ToLower(string(instance.State)),
+			Since:       time.Now().Add(-instance.Uptime).UTC().Format(time.RFC3339),
+			CPU:         instance.CPU,
+			MemoryUsage: instance.MemoryUsage,
+			MemoryQuota: instance.MemoryQuota,
+			DiskUsage:   instance.DiskUsage,
+			DiskQuota:   instance.DiskQuota,
+			Details:     instance.Details,
+		})
+	}
+
+	return doc
+}
@@ -0,0 +1,87 @@
+package document_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/document"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App", func() {
+	Describe("NewApp", func() {
+		var droplet ccv3.Droplet
+
+		BeforeEach(func() {
+			droplet = ccv3.Droplet{
+				CreatedAt: "2019-03-01T10:00:00Z",
+				Stack:     "cflinuxfs3",
+				Image:     "some-image",
+				Buildpacks: []ccv3.DropletBuildpack{
+					{Name: "ruby_buildpack", DetectOutput: "ruby 2.5"},
+					{Name: "binary_buildpack"},
+				},
+			}
+		})
+
+		It("lists the buildpacks of a buildpack app", func() {
+			routes := v2action.Routes{{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}}}
+			doc := NewApp("some-app", "some-guid", constant.ApplicationStarted, constant.AppLifecycleTypeBuildpack, droplet, routes, nil)
+
+			Expect(doc).To(Equal(App{
+				Name:         "some-app",
+				GUID:         "some-guid",
+				State:        "started",
+				Routes:       []string{"some-app.example.com"},
+				LastUploaded: "2019-03-01T10:00:00Z",
+				Stack:        "cflinuxfs3",
+				Buildpacks:   []string{"ruby 2.5", "binary_buildpack"},
+			}))
+		})
+
+		It("shows the image of a docker app", func() {
+			doc := NewApp("some-app", "some-guid", constant.ApplicationStopped, constant.AppLifecycleTypeDocker, droplet, nil, nil)
+
+			Expect(doc.DockerImage).To(Equal("some-image"))
+			Expect(doc.Buildpacks).To(BeEmpty())
+			Expect(doc.Routes).To(BeEmpty())
+		})
+	})
+
+	Describe("NewProcess", func() {
+		It("counts the running instances and converts each instance", func() {
+			process := ccv3.Process{
+				Type:            "web",
+				HealthCheckType: constant.HTTP,
+				MemoryInMB:      types.NullUint64{Value: 256, IsSet: true},
+				DiskInMB:        types.NullUint64{Value: 1024, IsSet: true},
+			}
+			instances := []ccv3.ProcessInstance{
+				{Index: 0, State: constant.ProcessInstanceRunning, Uptime: time.Hour, CPU: 0.5},
+				{Index: 1, State: constant.ProcessInstanceCrashed, Details: "some-details"},
+			}
+
+			doc := NewProcess(process, instances)
+
+			Expect(doc.Type).To(Equal("web"))
+			Expect(doc.Instances).To(Equal(2))
+			Expect(doc.RunningInstances).To(Equal(1))
+			Expect(doc.MemoryInMB).To(BeEquivalentTo(256))
+			Expect(doc.DiskInMB).To(BeEquivalentTo(1024))
+			Expect(doc.HealthCheckType).To(Equal("http"))
+
+			Expect(doc.InstanceDetails).To(HaveLen(2))
+			Expect(doc.InstanceDetails[0].State).To(Equal("running"))
+			Expect(doc.InstanceDetails[0].CPU).To(Equal(0.5))
+			since, err := time.Parse(time.RFC3339, doc.InstanceDetails[0].Since)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(since).To(BeTemporally("~", time.Now().Add(-time.Hour), 2*time.Second))
+			Expect(doc.InstanceDetails[1].State).To(Equal("crashed"))
+			Expect(doc.InstanceDetails[1].Details).To(Equal("some-details"))
+		})
+	})
+})
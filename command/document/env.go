@@ -0,0 +1,26 @@
+package document
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// Env is the structured representation of the environment of an
+// application.
+type Env struct {
+	App                  string                 `json:"app" yaml:"app"`
+	System               map[string]interface{} `json:"system" yaml:"system"`
+	Application          map[string]interface{} `json:"application" yaml:"application"`
+	EnvironmentVariables map[string]interface{} `json:"user_provided" yaml:"user_provided"`
+	Running              map[string]interface{} `json:"running" yaml:"running"`
+	Staging              map[string]interface{} `json:"staging" yaml:"staging"`
+}
+
+// NewEnv converts the environment of the named application into an Env.
+func NewEnv(appName string, env ccv3.Environment) Env {
+	return Env{
+		App:                  appName,
+		System:               env.System,
+		Application:          env.Application,
+		EnvironmentVariables: env.EnvironmentVariables,
+		Running:              env.Running,
+		Staging:              env.Staging,
+	}
+}
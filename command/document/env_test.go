@@ -0,0 +1,29 @@
+package document_test
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "code.cloudfoundry.org/cli/command/document"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Env", func() {
+	It("holds each group of the environment", func() {
+		env := ccv3.Environment{
+			System:               map[string]interface{}{"VCAP_SERVICES": "{}"},
+			Application:          map[string]interface{}{"VCAP_APPLICATION": "{}"},
+			EnvironmentVariables: map[string]interface{}{"FOO": "bar"},
+			Running:              map[string]interface{}{"RUNNING": "yes"},
+			Staging:              map[string]interface{}{"STAGING": "yes"},
+		}
+
+		Expect(NewEnv("some-app", env)).To(Equal(Env{
+			App:                  "some-app",
+			System:               env.System,
+			Application:          env.Application,
+			EnvironmentVariables: env.EnvironmentVariables,
+			Running:              env.Running,
+			Staging:              env.Staging,
+		}))
+	})
+})
@@ -48,6 +48,10 @@ type PluginRepoName struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 }
 
+type ProfileName struct {
+	ProfileName string `positional-arg-name:"PROFILE_NAME" required:"true" description:"The profile name"`
+}
+
 type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
@@ -287,10 +291,18 @@ type AddPluginRepoArgs struct {
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
 }
 
+type PluginRepoIndexArgs struct {
+	Directory PathWithExistenceCheck `positional-arg-name:"DIRECTORY" required:"true" description:"The directory of plugin binaries"`
+}
+
 type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
 
+type UpgradePluginsArgs struct {
+	PluginNames []string `positional-arg-name:"PLUGIN_NAME" description:"The names of the plugins to upgrade (Default: all installed plugins)"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
@@ -330,3 +342,23 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type ImportOrgArgs struct {
+	PathToConfig PathWithExistenceCheck `positional-arg-name:"PATH" required:"true" description:"Path to the org configuration file"`
+}
+
+type ScheduleTaskArgs struct {
+	AppName  string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Schedule string `positional-arg-name:"SCHEDULE" required:"true" description:"The cron schedule"`
+	Command  string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
+}
+
+type DeleteTaskScheduleArgs struct {
+	AppName  string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	TaskName string `positional-arg-name:"TASK_NAME" required:"true" description:"The name of the scheduled task"`
+}
+
+type SecureCopyArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The path to copy from, written as APP_NAME:PATH for a path on the app instance"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The path to copy to, written as APP_NAME:PATH for a path on the app instance"`
+}
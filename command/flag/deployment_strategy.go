@@ -0,0 +1,9 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type DeploymentStrategy string
+
+func (DeploymentStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{"rolling", "canary"}, prefix, false)
+}
@@ -0,0 +1,31 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentStrategy", func() {
+	var strategy DeploymentStrategy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := strategy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'rolling' when passed 'r'", "r",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'canary' when passed 'Ca'", "Ca",
+				[]flags.Completion{{Item: "canary"}}),
+			Entry("returns 'rolling' and 'canary' when passed nothing", "",
+				[]flags.Completion{{Item: "rolling"}, {Item: "canary"}}),
+			Entry("completes to nothing when passed 'blue'", "blue",
+				[]flags.Completion{}),
+		)
+	})
+})
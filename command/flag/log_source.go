@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogSource is the source type of a log message, such as APP or RTR.
+type LogSource string
+
+func (LogSource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"APP", "RTR", "STG", "CELL", "API"}, prefix, false)
+}
+
+func (s *LogSource) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "APP", "RTR", "STG", "CELL", "API":
+		*s = LogSource(valUpper)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SOURCE must be "APP", "RTR", "STG", "CELL" or "API"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSource", func() {
+	var source LogSource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := source.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'APP' and 'API' when passed 'a'", "a",
+				[]flags.Completion{{Item: "APP"}, {Item: "API"}}),
+			Entry("returns 'RTR' when passed 'R'", "R",
+				[]flags.Completion{{Item: "RTR"}}),
+			Entry("returns all sources when passed ''", "",
+				[]flags.Completion{{Item: "APP"}, {Item: "RTR"}, {Item: "STG"}, {Item: "CELL"}, {Item: "API"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			source = ""
+		})
+
+		DescribeTable("upcases and sets the source",
+			func(input string, expectedSource LogSource) {
+				err := source.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(source).To(Equal(expectedSource))
+			},
+			Entry("sets 'APP' when passed 'app'", "app", LogSource("APP")),
+			Entry("sets 'CELL' when passed 'Cell'", "Cell", LogSource("CELL")),
+			Entry("sets 'STG' when passed 'STG'", "STG", LogSource("STG")),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := source.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE must be "APP", "RTR", "STG", "CELL" or "API"`,
+				}))
+				Expect(source).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogStream is the output stream of a log message, either stdout or stderr.
+type LogStream string
+
+func (LogStream) Complete(prefix string) []flags.Completion {
+	return completions([]string{"stdout", "stderr"}, prefix, false)
+}
+
+func (s *LogStream) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "stdout", "stderr":
+		*s = LogStream(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STREAM must be "stdout" or "stderr"`,
+		}
+	}
+	return nil
+}
+
+// MessageType returns the log message type of the stream, OUT or ERR.
+func (s LogStream) MessageType() string {
+	if s == "stderr" {
+		return "ERR"
+	}
+	return "OUT"
+}
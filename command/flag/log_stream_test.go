@@ -0,0 +1,54 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogStream", func() {
+	var stream LogStream
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := stream.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'stdout' and 'stderr' when passed 's'", "s",
+				[]flags.Completion{{Item: "stdout"}, {Item: "stderr"}}),
+			Entry("returns 'stderr' when passed 'STDE'", "STDE",
+				[]flags.Completion{{Item: "stderr"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			stream = ""
+		})
+
+		DescribeTable("downcases and sets the stream",
+			func(input string, expectedStream LogStream, expectedType string) {
+				err := stream.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stream).To(Equal(expectedStream))
+				Expect(stream.MessageType()).To(Equal(expectedType))
+			},
+			Entry("sets 'stdout' when passed 'stdout'", "stdout", LogStream("stdout"), "OUT"),
+			Entry("sets 'stderr' when passed 'StdErr'", "StdErr", LogStream("stderr"), "ERR"),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := stream.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STREAM must be "stdout" or "stderr"`,
+				}))
+				Expect(stream).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OutputFormat struct {
+	Format configv3.OutputFormat
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json", "table", "yaml"}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "json", "table", "yaml":
+		o.Format = configv3.OutputFormat(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT_FORMAT must be "json", "yaml", or "table"`,
+		}
+	}
+	return nil
+}
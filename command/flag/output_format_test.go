@@ -0,0 +1,60 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/configv3"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := outputFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("completes to 'json', 'table', and 'yaml' when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "table"}, {Item: "yaml"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			outputFormat = OutputFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(format string, expectedFormat configv3.OutputFormat) {
+				err := outputFormat.UnmarshalFlag(format)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputFormat.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'json'", "json", configv3.OutputFormatJSON),
+			Entry("sets 'json' when passed 'JSon'", "JSon", configv3.OutputFormatJSON),
+			Entry("sets 'yaml' when passed 'yaml'", "yaml", configv3.OutputFormatYAML),
+			Entry("sets 'table' when passed 'table'", "table", configv3.OutputFormatTable),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := outputFormat.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `OUTPUT_FORMAT must be "json", "yaml", or "table"`,
+				}))
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Regexp is a regular expression that is compiled when the flag is parsed.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalFlag(val string) error {
+	compiled, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Invalid regular expression: %s", err),
+		}
+	}
+	r.Regexp = compiled
+	return nil
+}
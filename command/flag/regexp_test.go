@@ -0,0 +1,35 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var pattern Regexp
+
+	BeforeEach(func() {
+		pattern = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("compiles the regular expression", func() {
+			err := pattern.UnmarshalFlag("^GET /health")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pattern.MatchString("GET /healthz")).To(BeTrue())
+			Expect(pattern.MatchString("POST /health")).To(BeFalse())
+		})
+
+		When("the regular expression is invalid", func() {
+			It("returns an error", func() {
+				err := pattern.UnmarshalFlag("(unclosed")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*flags.Error).Type).To(Equal(flags.ErrRequired))
+				Expect(err.Error()).To(ContainSubstring("Invalid regular expression"))
+				Expect(pattern.Regexp).To(BeNil())
+			})
+		})
+	})
+})
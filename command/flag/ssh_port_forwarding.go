@@ -43,3 +43,51 @@ func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
 
 	return nil
 }
+
+// SSHRemotePortForwarding is an -R specification,
+// [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT. The app instance listens
+// on the remote address and connections are forwarded to the local address.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	var forward SSHPortForwarding
+	if err := forward.UnmarshalFlag(val); err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Bad remote forwarding specification '%s'", val),
+		}
+	}
+
+	// The listening side is written first, as it is for local forwarding.
+	s.RemoteAddress = forward.LocalAddress
+	s.LocalAddress = forward.RemoteAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is a -D specification, [BIND_ADDRESS:]PORT, of the
+// local address that accepts SOCKS5 connections.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
+	re := regexp.MustCompile(`^\d+$`)
+
+	switch {
+	case len(splitHosts) == 1 && re.MatchString(splitHosts[0]):
+		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && re.MatchString(splitHosts[1]):
+		s.LocalAddress = val
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
+		}
+	}
+
+	return nil
+}
@@ -60,3 +60,86 @@ var _ = Describe("SpaceRole", func() {
 		)
 	})
 })
+
+var _ = Describe("SSHRemotePortForwarding", func() {
+	var forward SSHRemotePortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHRemotePortForwarding{}
+		})
+
+		When("passed remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("9229:localhost:9229")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "localhost:9229",
+					LocalAddress:  "localhost:9229",
+				}))
+			})
+		})
+
+		When("passed remote:remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:8080:mock-server:3000")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "0.0.0.0:8080",
+					LocalAddress:  "mock-server:3000",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad remote forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("1 colon", "localhost:8080"),
+			Entry("incorrect port numbers", "8080:localhost:potato"),
+		)
+	})
+})
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		When("passed a port", func() {
+			It("listens on localhost", func() {
+				Expect(forward.UnmarshalFlag("1080")).To(Succeed())
+				Expect(forward.LocalAddress).To(Equal("localhost:1080"))
+			})
+		})
+
+		When("passed a bind address and port", func() {
+			It("listens on the bind address", func() {
+				Expect(forward.UnmarshalFlag("0.0.0.0:1080")).To(Succeed())
+				Expect(forward.LocalAddress).To(Equal("0.0.0.0:1080"))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("not a port", "socks"),
+			Entry("empty bind address", ":1080"),
+			Entry("too many colons", "a:b:1080"),
+		)
+	})
+})
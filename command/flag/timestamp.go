@@ -0,0 +1,31 @@
+package flag
+
+import (
+	"fmt"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given either as an RFC3339 timestamp, such as
+// 2019-03-01T10:00:00Z, or as a duration before now, such as 30m or 2h.
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalFlag(val string) error {
+	if parsed, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time = parsed
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		t.Time = time.Now().Add(-duration)
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Invalid time '%s': use an RFC3339 timestamp such as 2019-03-01T10:00:00Z or a duration ago such as 30m", val),
+	}
+}
@@ -0,0 +1,45 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("accepts RFC3339 timestamps", func() {
+			err := timestamp.UnmarshalFlag("2019-03-01T10:00:00Z")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timestamp.Time).To(Equal(time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)))
+		})
+
+		It("accepts durations before now", func() {
+			err := timestamp.UnmarshalFlag("30m")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timestamp.Time).To(BeTemporally("~", time.Now().Add(-30*time.Minute), time.Second))
+		})
+
+		expectInvalid := func(val string) {
+			err := timestamp.UnmarshalFlag(val)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*flags.Error).Type).To(Equal(flags.ErrRequired))
+			Expect(err.Error()).To(ContainSubstring("Invalid time '%s'", val))
+			Expect(timestamp.Time.IsZero()).To(BeTrue())
+		}
+
+		It("rejects other values", func() {
+			expectInvalid("yesterday")
+			expectInvalid("-5m")
+		})
+	})
+})
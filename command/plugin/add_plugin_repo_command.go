@@ -12,11 +12,13 @@ import (
 
 type AddPluginRepoActor interface {
 	AddPluginRepository(repoName string, repoURL string) error
+	AddPluginRepositoryPublicKeys(repoName string, publicKeys []string) error
 }
 
 type AddPluginRepoCommand struct {
 	RequiredArgs      flag.AddPluginRepoArgs `positional-args:"yes"`
-	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo"`
+	PublicKeys        []string               `long:"public-key" description:"Base64-encoded ed25519 public key trusted to sign plugins in this repository (can be specified multiple times)"`
+	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL [--public-key PUBLIC_KEY]...\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo --public-key PKSf8AJtXbyfY+/xhgu1PFXH06mNBiTKp3veWgZEPZQ="`
 	relatedCommands   interface{}            `related_commands:"install-plugin, list-plugin-repos"`
 	SkipSSLValidation bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
@@ -32,9 +34,11 @@ func (cmd *AddPluginRepoCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd AddPluginRepoCommand) Execute(args []string) error {
-	err := cmd.Actor.AddPluginRepository(cmd.RequiredArgs.PluginRepoName, cmd.RequiredArgs.PluginRepoURL)
+	repoName := cmd.RequiredArgs.PluginRepoName
+	err := cmd.Actor.AddPluginRepository(repoName, cmd.RequiredArgs.PluginRepoURL)
 	switch e := err.(type) {
 	case actionerror.RepositoryAlreadyExistsError:
+		repoName = e.Name
 		cmd.UI.DisplayTextWithFlavor("{{.RepositoryURL}} already registered as {{.RepositoryName}}",
 			map[string]interface{}{
 				"RepositoryName": e.Name,
@@ -50,5 +54,17 @@ func (cmd AddPluginRepoCommand) Execute(args []string) error {
 		return err
 	}
 
+	if len(cmd.PublicKeys) > 0 {
+		err = cmd.Actor.AddPluginRepositoryPublicKeys(repoName, cmd.PublicKeys)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayText("Trusted {{.Count}} public key(s) for {{.RepositoryName}}",
+			map[string]interface{}{
+				"Count":          len(cmd.PublicKeys),
+				"RepositoryName": repoName,
+			})
+	}
+
 	return nil
 }
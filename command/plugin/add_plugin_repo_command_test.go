@@ -90,6 +90,49 @@ var _ = Describe("add-plugin-repo command", func() {
 			repoName, repoURL := fakeActor.AddPluginRepositoryArgsForCall(0)
 			Expect(repoName).To(Equal("some-repo"))
 			Expect(repoURL).To(Equal("https://some-repo-URL"))
+			Expect(fakeActor.AddPluginRepositoryPublicKeysCallCount()).To(Equal(0))
+		})
+	})
+
+	When("public keys are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.PluginRepoName = "some-repo"
+			cmd.RequiredArgs.PluginRepoURL = "https://some-repo-URL"
+			cmd.PublicKeys = []string{"some-key", "some-other-key"}
+		})
+
+		It("adds the plugin repo and trusts the keys", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("https://some-repo-URL added as some-repo"))
+			Expect(testUI.Out).To(Say(`Trusted 2 public key\(s\) for some-repo`))
+
+			Expect(fakeActor.AddPluginRepositoryPublicKeysCallCount()).To(Equal(1))
+			repoName, publicKeys := fakeActor.AddPluginRepositoryPublicKeysArgsForCall(0)
+			Expect(repoName).To(Equal("some-repo"))
+			Expect(publicKeys).To(Equal([]string{"some-key", "some-other-key"}))
+		})
+
+		When("the repo is already registered under another name", func() {
+			BeforeEach(func() {
+				fakeActor.AddPluginRepositoryReturns(actionerror.RepositoryAlreadyExistsError{Name: "Some-Repo", URL: "https://some-repo-URL"})
+			})
+
+			It("trusts the keys for the registered repo", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				repoName, _ := fakeActor.AddPluginRepositoryPublicKeysArgsForCall(0)
+				Expect(repoName).To(Equal("Some-Repo"))
+			})
+		})
+
+		When("a key is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.AddPluginRepositoryPublicKeysReturns(actionerror.InvalidPluginPublicKeyError{PublicKey: "some-key"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidPluginPublicKeyError{PublicKey: "some-key"}))
+			})
 		})
 	})
 })
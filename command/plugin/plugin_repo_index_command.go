@@ -0,0 +1,123 @@
+package plugin
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/pluginrepo"
+)
+
+//go:generate counterfeiter . PluginRepoIndexActor
+
+type PluginRepoIndexActor interface {
+	GeneratePluginRepositoryIndex(metadata pluginaction.PluginMetadata, dir string, baseURL string, tempPluginDir string) (plugin.PluginRepository, pluginaction.Warnings, error)
+}
+
+type PluginRepoIndexCommand struct {
+	RequiredArgs    flag.PluginRepoIndexArgs `positional-args:"yes"`
+	URL             string                   `long:"url" description:"URL the directory is served at, used as the base of the plugin binary URLs (Default with --listen: the URL the index is requested at)"`
+	Listen          string                   `long:"listen" description:"Serve the directory and its index at this address instead of writing the index (e.g. :8080)"`
+	usage           interface{}              `usage:"CF_NAME plugin-repo-index DIRECTORY --url URL\n   CF_NAME plugin-repo-index DIRECTORY --listen ADDRESS [--url URL]\n\n   Indexes the plugin binaries in DIRECTORY, which has a subdirectory for each plugin holding\n   one binary per platform. A binary is signed by a file of the same name with a .sig extension.\n   The index is written to DIRECTORY/list, or served with DIRECTORY when --listen is provided.\n\nEXAMPLES:\n   CF_NAME plugin-repo-index ./plugins --url https://plugins.example.com\n   CF_NAME plugin-repo-index ./plugins --listen :8080"`
+	relatedCommands interface{}              `related_commands:"add-plugin-repo, repo-plugins"`
+	UI              command.UI
+	Config          command.Config
+	Actor           PluginRepoIndexActor
+}
+
+func (cmd *PluginRepoIndexCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd PluginRepoIndexCommand) Execute(args []string) error {
+	if cmd.URL == "" && cmd.Listen == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "--url"}
+	}
+
+	dir := string(cmd.RequiredArgs.Directory)
+	baseURL := cmd.URL
+
+	var listener net.Listener
+	if cmd.Listen != "" {
+		var err error
+		listener, err = net.Listen("tcp", cmd.Listen)
+		if err != nil {
+			return err
+		}
+		defer listener.Close()
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Indexing plugins in {{.Directory}}...", map[string]interface{}{
+		"Directory": dir,
+	})
+
+	repository, err := cmd.generateIndex(dir, baseURL)
+	if err != nil {
+		return err
+	}
+
+	if listener == nil {
+		return cmd.writeIndex(dir, repository)
+	}
+
+	handler, err := pluginrepo.NewHandler(dir, repository)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Serving {{.Count}} plugin(s) on {{.Address}}. Press Ctrl-C to stop.", map[string]interface{}{
+		"Count":   len(repository.Plugins),
+		"Address": listener.Addr().String(),
+	})
+	return http.Serve(listener, handler)
+}
+
+func (cmd PluginRepoIndexCommand) generateIndex(dir string, baseURL string) (plugin.PluginRepository, error) {
+	tempPluginDir, err := ioutil.TempDir("", "plugin-repo-index")
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return plugin.PluginRepository{}, err
+	}
+
+	repository, warnings, err := cmd.Actor.GeneratePluginRepositoryIndex(rpcService, dir, baseURL, tempPluginDir)
+	cmd.UI.DisplayWarnings(warnings)
+	return repository, err
+}
+
+func (cmd PluginRepoIndexCommand) writeIndex(dir string, repository plugin.PluginRepository) error {
+	index, err := json.MarshalIndent(repository, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	indexPath := filepath.Join(dir, pluginrepo.IndexPath)
+	err = ioutil.WriteFile(indexPath, index, 0644)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Wrote index of {{.Count}} plugin(s) to {{.Path}}", map[string]interface{}{
+		"Count": len(repository.Plugins),
+		"Path":  indexPath,
+	})
+	return nil
+}
@@ -0,0 +1,119 @@
+package plugin_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("plugin-repo-index command", func() {
+	var (
+		cmd        PluginRepoIndexCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakePluginRepoIndexActor
+		dir        string
+		repository plugin.PluginRepository
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakePluginRepoIndexActor)
+
+		var err error
+		dir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = PluginRepoIndexCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.Directory = flag.PathWithExistenceCheck(dir)
+
+		repository = plugin.PluginRepository{
+			Plugins: []plugin.Plugin{{
+				Name:     "some-plugin",
+				Version:  "1.2.3",
+				Binaries: []plugin.PluginBinary{{Platform: "linux64", URL: "https://example.com/some-plugin/some-binary", Checksum: "some-checksum"}},
+			}},
+		}
+		fakeActor.GeneratePluginRepositoryIndexReturns(repository, pluginaction.Warnings{"some-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither --url nor --listen is provided", func() {
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "--url"}))
+			Expect(fakeActor.GeneratePluginRepositoryIndexCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--url is provided", func() {
+		BeforeEach(func() {
+			cmd.URL = "https://example.com"
+		})
+
+		It("writes the index to the directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GeneratePluginRepositoryIndexCallCount()).To(Equal(1))
+			_, dirArg, baseURLArg, _ := fakeActor.GeneratePluginRepositoryIndexArgsForCall(0)
+			Expect(dirArg).To(Equal(dir))
+			Expect(baseURLArg).To(Equal("https://example.com"))
+
+			index, err := ioutil.ReadFile(filepath.Join(dir, "list"))
+			Expect(err).ToNot(HaveOccurred())
+			var written plugin.PluginRepository
+			Expect(json.Unmarshal(index, &written)).To(Succeed())
+			Expect(written).To(Equal(repository))
+
+			Expect(testUI.Out).To(Say(`Indexing plugins in %s\.\.\.`, dir))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Wrote index of 1 plugin\(s\) to %s`, filepath.Join(dir, "list")))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("generating the index fails", func() {
+			BeforeEach(func() {
+				fakeActor.GeneratePluginRepositoryIndexReturns(plugin.PluginRepository{}, pluginaction.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error without writing the index", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(filepath.Join(dir, "list")).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
+	When("--listen is provided with an invalid address", func() {
+		BeforeEach(func() {
+			cmd.Listen = "not-an-address"
+		})
+
+		It("returns the error before generating the index", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeActor.GeneratePluginRepositoryIndexCallCount()).To(Equal(0))
+		})
+	})
+})
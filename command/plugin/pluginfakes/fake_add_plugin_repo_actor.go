@@ -20,6 +20,18 @@ type FakeAddPluginRepoActor struct {
 	addPluginRepositoryReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AddPluginRepositoryPublicKeysStub        func(string, []string) error
+	addPluginRepositoryPublicKeysMutex       sync.RWMutex
+	addPluginRepositoryPublicKeysArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	addPluginRepositoryPublicKeysReturns struct {
+		result1 error
+	}
+	addPluginRepositoryPublicKeysReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -85,11 +97,79 @@ func (fake *FakeAddPluginRepoActor) AddPluginRepositoryReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryPublicKeys(arg1 string, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	ret, specificReturn := fake.addPluginRepositoryPublicKeysReturnsOnCall[len(fake.addPluginRepositoryPublicKeysArgsForCall)]
+	fake.addPluginRepositoryPublicKeysArgsForCall = append(fake.addPluginRepositoryPublicKeysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddPluginRepositoryPublicKeys", []interface{}{arg1, arg2Copy})
+	fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	if fake.AddPluginRepositoryPublicKeysStub != nil {
+		return fake.AddPluginRepositoryPublicKeysStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.addPluginRepositoryPublicKeysReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryPublicKeysCallCount() int {
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
+	return len(fake.addPluginRepositoryPublicKeysArgsForCall)
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryPublicKeysCalls(stub func(string, []string) error) {
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	defer fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	fake.AddPluginRepositoryPublicKeysStub = stub
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryPublicKeysArgsForCall(i int) (string, []string) {
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
+	argsForCall := fake.addPluginRepositoryPublicKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryPublicKeysReturns(result1 error) {
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	defer fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	fake.AddPluginRepositoryPublicKeysStub = nil
+	fake.addPluginRepositoryPublicKeysReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryPublicKeysReturnsOnCall(i int, result1 error) {
+	fake.addPluginRepositoryPublicKeysMutex.Lock()
+	defer fake.addPluginRepositoryPublicKeysMutex.Unlock()
+	fake.AddPluginRepositoryPublicKeysStub = nil
+	if fake.addPluginRepositoryPublicKeysReturnsOnCall == nil {
+		fake.addPluginRepositoryPublicKeysReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addPluginRepositoryPublicKeysReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAddPluginRepoActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginRepositoryPublicKeysMutex.RLock()
+	defer fake.addPluginRepositoryPublicKeysMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
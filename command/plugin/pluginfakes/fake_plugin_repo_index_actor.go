@@ -0,0 +1,128 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package pluginfakes
+
+import (
+	sync "sync"
+
+	pluginaction "code.cloudfoundry.org/cli/actor/pluginaction"
+	plugina "code.cloudfoundry.org/cli/api/plugin"
+	plugin "code.cloudfoundry.org/cli/command/plugin"
+)
+
+type FakePluginRepoIndexActor struct {
+	GeneratePluginRepositoryIndexStub        func(pluginaction.PluginMetadata, string, string, string) (plugina.PluginRepository, pluginaction.Warnings, error)
+	generatePluginRepositoryIndexMutex       sync.RWMutex
+	generatePluginRepositoryIndexArgsForCall []struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	generatePluginRepositoryIndexReturns struct {
+		result1 plugina.PluginRepository
+		result2 pluginaction.Warnings
+		result3 error
+	}
+	generatePluginRepositoryIndexReturnsOnCall map[int]struct {
+		result1 plugina.PluginRepository
+		result2 pluginaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePluginRepoIndexActor) GeneratePluginRepositoryIndex(arg1 pluginaction.PluginMetadata, arg2 string, arg3 string, arg4 string) (plugina.PluginRepository, pluginaction.Warnings, error) {
+	fake.generatePluginRepositoryIndexMutex.Lock()
+	ret, specificReturn := fake.generatePluginRepositoryIndexReturnsOnCall[len(fake.generatePluginRepositoryIndexArgsForCall)]
+	fake.generatePluginRepositoryIndexArgsForCall = append(fake.generatePluginRepositoryIndexArgsForCall, struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GeneratePluginRepositoryIndex", []interface{}{arg1, arg2, arg3, arg4})
+	fake.generatePluginRepositoryIndexMutex.Unlock()
+	if fake.GeneratePluginRepositoryIndexStub != nil {
+		return fake.GeneratePluginRepositoryIndexStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.generatePluginRepositoryIndexReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePluginRepoIndexActor) GeneratePluginRepositoryIndexCallCount() int {
+	fake.generatePluginRepositoryIndexMutex.RLock()
+	defer fake.generatePluginRepositoryIndexMutex.RUnlock()
+	return len(fake.generatePluginRepositoryIndexArgsForCall)
+}
+
+func (fake *FakePluginRepoIndexActor) GeneratePluginRepositoryIndexCalls(stub func(pluginaction.PluginMetadata, string, string, string) (plugina.PluginRepository, pluginaction.Warnings, error)) {
+	fake.generatePluginRepositoryIndexMutex.Lock()
+	defer fake.generatePluginRepositoryIndexMutex.Unlock()
+	fake.GeneratePluginRepositoryIndexStub = stub
+}
+
+func (fake *FakePluginRepoIndexActor) GeneratePluginRepositoryIndexArgsForCall(i int) (pluginaction.PluginMetadata, string, string, string) {
+	fake.generatePluginRepositoryIndexMutex.RLock()
+	defer fake.generatePluginRepositoryIndexMutex.RUnlock()
+	argsForCall := fake.generatePluginRepositoryIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePluginRepoIndexActor) GeneratePluginRepositoryIndexReturns(result1 plugina.PluginRepository, result2 pluginaction.Warnings, result3 error) {
+	fake.generatePluginRepositoryIndexMutex.Lock()
+	defer fake.generatePluginRepositoryIndexMutex.Unlock()
+	fake.GeneratePluginRepositoryIndexStub = nil
+	fake.generatePluginRepositoryIndexReturns = struct {
+		result1 plugina.PluginRepository
+		result2 pluginaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePluginRepoIndexActor) GeneratePluginRepositoryIndexReturnsOnCall(i int, result1 plugina.PluginRepository, result2 pluginaction.Warnings, result3 error) {
+	fake.generatePluginRepositoryIndexMutex.Lock()
+	defer fake.generatePluginRepositoryIndexMutex.Unlock()
+	fake.GeneratePluginRepositoryIndexStub = nil
+	if fake.generatePluginRepositoryIndexReturnsOnCall == nil {
+		fake.generatePluginRepositoryIndexReturnsOnCall = make(map[int]struct {
+			result1 plugina.PluginRepository
+			result2 pluginaction.Warnings
+			result3 error
+		})
+	}
+	fake.generatePluginRepositoryIndexReturnsOnCall[i] = struct {
+		result1 plugina.PluginRepository
+		result2 pluginaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePluginRepoIndexActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.generatePluginRepositoryIndexMutex.RLock()
+	defer fake.generatePluginRepositoryIndexMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePluginRepoIndexActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.PluginRepoIndexActor = new(FakePluginRepoIndexActor)
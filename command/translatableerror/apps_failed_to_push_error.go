@@ -0,0 +1,39 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AppPushFailure is the error that caused an app to fail to push.
+type AppPushFailure struct {
+	AppName string
+	Err     error
+}
+
+type AppsFailedToPushError struct {
+	Failures []AppPushFailure
+}
+
+func (AppsFailedToPushError) Error() string {
+	return "Failed to push apps:\n{{.Errors}}"
+}
+
+func (e AppsFailedToPushError) Translate(translate func(string, ...interface{}) string) string {
+	var formattedErrs []string
+	for _, failure := range e.Failures {
+		var message string
+		if err, ok := failure.Err.(TranslatableError); ok {
+			message = err.Translate(translate)
+		} else if failure.Err != nil {
+			message = failure.Err.Error()
+		} else {
+			message = translate("UNKNOWN REASON")
+		}
+		formattedErrs = append(formattedErrs, fmt.Sprintf("- %s: %s", failure.AppName, message))
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": strings.Join(formattedErrs, "\n"),
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// CanaryProbeFailedError is returned when the probe run against the canary
+// instances of a deployment fails and the deployment has been rolled back.
+type CanaryProbeFailedError struct {
+	AppName string
+	Probe   string
+	Reason  string
+}
+
+func (CanaryProbeFailedError) Error() string {
+	return "Canary probe '{{.Probe}}' failed for app {{.AppName}}: {{.Reason}}\nThe deployment has been rolled back."
+}
+
+func (e CanaryProbeFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Probe":   e.Probe,
+		"Reason":  e.Reason,
+	})
+}
@@ -61,14 +61,24 @@ func ConvertToTranslatableError(err error) error {
 		return HTTPHealthCheckInvalidError{}
 	case actionerror.InvalidBuildpacksError:
 		return InvalidBuildpacksError{}
+	case actionerror.InvalidPluginPublicKeyError:
+		return InvalidPluginPublicKeyError(e)
+	case actionerror.InvalidPluginVersionRangeError:
+		return InvalidPluginVersionRangeError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
+	case actionerror.InvalidTaskScheduleError:
+		return InvalidTaskScheduleError(e)
 	case actionerror.InvalidTCPRouteSettings:
 		return HostAndPathNotAllowedWithTCPDomainError(e)
 	case actionerror.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError(e)
+	case actionerror.LoginDeniedError:
+		return LoginDeniedError(e)
+	case actionerror.LoginTimeoutError:
+		return LoginTimeoutError(e)
 	case actionerror.MissingNameError:
 		return RequiredNameForPushError{}
 	case actionerror.MultipleBuildpacksFoundError:
@@ -87,6 +97,8 @@ func ConvertToTranslatableError(err error) error {
 		return FileNotFoundError(e)
 	case actionerror.NoOrganizationTargetedError:
 		return NoOrganizationTargetedError(e)
+	case actionerror.NoRunningProcessInstancesError:
+		return NoRunningProcessInstancesError(e)
 	case actionerror.NoSpaceTargetedError:
 		return NoSpaceTargetedError(e)
 	case actionerror.NotLoggedInError:
@@ -103,6 +115,8 @@ func ConvertToTranslatableError(err error) error {
 		return PluginInvalidError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginUpgradeRolledBackError:
+		return PluginUpgradeRolledBackError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
@@ -132,6 +146,14 @@ func ConvertToTranslatableError(err error) error {
 		}
 	case actionerror.ServiceInstanceNotSharedToSpaceError:
 		return ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: e.ServiceInstanceName}
+	case actionerror.ServiceKeyNotFoundError:
+		return ServiceKeyNotFoundError(e)
+	case actionerror.ServiceKeyParametersUnavailableError:
+		return ServiceKeyParametersUnavailableError(e)
+	case actionerror.ServiceOperationFailedError:
+		return ServiceOperationFailedError(e)
+	case actionerror.ServiceOperationTimeoutError:
+		return ServiceOperationTimeoutError(e)
 	case actionerror.ServicePlanNotFoundError:
 		return ServicePlanNotFoundError(e)
 	case actionerror.SharedServiceInstanceNotFoundError:
@@ -144,6 +166,10 @@ func ConvertToTranslatableError(err error) error {
 		return StackNotFoundError(e)
 	case actionerror.StagingTimeoutError:
 		return StagingTimeoutError(e)
+	case actionerror.TaskFailedError:
+		return TaskFailedError(e)
+	case actionerror.TaskScheduleNotFoundError:
+		return TaskScheduleNotFoundError(e)
 	case actionerror.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
 	case actionerror.TCPRouteOptionsNotProvidedError:
@@ -216,6 +242,8 @@ func ConvertToTranslatableError(err error) error {
 		return DownloadPluginHTTPError{Message: e.Error()}
 
 	// SSH Errors
+	case ssherror.RecursiveCopyRequiredError:
+		return SSHRecursiveCopyRequiredError{Path: e.Path}
 	case ssherror.UnableToAuthenticateError:
 		return SSHUnableToAuthenticateError{}
 
@@ -117,6 +117,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidBuildpacksError{},
 			InvalidBuildpacksError{}),
 
+		Entry("actionerror.InvalidPluginPublicKeyError -> InvalidPluginPublicKeyError",
+			actionerror.InvalidPluginPublicKeyError{PublicKey: "some-key"},
+			InvalidPluginPublicKeyError{PublicKey: "some-key"}),
+
+		Entry("actionerror.InvalidPluginVersionRangeError -> InvalidPluginVersionRangeError",
+			actionerror.InvalidPluginVersionRangeError{VersionRange: "some-range"},
+			InvalidPluginVersionRangeError{VersionRange: "some-range"}),
+
 		Entry("actionerror.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
@@ -125,10 +133,22 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
 
+		Entry("actionerror.InvalidTaskScheduleError -> InvalidTaskScheduleError",
+			actionerror.InvalidTaskScheduleError{Schedule: "* *", Reason: "some-reason"},
+			InvalidTaskScheduleError{Schedule: "* *", Reason: "some-reason"}),
+
 		Entry("actionerror.InvalidTCPRouteSettings -> HostAndPathNotAllowedWithTCPDomainError",
 			actionerror.InvalidTCPRouteSettings{Domain: "some-domain"},
 			HostAndPathNotAllowedWithTCPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.LoginDeniedError -> LoginDeniedError",
+			actionerror.LoginDeniedError{},
+			LoginDeniedError{}),
+
+		Entry("actionerror.LoginTimeoutError -> LoginTimeoutError",
+			actionerror.LoginTimeoutError{Timeout: time.Minute},
+			LoginTimeoutError{Timeout: time.Minute}),
+
 		Entry("actionerror.MissingNameError -> RequiredNameForPushError",
 			actionerror.MissingNameError{},
 			RequiredNameForPushError{}),
@@ -165,6 +185,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoOrganizationTargetedError{BinaryName: "faceman"},
 			NoOrganizationTargetedError{BinaryName: "faceman"}),
 
+		Entry("actionerror.NoRunningProcessInstancesError -> NoRunningProcessInstancesError",
+			actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+			NoRunningProcessInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.NoSpaceTargetedError -> NoSpaceTargetedError",
 			actionerror.NoSpaceTargetedError{BinaryName: "faceman"},
 			NoSpaceTargetedError{BinaryName: "faceman"}),
@@ -211,6 +235,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginUpgradeRolledBackError -> PluginUpgradeRolledBackError",
+			actionerror.PluginUpgradeRolledBackError{PluginName: "some-plugin", PreviousVersion: "1.2.3", Err: genericErr},
+			PluginUpgradeRolledBackError{PluginName: "some-plugin", PreviousVersion: "1.2.3", Err: genericErr}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -280,6 +308,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
 
+		Entry("actionerror.TaskFailedError -> TaskFailedError",
+			actionerror.TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"},
+			TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"}),
+
+		Entry("actionerror.TaskScheduleNotFoundError -> TaskScheduleNotFoundError",
+			actionerror.TaskScheduleNotFoundError{AppName: "some-app", Name: "some-schedule"},
+			TaskScheduleNotFoundError{AppName: "some-app", Name: "some-schedule"}),
+
 		Entry("actionerror.TaskWorkersUnavailableError -> RunTaskError",
 			actionerror.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
@@ -314,6 +350,22 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: "some-service-instance-name"},
 			ServiceInstanceNotSharedToSpaceError{ServiceInstanceName: "some-service-instance-name"}),
 
+		Entry("actionerror.ServiceKeyNotFoundError -> ServiceKeyNotFoundError",
+			actionerror.ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"},
+			ServiceKeyNotFoundError{Name: "some-key", ServiceInstanceName: "some-service-instance"}),
+
+		Entry("actionerror.ServiceKeyParametersUnavailableError -> ServiceKeyParametersUnavailableError",
+			actionerror.ServiceKeyParametersUnavailableError{Name: "some-key", Err: err},
+			ServiceKeyParametersUnavailableError{Name: "some-key", Err: err}),
+
+		Entry("actionerror.ServiceOperationFailedError -> ServiceOperationFailedError",
+			actionerror.ServiceOperationFailedError{Operation: "create", Description: "some-description"},
+			ServiceOperationFailedError{Operation: "create", Description: "some-description"}),
+
+		Entry("actionerror.ServiceOperationTimeoutError -> ServiceOperationTimeoutError",
+			actionerror.ServiceOperationTimeoutError{Operation: "create", Timeout: time.Minute},
+			ServiceOperationTimeoutError{Operation: "create", Timeout: time.Minute}),
+
 		Entry("TipDecoratorError calls translates error on base error",
 			TipDecoratorError{BaseError: ccerror.APINotFoundError{URL: "some-url"}},
 			TipDecoratorError{BaseError: APINotFoundError{URL: "some-url"}}),
@@ -426,6 +478,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 		),
 
 		// SSH Error
+		Entry("ssherror.RecursiveCopyRequiredError -> SSHRecursiveCopyRequiredError",
+			ssherror.RecursiveCopyRequiredError{Path: "some-path"},
+			SSHRecursiveCopyRequiredError{Path: "some-path"}),
+
 		Entry("ssherror.UnableToAuthenticateError -> UnableToAuthenticateError",
 			ssherror.UnableToAuthenticateError{},
 			SSHUnableToAuthenticateError{}),
@@ -0,0 +1,17 @@
+package translatableerror
+
+// ExperimentalLoginRequiredError is returned when a login option is only
+// supported by the experimental login command and it is not enabled.
+type ExperimentalLoginRequiredError struct {
+	Flag string
+}
+
+func (ExperimentalLoginRequiredError) Error() string {
+	return "Option '{{.Flag}}' requires the experimental login command. Set CF_EXPERIMENTAL_LOGIN=true to use it."
+}
+
+func (e ExperimentalLoginRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Flag": e.Flag,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidOrganizationConfigError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidOrganizationConfigError) Error() string {
+	return "Unable to parse org configuration {{.Path}}: {{.Err}}"
+}
+
+func (e InvalidOrganizationConfigError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+		"Err":  e.Err.Error(),
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidPluginPublicKeyError struct {
+	PublicKey string
+}
+
+func (InvalidPluginPublicKeyError) Error() string {
+	return "Public key {{.PublicKey}} is not a base64-encoded ed25519 public key."
+}
+
+func (e InvalidPluginPublicKeyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PublicKey": e.PublicKey,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidPluginSignatureError struct {
+	RepositoryName string
+}
+
+func (InvalidPluginSignatureError) Error() string {
+	return "Downloaded plugin binary's signature was not made by a key trusted for repository {{.RepositoryName}}.\nThe binary may have been tampered with. Do not install it and contact the repository owner."
+}
+
+func (e InvalidPluginSignatureError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"RepositoryName": e.RepositoryName,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidPluginVersionRangeError struct {
+	VersionRange string
+}
+
+func (InvalidPluginVersionRangeError) Error() string {
+	return "Version range '{{.VersionRange}}' is not a valid semantic version range, e.g. '>=1.2.0 <2.0.0'."
+}
+
+func (e InvalidPluginVersionRangeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"VersionRange": e.VersionRange,
+	})
+}
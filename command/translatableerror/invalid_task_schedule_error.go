@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidTaskScheduleError struct {
+	Schedule string
+	Reason   string
+}
+
+func (InvalidTaskScheduleError) Error() string {
+	return "Invalid task schedule '{{.Schedule}}': {{.Reason}}"
+}
+
+func (e InvalidTaskScheduleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Schedule": e.Schedule,
+		"Reason":   e.Reason,
+	})
+}
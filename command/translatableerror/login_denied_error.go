@@ -0,0 +1,11 @@
+package translatableerror
+
+type LoginDeniedError struct{}
+
+func (LoginDeniedError) Error() string {
+	return "The login was denied."
+}
+
+func (e LoginDeniedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+import "time"
+
+type LoginTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (LoginTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for the login to be approved. Run login again to retry."
+}
+
+func (e LoginTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout": e.Timeout.String(),
+	})
+}
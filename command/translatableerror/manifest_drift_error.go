@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ManifestDriftError is returned when applying a manifest would change at
+// least one of the deployed applications.
+type ManifestDriftError struct {
+	AppNames []string
+}
+
+func (ManifestDriftError) Error() string {
+	return "Applying the manifest would change: {{.AppNames}}"
+}
+
+func (e ManifestDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoRunningProcessInstancesError is returned when an action needs at least
+// one running instance of a process and there are none.
+type NoRunningProcessInstancesError struct {
+	ProcessType string
+}
+
+func (NoRunningProcessInstancesError) Error() string {
+	return "Process {{.ProcessType}} has no running instances"
+}
+
+func (e NoRunningProcessInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
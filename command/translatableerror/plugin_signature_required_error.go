@@ -0,0 +1,17 @@
+package translatableerror
+
+type PluginSignatureRequiredError struct {
+	PluginName     string
+	RepositoryName string
+}
+
+func (PluginSignatureRequiredError) Error() string {
+	return "Plugin {{.PluginName}} from repository {{.RepositoryName}} is not signed by a trusted key and --require-signature was provided."
+}
+
+func (e PluginSignatureRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":     e.PluginName,
+		"RepositoryName": e.RepositoryName,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type PluginUpgradeRolledBackError struct {
+	PluginName      string
+	PreviousVersion string
+	Err             error
+}
+
+func (PluginUpgradeRolledBackError) Error() string {
+	return "Upgrading plugin {{.PluginName}} failed: {{.Err}}\nPlugin {{.PluginName}} {{.PreviousVersion}} was restored."
+}
+
+func (e PluginUpgradeRolledBackError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":      e.PluginName,
+		"PreviousVersion": e.PreviousVersion,
+		"Err":             e.Err,
+	})
+}
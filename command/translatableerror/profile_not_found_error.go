@@ -0,0 +1,15 @@
+package translatableerror
+
+type ProfileNotFoundError struct {
+	ProfileName string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return "Profile {{.ProfileName}} does not exist."
+}
+
+func (e ProfileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProfileName": e.ProfileName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ScheduledTasksFailedError struct {
+	Failed int
+	Total  int
+}
+
+func (ScheduledTasksFailedError) Error() string {
+	return "{{.Failed}} of {{.Total}} due tasks failed to run."
+}
+
+func (e ScheduledTasksFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// SecureCopyPathsError is returned when scp is given two local paths or two
+// paths on app instances.
+type SecureCopyPathsError struct{}
+
+func (SecureCopyPathsError) DisplayUsage() {}
+
+func (SecureCopyPathsError) Error() string {
+	return "Incorrect Usage: Exactly one of SOURCE and DESTINATION must be a path on the app instance, written as APP_NAME:PATH."
+}
+
+func (e SecureCopyPathsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceKeyNotFoundError struct {
+	Name                string
+	ServiceInstanceName string
+}
+
+func (ServiceKeyNotFoundError) Error() string {
+	return "No service key {{.ServiceKey}} found for service instance {{.ServiceInstance}}"
+}
+
+func (e ServiceKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceKey":      e.Name,
+		"ServiceInstance": e.ServiceInstanceName,
+	})
+}
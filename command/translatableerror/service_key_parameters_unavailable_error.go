@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceKeyParametersUnavailableError struct {
+	Name string
+	Err  error
+}
+
+func (ServiceKeyParametersUnavailableError) Error() string {
+	return "Could not get the parameters of service key {{.ServiceKey}}: {{.Error}}\nProvide the parameters of the new key with -c, or use -c '{}' to create it without parameters."
+}
+
+func (e ServiceKeyParametersUnavailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceKey": e.Name,
+		"Error":      e.Err,
+	})
+}
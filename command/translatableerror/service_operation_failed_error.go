@@ -0,0 +1,17 @@
+package translatableerror
+
+type ServiceOperationFailedError struct {
+	Operation   string
+	Description string
+}
+
+func (ServiceOperationFailedError) Error() string {
+	return "Service {{.Operation}} failed: {{.Description}}"
+}
+
+func (e ServiceOperationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Operation":   e.Operation,
+		"Description": e.Description,
+	})
+}
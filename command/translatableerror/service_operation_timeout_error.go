@@ -0,0 +1,19 @@
+package translatableerror
+
+import "time"
+
+type ServiceOperationTimeoutError struct {
+	Operation string
+	Timeout   time.Duration
+}
+
+func (ServiceOperationTimeoutError) Error() string {
+	return "Timed out after {{.Timeout}} waiting for service {{.Operation}} to complete. The operation may still be running on the service broker."
+}
+
+func (e ServiceOperationTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Operation": e.Operation,
+		"Timeout":   e.Timeout.String(),
+	})
+}
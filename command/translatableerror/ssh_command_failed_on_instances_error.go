@@ -0,0 +1,19 @@
+package translatableerror
+
+// SSHCommandFailedOnInstancesError is returned when a command run with
+// --all-instances did not succeed on every instance.
+type SSHCommandFailedOnInstancesError struct {
+	Failed int
+	Total  int
+}
+
+func (SSHCommandFailedOnInstancesError) Error() string {
+	return "Command failed on {{.Failed}} of {{.Total}} instances."
+}
+
+func (e SSHCommandFailedOnInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
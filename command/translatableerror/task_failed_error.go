@@ -0,0 +1,19 @@
+package translatableerror
+
+type TaskFailedError struct {
+	Name       string
+	SequenceID int64
+	Reason     string
+}
+
+func (TaskFailedError) Error() string {
+	return "Task {{.Name}} ({{.SequenceID}}) failed: {{.Reason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":       e.Name,
+		"SequenceID": e.SequenceID,
+		"Reason":     e.Reason,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type TaskScheduleNotFoundError struct {
+	AppName string
+	Name    string
+}
+
+func (TaskScheduleNotFoundError) Error() string {
+	return "Task schedule '{{.Name}}' for app '{{.AppName}}' not found."
+}
+
+func (e TaskScheduleNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":    e.Name,
+		"AppName": e.AppName,
+	})
+}
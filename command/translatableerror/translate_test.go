@@ -41,11 +41,13 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
+		Entry("AppsFailedToPushError", AppsFailedToPushError{Failures: []AppPushFailure{{AppName: "some-app", Err: JobFailedError{}}}}),
 		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", UnauthorizedError{}),
 		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
 		Entry("BuildpackStackChangeError", BuildpackStackChangeError{}),
+		Entry("CanaryProbeFailedError", CanaryProbeFailedError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
@@ -54,6 +56,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("EmptyArchiveError", EmptyArchiveError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("EmptyBuildpacksError", EmptyBuildpacksError{}),
+		Entry("ExperimentalLoginRequiredError", ExperimentalLoginRequiredError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
@@ -64,14 +67,22 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidPluginPublicKeyError", InvalidPluginPublicKeyError{}),
+		Entry("InvalidPluginVersionRangeError", InvalidPluginVersionRangeError{}),
+		Entry("InvalidPluginSignatureError", InvalidPluginSignatureError{}),
+		Entry("InvalidOrganizationConfigError", InvalidOrganizationConfigError{Err: errors.New("some-error")}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
+		Entry("InvalidTaskScheduleError", InvalidTaskScheduleError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("LoginDeniedError", LoginDeniedError{}),
+		Entry("LoginTimeoutError", LoginTimeoutError{}),
 		Entry("ManifestCreationError", ManifestCreationError{}),
+		Entry("ManifestDriftError", ManifestDriftError{AppNames: []string{"some-app"}}),
 		Entry("ManifestFileNotFoundInDirectoryError", ManifestFileNotFoundInDirectoryError{}),
 		Entry("MinimumCFAPIVersionNotMetError", MinimumCFAPIVersionNotMetError{}),
 		Entry("MinimumCLIVersionNotMetError", MinimumCLIVersionNotMetError{}),
@@ -84,6 +95,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("NoRunningProcessInstancesError", NoRunningProcessInstancesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
@@ -99,9 +111,12 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PluginSignatureRequiredError", PluginSignatureRequiredError{}),
+		Entry("PluginUpgradeRolledBackError", PluginUpgradeRolledBackError{Err: errors.New("some-error")}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
+		Entry("ProfileNotFoundError", ProfileNotFoundError{}),
 		Entry("PropertyCombinationError", PropertyCombinationError{Properties: []string{"property-1", "property-2"}}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
@@ -110,12 +125,19 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RoutePathWithTCPDomainError", RoutePathWithTCPDomainError{}),
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("ScheduledTasksFailedError", ScheduledTasksFailedError{}),
+		Entry("SecureCopyPathsError", SecureCopyPathsError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("ServiceKeyNotFoundError", ServiceKeyNotFoundError{}),
+		Entry("ServiceOperationFailedError", ServiceOperationFailedError{}),
+		Entry("ServiceOperationTimeoutError", ServiceOperationTimeoutError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
 		Entry("SpaceQuotaNotFoundByNameError", SpaceQuotaNotFoundByNameError{}),
+		Entry("SSHCommandFailedOnInstancesError", SSHCommandFailedOnInstancesError{}),
+		Entry("SSHRecursiveCopyRequiredError", SSHRecursiveCopyRequiredError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
@@ -124,6 +146,8 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TaskFailedError", TaskFailedError{}),
+		Entry("TaskScheduleNotFoundError", TaskScheduleNotFoundError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
@@ -16,13 +16,16 @@ type UI interface {
 	DisplayFileDeprecationWarning()
 	DisplayHeader(text string)
 	DisplayInstancesTableForApp(table [][]string)
+	DisplayStructuredLogMessage(message ui.LogMessage)
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
+	DisplayStructuredEntry(data interface{}) error
 	DisplayOK()
 	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayStructuredOutput(data interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextPrompt(template string, templateValues ...map[string]interface{}) (string, error)
@@ -33,6 +36,7 @@ type UI interface {
 	GetErr() io.Writer
 	GetIn() io.Reader
 	GetOut() io.Writer
+	IsStructuredOutput() bool
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
@@ -1,19 +1,88 @@
 package v6
 
 import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/document"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetSpaceApplicationSummaries(spaceGUID string) ([]v2action.SpaceApplicationSummary, v2action.Warnings, error)
+}
+
 type AppsCommand struct {
 	usage           interface{} `usage:"CF_NAME apps"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates the clients when the output is structured; the table
+// output is handled by the legacy command.
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd AppsCommand) Execute(args []string) error {
+	if !cmd.UI.IsStructuredOutput() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	summaries, warnings, err := cmd.Actor.GetSpaceApplicationSummaries(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	doc := appsDocument{Apps: []document.App{}}
+	for _, summary := range summaries {
+		routes := summary.URLs
+		if routes == nil {
+			routes = []string{}
+		}
+
+		doc.Apps = append(doc.Apps, document.App{
+			Name:   summary.Name,
+			GUID:   summary.GUID,
+			State:  strings.ToLower(summary.State),
+			Routes: routes,
+			Processes: []document.Process{{
+				Type:             "web",
+				Instances:        summary.Instances,
+				RunningInstances: summary.RunningInstances,
+				MemoryInMB:       summary.Memory,
+				DiskInMB:         summary.DiskQuota,
+			}},
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
 }
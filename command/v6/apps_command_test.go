@@ -0,0 +1,128 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeAppsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the output format is a table", func() {
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeActor.GetSpaceApplicationSummariesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the output format is JSON", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatJSON
+		})
+
+		When("checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		When("getting the apps succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceApplicationSummariesReturns([]v2action.SpaceApplicationSummary{
+					{
+						Name:             "app-1",
+						GUID:             "app-guid-1",
+						State:            "STARTED",
+						Instances:        2,
+						RunningInstances: 1,
+						Memory:           256,
+						DiskQuota:        1024,
+						URLs:             []string{"app-1.example.com"},
+					},
+					{Name: "app-2", GUID: "app-guid-2", State: "STOPPED"},
+				}, v2action.Warnings{"apps-warning"}, nil)
+			})
+
+			It("displays the apps as a JSON document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetSpaceApplicationSummariesArgsForCall(0)).To(Equal("some-space-guid"))
+
+				Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+					"apps": [
+						{
+							"name": "app-1",
+							"guid": "app-guid-1",
+							"state": "started",
+							"routes": ["app-1.example.com"],
+							"processes": [{"type": "web", "instances": 2, "running_instances": 1, "memory_in_mb": 256, "disk_in_mb": 1024}]
+						},
+						{
+							"name": "app-2",
+							"guid": "app-guid-2",
+							"state": "stopped",
+							"routes": [],
+							"processes": [{"type": "web", "instances": 0, "running_instances": 0, "memory_in_mb": 0, "disk_in_mb": 0}]
+						}
+					]
+				}`))
+				Expect(testUI.Err).To(Say(`{"warnings":\["apps-warning"\]}`))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceApplicationSummariesReturns(nil, v2action.Warnings{"apps-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say(`{"warnings":\["apps-warning"\]}`))
+			})
+		})
+	})
+})
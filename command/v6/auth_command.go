@@ -2,6 +2,8 @@ package v6
 
 import (
 	"fmt"
+	"io/ioutil"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -21,11 +23,12 @@ type AuthActor interface {
 }
 
 type AuthCommand struct {
-	RequiredArgs      flag.Authentication `positional-args:"yes"`
-	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
-	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overriden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands   interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication         `positional-args:"yes"`
+	AssertionFile     flag.PathWithExistenceCheck `long:"assertion-file" description:"File containing a signed identity token (JWT) to exchange for an access token, e.g. the OIDC token of a CI pipeline"`
+	ClientCredentials bool                        `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	Origin            string                      `long:"origin" description:"Indicates the identity provider to be used for authentication"`
+	usage             interface{}                 `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n   CF_NAME auth --assertion-file TOKEN_FILE\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overriden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth --assertion-file $CI_OIDC_TOKEN_FILE (exchange an identity token for an access token)"`
+	relatedCommands   interface{}                 `related_commands:"api, login, target"`
 
 	UI     command.UI
 	Config command.Config
@@ -59,9 +62,33 @@ func (cmd AuthCommand) Execute(args []string) error {
 		}
 	}
 
-	username, password, err := cmd.checkEnvVariables()
-	if err != nil {
-		return err
+	var (
+		username  string
+		password  string
+		grantType constant.GrantType
+		err       error
+	)
+	if cmd.AssertionFile != "" {
+		err = cmd.checkAssertionFlags()
+		if err != nil {
+			return err
+		}
+
+		password, err = cmd.readAssertion()
+		if err != nil {
+			return err
+		}
+		grantType = constant.GrantTypeJWTBearer
+	} else {
+		username, password, err = cmd.checkEnvVariables()
+		if err != nil {
+			return err
+		}
+
+		grantType = constant.GrantTypePassword
+		if cmd.ClientCredentials {
+			grantType = constant.GrantTypeClientCredentials
+		}
 	}
 
 	err = command.WarnIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, cmd.Actor.CloudControllerAPIVersion(), cmd.UI)
@@ -76,11 +103,6 @@ func (cmd AuthCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayText("Authenticating...")
 
-	grantType := constant.GrantTypePassword
-	if cmd.ClientCredentials {
-		grantType = constant.GrantTypeClientCredentials
-	}
-
 	err = cmd.Actor.Authenticate(username, password, cmd.Origin, grantType)
 	if err != nil {
 		return err
@@ -96,6 +118,28 @@ func (cmd AuthCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd AuthCommand) checkAssertionFlags() error {
+	switch {
+	case cmd.ClientCredentials:
+		return translatableerror.ArgumentCombinationError{Args: []string{"--assertion-file", "--client-credentials"}}
+	case cmd.Origin != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"--assertion-file", "--origin"}}
+	case cmd.RequiredArgs.Username != "" || cmd.RequiredArgs.Password != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"--assertion-file", "USERNAME", "PASSWORD"}}
+	}
+	return nil
+}
+
+// readAssertion returns the identity token in the assertion file, without the
+// trailing newline most tools write after it.
+func (cmd AuthCommand) readAssertion() (string, error) {
+	rawAssertion, err := ioutil.ReadFile(string(cmd.AssertionFile))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(rawAssertion)), nil
+}
+
 func (cmd AuthCommand) checkEnvVariables() (string, string, error) {
 	var (
 		userMissing     bool
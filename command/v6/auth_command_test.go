@@ -2,11 +2,14 @@ package v6_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
@@ -250,6 +253,60 @@ var _ = Describe("auth Command", func() {
 			})
 		})
 
+		When("--assertion-file is set", func() {
+			var assertionFile string
+
+			BeforeEach(func() {
+				tmpFile, tmpErr := ioutil.TempFile("", "assertion")
+				Expect(tmpErr).ToNot(HaveOccurred())
+				_, tmpErr = tmpFile.WriteString("some.signed.token\n")
+				Expect(tmpErr).ToNot(HaveOccurred())
+				Expect(tmpFile.Close()).To(Succeed())
+
+				assertionFile = tmpFile.Name()
+				cmd.AssertionFile = flag.PathWithExistenceCheck(assertionFile)
+			})
+
+			AfterEach(func() {
+				Expect(os.Remove(assertionFile)).To(Succeed())
+			})
+
+			It("exchanges the identity token in the file for an access token", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				ID, secret, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(ID).To(BeEmpty())
+				Expect(secret).To(Equal("some.signed.token"))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeJWTBearer))
+			})
+
+			When("--client-credentials is also set", func() {
+				BeforeEach(func() {
+					cmd.ClientCredentials = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--assertion-file", "--client-credentials"}}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a username is also provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Username = testID
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--assertion-file", "USERNAME", "PASSWORD"}}))
+				})
+			})
+		})
+
 		When("--client-credentials is not set", func() {
 			When("username and password are only provided as arguments", func() {
 				BeforeEach(func() {
@@ -9,6 +9,8 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -56,6 +58,16 @@ var _ = Describe("bind-service Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--wait-timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.WaitTimeout = flag.PositiveInteger{Value: 60}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--wait-timeout", Arg2: "--wait"}))
+		})
+	})
+
 	When("a cloud controller API endpoint is set", func() {
 		BeforeEach(func() {
 			fakeConfig.TargetReturns("some-url")
@@ -231,6 +243,58 @@ var _ = Describe("bind-service Command", func() {
 
 					Expect(testUI.Out).To(Say("TIP: Once this operation succeeds, use 'faceman restage %s' to ensure your env variable changes take effect.", cmd.RequiredArgs.AppName))
 				})
+
+				When("--wait is provided", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+						fakeActor.BindServiceBySpaceReturns(
+							v2action.ServiceBinding{GUID: "some-binding-guid", LastOperation: ccv2.LastOperation{State: constant.LastOperationInProgress}},
+							nil,
+							nil,
+						)
+
+						lastOperations := make(chan v2action.LastOperation, 1)
+						warnings := make(chan string)
+						errs := make(chan error)
+						lastOperations <- v2action.LastOperation{Type: "create", State: constant.LastOperationSucceeded}
+						close(lastOperations)
+						close(warnings)
+						close(errs)
+						fakeActor.PollServiceBindingOperationReturns(lastOperations, warnings, errs)
+					})
+
+					It("waits for the binding to be created", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						guid, timeout := fakeActor.PollServiceBindingOperationArgsForCall(0)
+						Expect(guid).To(Equal("some-binding-guid"))
+						Expect(timeout).To(BeZero())
+
+						Expect(testUI.Out).To(Say("create succeeded"))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).ToNot(Say("Binding in progress"))
+						Expect(testUI.Out).To(Say("TIP: Use 'faceman restage %s' to ensure your env variable changes take effect", cmd.RequiredArgs.AppName))
+					})
+				})
+
+				When("--wait is provided and the binding fails", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+						lastOperations := make(chan v2action.LastOperation)
+						warnings := make(chan string)
+						errs := make(chan error, 1)
+						errs <- actionerror.ServiceOperationFailedError{Operation: "create", Description: "broker error"}
+						close(lastOperations)
+						close(warnings)
+						close(errs)
+						fakeActor.PollServiceBindingOperationReturns(lastOperations, warnings, errs)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(actionerror.ServiceOperationFailedError{Operation: "create", Description: "broker error"}))
+						Expect(testUI.Out).ToNot(Say("OK"))
+					})
+				})
 			})
 		})
 	})
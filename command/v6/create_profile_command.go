@@ -0,0 +1,40 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type CreateProfileCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME create-profile PROFILE_NAME\n\n   Saves the current API endpoint, login and targeted org and space as a named profile."`
+	relatedCommands interface{}      `related_commands:"delete-profile, profiles, switch-profile"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *CreateProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd CreateProfileCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Creating profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": cmd.RequiredArgs.ProfileName,
+	})
+
+	if _, found := cmd.Config.GetProfile(cmd.RequiredArgs.ProfileName); found {
+		cmd.UI.DisplayWarning("Profile {{.ProfileName}} already exists.", map[string]interface{}{
+			"ProfileName": cmd.RequiredArgs.ProfileName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.Config.SaveProfile(cmd.RequiredArgs.ProfileName)
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-profile Command", func() {
+	var (
+		cmd        CreateProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = CreateProfileCommand{
+			RequiredArgs: flag.ProfileName{ProfileName: "some-profile"},
+			UI:           testUI,
+			Config:       fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the profile does not exist", func() {
+		It("saves the current target as the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Creating profile some-profile..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.SaveProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.SaveProfileArgsForCall(0)).To(Equal("some-profile"))
+		})
+	})
+
+	When("the profile already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.GetProfileReturns(configv3.Profile{Name: "some-profile"}, true)
+		})
+
+		It("warns and does not replace the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("Profile some-profile already exists."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeConfig.SaveProfileCallCount()).To(Equal(0))
+		})
+	})
+})
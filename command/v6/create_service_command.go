@@ -1,6 +1,8 @@
 package v6
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -15,6 +17,7 @@ import (
 
 type CreateServiceActor interface {
 	CreateServiceInstance(spaceGUID, serviceName, servicePlanName, serviceInstanceName, brokerName string, params map[string]interface{}, tags []string) (v2action.ServiceInstance, v2action.Warnings, error)
+	PollServiceInstanceOperation(serviceInstanceGUID string, timeout time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)
 }
 
 type CreateServiceCommand struct {
@@ -22,7 +25,9 @@ type CreateServiceCommand struct {
 	ServiceBroker    string                        `short:"b" description:"Create a service instance from a particular broker. Required when service name is ambiguous"`
 	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
 	Tags             flag.Tags                     `short:"t" description:"User provided tags"`
-	usage            interface{}                   `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-b BROKER] [-c PARAMETERS_AS_JSON] [-t TAGS]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\""`
+	Wait             bool                          `long:"wait" description:"Wait for the service broker to finish creating the service instance"`
+	WaitTimeout      flag.PositiveInteger          `long:"wait-timeout" description:"Maximum time (in seconds) to wait with --wait (Default: no limit)"`
+	usage            interface{}                   `usage:"CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-b BROKER] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait [--wait-timeout SECONDS]]\n\n   Optionally provide service-specific configuration parameters in a valid JSON object in-line:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{\"name\":\"value\",\"name\":\"value\"}'\n\n   Optionally provide a file containing service-specific configuration parameters in a valid JSON object.\n   The path to the parameters file can be an absolute or relative path to a file:\n\n   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE\n\n   Example of valid JSON object:\n   {\n      \"cluster_nodes\": {\n         \"count\": 5,\n         \"memory_mb\": 1024\n      }\n   }\n\nTIP:\n   Use 'CF_NAME create-user-provided-service' to make user-provided services available to CF apps\n\nEXAMPLES:\n   Linux/Mac:\n      CF_NAME create-service db-service silver mydb -c '{\"ram_gb\":4}'\n\n   Windows Command Line:\n      CF_NAME create-service db-service silver mydb -c \"{\\\"ram_gb\\\":4}\"\n\n   Windows PowerShell:\n      CF_NAME create-service db-service silver mydb -c '{\\\"ram_gb\\\":4}'\n\n   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n   CF_NAME create-service db-service silver mydb -t \"list, of, tags\"\n\n   CF_NAME create-service db-service silver mydb --wait --wait-timeout 600"`
 	relatedCommands  interface{}                   `related_commands:"bind-service, create-user-provided-service, marketplace, services"`
 
 	UI          command.UI
@@ -54,6 +59,10 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.WaitTimeout.Value > 0 && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{Arg1: "--wait-timeout", Arg2: "--wait"}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
@@ -95,6 +104,17 @@ func (cmd CreateServiceCommand) Execute(args []string) error {
 		return err
 	}
 
+	if instance.LastOperation.State == constant.LastOperationInProgress && cmd.Wait {
+		lastOperations, warnings, errs := cmd.Actor.PollServiceInstanceOperation(instance.GUID, time.Duration(cmd.WaitTimeout.Value)*time.Second)
+		err = shared.PollServiceOperation(cmd.UI, lastOperations, warnings, errs)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
 	if instance.LastOperation.State == constant.LastOperationInProgress {
 		cmd.UI.DisplayOK()
 		cmd.UI.DisplayTextWithFlavor("Create in progress. Use 'cf services' or 'cf service {{.ServiceInstance}}' to check operation status.",
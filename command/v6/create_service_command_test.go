@@ -2,7 +2,9 @@ package v6_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -66,6 +68,17 @@ var _ = Describe("create-service Command", func() {
 		})
 	})
 
+	When("--wait-timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.WaitTimeout = flag.PositiveInteger{Value: 60}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--wait-timeout", Arg2: "--wait"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	It("checks the user is logged in, and targeting an org and space", func() {
 		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
 		orgChecked, spaceChecked := fakeSharedActor.CheckTargetArgsForCall(0)
@@ -168,6 +181,58 @@ var _ = Describe("create-service Command", func() {
 					Expect(testUI.Out).To(Say("OK"))
 					Expect(testUI.Out).To(Say("Create in progress\\. Use 'cf services' or 'cf service cool-service' to check operation status\\."))
 				})
+
+				When("--wait is provided", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+						cmd.WaitTimeout = flag.PositiveInteger{Value: 60}
+						fakeActor.CreateServiceInstanceReturns(v2action.ServiceInstance{GUID: "some-instance-guid", LastOperation: ccv2.LastOperation{State: constant.LastOperationInProgress}}, nil, nil)
+
+						lastOperations := make(chan v2action.LastOperation, 2)
+						warnings := make(chan string, 1)
+						errs := make(chan error)
+						lastOperations <- v2action.LastOperation{Type: "create", State: constant.LastOperationInProgress, Description: "provisioning"}
+						lastOperations <- v2action.LastOperation{Type: "create", State: constant.LastOperationSucceeded}
+						warnings <- "poll-warning"
+						close(lastOperations)
+						close(warnings)
+						close(errs)
+						fakeActor.PollServiceInstanceOperationReturns(lastOperations, warnings, errs)
+					})
+
+					It("waits for the create to finish and displays each state", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						guid, timeout := fakeActor.PollServiceInstanceOperationArgsForCall(0)
+						Expect(guid).To(Equal("some-instance-guid"))
+						Expect(timeout).To(Equal(time.Minute))
+
+						Expect(testUI.Out).To(Say("create in progress: provisioning"))
+						Expect(testUI.Out).To(Say("create succeeded"))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Out).ToNot(Say("Create in progress"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+					})
+				})
+
+				When("--wait is provided and the create fails", func() {
+					BeforeEach(func() {
+						cmd.Wait = true
+						lastOperations := make(chan v2action.LastOperation)
+						warnings := make(chan string)
+						errs := make(chan error, 1)
+						errs <- actionerror.ServiceOperationFailedError{Operation: "create", Description: "out of capacity"}
+						close(lastOperations)
+						close(warnings)
+						close(errs)
+						fakeActor.PollServiceInstanceOperationReturns(lastOperations, warnings, errs)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError(actionerror.ServiceOperationFailedError{Operation: "create", Description: "out of capacity"}))
+						Expect(testUI.Out).ToNot(Say("OK"))
+					})
+				})
 			})
 		})
 
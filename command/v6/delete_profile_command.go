@@ -0,0 +1,54 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DeleteProfileCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-profile PROFILE_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"create-profile, profiles"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *DeleteProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd DeleteProfileCommand) Execute(args []string) error {
+	if !cmd.Force {
+		deleteProfile, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the profile {{.ProfileName}}?", map[string]interface{}{
+			"ProfileName": cmd.RequiredArgs.ProfileName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteProfile {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": cmd.RequiredArgs.ProfileName,
+	})
+
+	if _, found := cmd.Config.GetProfile(cmd.RequiredArgs.ProfileName); !found {
+		cmd.UI.DisplayText("Profile {{.ProfileName}} does not exist.", map[string]interface{}{
+			"ProfileName": cmd.RequiredArgs.ProfileName,
+		})
+	} else {
+		cmd.Config.DeleteProfile(cmd.RequiredArgs.ProfileName)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
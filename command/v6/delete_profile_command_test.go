@@ -0,0 +1,90 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-profile Command", func() {
+	var (
+		cmd        DeleteProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = DeleteProfileCommand{
+			RequiredArgs: flag.ProfileName{ProfileName: "some-profile"},
+			UI:           testUI,
+			Config:       fakeConfig,
+		}
+		fakeConfig.GetProfileReturns(configv3.Profile{Name: "some-profile"}, true)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Really delete the profile some-profile\?`))
+			Expect(testUI.Out).To(Say("Deleting profile some-profile..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteProfileArgsForCall(0)).To(Equal("some-profile"))
+		})
+	})
+
+	When("the user cancels the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		When("the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.GetProfileReturns(configv3.Profile{}, false)
+			})
+
+			It("displays that the profile does not exist", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Deleting profile some-profile..."))
+				Expect(testUI.Out).To(Say("Profile some-profile does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
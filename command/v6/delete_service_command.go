@@ -9,7 +9,9 @@ import (
 type DeleteServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f]"`
+	Wait            bool                 `long:"wait" description:"Wait for the service broker to finish deleting the service instance"`
+	WaitTimeout     flag.PositiveInteger `long:"wait-timeout" description:"Maximum time (in seconds) to wait with --wait (Default: no limit)"`
+	usage           interface{}          `usage:"CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait [--wait-timeout SECONDS]]"`
 	relatedCommands interface{}          `related_commands:"unbind-service, services"`
 }
 
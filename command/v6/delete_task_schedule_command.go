@@ -0,0 +1,93 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . DeleteTaskScheduleActor
+
+type DeleteTaskScheduleActor interface {
+	DeleteTaskSchedule(spaceGUID string, appName string, name string) error
+}
+
+type DeleteTaskScheduleCommand struct {
+	RequiredArgs    flag.DeleteTaskScheduleArgs `positional-args:"yes"`
+	Force           bool                        `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}                 `usage:"CF_NAME delete-task-schedule APP_NAME TASK_NAME [-f]"`
+	relatedCommands interface{}                 `related_commands:"schedule-task, task-schedules"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteTaskScheduleActor
+}
+
+func (cmd *DeleteTaskScheduleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd DeleteTaskScheduleCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteSchedule, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the task schedule {{.TaskName}} for app {{.AppName}}?", map[string]interface{}{
+			"TaskName": cmd.RequiredArgs.TaskName,
+			"AppName":  cmd.RequiredArgs.AppName,
+		})
+
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteSchedule {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+	cmd.UI.DisplayTextWithFlavor("Deleting task schedule {{.TaskName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskName":    cmd.RequiredArgs.TaskName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	err = cmd.Actor.DeleteTaskSchedule(space.GUID, cmd.RequiredArgs.AppName, cmd.RequiredArgs.TaskName)
+	if _, ok := err.(actionerror.TaskScheduleNotFoundError); ok {
+		cmd.UI.DisplayWarning("Task schedule {{.TaskName}} for app {{.AppName}} does not exist.", map[string]interface{}{
+			"TaskName": cmd.RequiredArgs.TaskName,
+			"AppName":  cmd.RequiredArgs.AppName,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-task-schedule Command", func() {
+	var (
+		cmd             DeleteTaskScheduleCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeDeleteTaskScheduleActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeDeleteTaskScheduleActor)
+
+		cmd = DeleteTaskScheduleCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.TaskName = "nightly"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("the user declines the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the schedule", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the task schedule nightly for app some-app\?`))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteTaskScheduleCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms the prompt", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the schedule", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Deleting task schedule nightly for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			spaceGUID, appName, name := fakeActor.DeleteTaskScheduleArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(appName).To(Equal("some-app"))
+			Expect(name).To(Equal("nightly"))
+		})
+	})
+
+	When("-f is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		When("the schedule does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteTaskScheduleReturns(actionerror.TaskScheduleNotFoundError{AppName: "some-app", Name: "nightly"})
+			})
+
+			It("displays a warning and OK", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Task schedule nightly for app some-app does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("deleting the schedule returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteTaskScheduleReturns(errors.New("delete error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("delete error"))
+			})
+		})
+	})
+})
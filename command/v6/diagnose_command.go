@@ -0,0 +1,230 @@
+package v6
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/noaa/consumer"
+)
+
+//go:generate counterfeiter . DiagnoseActor
+
+type DiagnoseActor interface {
+	GetApplicationDiagnosisByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) (v2v3action.ApplicationDiagnosis, v2v3action.Warnings, error)
+}
+
+type DiagnoseCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME diagnose APP_NAME"`
+	relatedCommands interface{}  `related_commands:"app, events, get-health-check, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DiagnoseActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *DiagnoseCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
+	v3Actor := v3action.NewActor(ccClient, config, nil, nil)
+	cmd.Actor = v2v3action.NewActor(v2Actor, v3Actor)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+
+	return nil
+}
+
+func (cmd DiagnoseCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Diagnosing app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	diagnosis, warnings, err := cmd.Actor.GetApplicationDiagnosisByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayApplication(diagnosis)
+	for _, process := range diagnosis.ProcessSummaries {
+		cmd.displayProcess(process)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(diagnosis.Crashes) == 0 {
+		cmd.UI.DisplayText("No crashes found for app {{.AppName}}.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+		return nil
+	}
+
+	cmd.displayCrashes(diagnosis.Crashes)
+	cmd.displayLikelyCauses(diagnosis)
+
+	return nil
+}
+
+func (cmd DiagnoseCommand) displayApplication(diagnosis v2v3action.ApplicationDiagnosis) {
+	var buildpacks []string
+	for _, buildpack := range diagnosis.CurrentDroplet.Buildpacks {
+		if buildpack.DetectOutput != "" {
+			buildpacks = append(buildpacks, buildpack.DetectOutput)
+		} else {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), diagnosis.Name},
+		{cmd.UI.TranslateText("requested state:"), strings.ToLower(string(diagnosis.State))},
+		{cmd.UI.TranslateText("stack:"), diagnosis.CurrentDroplet.Stack},
+		{cmd.UI.TranslateText("buildpacks:"), strings.Join(buildpacks, ", ")},
+		{cmd.UI.TranslateText("droplet state:"), strings.ToLower(string(diagnosis.CurrentDroplet.State))},
+	}, 3)
+}
+
+func (cmd DiagnoseCommand) displayProcess(process v3action.ProcessSummary) {
+	cmd.UI.DisplayNewline()
+
+	healthCheck := string(process.HealthCheckType)
+	if process.HealthCheckType == constant.HTTP && process.HealthCheckEndpoint != "" {
+		healthCheck = fmt.Sprintf("%s %s", healthCheck, process.HealthCheckEndpoint)
+	}
+
+	keyValueTable := [][]string{
+		{cmd.UI.TranslateText("type:"), process.Type},
+		{cmd.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
+		{cmd.UI.TranslateText("health check:"), healthCheck},
+		{cmd.UI.TranslateText("health check timeouts:"), cmd.UI.TranslateText("invocation {{.InvocationTimeout}}s, start {{.StartTimeout}}s", map[string]interface{}{
+			"InvocationTimeout": process.HealthCheckInvocationTimeout,
+			"StartTimeout":      process.HealthCheckTimeout,
+		})},
+	}
+	if process.Command.IsSet {
+		keyValueTable = append(keyValueTable, []string{cmd.UI.TranslateText("start command:"), process.Command.Value})
+	}
+	cmd.UI.DisplayKeyValueTable("", keyValueTable, 3)
+
+	if len(process.InstanceDetails) == 0 {
+		return
+	}
+
+	table := [][]string{{
+		"",
+		cmd.UI.TranslateText("state"),
+		cmd.UI.TranslateText("since"),
+		cmd.UI.TranslateText("memory"),
+	}}
+	for _, instance := range process.InstanceDetails {
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.Index),
+			cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
+			instance.StartTime().Local().Format("2006-01-02 15:04:05 PM"),
+			cmd.UI.TranslateText("{{.MemUsage}} of {{.MemQuota}}", map[string]interface{}{
+				"MemUsage": bytefmt.ByteSize(instance.MemoryUsage),
+				"MemQuota": bytefmt.ByteSize(instance.MemoryQuota),
+			}),
+		})
+	}
+	cmd.UI.DisplayInstancesTableForApp(table)
+}
+
+func (cmd DiagnoseCommand) displayCrashes(crashes []v2v3action.Crash) {
+	cmd.UI.DisplayText("Recent crashes:")
+
+	table := [][]string{{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("instance"),
+		cmd.UI.TranslateText("exit status"),
+		cmd.UI.TranslateText("description"),
+	}}
+	for _, crash := range crashes {
+		table = append(table, []string{
+			crash.Timestamp.Local().Format(eventTimestampFormat),
+			fmt.Sprintf("#%d", crash.Index),
+			fmt.Sprint(crash.ExitStatus),
+			crash.ExitDescription,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	for _, crash := range crashes {
+		if len(crash.Logs) == 0 {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Logs around the crash of instance #{{.Index}} at {{.Time}}:", map[string]interface{}{
+			"Index": crash.Index,
+			"Time":  crash.Timestamp.Local().Format(eventTimestampFormat),
+		})
+		for _, log := range crash.Logs {
+			cmd.UI.DisplayLogMessage(log, true)
+		}
+	}
+}
+
+func (cmd DiagnoseCommand) displayLikelyCauses(diagnosis v2v3action.ApplicationDiagnosis) {
+	cmd.UI.DisplayNewline()
+
+	if len(diagnosis.LikelyCauses) == 0 {
+		cmd.UI.DisplayText("No likely cause found. Run '{{.BinaryName}} logs {{.AppName}} --recent' for more details.", map[string]interface{}{
+			"AppName":    diagnosis.Name,
+			"BinaryName": cmd.Config.BinaryName(),
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("Likely causes:")
+	for i, likelyCause := range diagnosis.LikelyCauses {
+		cmd.UI.DisplayText("{{.Rank}}. {{.Cause}} ({{.Crashes}} of {{.Total}} crashes)", map[string]interface{}{
+			"Rank":    i + 1,
+			"Cause":   cmd.UI.TranslateText(string(likelyCause.Cause)),
+			"Crashes": likelyCause.Crashes,
+			"Total":   len(diagnosis.Crashes),
+		})
+		for _, evidence := range likelyCause.Evidence {
+			cmd.UI.DisplayText("   {{.Evidence}}", map[string]interface{}{
+				"Evidence": evidence,
+			})
+		}
+	}
+}
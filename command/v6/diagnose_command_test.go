@@ -0,0 +1,184 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diagnose Command", func() {
+	var (
+		cmd             DiagnoseCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeDiagnoseActor
+		diagnosis       v2v3action.ApplicationDiagnosis
+		crashTime       time.Time
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeDiagnoseActor)
+
+		cmd = DiagnoseCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		crashTime = time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+		diagnosis = v2v3action.ApplicationDiagnosis{
+			ApplicationSummary: v3action.ApplicationSummary{
+				Application: v3action.Application{Name: "some-app", State: constant.ApplicationStarted},
+				CurrentDroplet: v3action.Droplet{
+					Stack:      "cflinuxfs3",
+					State:      constant.DropletStaged,
+					Buildpacks: []v3action.Buildpack{{Name: "ruby_buildpack"}},
+				},
+				ProcessSummaries: v3action.ProcessSummaries{
+					{
+						Process: v3action.Process{
+							Type:                         constant.ProcessTypeWeb,
+							Command:                      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+							HealthCheckType:              constant.Port,
+							HealthCheckInvocationTimeout: 1,
+							HealthCheckTimeout:           60,
+						},
+						InstanceDetails: []v3action.ProcessInstance{
+							{Index: 0, State: constant.ProcessInstanceCrashed, MemoryUsage: 1024 * 1024, MemoryQuota: 32 * 1024 * 1024},
+						},
+					},
+				},
+			},
+		}
+		fakeActor.GetApplicationDiagnosisByNameAndSpaceReturns(diagnosis, v2v3action.Warnings{"diagnose-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetApplicationDiagnosisByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app has not crashed", func() {
+		It("displays the app and its processes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			appName, spaceGUID, _ := fakeActor.GetApplicationDiagnosisByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Diagnosing app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`requested state:\s+started`))
+			Expect(testUI.Out).To(Say(`stack:\s+cflinuxfs3`))
+			Expect(testUI.Out).To(Say(`buildpacks:\s+ruby_buildpack`))
+			Expect(testUI.Out).To(Say(`droplet state:\s+staged`))
+			Expect(testUI.Out).To(Say(`type:\s+web`))
+			Expect(testUI.Out).To(Say(`health check:\s+port`))
+			Expect(testUI.Out).To(Say(`health check timeouts:\s+invocation 1s, start 60s`))
+			Expect(testUI.Out).To(Say(`start command:\s+bundle exec rackup`))
+			Expect(testUI.Out).To(Say(`#0\s+crashed\s+.*1M of 32M`))
+			Expect(testUI.Out).To(Say(`No crashes found for app some-app\.`))
+			Expect(testUI.Err).To(Say("diagnose-warning"))
+		})
+	})
+
+	When("the app has crashed", func() {
+		BeforeEach(func() {
+			diagnosis.Crashes = []v2v3action.Crash{
+				{
+					Index:           0,
+					Timestamp:       crashTime,
+					ExitStatus:      137,
+					ExitDescription: "APP/PROC/WEB: Exited with status 137 (out of memory)",
+					Logs: []v2action.LogMessage{
+						*v2action.NewLogMessage("java.lang.OutOfMemoryError", int(events.LogMessage_ERR), crashTime, "APP/PROC/WEB", "0"),
+					},
+				},
+			}
+			diagnosis.LikelyCauses = []v2v3action.LikelyCause{
+				{
+					Cause:    v2v3action.CrashCauseOutOfMemory,
+					Score:    4,
+					Crashes:  1,
+					Evidence: []string{"instance #0: APP/PROC/WEB: Exited with status 137 (out of memory)"},
+				},
+			}
+			fakeActor.GetApplicationDiagnosisByNameAndSpaceReturns(diagnosis, nil, nil)
+		})
+
+		It("displays the crashes, the logs around them and the likely causes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Recent crashes:"))
+			Expect(testUI.Out).To(Say(`time\s+instance\s+exit status\s+description`))
+			Expect(testUI.Out).To(Say(`#0\s+137\s+APP/PROC/WEB: Exited with status 137 \(out of memory\)`))
+			Expect(testUI.Out).To(Say(`Logs around the crash of instance #0 at`))
+			Expect(testUI.Out).To(Say(`\[APP/PROC/WEB/0\] ERR java\.lang\.OutOfMemoryError`))
+			Expect(testUI.Out).To(Say("Likely causes:"))
+			Expect(testUI.Out).To(Say(`1\. out of memory \(1 of 1 crashes\)`))
+			Expect(testUI.Out).To(Say(`   instance #0: APP/PROC/WEB: Exited with status 137 \(out of memory\)`))
+		})
+
+		When("no cause could be found", func() {
+			BeforeEach(func() {
+				diagnosis.LikelyCauses = nil
+				fakeActor.GetApplicationDiagnosisByNameAndSpaceReturns(diagnosis, nil, nil)
+			})
+
+			It("suggests looking at the logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No likely cause found\. Run 'faceman logs some-app --recent' for more details\.`))
+			})
+		})
+	})
+
+	When("diagnosing the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationDiagnosisByNameAndSpaceReturns(v2v3action.ApplicationDiagnosis{}, v2v3action.Warnings{"diagnose-warning"}, errors.New("diagnose-error"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("diagnose-error"))
+			Expect(testUI.Err).To(Say("diagnose-warning"))
+		})
+	})
+})
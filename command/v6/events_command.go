@@ -1,20 +1,279 @@
 package v6
 
 import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
+const eventTimestampFormat = "2006-01-02T15:04:05.00-0700"
+
+//go:generate counterfeiter . EventsActor
+
+type EventsActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetEvents(filter v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)
+	PollEvents(filter v2action.EventFilter, stop <-chan bool) (<-chan v2action.Event, <-chan string, <-chan error)
+}
+
 type EventsCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME events APP_NAME"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	Org             bool                 `long:"org" description:"Show events from every space in the targeted org instead of the targeted space"`
+	Types           []string             `long:"type" description:"Only show events of this type, such as audit.app.crash or audit.app.update (can be specified multiple times)"`
+	Actor           string               `long:"actor" description:"Only show events initiated by the user, client or app with this name or GUID"`
+	Since           flag.Timestamp       `long:"since" description:"Only show events at or after this time, given as an RFC3339 timestamp or a duration ago such as 2h"`
+	Until           flag.Timestamp       `long:"until" description:"Only show events before this time, given as an RFC3339 timestamp or a duration ago such as 2h"`
+	Follow          bool                 `long:"follow" description:"Keep polling for new events until interrupted, starting now unless --since is provided"`
+	usage           interface{}          `usage:"CF_NAME events APP_NAME\n   CF_NAME events [APP_NAME] [--org] [--type EVENT_TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--follow]\n\n   Without --since, only the 50 most recent events are shown.\n\nEXAMPLES:\n   CF_NAME events --type audit.app.crash --since 1h\n   CF_NAME events --org --actor admin --since 2019-03-01T10:00:00Z --until 2019-03-01T11:00:00Z\n   CF_NAME events my-app --follow --output json | jq .type"`
+	relatedCommands interface{}          `related_commands:"app, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	EventsActor EventsActor
 }
 
-func (EventsCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates the clients when the refactored command is used; listing
+// the recent events of a single app is handled by the legacy command.
+func (cmd *EventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	if cmd.usesLegacyCommand() {
+		return nil
+	}
+
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.EventsActor = v2action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (EventsCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd EventsCommand) Execute(args []string) error {
+	if cmd.usesLegacyCommand() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	if cmd.Org && cmd.RequiredArgs.AppName != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}
+	}
+	if cmd.Follow && !cmd.Until.IsZero() {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--follow", "--until"}}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, !cmd.Org)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	filter, err := cmd.eventFilter(user.Name)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Follow {
+		return cmd.followEvents(filter)
+	}
+
+	events, warnings, err := cmd.EventsActor.GetEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		doc := eventsDocument{Events: []eventDocument{}}
+		for _, event := range events {
+			doc.Events = append(doc.Events, newEventDocument(event))
+		}
+		return cmd.UI.DisplayStructuredOutput(doc)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No events found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+		cmd.UI.TranslateText("actor"),
+		cmd.UI.TranslateText("target"),
+	}}
+	for _, event := range events {
+		table = append(table, eventRow(event))
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// usesLegacyCommand returns true when only an app name is provided and the
+// output is not structured, which keeps the output of the legacy events
+// command.
+func (cmd EventsCommand) usesLegacyCommand() bool {
+	return cmd.RequiredArgs.AppName != "" &&
+		!cmd.Org &&
+		len(cmd.Types) == 0 &&
+		cmd.Actor == "" &&
+		cmd.Since.IsZero() &&
+		cmd.Until.IsZero() &&
+		!cmd.Follow &&
+		!cmd.UI.IsStructuredOutput()
+}
+
+func (cmd EventsCommand) eventFilter(username string) (v2action.EventFilter, error) {
+	filter := v2action.EventFilter{
+		Types: cmd.Types,
+		Actor: cmd.Actor,
+		Since: cmd.Since.Time,
+		Until: cmd.Until.Time,
+	}
+	if filter.Since.IsZero() && !cmd.Follow {
+		filter.Limit = v2action.DefaultEventLimit
+	}
+
+	displayFlavor := !cmd.UI.IsStructuredOutput()
+	flavorData := map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	}
+
+	switch {
+	case cmd.Org:
+		filter.OrganizationGUID = cmd.Config.TargetedOrganization().GUID
+		if displayFlavor {
+			cmd.UI.DisplayTextWithFlavor("Getting events for org {{.OrgName}} as {{.Username}}...", flavorData)
+		}
+	case cmd.RequiredArgs.AppName != "":
+		app, warnings, err := cmd.EventsActor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return filter, err
+		}
+		filter.ActeeGUID = app.GUID
+		if displayFlavor {
+			cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", flavorData)
+		}
+	default:
+		filter.SpaceGUID = cmd.Config.TargetedSpace().GUID
+		if displayFlavor {
+			cmd.UI.DisplayTextWithFlavor("Getting events for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", flavorData)
+		}
+	}
+
+	if displayFlavor {
+		cmd.UI.DisplayNewline()
+	}
+
+	return filter, nil
+}
+
+func (cmd EventsCommand) followEvents(filter v2action.EventFilter) error {
+	stop := make(chan bool)
+	defer close(stop)
+
+	events, warnings, errs := cmd.EventsActor.PollEvents(filter, stop)
+
+	for events != nil || warnings != nil || errs != nil {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				break
+			}
+
+			if cmd.UI.IsStructuredOutput() {
+				err := cmd.UI.DisplayStructuredEntry(newEventDocument(event))
+				if err != nil {
+					return err
+				}
+				break
+			}
+			cmd.UI.DisplayNonWrappingTable("", [][]string{eventRow(event)}, ui.DefaultTableSpacePadding)
+		case warning, ok := <-warnings:
+			if !ok {
+				warnings = nil
+				break
+			}
+			cmd.UI.DisplayWarning(warning)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func eventRow(event v2action.Event) []string {
+	actor := event.ActorName
+	if actor == "" {
+		actor = event.ActorGUID
+	}
+
+	target := event.ActeeName
+	if event.ActeeType != "" {
+		target = fmt.Sprintf("%s %s", event.ActeeType, event.ActeeName)
+	}
+
+	return []string{
+		event.Timestamp.Local().Format(eventTimestampFormat),
+		string(event.Type),
+		actor,
+		target,
+	}
+}
+
+type eventsDocument struct {
+	Events []eventDocument `json:"events" yaml:"events"`
+}
+
+type eventDocument struct {
+	GUID      string                 `json:"guid" yaml:"guid"`
+	Type      string                 `json:"type" yaml:"type"`
+	Timestamp string                 `json:"timestamp" yaml:"timestamp"`
+	ActorGUID string                 `json:"actor_guid" yaml:"actor_guid"`
+	ActorType string                 `json:"actor_type" yaml:"actor_type"`
+	ActorName string                 `json:"actor_name" yaml:"actor_name"`
+	ActeeGUID string                 `json:"actee_guid" yaml:"actee_guid"`
+	ActeeType string                 `json:"actee_type" yaml:"actee_type"`
+	ActeeName string                 `json:"actee_name" yaml:"actee_name"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+func newEventDocument(event v2action.Event) eventDocument {
+	return eventDocument{
+		GUID:      event.GUID,
+		Type:      string(event.Type),
+		Timestamp: event.Timestamp.UTC().Format(time.RFC3339),
+		ActorGUID: event.ActorGUID,
+		ActorType: event.ActorType,
+		ActorName: event.ActorName,
+		ActeeGUID: event.ActeeGUID,
+		ActeeType: event.ActeeType,
+		ActeeName: event.ActeeName,
+		Metadata:  event.Metadata,
+	}
 }
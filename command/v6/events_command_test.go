@@ -0,0 +1,344 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("events Command", func() {
+	var (
+		cmd             EventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeEventsActor
+		executeErr      error
+		timestamp       time.Time
+		event           v2action.Event
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeEventsActor)
+
+		cmd = EventsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			EventsActor: fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		timestamp = time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+		event = v2action.Event{
+			GUID:      "some-event-guid",
+			Type:      constant.EventTypeAuditApplicationUpdate,
+			ActorName: "admin",
+			ActeeType: "app",
+			ActeeName: "some-app",
+			Timestamp: timestamp,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("only an app name is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.OptionalAppName{AppName: "some-app"}
+		})
+
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+				fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+			})
+
+			It("uses the refactored command", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+					ActeeGUID: "some-app-guid",
+					Limit:     v2action.DefaultEventLimit,
+				}))
+			})
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("no app name is provided", func() {
+		BeforeEach(func() {
+			cmd.Types = []string{"audit.app.update"}
+			cmd.Actor = "admin"
+			cmd.Since = flag.Timestamp{Time: timestamp}
+			fakeActor.GetEventsReturns([]v2action.Event{event}, v2action.Warnings{"get-events-warning"}, nil)
+		})
+
+		It("displays the events of the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Getting events for space some-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target`))
+			Expect(testUI.Out).To(Say(`audit\.app\.update\s+admin\s+app some-app`))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+				SpaceGUID: "some-space-guid",
+				Types:     []string{"audit.app.update"},
+				Actor:     "admin",
+				Since:     timestamp,
+			}))
+		})
+
+		When("there are no events", func() {
+			BeforeEach(func() {
+				fakeActor.GetEventsReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No events found."))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetEventsReturns(nil, v2action.Warnings{"get-events-warning"}, errors.New("get-events-error"))
+			})
+
+			It("returns the error and displays the warnings", func() {
+				Expect(executeErr).To(MatchError("get-events-error"))
+				Expect(testUI.Err).To(Say("get-events-warning"))
+			})
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the events as a JSON document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting events"))
+				Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{"events": [{
+					"guid": "some-event-guid",
+					"type": "audit.app.update",
+					"timestamp": "2019-03-01T10:00:00Z",
+					"actor_guid": "",
+					"actor_type": "",
+					"actor_name": "admin",
+					"actee_guid": "",
+					"actee_type": "app",
+					"actee_name": "some-app"
+				}]}`))
+			})
+		})
+
+		When("--since is not provided", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.Timestamp{}
+			})
+
+			It("only requests the most recent events", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetEventsArgsForCall(0).Limit).To(Equal(v2action.DefaultEventLimit))
+			})
+		})
+
+		When("the output format is YAML", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatYAML
+			})
+
+			It("displays the events as a YAML document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting events"))
+				Expect(testUI.Out).To(Say(`events:\n- guid: some-event-guid\n  type: audit.app.update\n`))
+			})
+		})
+	})
+
+	When("--org is provided", func() {
+		BeforeEach(func() {
+			cmd.Org = true
+		})
+
+		It("displays the events of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting events for org some-org as steve\.\.\.`))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+				OrganizationGUID: "some-org-guid",
+				Limit:            v2action.DefaultEventLimit,
+			}))
+		})
+
+		When("an app name is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.OptionalAppName{AppName: "some-app"}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--org"}}))
+			})
+		})
+	})
+
+	When("an app name is provided with filters", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.OptionalAppName{AppName: "some-app"}
+			cmd.Types = []string{"audit.app.crash"}
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid"}, v2action.Warnings{"get-app-warning"}, nil)
+		})
+
+		It("displays the events of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting events for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+				ActeeGUID: "some-app-guid",
+				Types:     []string{"audit.app.crash"},
+				Limit:     v2action.DefaultEventLimit,
+			}))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--follow is provided", func() {
+		var (
+			events   chan v2action.Event
+			warnings chan string
+			errs     chan error
+		)
+
+		BeforeEach(func() {
+			cmd.Follow = true
+
+			events = make(chan v2action.Event, 1)
+			warnings = make(chan string, 1)
+			errs = make(chan error, 1)
+			events <- event
+			warnings <- "poll-warning"
+			close(events)
+			close(warnings)
+			fakeActor.PollEventsReturns(events, warnings, errs)
+		})
+
+		When("polling stops", func() {
+			BeforeEach(func() {
+				close(errs)
+			})
+
+			It("displays each event as it arrives", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`audit\.app\.update\s+admin\s+app some-app`))
+				Expect(testUI.Err).To(Say("poll-warning"))
+
+				filter, _ := fakeActor.PollEventsArgsForCall(0)
+				Expect(filter).To(Equal(v2action.EventFilter{SpaceGUID: "some-space-guid"}))
+			})
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+				close(errs)
+			})
+
+			It("displays one JSON object per line for each event", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`(?m)^\{"guid":"some-event-guid","type":"audit\.app\.update",.*\}\n`))
+			})
+		})
+
+		When("the output format is YAML", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatYAML
+				close(errs)
+			})
+
+			It("displays a YAML document for each event", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`---\nguid: some-event-guid\ntype: audit\.app\.update\n`))
+				Expect(testUI.Out).ToNot(Say(`\{`))
+			})
+		})
+
+		When("polling fails", func() {
+			BeforeEach(func() {
+				errs <- errors.New("poll-error")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("poll-error"))
+			})
+		})
+
+		When("--until is also provided", func() {
+			BeforeEach(func() {
+				cmd.Until = flag.Timestamp{Time: timestamp}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--follow", "--until"}}))
+				Expect(fakeActor.PollEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,165 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v6/shared"
+	yaml "gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . ExportOrgActor
+
+type ExportOrgActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error)
+	GetOrganizationConfig(orgName string) (v2action.OrganizationConfig, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . ExportOrgActorV3
+
+type ExportOrgActorV3 interface {
+	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+}
+
+type ExportOrgCommand struct {
+	RequiredArgs        flag.Organization `positional-args:"yes"`
+	IncludeFeatureFlags bool              `long:"include-feature-flags" description:"Also export the feature flags. Feature flags affect every org in the foundation"`
+	usage               interface{}       `usage:"CF_NAME export-org ORG [--include-feature-flags]\n\nEXAMPLES:\n   CF_NAME export-org my-org > my-org.yml\n   CF_NAME --output json export-org my-org > my-org.json"`
+	relatedCommands     interface{}       `related_commands:"import-org, org, org-users, space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportOrgActor
+	ActorV3     ExportOrgActorV3
+}
+
+func (cmd *ExportOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	return nil
+}
+
+func (cmd ExportOrgCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	orgConfig, warnings, err := cmd.Actor.GetOrganizationConfig(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.ActorV3 != nil {
+		err = cmd.addIsolationSegments(&orgConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.IncludeFeatureFlags {
+		err = cmd.addFeatureFlags(&orgConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructuredOutput(orgConfig)
+	}
+
+	raw, err := yaml.Marshal(orgConfig)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.UI.GetOut().Write(raw)
+	return err
+}
+
+// addFeatureFlags fills in the foundation-wide feature flags.
+func (cmd ExportOrgCommand) addFeatureFlags(orgConfig *v2action.OrganizationConfig) error {
+	featureFlags, warnings, err := cmd.Actor.GetFeatureFlags()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	orgConfig.FeatureFlags = map[string]bool{}
+	for _, featureFlag := range featureFlags {
+		orgConfig.FeatureFlags[featureFlag.Name] = featureFlag.Enabled
+	}
+	return nil
+}
+
+// addIsolationSegments fills in the isolation segments of the organization
+// and its spaces, which are only available through the V3 API.
+func (cmd ExportOrgCommand) addIsolationSegments(orgConfig *v2action.OrganizationConfig) error {
+	org, warnings, err := cmd.Actor.GetOrganizationByName(orgConfig.Name)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	isolationSegments, v3Warnings, err := cmd.ActorV3.GetIsolationSegmentsByOrganization(org.GUID)
+	cmd.UI.DisplayWarnings(v3Warnings)
+	if err != nil {
+		return err
+	}
+	for _, isolationSegment := range isolationSegments {
+		orgConfig.IsolationSegments = append(orgConfig.IsolationSegments, isolationSegment.Name)
+		if isolationSegment.GUID == org.DefaultIsolationSegmentGUID {
+			orgConfig.DefaultIsolationSegment = isolationSegment.Name
+		}
+	}
+
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	spaceGUIDs := map[string]string{}
+	for _, space := range spaces {
+		spaceGUIDs[space.Name] = space.GUID
+	}
+
+	for i, spaceConfig := range orgConfig.Spaces {
+		isolationSegment, v3Warnings, err := cmd.ActorV3.GetEffectiveIsolationSegmentBySpace(spaceGUIDs[spaceConfig.Name], "")
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if err != nil {
+			if _, ok := err.(actionerror.NoRelationshipError); ok {
+				continue
+			}
+			return err
+		}
+		orgConfig.Spaces[i].IsolationSegment = isolationSegment.Name
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-org Command", func() {
+	var (
+		cmd             ExportOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeExportOrgActor
+		fakeActorV3     *v6fakes.FakeExportOrgActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeExportOrgActor)
+		fakeActorV3 = new(v6fakes.FakeExportOrgActorV3)
+
+		cmd = ExportOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		cmd.RequiredArgs.Organization = "some-org"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeFalse())
+			Expect(targetedSpaceRequired).To(BeFalse())
+		})
+	})
+
+	When("getting the org config returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationConfigReturns(v2action.OrganizationConfig{}, v2action.Warnings{"config-warning"}, errors.New("config error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("config error"))
+			Expect(testUI.Err).To(Say("config-warning"))
+			Expect(fakeActorV3.GetIsolationSegmentsByOrganizationCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the org config succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationConfigReturns(v2action.OrganizationConfig{
+				Name:     "some-org",
+				Managers: []string{"amy"},
+				Spaces:   []v2action.SpaceConfig{{Name: "space-1"}, {Name: "space-2"}},
+			}, v2action.Warnings{"config-warning"}, nil)
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org", DefaultIsolationSegmentGUID: "iso-2-guid"}, nil, nil)
+			fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "space-1-guid", Name: "space-1"}, {GUID: "space-2-guid", Name: "space-2"}}, nil, nil)
+			fakeActorV3.GetIsolationSegmentsByOrganizationReturns([]v3action.IsolationSegment{
+				{GUID: "iso-1-guid", Name: "iso-1"},
+				{GUID: "iso-2-guid", Name: "iso-2"},
+			}, v3action.Warnings{"iso-warning"}, nil)
+			fakeActorV3.GetEffectiveIsolationSegmentBySpaceStub = func(spaceGUID string, _ string) (v3action.IsolationSegment, v3action.Warnings, error) {
+				if spaceGUID == "space-1-guid" {
+					return v3action.IsolationSegment{Name: "iso-1"}, nil, nil
+				}
+				return v3action.IsolationSegment{}, nil, actionerror.NoRelationshipError{}
+			}
+		})
+
+		It("writes the configuration with isolation segments as YAML", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("config-warning"))
+			Expect(testUI.Err).To(Say("iso-warning"))
+
+			Expect(testUI.Out).To(Say(`name: some-org\n`))
+			Expect(testUI.Out).To(Say(`isolation_segments:\n- iso-1\n- iso-2\n`))
+			Expect(testUI.Out).To(Say(`default_isolation_segment: iso-2\n`))
+			Expect(testUI.Out).To(Say(`managers:\n- amy\n`))
+			Expect(testUI.Out).To(Say(`- name: space-1\n  isolation_segment: iso-1\n`))
+			Expect(testUI.Out).To(Say(`- name: space-2\n`))
+			Expect(testUI.Out).ToNot(Say("isolation_segment"))
+
+			Expect(fakeActor.GetOrganizationConfigArgsForCall(0)).To(Equal("some-org"))
+			Expect(fakeActorV3.GetIsolationSegmentsByOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+
+		It("does not export the feature flags", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("feature_flags"))
+			Expect(fakeActor.GetFeatureFlagsCallCount()).To(Equal(0))
+		})
+
+		When("--include-feature-flags is provided", func() {
+			BeforeEach(func() {
+				cmd.IncludeFeatureFlags = true
+				fakeActor.GetFeatureFlagsReturns([]v2action.FeatureFlag{
+					{Name: "flag-a", Enabled: true},
+					{Name: "flag-b", Enabled: false},
+				}, v2action.Warnings{"feature-flag-warning"}, nil)
+			})
+
+			It("exports the feature flags", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("feature-flag-warning"))
+				Expect(testUI.Out).To(Say(`feature_flags:\n  flag-a: true\n  flag-b: false\n`))
+			})
+
+			When("getting the feature flags fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetFeatureFlagsReturns(nil, nil, errors.New("feature flag error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("feature flag error"))
+				})
+			})
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("writes the configuration as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"name": "some-org"`))
+				Expect(testUI.Out).To(Say(`"default_isolation_segment": "iso-2"`))
+			})
+		})
+
+		When("the V3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("writes the configuration without isolation segments", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`name: some-org\n`))
+				Expect(testUI.Out).ToNot(Say("isolation_segment"))
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,312 @@
+package v6
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	yaml "gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . ImportOrgActor
+
+type ImportOrgActor interface {
+	ApplyOrganizationConfig(config v2action.OrganizationConfig) (v2action.Organization, []v2action.ConfigChange, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . ImportOrgActorV3
+
+type ImportOrgActorV3 interface {
+	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error)
+	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error)
+	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v3action.Warnings, error)
+}
+
+type ImportOrgCommand struct {
+	RequiredArgs      flag.ImportOrgArgs `positional-args:"yes"`
+	ApplyFeatureFlags bool               `long:"apply-feature-flags" description:"Also apply the feature_flags in the file. Feature flags affect every org in the foundation"`
+	usage             interface{}        `usage:"CF_NAME import-org PATH [--apply-feature-flags]\n\n   Creates or updates the org described by the file to match it. Resources and roles not listed in the file are left untouched.\n\nEXAMPLES:\n   CF_NAME import-org my-org.yml"`
+	relatedCommands   interface{}        `related_commands:"export-org, org, org-users, space-quotas"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ImportOrgActor
+	ActorV3     ImportOrgActorV3
+}
+
+func (cmd *ImportOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config, nil, nil)
+	}
+
+	return nil
+}
+
+func (cmd ImportOrgCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	orgConfig, err := cmd.readConfig(string(cmd.RequiredArgs.PathToConfig))
+	if err != nil {
+		return err
+	}
+
+	if cmd.ActorV3 == nil && usesIsolationSegments(orgConfig) {
+		return translatableerror.V3APIDoesNotExistError{Message: "Isolation segments in the org configuration are not supported by this Cloud Controller."}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Importing org {{.OrgName}} from {{.Path}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgConfig.Name,
+			"Path":     cmd.RequiredArgs.PathToConfig,
+			"Username": user.Name,
+		})
+	}
+
+	if len(orgConfig.FeatureFlags) > 0 {
+		if cmd.ApplyFeatureFlags {
+			cmd.UI.DisplayWarning("Applying the feature flags in {{.Path}}. Feature flags affect every org in the foundation, not only {{.OrgName}}.", map[string]interface{}{
+				"Path":    cmd.RequiredArgs.PathToConfig,
+				"OrgName": orgConfig.Name,
+			})
+		} else {
+			cmd.UI.DisplayWarning("Ignoring the feature flags in {{.Path}} because they affect every org in the foundation. Use --apply-feature-flags to apply them.", map[string]interface{}{
+				"Path": cmd.RequiredArgs.PathToConfig,
+			})
+			orgConfig.FeatureFlags = nil
+		}
+	}
+
+	org, changes, warnings, err := cmd.Actor.ApplyOrganizationConfig(orgConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err == nil && cmd.ActorV3 != nil {
+		var isolationSegmentChanges []v2action.ConfigChange
+		isolationSegmentChanges, err = cmd.applyIsolationSegments(org, orgConfig)
+		changes = append(changes, isolationSegmentChanges...)
+	}
+
+	displayErr := cmd.displayChanges(changes)
+	if err != nil {
+		return err
+	}
+	if displayErr != nil {
+		return displayErr
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayOK()
+	}
+	return nil
+}
+
+func (cmd ImportOrgCommand) readConfig(path string) (v2action.OrganizationConfig, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return v2action.OrganizationConfig{}, err
+	}
+
+	var orgConfig v2action.OrganizationConfig
+	err = yaml.UnmarshalStrict(raw, &orgConfig)
+	if err != nil {
+		return v2action.OrganizationConfig{}, translatableerror.InvalidOrganizationConfigError{Path: path, Err: err}
+	}
+
+	return orgConfig, nil
+}
+
+// applyIsolationSegments entitles the organization to the isolation segments
+// in the configuration and assigns the organization default and space
+// isolation segments. These are only available through the V3 API.
+func (cmd ImportOrgCommand) applyIsolationSegments(org v2action.Organization, orgConfig v2action.OrganizationConfig) ([]v2action.ConfigChange, error) {
+	var changes []v2action.ConfigChange
+
+	entitled, warnings, err := cmd.ActorV3.GetIsolationSegmentsByOrganization(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return changes, err
+	}
+	entitledNames := map[string]bool{}
+	for _, isolationSegment := range entitled {
+		entitledNames[isolationSegment.Name] = true
+	}
+
+	for _, name := range orgConfig.IsolationSegments {
+		change := v2action.ConfigChange{Resource: "isolation segment", Name: name, Action: v2action.ConfigUnchanged}
+		if !entitledNames[name] {
+			warnings, err = cmd.ActorV3.EntitleIsolationSegmentToOrganizationByName(name, org.Name)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return changes, err
+			}
+			change.Action = v2action.ConfigCreated
+		}
+		changes = append(changes, change)
+	}
+
+	if orgConfig.DefaultIsolationSegment != "" {
+		change := v2action.ConfigChange{Resource: "default isolation segment", Name: orgConfig.DefaultIsolationSegment, Action: v2action.ConfigUnchanged}
+		isolationSegment, warnings, err := cmd.ActorV3.GetIsolationSegmentByName(orgConfig.DefaultIsolationSegment)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return changes, err
+		}
+		if isolationSegment.GUID != org.DefaultIsolationSegmentGUID {
+			warnings, err = cmd.ActorV3.SetOrganizationDefaultIsolationSegment(org.GUID, isolationSegment.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return changes, err
+			}
+			change.Action = v2action.ConfigUpdated
+		}
+		changes = append(changes, change)
+	}
+
+	spaceChanges, err := cmd.applySpaceIsolationSegments(org.GUID, orgConfig.Spaces)
+	return append(changes, spaceChanges...), err
+}
+
+func (cmd ImportOrgCommand) applySpaceIsolationSegments(orgGUID string, spaceConfigs []v2action.SpaceConfig) ([]v2action.ConfigChange, error) {
+	var changes []v2action.ConfigChange
+	if !spacesUseIsolationSegments(spaceConfigs) {
+		return changes, nil
+	}
+
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(orgGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return changes, err
+	}
+	spaceGUIDs := map[string]string{}
+	for _, space := range spaces {
+		spaceGUIDs[space.Name] = space.GUID
+	}
+
+	for _, spaceConfig := range spaceConfigs {
+		if spaceConfig.IsolationSegment == "" {
+			continue
+		}
+
+		change := v2action.ConfigChange{
+			Resource: "space isolation segment",
+			Name:     spaceConfig.Name + "/" + spaceConfig.IsolationSegment,
+			Action:   v2action.ConfigUnchanged,
+		}
+		spaceGUID := spaceGUIDs[spaceConfig.Name]
+
+		current, v3Warnings, err := cmd.ActorV3.GetEffectiveIsolationSegmentBySpace(spaceGUID, "")
+		cmd.UI.DisplayWarnings(v3Warnings)
+		if _, ok := err.(actionerror.NoRelationshipError); ok {
+			err = nil
+		}
+		if err != nil {
+			return changes, err
+		}
+
+		if current.Name != spaceConfig.IsolationSegment {
+			v3Warnings, err = cmd.ActorV3.AssignIsolationSegmentToSpaceByNameAndSpace(spaceConfig.IsolationSegment, spaceGUID)
+			cmd.UI.DisplayWarnings(v3Warnings)
+			if err != nil {
+				return changes, err
+			}
+			change.Action = v2action.ConfigUpdated
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+type importOrgDocument struct {
+	Changes []importOrgChangeDocument `json:"changes" yaml:"changes"`
+}
+
+type importOrgChangeDocument struct {
+	Resource string `json:"resource" yaml:"resource"`
+	Name     string `json:"name" yaml:"name"`
+	Status   string `json:"status" yaml:"status"`
+}
+
+func (cmd ImportOrgCommand) displayChanges(changes []v2action.ConfigChange) error {
+	if cmd.UI.IsStructuredOutput() {
+		doc := importOrgDocument{Changes: []importOrgChangeDocument{}}
+		for _, change := range changes {
+			doc.Changes = append(doc.Changes, importOrgChangeDocument{
+				Resource: change.Resource,
+				Name:     change.Name,
+				Status:   string(change.Action),
+			})
+		}
+		return cmd.UI.DisplayStructuredOutput(doc)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("resource"),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("status"),
+	}}
+	for _, change := range changes {
+		table = append(table, []string{
+			cmd.UI.TranslateText(change.Resource),
+			change.Name,
+			cmd.UI.TranslateText(string(change.Action)),
+		})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func usesIsolationSegments(orgConfig v2action.OrganizationConfig) bool {
+	return len(orgConfig.IsolationSegments) > 0 ||
+		orgConfig.DefaultIsolationSegment != "" ||
+		spacesUseIsolationSegments(orgConfig.Spaces)
+}
+
+func spacesUseIsolationSegments(spaceConfigs []v2action.SpaceConfig) bool {
+	for _, spaceConfig := range spaceConfigs {
+		if spaceConfig.IsolationSegment != "" {
+			return true
+		}
+	}
+	return false
+}
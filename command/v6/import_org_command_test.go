@@ -0,0 +1,265 @@
+package v6_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-org Command", func() {
+	var (
+		cmd             ImportOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeImportOrgActor
+		fakeActorV3     *v6fakes.FakeImportOrgActorV3
+		binaryName      string
+		configPath      string
+		configContents  string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeImportOrgActor)
+		fakeActorV3 = new(v6fakes.FakeImportOrgActorV3)
+
+		cmd = ImportOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		configContents = `name: some-org
+managers:
+- amy
+spaces:
+- name: space-1
+  allow_ssh: true
+`
+	})
+
+	JustBeforeEach(func() {
+		file, err := ioutil.TempFile("", "import-org-test")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.WriteString(configContents)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		configPath = file.Name()
+		cmd.RequiredArgs.PathToConfig = flag.PathWithExistenceCheck(configPath)
+
+		executeErr = cmd.Execute(nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(configPath)).To(Succeed())
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.ApplyOrganizationConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the file is not a valid org configuration", func() {
+		BeforeEach(func() {
+			configContents = "name: some-org\nmanagerz: [amy]\n"
+		})
+
+		It("returns an InvalidOrganizationConfigError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidOrganizationConfigError{}))
+			Expect(fakeActor.ApplyOrganizationConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the file is JSON", func() {
+		BeforeEach(func() {
+			configContents = `{"name": "some-org", "quota": {"name": "some-quota", "memory_limit": 1024, "total_private_domains": 2}}`
+		})
+
+		It("applies the configuration", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			orgConfig := fakeActor.ApplyOrganizationConfigArgsForCall(0)
+			Expect(orgConfig.Name).To(Equal("some-org"))
+			Expect(orgConfig.Quota.Name).To(Equal("some-quota"))
+			Expect(orgConfig.Quota.MemoryLimit).To(Equal(1024))
+			Expect(orgConfig.Quota.TotalPrivateDomains).To(Equal(2))
+		})
+	})
+
+	When("applying the configuration succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyOrganizationConfigReturns(
+				v2action.Organization{GUID: "some-org-guid", Name: "some-org"},
+				[]v2action.ConfigChange{
+					{Resource: "org", Name: "some-org", Action: v2action.ConfigUnchanged},
+					{Resource: "org manager", Name: "amy", Action: v2action.ConfigCreated},
+					{Resource: "space", Name: "space-1", Action: v2action.ConfigUpdated},
+				},
+				v2action.Warnings{"apply-warning"}, nil)
+		})
+
+		It("displays the changes and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Importing org some-org from .* as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`resource\s+name\s+status`))
+			Expect(testUI.Out).To(Say(`org\s+some-org\s+unchanged`))
+			Expect(testUI.Out).To(Say(`org manager\s+amy\s+created`))
+			Expect(testUI.Out).To(Say(`space\s+space-1\s+updated`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			orgConfig := fakeActor.ApplyOrganizationConfigArgsForCall(0)
+			Expect(orgConfig.Managers).To(Equal([]string{"amy"}))
+			Expect(*orgConfig.Spaces[0].AllowSSH).To(BeTrue())
+			Expect(fakeActorV3.GetIsolationSegmentsByOrganizationCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the changes as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Importing"))
+				Expect(testUI.Out).To(Say(`"resource": "org manager",\s+"name": "amy",\s+"status": "created"`))
+			})
+		})
+	})
+
+	When("the configuration has feature flags", func() {
+		BeforeEach(func() {
+			configContents = `name: some-org
+feature_flags:
+  diego_docker: true
+`
+		})
+
+		It("warns and does not apply them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say(`Ignoring the feature flags in .* because they affect every org in the foundation\. Use --apply-feature-flags to apply them\.`))
+			orgConfig := fakeActor.ApplyOrganizationConfigArgsForCall(0)
+			Expect(orgConfig.FeatureFlags).To(BeNil())
+		})
+
+		When("--apply-feature-flags is provided", func() {
+			BeforeEach(func() {
+				cmd.ApplyFeatureFlags = true
+			})
+
+			It("warns that they affect the whole foundation and applies them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say(`Applying the feature flags in .*\. Feature flags affect every org in the foundation, not only some-org\.`))
+				orgConfig := fakeActor.ApplyOrganizationConfigArgsForCall(0)
+				Expect(orgConfig.FeatureFlags).To(Equal(map[string]bool{"diego_docker": true}))
+			})
+		})
+	})
+
+	When("the configuration has isolation segments", func() {
+		BeforeEach(func() {
+			configContents = `name: some-org
+isolation_segments: [iso-1, iso-2]
+default_isolation_segment: iso-2
+spaces:
+- name: space-1
+  isolation_segment: iso-1
+- name: space-2
+  isolation_segment: iso-2
+`
+			fakeActor.ApplyOrganizationConfigReturns(
+				v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, nil, nil, nil)
+			fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "space-1-guid", Name: "space-1"}, {GUID: "space-2-guid", Name: "space-2"}}, nil, nil)
+			fakeActorV3.GetIsolationSegmentsByOrganizationReturns([]v3action.IsolationSegment{{Name: "iso-1"}}, v3action.Warnings{"iso-warning"}, nil)
+			fakeActorV3.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{GUID: "iso-2-guid", Name: "iso-2"}, nil, nil)
+			fakeActorV3.GetEffectiveIsolationSegmentBySpaceStub = func(spaceGUID string, _ string) (v3action.IsolationSegment, v3action.Warnings, error) {
+				if spaceGUID == "space-1-guid" {
+					return v3action.IsolationSegment{Name: "iso-1"}, nil, nil
+				}
+				return v3action.IsolationSegment{}, nil, actionerror.NoRelationshipError{}
+			}
+		})
+
+		It("entitles, sets and assigns the missing isolation segments", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("iso-warning"))
+			Expect(testUI.Out).To(Say(`isolation segment\s+iso-1\s+unchanged`))
+			Expect(testUI.Out).To(Say(`isolation segment\s+iso-2\s+created`))
+			Expect(testUI.Out).To(Say(`default isolation segment\s+iso-2\s+updated`))
+			Expect(testUI.Out).To(Say(`space isolation segment\s+space-1/iso-1\s+unchanged`))
+			Expect(testUI.Out).To(Say(`space isolation segment\s+space-2/iso-2\s+updated`))
+
+			Expect(fakeActorV3.EntitleIsolationSegmentToOrganizationByNameCallCount()).To(Equal(1))
+			isoName, orgName := fakeActorV3.EntitleIsolationSegmentToOrganizationByNameArgsForCall(0)
+			Expect(isoName).To(Equal("iso-2"))
+			Expect(orgName).To(Equal("some-org"))
+
+			orgGUID, isoGUID := fakeActorV3.SetOrganizationDefaultIsolationSegmentArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(isoGUID).To(Equal("iso-2-guid"))
+
+			Expect(fakeActorV3.AssignIsolationSegmentToSpaceByNameAndSpaceCallCount()).To(Equal(1))
+			isoName, spaceGUID := fakeActorV3.AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(0)
+			Expect(isoName).To(Equal("iso-2"))
+			Expect(spaceGUID).To(Equal("space-2-guid"))
+		})
+
+		When("the V3 API is not available", func() {
+			BeforeEach(func() {
+				cmd.ActorV3 = nil
+			})
+
+			It("returns a V3APIDoesNotExistError without applying anything", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.V3APIDoesNotExistError{}))
+				Expect(fakeActor.ApplyOrganizationConfigCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("applying the configuration fails", func() {
+		BeforeEach(func() {
+			fakeActor.ApplyOrganizationConfigReturns(
+				v2action.Organization{},
+				[]v2action.ConfigChange{{Resource: "org", Name: "some-org", Action: v2action.ConfigCreated}},
+				v2action.Warnings{"apply-warning"}, errors.New("apply error"))
+		})
+
+		It("displays the changes made so far and returns the error", func() {
+			Expect(executeErr).To(MatchError("apply error"))
+			Expect(testUI.Out).To(Say(`org\s+some-org\s+created`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+			Expect(fakeActorV3.GetIsolationSegmentsByOrganizationCallCount()).To(Equal(0))
+		})
+	})
+})
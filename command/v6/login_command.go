@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -12,17 +13,26 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/browser"
 )
 
 //go:generate counterfeiter . LoginActor
 
 const maxLoginTries = 3
 
+// browserLoginTimeout is how long to wait for the user to authorize the CLI in
+// the browser.
+const browserLoginTimeout = 5 * time.Minute
+
 type LoginActor interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error
+	AuthenticateWithAuthorizationCode(login v3action.AuthorizationCodeLogin, timeout time.Duration) error
+	AuthenticateWithDeviceCode(login v3action.DeviceCodeLogin) error
 	CloudControllerAPIVersion() string
 	GetLoginPrompts() map[string]coreconfig.AuthPrompt
 	SetTarget(settings v3action.TargetSettings) (v3action.Warnings, error)
+	StartAuthorizationCodeLogin() (v3action.AuthorizationCodeLogin, error)
+	StartDeviceCodeLogin() (v3action.DeviceCodeLogin, error)
 }
 
 //go:generate counterfeiter . ActorMaker
@@ -49,6 +59,8 @@ var actorMaker ActorMakerFunc = func(config command.Config, ui command.UI, targe
 
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Log in through a web browser"`
+	DeviceCode        bool        `long:"device-code" description:"Log in by entering a code in a web browser on another device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -56,13 +68,14 @@ type LoginCommand struct {
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --browser | --device-code]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (CF_NAME will open a browser in which to log in)\n   CF_NAME login --device-code (CF_NAME will provide a url and a code to log in from another device)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 
 	UI         command.UI
 	Actor      LoginActor
 	ActorMaker ActorMaker
 	Config     command.Config
+	OpenURL    func(url string) error
 }
 
 func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
@@ -74,21 +87,36 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = actor
 	cmd.UI = ui
 	cmd.Config = config
+	cmd.OpenURL = browser.Open
 	return nil
 }
 
 func (cmd *LoginCommand) Execute(args []string) error {
 	if !cmd.Config.ExperimentalLogin() {
+		switch {
+		case cmd.Browser:
+			return translatableerror.ExperimentalLoginRequiredError{Flag: "--browser"}
+		case cmd.DeviceCode:
+			return translatableerror.ExperimentalLoginRequiredError{Flag: "--device-code"}
+		}
 		return translatableerror.UnrefactoredCommandError{}
 	}
+
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
 	cmd.UI.DisplayWarning("Using experimental login command, some behavior may be different")
 
+	skipSSLValidation := cmd.SkipSSLValidation
 	if cmd.APIEndpoint != "" {
 		cmd.UI.DisplayTextWithFlavor("API endpoint: {{.APIEndpoint}}", map[string]interface{}{
 			"APIEndpoint": cmd.APIEndpoint,
 		})
 	} else if cmd.Config.Target() != "" {
 		cmd.APIEndpoint = cmd.Config.Target()
+		skipSSLValidation = skipSSLValidation || cmd.Config.SkipSSLValidation()
 		cmd.UI.DisplayTextWithFlavor("API endpoint: {{.APIEndpoint}}", map[string]interface{}{
 			"APIEndpoint": cmd.APIEndpoint,
 		})
@@ -108,9 +136,9 @@ func (cmd *LoginCommand) Execute(args []string) error {
 
 	settings := v3action.TargetSettings{
 		URL:               endpoint.String(),
-		SkipSSLValidation: true,
+		SkipSSLValidation: skipSSLValidation,
 	}
-	_, err := cmd.Actor.SetTarget(settings)
+	_, err = cmd.Actor.SetTarget(settings)
 	if err != nil {
 		return err
 	}
@@ -126,6 +154,13 @@ func (cmd *LoginCommand) Execute(args []string) error {
 		return errors.New("Service account currently logged in. Use 'cf logout' to log out service account and try again.")
 	}
 
+	switch {
+	case cmd.Browser:
+		return cmd.authenticateWithBrowser()
+	case cmd.DeviceCode:
+		return cmd.authenticateWithDeviceCode()
+	}
+
 	err = cmd.authenticate()
 	if err != nil {
 		return errors.New("Unable to authenticate.")
@@ -134,6 +169,84 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd *LoginCommand) validateFlags() error {
+	if cmd.Browser && cmd.DeviceCode {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--browser", "--device-code"}}
+	}
+
+	var federatedFlag string
+	switch {
+	case cmd.Browser:
+		federatedFlag = "--browser"
+	case cmd.DeviceCode:
+		federatedFlag = "--device-code"
+	default:
+		return nil
+	}
+
+	if cmd.Username != "" || cmd.Password != "" || cmd.SSO || cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{federatedFlag, "-u", "-p", "--sso", "--sso-passcode"}}
+	}
+	if cmd.Organization != "" || cmd.Space != "" {
+		return translatableerror.ArgumentCombinationError{Args: []string{federatedFlag, "-o", "-s"}}
+	}
+	return nil
+}
+
+// authenticateWithBrowser logs in with an authorization code obtained by the
+// user in a web browser. The URL is always displayed in case the browser
+// cannot be opened, e.g. over SSH.
+func (cmd *LoginCommand) authenticateWithBrowser() error {
+	login, err := cmd.Actor.StartAuthorizationCodeLogin()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Opening a browser to log in. If it does not open, visit this URL:")
+	cmd.UI.DisplayText("{{.URL}}", map[string]interface{}{"URL": login.URL})
+	cmd.UI.DisplayNewline()
+	_ = cmd.OpenURL(login.URL)
+
+	cmd.UI.DisplayText("Waiting for the login to be approved...")
+	err = cmd.Actor.AuthenticateWithAuthorizationCode(login, browserLoginTimeout)
+	return cmd.finishFederatedLogin(err)
+}
+
+// authenticateWithDeviceCode logs in by having the user enter a code in a web
+// browser on another device, for hosts without a browser.
+func (cmd *LoginCommand) authenticateWithDeviceCode() error {
+	login, err := cmd.Actor.StartDeviceCodeLogin()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("To log in, visit {{.URL}} on any device and enter the code {{.UserCode}}", map[string]interface{}{
+		"URL":      login.VerificationURI,
+		"UserCode": login.UserCode,
+	})
+	if login.VerificationURIComplete != "" {
+		cmd.UI.DisplayText("or visit {{.URL}} to have the code entered for you.", map[string]interface{}{
+			"URL": login.VerificationURIComplete,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Waiting for the login to be approved...")
+	err = cmd.Actor.AuthenticateWithDeviceCode(login)
+	return cmd.finishFederatedLogin(err)
+}
+
+func (cmd *LoginCommand) finishFederatedLogin(err error) error {
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.showStatus()
+	return nil
+}
+
 func (cmd *LoginCommand) authenticate() error {
 	prompts := cmd.Actor.GetLoginPrompts()
 	credentials := make(map[string]string)
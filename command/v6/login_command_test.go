@@ -2,6 +2,10 @@ package v6_test
 
 import (
 	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -55,6 +59,202 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	Describe("federated login", func() {
+		var openedURLs []string
+
+		BeforeEach(func() {
+			openedURLs = nil
+			cmd.OpenURL = func(url string) error {
+				openedURLs = append(openedURLs, url)
+				return nil
+			}
+			cmd.APIEndpoint = "api.example.com"
+			fakeConfig.ExperimentalLoginReturns(true)
+			fakeConfig.APIVersionReturns("3.4.5")
+			fakeConfig.CurrentUserNameReturns("some-user", nil)
+		})
+
+		When("--browser is provided", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				fakeActor.StartAuthorizationCodeLoginReturns(v3action.AuthorizationCodeLogin{URL: "https://login.example.com/oauth/authorize"}, nil)
+			})
+
+			It("logs in through the browser", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.URL).To(Equal("https://api.example.com"))
+				Expect(settings.SkipSSLValidation).To(BeFalse())
+				Expect(fakeActorMaker.NewActorCallCount()).To(Equal(1))
+				Expect(openedURLs).To(ConsistOf("https://login.example.com/oauth/authorize"))
+
+				Expect(fakeActor.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(1))
+				login, timeout := fakeActor.AuthenticateWithAuthorizationCodeArgsForCall(0)
+				Expect(login.URL).To(Equal("https://login.example.com/oauth/authorize"))
+				Expect(timeout).To(Equal(5 * time.Minute))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say("Opening a browser to log in. If it does not open, visit this URL:"))
+				Expect(testUI.Out).To(Say(`https://login\.example\.com/oauth/authorize`))
+				Expect(testUI.Out).To(Say(`Waiting for the login to be approved\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`API endpoint:\s+api\.example\.com \(API version: 3\.4\.5\)`))
+				Expect(testUI.Out).To(Say(`User:\s+some-user`))
+			})
+
+			When("the experimental login flag is not set", func() {
+				BeforeEach(func() {
+					fakeConfig.ExperimentalLoginReturns(false)
+				})
+
+				It("returns an ExperimentalLoginRequiredError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ExperimentalLoginRequiredError{Flag: "--browser"}))
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			When("--skip-ssl-validation is provided", func() {
+				BeforeEach(func() {
+					cmd.SkipSSLValidation = true
+				})
+
+				It("targets the API without SSL validation", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+					Expect(fakeActor.SetTargetArgsForCall(0).SkipSSLValidation).To(BeTrue())
+				})
+			})
+
+			When("no API endpoint is provided", func() {
+				BeforeEach(func() {
+					cmd.APIEndpoint = ""
+					fakeConfig.TargetReturns("https://api.example.com")
+				})
+
+				When("the targeted API skips SSL validation", func() {
+					BeforeEach(func() {
+						fakeConfig.SkipSSLValidationReturns(true)
+					})
+
+					It("keeps skipping SSL validation", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.SetTargetArgsForCall(0).SkipSSLValidation).To(BeTrue())
+					})
+				})
+
+				When("the targeted API validates SSL", func() {
+					It("keeps SSL validation on", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.SetTargetArgsForCall(0).SkipSSLValidation).To(BeFalse())
+					})
+				})
+			})
+
+			When("the browser cannot be opened", func() {
+				BeforeEach(func() {
+					cmd.OpenURL = func(string) error { return errors.New("no browser") }
+				})
+
+				It("still waits for the login", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(1))
+				})
+			})
+
+			When("the login is not approved in time", func() {
+				BeforeEach(func() {
+					fakeActor.AuthenticateWithAuthorizationCodeReturns(actionerror.LoginTimeoutError{Timeout: 5 * time.Minute})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.LoginTimeoutError{Timeout: 5 * time.Minute}))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+
+			When("a username is also provided", func() {
+				BeforeEach(func() {
+					cmd.Username = "some-user"
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--browser", "-u", "-p", "--sso", "--sso-passcode"}}))
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			When("an org or space is also provided", func() {
+				BeforeEach(func() {
+					cmd.Organization = "some-org"
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--browser", "-o", "-s"}}))
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			When("--device-code is also provided", func() {
+				BeforeEach(func() {
+					cmd.DeviceCode = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--browser", "--device-code"}}))
+				})
+			})
+		})
+
+		When("--device-code is provided", func() {
+			BeforeEach(func() {
+				cmd.DeviceCode = true
+				fakeActor.StartDeviceCodeLoginReturns(v3action.DeviceCodeLogin{
+					DeviceCode:              "some-device-code",
+					UserCode:                "ABCD-EFGH",
+					VerificationURI:         "https://login.example.com/device",
+					VerificationURIComplete: "https://login.example.com/device?user_code=ABCD-EFGH",
+				}, nil)
+			})
+
+			It("displays the code and waits for the login to be approved", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(openedURLs).To(BeEmpty())
+
+				Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(1))
+				Expect(fakeActor.AuthenticateWithDeviceCodeArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+
+				Expect(testUI.Out).To(Say(`To log in, visit https://login\.example\.com/device on any device and enter the code ABCD-EFGH`))
+				Expect(testUI.Out).To(Say(`or visit https://login\.example\.com/device\?user_code=ABCD-EFGH to have the code entered for you\.`))
+				Expect(testUI.Out).To(Say(`Waiting for the login to be approved\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+
+			When("the user denies the login", func() {
+				BeforeEach(func() {
+					fakeActor.AuthenticateWithDeviceCodeReturns(actionerror.LoginDeniedError{})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.LoginDeniedError{}))
+				})
+			})
+		})
+
+		When("a service account is logged in", func() {
+			BeforeEach(func() {
+				cmd.DeviceCode = true
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns an error without logging in", func() {
+				Expect(executeErr).To(MatchError("Service account currently logged in. Use 'cf logout' to log out service account and try again."))
+				Expect(fakeActor.StartDeviceCodeLoginCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("the experimental login flag is set", func() {
 		BeforeEach(func() {
 			fakeConfig.ExperimentalLoginReturns(true)
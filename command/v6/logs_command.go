@@ -1,6 +1,8 @@
 package v6
 
 import (
+	"strconv"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -8,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . LogsActor
@@ -18,10 +21,14 @@ type LogsActor interface {
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppName     `positional-args:"yes"`
+	Recent          bool             `long:"recent" description:"Dump recent logs instead of tailing"`
+	Sources         []flag.LogSource `long:"source" description:"Only show logs from this source type: APP, RTR, STG, CELL or API (can be specified multiple times)"`
+	Instances       []int            `long:"instance" description:"Only show logs from this app instance index (can be specified multiple times)"`
+	Stream          flag.LogStream   `long:"stream" description:"Only show logs written to this stream: stdout or stderr"`
+	Grep            flag.Regexp      `long:"grep" description:"Only show log messages matching this regular expression"`
+	usage           interface{}      `usage:"CF_NAME logs APP_NAME [--recent] [--source SOURCE]... [--instance INDEX]... [--stream (stdout | stderr)] [--grep REGEX]\n\nEXAMPLES:\n   CF_NAME logs my-app --source APP --stream stderr\n   CF_NAME logs my-app --recent --grep 'status=5[0-9]{2}' --output json | jq .message"`
+	relatedCommands interface{}      `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
@@ -57,14 +64,16 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.RequiredArgs.AppName,
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
@@ -80,8 +89,9 @@ func (cmd LogsCommand) displayRecentLogs() error {
 		cmd.NOAAClient,
 	)
 
+	filter := cmd.logFilter()
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		cmd.displayLogMessage(filter, message)
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -100,6 +110,7 @@ func (cmd LogsCommand) streamLogs() error {
 		return err
 	}
 
+	filter := cmd.logFilter()
 	var messagesClosed, errLogsClosed bool
 	for {
 		select {
@@ -109,7 +120,7 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			cmd.displayLogMessage(filter, message)
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -127,3 +138,31 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+func (cmd LogsCommand) logFilter() sharedaction.LogFilter {
+	filter := sharedaction.LogFilter{
+		Pattern: cmd.Grep.Regexp,
+	}
+	for _, source := range cmd.Sources {
+		filter.SourceTypes = append(filter.SourceTypes, string(source))
+	}
+	for _, instance := range cmd.Instances {
+		filter.Instances = append(filter.Instances, strconv.Itoa(instance))
+	}
+	if cmd.Stream != "" {
+		filter.MessageType = cmd.Stream.MessageType()
+	}
+	return filter
+}
+
+func (cmd LogsCommand) displayLogMessage(filter sharedaction.LogFilter, message ui.LogMessage) {
+	if !filter.Matches(message) {
+		return
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayStructuredLogMessage(message)
+		return
+	}
+	cmd.UI.DisplayLogMessage(message, true)
+}
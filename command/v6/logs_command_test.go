@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -144,6 +145,49 @@ var _ = Describe("logs command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 				})
+
+				When("filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Sources = []flag.LogSource{"APP"}
+						cmd.Instances = []int{2}
+						Expect(cmd.Stream.UnmarshalFlag("stdout")).To(Succeed())
+						Expect(cmd.Grep.UnmarshalFlag("message [0-9]")).To(Succeed())
+
+						fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+							[]v2action.LogMessage{
+								*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1"),
+								*v2action.NewLogMessage("i am message 2", 1, time.Unix(1, 0), "APP/PROC/WEB", "2"),
+								*v2action.NewLogMessage("i am message 3", 2, time.Unix(2, 0), "APP/PROC/WEB", "2"),
+								*v2action.NewLogMessage("i am message 4", 1, time.Unix(3, 0), "RTR", "2"),
+								*v2action.NewLogMessage("i am not a match", 1, time.Unix(4, 0), "APP/PROC/WEB", "2"),
+							},
+							nil,
+							nil)
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("i am message 1"))
+						Expect(testUI.Out).NotTo(Say("i am message 3"))
+						Expect(testUI.Out).NotTo(Say("i am message 4"))
+						Expect(testUI.Out).NotTo(Say("i am not a match"))
+						Expect(testUI.Out).To(Say("i am message 2"))
+					})
+				})
+
+				When("the output format is JSON", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+					})
+
+					It("displays one JSON object per log message without flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`\{"timestamp":"1970-01-01T00:00:00Z","source_type":"app","source_instance":"1","type":"OUT","message":"i am message 1"\}\n`))
+						Expect(testUI.Out).To(Say(`\{"timestamp":"1970-01-01T00:00:01Z","source_type":"another-app","source_instance":"2","type":"OUT","message":"i am message 2"\}\n`))
+						Expect(testUI.Err).To(Say("some-warning-1"))
+					})
+				})
 			})
 		})
 
@@ -244,6 +288,19 @@ var _ = Describe("logs command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 				})
+
+				When("the output format is JSON", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+					})
+
+					It("streams one JSON object per line without flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`^\{"timestamp":"1970-01-01T00:00:00Z","source_type":"app","source_instance":"1","type":"OUT","message":"i am message 1"\}\n`))
+						Expect(testUI.Out).To(Say(`^\{"timestamp":"1970-01-01T00:00:01Z","source_type":"another-app","source_instance":"2","type":"OUT","message":"i am message 2"\}\n$`))
+					})
+				})
 			})
 		})
 	})
@@ -48,10 +48,12 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgs, warnings, err := cmd.Actor.GetOrganizations()
 	cmd.UI.DisplayWarnings(warnings)
@@ -59,6 +61,10 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayOrgsDocument(orgs)
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -75,3 +81,20 @@ func (cmd OrgsCommand) displayOrgs(orgs []v2action.Organization) {
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+type orgsDocument struct {
+	Organizations []orgDocument `json:"organizations" yaml:"organizations"`
+}
+
+type orgDocument struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+func (cmd OrgsCommand) displayOrgsDocument(orgs []v2action.Organization) error {
+	doc := orgsDocument{Organizations: []orgDocument{}}
+	for _, org := range orgs {
+		doc.Organizations = append(doc.Organizations, orgDocument{Name: org.Name, GUID: org.GUID})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
@@ -125,6 +125,33 @@ var _ = Describe("orgs Command", func() {
 
 					Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(1))
 				})
+
+				When("the output format is JSON", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+						fakeActor.GetOrganizationsReturns(
+							[]v2action.Organization{
+								{Name: "org-1", GUID: "org-guid-1"},
+								{Name: "org-2", GUID: "org-guid-2"},
+							},
+							v2action.Warnings{"get-orgs-warning"},
+							nil)
+					})
+
+					It("displays the orgs as a JSON document and the warnings as a structured entry", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting orgs"))
+						Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+							"organizations": [
+								{"name": "org-1", "guid": "org-guid-1"},
+								{"name": "org-2", "guid": "org-guid-2"}
+							]
+						}`))
+
+						Expect(testUI.Err).To(Say(`{"warnings":\["get-orgs-warning"\]}`))
+					})
+				})
 			})
 
 			When("a translatable error is encountered getting orgs", func() {
@@ -0,0 +1,91 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ProfilesCommand struct {
+	usage           interface{} `usage:"CF_NAME profiles"`
+	relatedCommands interface{} `related_commands:"create-profile, delete-profile, switch-profile"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ProfilesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ProfilesCommand) Execute(args []string) error {
+	profiles := cmd.Config.Profiles()
+	currentProfile := cmd.Config.CurrentProfile()
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayProfilesDocument(profiles, currentProfile)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting profiles...")
+	cmd.UI.DisplayNewline()
+
+	if len(profiles) == 0 {
+		cmd.UI.DisplayText("No profiles found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, profile := range profiles {
+		var current string
+		if profile.Name == currentProfile {
+			current = "*"
+		}
+
+		table = append(table, []string{
+			current,
+			profile.Name,
+			profile.Target,
+			profile.TargetedOrganization.Name,
+			profile.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+type profilesDocument struct {
+	Profiles []profileDocument `json:"profiles" yaml:"profiles"`
+}
+
+type profileDocument struct {
+	Name        string `json:"name" yaml:"name"`
+	APIEndpoint string `json:"api_endpoint" yaml:"api_endpoint"`
+	Org         string `json:"org,omitempty" yaml:"org,omitempty"`
+	Space       string `json:"space,omitempty" yaml:"space,omitempty"`
+	Current     bool   `json:"current" yaml:"current"`
+}
+
+func (cmd ProfilesCommand) displayProfilesDocument(profiles []configv3.Profile, currentProfile string) error {
+	doc := profilesDocument{Profiles: []profileDocument{}}
+	for _, profile := range profiles {
+		doc.Profiles = append(doc.Profiles, profileDocument{
+			Name:        profile.Name,
+			APIEndpoint: profile.Target,
+			Org:         profile.TargetedOrganization.Name,
+			Space:       profile.TargetedSpace.Name,
+			Current:     profile.Name == currentProfile,
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
@@ -0,0 +1,86 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("profiles Command", func() {
+	var (
+		cmd        ProfilesCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = ProfilesCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no profiles", func() {
+		It("displays that no profiles were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting profiles..."))
+			Expect(testUI.Out).To(Say("No profiles found."))
+		})
+	})
+
+	When("there are profiles", func() {
+		BeforeEach(func() {
+			fakeConfig.ProfilesReturns([]configv3.Profile{
+				{
+					Name:                 "dev",
+					Target:               "https://api.dev.com",
+					TargetedOrganization: configv3.Organization{Name: "dev-org"},
+					TargetedSpace:        configv3.Space{Name: "dev-space"},
+				},
+				{
+					Name:   "prod",
+					Target: "https://api.prod.com",
+				},
+			})
+			fakeConfig.CurrentProfileReturns("prod")
+		})
+
+		It("displays the profiles and marks the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting profiles..."))
+			Expect(testUI.Out).To(Say(`name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`dev\s+https://api.dev.com\s+dev-org\s+dev-space`))
+			Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.com`))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the profiles as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting profiles..."))
+				Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+					"profiles": [
+						{"name": "dev", "api_endpoint": "https://api.dev.com", "org": "dev-org", "space": "dev-space", "current": false},
+						{"name": "prod", "api_endpoint": "https://api.prod.com", "current": true}
+					]
+				}`))
+			})
+		})
+	})
+})
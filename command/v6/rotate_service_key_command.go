@@ -0,0 +1,218 @@
+package v6
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"github.com/cloudfoundry/noaa/consumer"
+)
+
+//go:generate counterfeiter . RotateServiceKeyActor
+
+type RotateServiceKeyActor interface {
+	CreateSuccessorServiceKey(serviceInstanceName string, keyName string, spaceGUID string, parameters map[string]interface{}) (v2action.ServiceKeyRotation, v2action.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (v2action.Warnings, error)
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	RebindServiceInstanceToApplication(app v2action.Application, serviceInstanceGUID string) (v2action.ServiceBindingReplacement, v2action.Warnings, error)
+	RestageApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	RestartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	RestoreServiceBinding(replacement v2action.ServiceBindingReplacement) (v2action.Warnings, error)
+}
+
+type RotateServiceKeyCommand struct {
+	RequiredArgs     flag.ServiceInstanceKey       `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new service key, provided either in-line or in a file (Default: the parameters of the key being rotated)"`
+	Apps             []string                      `long:"app" description:"Rebind the service instance to this app so it receives new credentials (can be specified multiple times)"`
+	Restart          bool                          `long:"restart" description:"Restart the rebound apps"`
+	Restage          bool                          `long:"restage" description:"Restage the rebound apps"`
+	usage            interface{}                   `usage:"CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON] [--app APP_NAME]... [--restart | --restage]\n\n   Creates a new service key for the service instance, optionally rebinds and restarts or restages apps,\n   then deletes the old service key. The new key is named after the old one with a version suffix,\n   e.g. mykey-v2 replaces mykey. Rebinding an app replaces its binding with a new binding of the same\n   name and parameters, which gives the app new credentials.\n\n   If any step fails, the new key is deleted and the old key is kept. The bindings of apps that were\n   already rebound are recreated with their original name and parameters, but the service broker\n   issues new credentials for them, so those apps must be restarted or restaged.\n\nEXAMPLES:\n   CF_NAME rotate-service-key mydb mykey\n   CF_NAME rotate-service-key mydb mykey --app myapp --app myworker --restart"`
+	relatedCommands  interface{}                   `related_commands:"create-service-key, delete-service-key, service-keys"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RotateServiceKeyActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *RotateServiceKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd RotateServiceKeyCommand) Execute(args []string) error {
+	if cmd.Restart && cmd.Restage {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--restart", "--restage"}}
+	}
+	if len(cmd.Apps) == 0 {
+		if cmd.Restart {
+			return translatableerror.RequiredFlagsError{Arg1: "--restart", Arg2: "--app"}
+		}
+		if cmd.Restage {
+			return translatableerror.RequiredFlagsError{Arg1: "--restage", Arg2: "--app"}
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rotating service key {{.KeyName}} for service instance {{.ServiceInstanceName}} as {{.User}}...",
+		map[string]interface{}{
+			"KeyName":             cmd.RequiredArgs.ServiceKey,
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"User":                user.Name,
+		})
+
+	var apps []v2action.Application
+	for _, appName := range cmd.Apps {
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		apps = append(apps, app)
+	}
+
+	rotation, warnings, err := cmd.Actor.CreateSuccessorServiceKey(cmd.RequiredArgs.ServiceInstance, cmd.RequiredArgs.ServiceKey, cmd.Config.TargetedSpace().GUID, cmd.ParametersAsJSON)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayText("Created service key {{.KeyName}}.", map[string]interface{}{
+		"KeyName": rotation.NewKey.Name,
+	})
+
+	replacements, err := cmd.rebindApps(rotation, apps)
+	if err != nil {
+		cmd.rollback(rotation, replacements)
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Deleting service key {{.KeyName}}...", map[string]interface{}{
+		"KeyName": rotation.OldKey.Name,
+	})
+	warnings, err = cmd.Actor.DeleteServiceKey(rotation.OldKey.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// rebindApps rebinds and restarts or restages the apps one by one. It returns
+// the bindings it replaced, including a binding that was deleted when
+// creating its replacement failed.
+func (cmd RotateServiceKeyCommand) rebindApps(rotation v2action.ServiceKeyRotation, apps []v2action.Application) ([]v2action.ServiceBindingReplacement, error) {
+	var replacements []v2action.ServiceBindingReplacement
+	for _, app := range apps {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Rebinding service instance {{.ServiceInstanceName}} to app {{.AppName}}...", map[string]interface{}{
+			"ServiceInstanceName": rotation.ServiceInstance.Name,
+			"AppName":             app.Name,
+		})
+		replacement, warnings, err := cmd.Actor.RebindServiceInstanceToApplication(app, rotation.ServiceInstance.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if replacement.ServiceInstanceGUID != "" {
+			replacements = append(replacements, replacement)
+		}
+		if err != nil {
+			return replacements, err
+		}
+
+		var (
+			messages    <-chan *v2action.LogMessage
+			logErrs     <-chan error
+			appState    <-chan v2action.ApplicationStateChange
+			apiWarnings <-chan string
+			errs        <-chan error
+		)
+		switch {
+		case cmd.Restart:
+			cmd.UI.DisplayText("Restarting app {{.AppName}}...", map[string]interface{}{"AppName": app.Name})
+			messages, logErrs, appState, apiWarnings, errs = cmd.Actor.RestartApplication(app, cmd.NOAAClient)
+		case cmd.Restage:
+			cmd.UI.DisplayText("Restaging app {{.AppName}}...", map[string]interface{}{"AppName": app.Name})
+			messages, logErrs, appState, apiWarnings, errs = cmd.Actor.RestageApplication(app, cmd.NOAAClient)
+		default:
+			continue
+		}
+		err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+		if err != nil {
+			return replacements, err
+		}
+	}
+
+	return replacements, nil
+}
+
+// rollback restores the replaced bindings, most recent first, and deletes the
+// new service key, leaving the old one in place. Failures are displayed as
+// warnings so that the error which caused the rollback is still returned.
+func (cmd RotateServiceKeyCommand) rollback(rotation v2action.ServiceKeyRotation, replacements []v2action.ServiceBindingReplacement) {
+	for i := len(replacements) - 1; i >= 0; i-- {
+		replacement := replacements[i]
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Rolling back: restoring the binding of service instance {{.ServiceInstanceName}} to app {{.AppName}}...", map[string]interface{}{
+			"ServiceInstanceName": rotation.ServiceInstance.Name,
+			"AppName":             replacement.App.Name,
+		})
+		warnings, err := cmd.Actor.RestoreServiceBinding(replacement)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			cmd.UI.DisplayWarning("Could not restore the binding of service instance {{.ServiceInstanceName}} to app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"ServiceInstanceName": rotation.ServiceInstance.Name,
+				"AppName":             replacement.App.Name,
+				"Error":               err.Error(),
+			})
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Rolling back: deleting service key {{.KeyName}}...", map[string]interface{}{
+		"KeyName": rotation.NewKey.Name,
+	})
+	warnings, err := cmd.Actor.DeleteServiceKey(rotation.NewKey.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("Could not delete service key {{.KeyName}}: {{.Error}}", map[string]interface{}{
+			"KeyName": rotation.NewKey.Name,
+			"Error":   err.Error(),
+		})
+	}
+
+	if len(replacements) > 0 {
+		var changedApps []string
+		for _, replacement := range replacements {
+			changedApps = append(changedApps, replacement.App.Name)
+		}
+		cmd.UI.DisplayWarning("The bindings of these apps were changed and have new credentials, restart or restage them to use the credentials: {{.AppNames}}", map[string]interface{}{
+			"AppNames": strings.Join(changedApps, ", "),
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-key Command", func() {
+	var (
+		cmd             RotateServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRotateServiceKeyActor
+		rotation        v2action.ServiceKeyRotation
+		executeErr      error
+	)
+
+	// closedStartChannels returns the channels of a restart or restage that
+	// finished, with err sent on the error channel if it is not nil.
+	closedStartChannels := func(err error) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+		messages := make(chan *v2action.LogMessage)
+		logErrs := make(chan error)
+		appState := make(chan v2action.ApplicationStateChange)
+		warnings := make(chan string)
+		errs := make(chan error, 1)
+		if err != nil {
+			errs <- err
+		}
+		close(messages)
+		close(logErrs)
+		close(appState)
+		close(warnings)
+		close(errs)
+		return messages, logErrs, appState, warnings, errs
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRotateServiceKeyActor)
+
+		cmd = RotateServiceKeyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.RequiredArgs.ServiceKey = "some-key"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		rotation = v2action.ServiceKeyRotation{
+			ServiceInstance: v2action.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+			OldKey:          v2action.ServiceKey{GUID: "old-key-guid", Name: "some-key"},
+			NewKey:          v2action.ServiceKey{GUID: "new-key-guid", Name: "some-key-v2"},
+		}
+		fakeActor.CreateSuccessorServiceKeyReturns(rotation, v2action.Warnings{"create-warning"}, nil)
+		fakeActor.DeleteServiceKeyReturns(v2action.Warnings{"delete-warning"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+			return v2action.Application{GUID: name + "-guid", Name: name}, nil, nil
+		}
+		fakeActor.RestartApplicationReturns(closedStartChannels(nil))
+		fakeActor.RestageApplicationReturns(closedStartChannels(nil))
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+			Expect(fakeActor.CreateSuccessorServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	It("creates the successor key and deletes the old key", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		instanceName, keyName, spaceGUID, parameters := fakeActor.CreateSuccessorServiceKeyArgsForCall(0)
+		Expect(instanceName).To(Equal("some-service-instance"))
+		Expect(keyName).To(Equal("some-key"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(parameters).To(BeNil())
+
+		Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+		Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal("old-key-guid"))
+		Expect(fakeActor.RebindServiceInstanceToApplicationCallCount()).To(Equal(0))
+
+		Expect(testUI.Out).To(Say(`Rotating service key some-key for service instance some-service-instance as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Created service key some-key-v2\.`))
+		Expect(testUI.Out).To(Say(`Deleting service key some-key\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("create-warning"))
+		Expect(testUI.Err).To(Say("delete-warning"))
+	})
+
+	When("parameters are provided", func() {
+		BeforeEach(func() {
+			cmd.ParametersAsJSON = flag.JSONOrFileWithValidation{"permissions": "read-only"}
+		})
+
+		It("passes them to the successor key", func() {
+			_, _, _, parameters := fakeActor.CreateSuccessorServiceKeyArgsForCall(0)
+			Expect(parameters).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+		})
+	})
+
+	When("creating the successor key fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSuccessorServiceKeyReturns(v2action.ServiceKeyRotation{}, v2action.Warnings{"create-warning"}, errors.New("create-error"))
+		})
+
+		It("returns the error without deleting any key", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("apps are provided", func() {
+		BeforeEach(func() {
+			cmd.Apps = []string{"web", "worker"}
+			cmd.Restart = true
+
+			fakeActor.RebindServiceInstanceToApplicationStub = func(app v2action.Application, serviceInstanceGUID string) (v2action.ServiceBindingReplacement, v2action.Warnings, error) {
+				return v2action.ServiceBindingReplacement{
+					App:                 app,
+					ServiceInstanceGUID: serviceInstanceGUID,
+					Name:                app.Name + "-binding",
+					NewBindingGUID:      app.Name + "-new-binding-guid",
+				}, nil, nil
+			}
+		})
+
+		It("rebinds and restarts each app before deleting the old key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.RebindServiceInstanceToApplicationCallCount()).To(Equal(2))
+			app, instanceGUID := fakeActor.RebindServiceInstanceToApplicationArgsForCall(0)
+			Expect(app.Name).To(Equal("web"))
+			Expect(instanceGUID).To(Equal("some-service-instance-guid"))
+			app, _ = fakeActor.RebindServiceInstanceToApplicationArgsForCall(1)
+			Expect(app.Name).To(Equal("worker"))
+
+			Expect(fakeActor.RestartApplicationCallCount()).To(Equal(2))
+			Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Rebinding service instance some-service-instance to app web\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restarting app web\.\.\.`))
+			Expect(testUI.Out).To(Say(`Rebinding service instance some-service-instance to app worker\.\.\.`))
+			Expect(testUI.Out).To(Say(`Restarting app worker\.\.\.`))
+			Expect(testUI.Out).To(Say(`Deleting service key some-key\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("--restage is provided instead", func() {
+			BeforeEach(func() {
+				cmd.Restart = false
+				cmd.Restage = true
+			})
+
+			It("restages each app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.RestageApplicationCallCount()).To(Equal(2))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an app cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceStub = nil
+				fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "web"})
+			})
+
+			It("returns the error before creating a key", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "web"}))
+				Expect(fakeActor.CreateSuccessorServiceKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("restarting an app fails", func() {
+			BeforeEach(func() {
+				fakeActor.RestartApplicationReturns(closedStartChannels(actionerror.StartupTimeoutError{Name: "web"}))
+			})
+
+			It("restores the binding, deletes the successor key, keeps the old key and returns the error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "web"}))
+
+				Expect(fakeActor.RestoreServiceBindingCallCount()).To(Equal(1))
+				Expect(fakeActor.RestoreServiceBindingArgsForCall(0)).To(Equal(v2action.ServiceBindingReplacement{
+					App:                 v2action.Application{GUID: "web-guid", Name: "web"},
+					ServiceInstanceGUID: "some-service-instance-guid",
+					Name:                "web-binding",
+					NewBindingGUID:      "web-new-binding-guid",
+				}))
+
+				Expect(fakeActor.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal("new-key-guid"))
+				Expect(testUI.Out).To(Say(`Rolling back: restoring the binding of service instance some-service-instance to app web\.\.\.`))
+				Expect(testUI.Out).To(Say(`Rolling back: deleting service key some-key-v2\.\.\.`))
+				Expect(testUI.Out).ToNot(Say(`Deleting service key some-key\.\.\.`))
+				Expect(testUI.Err).To(Say("The bindings of these apps were changed and have new credentials, restart or restage them to use the credentials: web"))
+			})
+		})
+
+		When("rebinding the second app fails", func() {
+			BeforeEach(func() {
+				rebind := fakeActor.RebindServiceInstanceToApplicationStub
+				fakeActor.RebindServiceInstanceToApplicationStub = func(app v2action.Application, serviceInstanceGUID string) (v2action.ServiceBindingReplacement, v2action.Warnings, error) {
+					replacement, warnings, _ := rebind(app, serviceInstanceGUID)
+					if app.Name == "worker" {
+						replacement.NewBindingGUID = ""
+						return replacement, warnings, errors.New("rebind-error")
+					}
+					return replacement, warnings, nil
+				}
+			})
+
+			It("restores the bindings of both apps, most recent first", func() {
+				Expect(executeErr).To(MatchError("rebind-error"))
+
+				Expect(fakeActor.RestoreServiceBindingCallCount()).To(Equal(2))
+				replacement := fakeActor.RestoreServiceBindingArgsForCall(0)
+				Expect(replacement.App.Name).To(Equal("worker"))
+				Expect(replacement.NewBindingGUID).To(BeEmpty())
+				replacement = fakeActor.RestoreServiceBindingArgsForCall(1)
+				Expect(replacement.App.Name).To(Equal("web"))
+
+				Expect(testUI.Err).To(Say("The bindings of these apps were changed and have new credentials, restart or restage them to use the credentials: web, worker"))
+			})
+		})
+
+		When("rebinding an app fails before its binding is deleted and the rollback fails too", func() {
+			BeforeEach(func() {
+				fakeActor.RebindServiceInstanceToApplicationStub = nil
+				fakeActor.RebindServiceInstanceToApplicationReturns(v2action.ServiceBindingReplacement{}, nil, errors.New("rebind-error"))
+				fakeActor.DeleteServiceKeyReturns(nil, errors.New("delete-error"))
+			})
+
+			It("warns about the rollback and returns the original error", func() {
+				Expect(executeErr).To(MatchError("rebind-error"))
+				Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.RestoreServiceBindingCallCount()).To(Equal(0))
+				Expect(testUI.Err).To(Say("Could not delete service key some-key-v2: delete-error"))
+				Expect(testUI.Err).ToNot(Say("The bindings of these apps were changed"))
+			})
+		})
+
+		When("restoring a binding fails", func() {
+			BeforeEach(func() {
+				fakeActor.RestartApplicationReturns(closedStartChannels(actionerror.StartupTimeoutError{Name: "web"}))
+				fakeActor.RestoreServiceBindingReturns(v2action.Warnings{"restore-warning"}, errors.New("restore-error"))
+			})
+
+			It("warns about it and still deletes the successor key", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "web"}))
+				Expect(testUI.Err).To(Say("restore-warning"))
+				Expect(testUI.Err).To(Say("Could not restore the binding of service instance some-service-instance to app web: restore-error"))
+				Expect(fakeActor.DeleteServiceKeyArgsForCall(0)).To(Equal("new-key-guid"))
+			})
+		})
+	})
+
+	When("--restart and --restage are both provided", func() {
+		BeforeEach(func() {
+			cmd.Apps = []string{"web"}
+			cmd.Restart = true
+			cmd.Restage = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--restart", "--restage"}}))
+		})
+	})
+
+	When("--restart is provided without --app", func() {
+		BeforeEach(func() {
+			cmd.Restart = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--restart", Arg2: "--app"}))
+		})
+	})
+})
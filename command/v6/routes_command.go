@@ -1,20 +1,109 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetOrganizationRouteSummaries(orgGUID string) ([]v2action.RouteSummary, v2action.Warnings, error)
+	GetSpaceRouteSummaries(spaceGUID string, spaceName string) ([]v2action.RouteSummary, v2action.Warnings, error)
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
 	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (RoutesCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates the clients when the output is structured; the table
+// output is handled by the legacy command.
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	if !ui.IsStructuredOutput() {
+		return nil
+	}
+
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil, config)
+
 	return nil
 }
 
-func (RoutesCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd RoutesCommand) Execute(args []string) error {
+	if !cmd.UI.IsStructuredOutput() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, !cmd.OrgLevel)
+	if err != nil {
+		return err
+	}
+
+	var (
+		summaries []v2action.RouteSummary
+		warnings  v2action.Warnings
+	)
+	if cmd.OrgLevel {
+		summaries, warnings, err = cmd.Actor.GetOrganizationRouteSummaries(cmd.Config.TargetedOrganization().GUID)
+	} else {
+		space := cmd.Config.TargetedSpace()
+		summaries, warnings, err = cmd.Actor.GetSpaceRouteSummaries(space.GUID, space.Name)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	doc := routesDocument{Routes: []routeDocument{}}
+	for _, summary := range summaries {
+		route := routeDocument{
+			URL:    summary.String(),
+			Space:  summary.SpaceName,
+			Host:   summary.Host,
+			Domain: summary.Domain.Name,
+			Path:   summary.Path,
+			Type:   "http",
+			Apps:   summary.AppNames,
+		}
+		if summary.Port.IsSet {
+			route.Port = summary.Port.Value
+		}
+		if summary.Domain.IsTCP() {
+			route.Type = "tcp"
+		}
+		doc.Routes = append(doc.Routes, route)
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
+
+type routesDocument struct {
+	Routes []routeDocument `json:"routes" yaml:"routes"`
+}
+
+type routeDocument struct {
+	URL    string   `json:"url" yaml:"url"`
+	Space  string   `json:"space" yaml:"space"`
+	Host   string   `json:"host,omitempty" yaml:"host,omitempty"`
+	Domain string   `json:"domain" yaml:"domain"`
+	Port   int      `json:"port,omitempty" yaml:"port,omitempty"`
+	Path   string   `json:"path,omitempty" yaml:"path,omitempty"`
+	Type   string   `json:"type" yaml:"type"`
+	Apps   []string `json:"apps" yaml:"apps"`
 }
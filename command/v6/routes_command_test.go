@@ -0,0 +1,142 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRoutesActor
+		executeErr      error
+		summaries       []v2action.RouteSummary
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		summaries = []v2action.RouteSummary{
+			{
+				Route: v2action.Route{
+					Host:   "host-1",
+					Path:   "/path",
+					Domain: v2action.Domain{Name: "example.com"},
+				},
+				SpaceName: "some-space",
+				AppNames:  []string{"app-1", "app-2"},
+			},
+			{
+				Route: v2action.Route{
+					Port:   types.NullInt{IsSet: true, Value: 1024},
+					Domain: v2action.Domain{Name: "tcp.example.com", RouterGroupType: constant.TCPRouterGroup},
+				},
+				SpaceName: "other-space",
+				AppNames:  []string{},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the output format is a table", func() {
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the output format is YAML", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatYAML
+			fakeActor.GetSpaceRouteSummariesReturns(summaries[:1], v2action.Warnings{"routes-warning"}, nil)
+		})
+
+		It("displays the routes in the targeted space as a YAML document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			spaceGUID, spaceName := fakeActor.GetSpaceRouteSummariesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchYAML(`
+routes:
+- url: host-1.example.com/path
+  space: some-space
+  host: host-1
+  domain: example.com
+  path: /path
+  type: http
+  apps: [app-1, app-2]
+`))
+		})
+	})
+
+	When("--orglevel is provided with JSON output", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatJSON
+			cmd.OrgLevel = true
+			fakeActor.GetOrganizationRouteSummariesReturns(summaries, v2action.Warnings{"routes-warning"}, nil)
+		})
+
+		It("displays the routes in every space of the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+			Expect(fakeActor.GetOrganizationRouteSummariesArgsForCall(0)).To(Equal("some-org-guid"))
+
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+				"routes": [
+					{"url": "host-1.example.com/path", "space": "some-space", "host": "host-1", "domain": "example.com", "path": "/path", "type": "http", "apps": ["app-1", "app-2"]},
+					{"url": "tcp.example.com:1024", "space": "other-space", "domain": "tcp.example.com", "port": 1024, "type": "tcp", "apps": []}
+				]
+			}`))
+			Expect(testUI.Err).To(Say(`{"warnings":\["routes-warning"\]}`))
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationRouteSummariesReturns(nil, nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+})
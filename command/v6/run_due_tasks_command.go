@@ -0,0 +1,116 @@
+package v6
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . RunDueTasksActor
+
+type RunDueTasksActor interface {
+	GetDueTaskSchedules(now time.Time) ([]v3action.TaskSchedule, error)
+	RunTaskSchedule(taskSchedule v3action.TaskSchedule, now time.Time) (v3action.Task, v3action.Warnings, error)
+}
+
+type RunDueTasksCommand struct {
+	DryRun          bool        `long:"dry-run" description:"Display the task schedules that are due without running them"`
+	Loop            bool        `long:"loop" description:"Keep running and run the tasks of every space as they become due"`
+	usage           interface{} `usage:"CF_NAME run-due-tasks [--dry-run] [--loop]\n\n   Runs the tasks of all stored task schedules for the targeted API endpoint, in every org and space, that have become due since they last ran. Schedules created for other API endpoints are skipped. Run it regularly from cron or CI, or keep a single runner going with --loop.\n\nEXAMPLES:\n   CF_NAME run-due-tasks\n   CF_NAME run-due-tasks --loop"`
+	relatedCommands interface{} `related_commands:"run-task, schedule-task, task-schedules"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RunDueTasksActor
+}
+
+func (cmd *RunDueTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd RunDueTasksCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	for {
+		now := time.Now().UTC()
+		err = cmd.runDueTasks(now)
+		if !cmd.Loop {
+			return err
+		}
+		if err != nil {
+			cmd.UI.DisplayWarning(err.Error())
+		}
+
+		time.Sleep(time.Until(now.Truncate(time.Minute).Add(time.Minute)))
+	}
+}
+
+func (cmd RunDueTasksCommand) runDueTasks(now time.Time) error {
+	schedules, err := cmd.Actor.GetDueTaskSchedules(now)
+	if err != nil {
+		return err
+	}
+
+	if len(schedules) == 0 {
+		if !cmd.Loop {
+			cmd.UI.DisplayText("No task schedules are due.")
+		}
+		return nil
+	}
+
+	failed := 0
+	for _, schedule := range schedules {
+		templateValues := map[string]interface{}{
+			"TaskName":  schedule.Name,
+			"AppName":   schedule.AppName,
+			"OrgName":   schedule.OrgName,
+			"SpaceName": schedule.SpaceName,
+		}
+
+		if cmd.DryRun {
+			cmd.UI.DisplayText("Task {{.TaskName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} is due.", templateValues)
+			continue
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Running task {{.TaskName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}}...", templateValues)
+		task, warnings, err := cmd.Actor.RunTaskSchedule(schedule, now)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			failed++
+			cmd.UI.DisplayWarning("Failed to run task {{.TaskName}}: {{.Error}}", map[string]interface{}{
+				"TaskName": schedule.Name,
+				"Error":    err.Error(),
+			})
+			continue
+		}
+
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayKeyValueTable("", [][]string{
+			{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
+		}, 3)
+	}
+
+	if failed > 0 {
+		return translatableerror.ScheduledTasksFailedError{Failed: failed, Total: len(schedules)}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("run-due-tasks Command", func() {
+	var (
+		cmd             RunDueTasksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRunDueTasksActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRunDueTasksActor)
+
+		cmd = RunDueTasksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("no task schedules are due", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No task schedules are due."))
+			Expect(fakeActor.GetDueTaskSchedulesArgsForCall(0)).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+	})
+
+	When("getting the due task schedules returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetDueTaskSchedulesReturns(nil, errors.New("load error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("load error"))
+		})
+	})
+
+	When("task schedules are due", func() {
+		var schedules []v3action.TaskSchedule
+
+		BeforeEach(func() {
+			schedules = []v3action.TaskSchedule{
+				{Name: "hourly", AppName: "app-1", OrgName: "org-1", SpaceName: "space-1"},
+				{Name: "nightly", AppName: "app-2", OrgName: "org-2", SpaceName: "space-2"},
+			}
+			fakeActor.GetDueTaskSchedulesReturns(schedules, nil)
+		})
+
+		It("runs every due task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Running task hourly for app app-1 in org org-1 / space space-1\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Running task nightly for app app-2 in org org-2 / space space-2\.\.\.`))
+
+			Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(2))
+			schedule, now := fakeActor.RunTaskScheduleArgsForCall(1)
+			Expect(schedule).To(Equal(schedules[1]))
+			Expect(now).To(Equal(fakeActor.GetDueTaskSchedulesArgsForCall(0)))
+		})
+
+		When("--dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("lists the due tasks without running them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Task hourly for app app-1 in org org-1 / space space-1 is due\.`))
+				Expect(testUI.Out).To(Say(`Task nightly for app app-2 in org org-2 / space space-2 is due\.`))
+				Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(0))
+			})
+		})
+
+		When("running a task fails", func() {
+			BeforeEach(func() {
+				fakeActor.RunTaskScheduleReturnsOnCall(0, v3action.Task{}, v3action.Warnings{"run-warning"}, errors.New("run error"))
+				fakeActor.RunTaskScheduleReturnsOnCall(1, v3action.Task{SequenceID: 7}, nil, nil)
+			})
+
+			It("runs the remaining tasks and returns a ScheduledTasksFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ScheduledTasksFailedError{Failed: 1, Total: 2}))
+				Expect(testUI.Err).To(Say("run-warning"))
+				Expect(testUI.Err).To(Say("Failed to run task hourly: run error"))
+				Expect(testUI.Out).To(Say(`task id:\s+7`))
+				Expect(fakeActor.RunTaskScheduleCallCount()).To(Equal(2))
+			})
+		})
+	})
+})
@@ -2,7 +2,10 @@ package v6
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
@@ -14,20 +17,28 @@ import (
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	PollTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 }
 
+// taskLogQuietPeriod is how long to keep displaying logs after the task has
+// finished, since its last log lines can arrive after its state changes.
+const taskLogQuietPeriod = time.Second
+
 type RunTaskCommand struct {
 	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
 	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	Wait            bool             `long:"wait" description:"Wait for the task to finish while displaying its logs, and exit with an error if it fails"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
+	NOAAClient  v3action.NOAAClient
 	Actor       RunTaskActor
 }
 
@@ -36,11 +47,12 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Config = config
 	cmd.SharedActor = sharedaction.NewActor(config)
 
-	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	client, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
 	if err != nil {
 		return err
 	}
 	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+	cmd.NOAAClient = shared.NewNOAAClient(client.Info.Logging(), config, uaaClient, ui)
 
 	return nil
 }
@@ -85,9 +97,22 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		inputTask.MemoryInMB = cmd.Memory.Value
 	}
 
+	var (
+		logStream    <-chan *v3action.LogMessage
+		logErrStream <-chan error
+	)
+	if cmd.Wait {
+		// Start streaming before the task is created so that none of its
+		// logs are missed.
+		logStream, logErrStream = cmd.Actor.GetStreamingLogs(application.GUID, cmd.NOAAClient)
+	}
+
 	task, warnings, err := cmd.Actor.RunTask(application.GUID, inputTask)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
+		if cmd.Wait {
+			cmd.NOAAClient.Close()
+		}
 		return err
 	}
 
@@ -98,5 +123,88 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(application.GUID, task, logStream, logErrStream)
+}
+
+// waitForTask displays the logs of the task until it has finished and its
+// logs have gone quiet. Logs of the app and of other tasks are filtered out.
+func (cmd RunTaskCommand) waitForTask(appGUID string, task v3action.Task, logStream <-chan *v3action.LogMessage, logErrStream <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskName}} to finish...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	filter := sharedaction.LogFilter{
+		SourceTypes: []string{"APP/TASK/" + task.Name},
+		Instances:   []string{"0"},
+	}
+
+	taskDone := make(chan struct{})
+	logsQuiet := make(chan struct{})
+	logsDone := make(chan struct{})
+	go func() {
+		defer close(logsDone)
+
+		finished := taskDone
+		var quiet <-chan time.Time
+		for logStream != nil || logErrStream != nil {
+			select {
+			case <-finished:
+				finished = nil
+				quiet = time.After(taskLogQuietPeriod)
+			case <-quiet:
+				quiet = nil
+				close(logsQuiet)
+			case message, ok := <-logStream:
+				if !ok {
+					logStream = nil
+					continue
+				}
+				if filter.Matches(message) {
+					cmd.UI.DisplayLogMessage(message, true)
+					if quiet != nil {
+						quiet = time.After(taskLogQuietPeriod)
+					}
+				}
+			case logErr, ok := <-logErrStream:
+				if !ok {
+					logErrStream = nil
+					continue
+				}
+				switch logErr.(type) {
+				case actionerror.NOAATimeoutError:
+					cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
+				default:
+					cmd.UI.DisplayWarning(logErr.Error())
+				}
+			}
+		}
+	}()
+
+	finishedTask, warnings, err := cmd.Actor.PollTask(appGUID, task)
+	close(taskDone)
+	select {
+	case <-logsQuiet:
+	case <-logsDone:
+	}
+	cmd.NOAAClient.Close()
+	<-logsDone
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Task {{.TaskName}} ({{.TaskID}}) {{.State}}.", map[string]interface{}{
+		"TaskName": finishedTask.Name,
+		"TaskID":   finishedTask.SequenceID,
+		"State":    cmd.UI.TranslateText(strings.ToLower(string(finishedTask.State))),
+	})
+
 	return nil
 }
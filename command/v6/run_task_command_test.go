@@ -2,10 +2,13 @@ package v6_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v6"
@@ -193,6 +196,124 @@ var _ = Describe("run-task Command", func() {
 						Expect(testUI.Err).To(Say("get-application-warning-3"))
 					})
 				})
+
+				When("--wait is provided", func() {
+					var (
+						fakeNOAAClient *v3actionfakes.FakeNOAAClient
+						logStream      chan *v3action.LogMessage
+						logErrStream   chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Wait = true
+						fakeNOAAClient = new(v3actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+
+						logStream = make(chan *v3action.LogMessage, 3)
+						logErrStream = make(chan error, 1)
+						logStream <- v3action.NewLogMessage("app message", 1, time.Now(), "APP/PROC/WEB", "0")
+						logStream <- v3action.NewLogMessage("task message", 1, time.Now(), "APP/TASK/some-task-name", "0")
+						logStream <- v3action.NewLogMessage("other task message", 1, time.Now(), "APP/TASK/other-task", "0")
+						logErrStream <- errors.New("log error")
+						fakeActor.GetStreamingLogsReturns(logStream, logErrStream)
+						fakeNOAAClient.CloseStub = func() error {
+							close(logStream)
+							close(logErrStream)
+							return nil
+						}
+
+						fakeActor.RunTaskReturns(
+							v3action.Task{Name: "some-task-name", SequenceID: 3},
+							v3action.Warnings{"run-task-warning"},
+							nil)
+					})
+
+					When("the task succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(
+								v3action.Task{Name: "some-task-name", SequenceID: 3, State: constant.TaskSucceeded},
+								v3action.Warnings{"poll-warning"},
+								nil)
+						})
+
+						It("streams the logs of the task until it has finished", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(1))
+							appGUID, client := fakeActor.GetStreamingLogsArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(client).To(Equal(fakeNOAAClient))
+
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+							appGUID, task := fakeActor.PollTaskArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(task).To(Equal(v3action.Task{Name: "some-task-name", SequenceID: 3}))
+
+							Expect(testUI.Out).ToNot(Say("app message"))
+							Expect(testUI.Out).ToNot(Say("other task message"))
+							Expect(testUI.Out).To(Say(`task id:\s+3`))
+							Expect(testUI.Out).To(Say("Waiting for task some-task-name to finish..."))
+							Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT task message`))
+							Expect(testUI.Out).To(Say(`Task some-task-name \(3\) succeeded\.`))
+
+							Expect(testUI.Err).To(Say("run-task-warning"))
+							Expect(testUI.Err).To(Say("log error"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+
+						When("the last logs of the task arrive after it has finished", func() {
+							BeforeEach(func() {
+								fakeActor.PollTaskStub = func(string, v3action.Task) (v3action.Task, v3action.Warnings, error) {
+									go func() {
+										time.Sleep(100 * time.Millisecond)
+										logStream <- v3action.NewLogMessage("late task message", 1, time.Now(), "APP/TASK/some-task-name", "0")
+									}()
+									return v3action.Task{Name: "some-task-name", SequenceID: 3, State: constant.TaskSucceeded}, nil, nil
+								}
+							})
+
+							It("displays them before closing the log stream", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT late task message`))
+								Expect(testUI.Out).To(Say(`Task some-task-name \(3\) succeeded\.`))
+								Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+							})
+						})
+					})
+
+					When("the task fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = actionerror.TaskFailedError{Name: "some-task-name", SequenceID: 3, Reason: "Exited with status 1"}
+							fakeActor.PollTaskReturns(
+								v3action.Task{Name: "some-task-name", SequenceID: 3, State: constant.TaskFailed},
+								v3action.Warnings{"poll-warning"},
+								expectedErr)
+						})
+
+						It("returns the TaskFailedError", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Out).ToNot(Say("succeeded"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					When("running the task returns an error", func() {
+						BeforeEach(func() {
+							fakeActor.RunTaskReturns(v3action.Task{}, nil, errors.New("run error"))
+						})
+
+						It("stops streaming logs and returns the error", func() {
+							Expect(executeErr).To(MatchError("run error"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(0))
+						})
+					})
+				})
 			})
 
 			When("there are errors", func() {
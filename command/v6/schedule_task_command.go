@@ -0,0 +1,116 @@
+package v6
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . ScheduleTaskActor
+
+type ScheduleTaskActor interface {
+	CreateTaskSchedule(taskSchedule v3action.TaskSchedule) error
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+}
+
+type ScheduleTaskCommand struct {
+	RequiredArgs    flag.ScheduleTaskArgs `positional-args:"yes"`
+	Disk            flag.Megabytes        `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory          flag.Megabytes        `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string                `long:"name" required:"true" description:"Name of the scheduled task"`
+	usage           interface{}           `usage:"CF_NAME schedule-task APP_NAME SCHEDULE COMMAND --name TASK_NAME [-k DISK] [-m MEMORY]\n\n   SCHEDULE is a cron schedule of the five fields minute, hour, day of month, month and day of week, evaluated in UTC, or one of @hourly, @daily, @weekly, @monthly and @yearly.\n   Schedules are stored locally. Run 'CF_NAME run-due-tasks' regularly to run the tasks that are due.\n\nEXAMPLES:\n   CF_NAME schedule-task my-app \"0 3 * * *\" \"bundle exec rake cleanup\" --name nightly-cleanup\n   CF_NAME schedule-task my-app @hourly \"bin/sync\" --name sync -m 256M"`
+	relatedCommands interface{}           `related_commands:"delete-task-schedule, run-due-tasks, run-task, task-schedules"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScheduleTaskActor
+}
+
+func (cmd *ScheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd ScheduleTaskCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scheduling task {{.TaskName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskName":    cmd.Name,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     org.Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	taskSchedule := v3action.TaskSchedule{
+		Name:      cmd.Name,
+		Schedule:  cmd.RequiredArgs.Schedule,
+		Command:   cmd.RequiredArgs.Command,
+		AppGUID:   application.GUID,
+		AppName:   cmd.RequiredArgs.AppName,
+		SpaceGUID: space.GUID,
+		SpaceName: space.Name,
+		OrgName:   org.Name,
+		CreatedAt: time.Now().UTC(),
+	}
+	if cmd.Disk.IsSet {
+		taskSchedule.DiskInMB = cmd.Disk.Value
+	}
+	if cmd.Memory.IsSet {
+		taskSchedule.MemoryInMB = cmd.Memory.Value
+	}
+
+	err = cmd.Actor.CreateTaskSchedule(taskSchedule)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("task name:"), taskSchedule.Name},
+		{cmd.UI.TranslateText("schedule:"), taskSchedule.Schedule},
+		{cmd.UI.TranslateText("next run:"), formatTaskScheduleTime(taskSchedule.NextRunAt())},
+	}, 3)
+
+	return nil
+}
+
+// formatTaskScheduleTime formats a task schedule time, which is empty for a
+// schedule that never ran or is never due.
+func formatTaskScheduleTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC1123)
+}
@@ -0,0 +1,136 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("schedule-task Command", func() {
+	var (
+		cmd             ScheduleTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeScheduleTaskActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeScheduleTaskActor)
+
+		cmd = ScheduleTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.Schedule = "0 3 * * *"
+		cmd.RequiredArgs.Command = "some command"
+		cmd.Name = "nightly"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the app returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.CreateTaskScheduleCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app exists", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"app-warning"}, nil)
+			cmd.Disk = flag.Megabytes{NullUint64: types.NullUint64{Value: 321, IsSet: true}}
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{Value: 123, IsSet: true}}
+		})
+
+		It("stores the task schedule and displays when it runs next", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Scheduling task nightly for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`task name:\s+nightly`))
+			Expect(testUI.Out).To(Say(`schedule:\s+0 3 \* \* \*`))
+			Expect(testUI.Out).To(Say(`next run:\s+\w{3}, \d{2} \w{3} \d{4} 03:00:00 UTC`))
+			Expect(testUI.Err).To(Say("app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			taskSchedule := fakeActor.CreateTaskScheduleArgsForCall(0)
+			Expect(taskSchedule.CreatedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			taskSchedule.CreatedAt = time.Time{}
+			Expect(taskSchedule).To(Equal(v3action.TaskSchedule{
+				Name:       "nightly",
+				Schedule:   "0 3 * * *",
+				Command:    "some command",
+				DiskInMB:   321,
+				MemoryInMB: 123,
+				AppGUID:    "some-app-guid",
+				AppName:    "some-app",
+				SpaceGUID:  "some-space-guid",
+				SpaceName:  "some-space",
+				OrgName:    "some-org",
+			}))
+		})
+
+		When("storing the schedule returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.CreateTaskScheduleReturns(errors.New("store error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("store error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package v6
+
+import (
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/sftp"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . SecureCopyActor
+
+type SecureCopyActor interface {
+	ExecuteSecureCopy(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, copyOptions sharedaction.SecureCopyOptions, progressBar sftp.ProgressBar) error
+}
+
+type SCPCommand struct {
+	RequiredArgs       flag.SecureCopyArgs `positional-args:"yes"`
+	ProcessIndex       uint                `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string              `long:"process" default:"web" description:"App process name"`
+	Quiet              bool                `long:"quiet" short:"q" description:"Do not display the progress of each file"`
+	Recursive          bool                `short:"r" description:"Recursively copy directories"`
+	SkipHostValidation bool                `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [--process PROCESS] [-i INDEX] [-r] [-q] [--skip-host-validation] SOURCE DESTINATION\n\n   Copies files to or from an app instance over SSH. Exactly one of SOURCE and DESTINATION is a path on the app instance, written as APP_NAME:PATH. A relative PATH is relative to the home directory of the app instance.\n\nEXAMPLES:\n   CF_NAME scp my-app:/home/vcap/app/heap.hprof .\n   CF_NAME scp -i 2 my-app:logs ./instance-2-logs -r\n   CF_NAME scp ./config.yml my-app:app/config.yml"`
+	relatedCommands interface{} `related_commands:"enable-ssh, ssh, ssh-code, ssh-enabled"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SSHActor
+	SCPActor    SecureCopyActor
+	SSHClient   *clissh.SecureShell
+	ProgressBar sftp.ProgressBar
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SCPActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewProgressBar()
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	sourceApp, sourcePath, sourceIsRemote := parseSecureCopyPath(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := parseSecureCopyPath(cmd.RequiredArgs.Destination)
+	if sourceIsRemote == destinationIsRemote {
+		return translatableerror.SecureCopyPathsError{}
+	}
+
+	appName := sourceApp
+	if destinationIsRemote {
+		appName = destinationApp
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying {{.Source}} to {{.Destination}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Source":      cmd.RequiredArgs.Source,
+		"Destination": cmd.RequiredArgs.Destination,
+		"AppName":     appName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var progressBar sftp.ProgressBar
+	if !cmd.Quiet {
+		progressBar = cmd.ProgressBar
+	}
+
+	err = cmd.SCPActor.ExecuteSecureCopy(
+		cmd.SSHClient,
+		sharedaction.SSHOptions{
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			Passcode:           sshAuth.Passcode,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		},
+		sharedaction.SecureCopyOptions{
+			Source:      sourcePath,
+			Destination: destinationPath,
+			ToRemote:    destinationIsRemote,
+			Recursive:   cmd.Recursive,
+		},
+		progressBar,
+	)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parseSecureCopyPath splits a path written as APP_NAME:PATH into the app name
+// and the path on the app instance. Any other path is local. An empty remote
+// path refers to the home directory of the app instance.
+func parseSecureCopyPath(rawPath string) (string, string, bool) {
+	if filepath.VolumeName(rawPath) != "" {
+		return "", rawPath, false
+	}
+
+	separator := strings.Index(rawPath, ":")
+	if separator <= 0 || strings.ContainsAny(rawPath[:separator], `/\`) {
+		return "", rawPath, false
+	}
+
+	appName, remotePath := rawPath[:separator], rawPath[separator+1:]
+	if remotePath == "" {
+		remotePath = "."
+	}
+	return appName, remotePath, true
+}
@@ -0,0 +1,175 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/clissh/sftp/sftpfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeV3SSHActor
+		fakeSCPActor    *v6fakes.FakeSecureCopyActor
+		fakeProgressBar *sftpfakes.FakeProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeV3SSHActor)
+		fakeSCPActor = new(v6fakes.FakeSecureCopyActor)
+		fakeProgressBar = new(sftpfakes.FakeProgressBar)
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SecureCopyArgs{Source: "some-app:/home/vcap/heap.hprof", Destination: "local-dir"},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			Recursive:          true,
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SCPActor:    fakeSCPActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	DescribeTable("invalid combinations of paths",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.SecureCopyArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.SecureCopyPathsError{}))
+		},
+		Entry("two local paths", "some-file", "/tmp/other-file"),
+		Entry("a local path containing a colon", "./some:file", "other-file"),
+		Entry("two remote paths", "app-1:some-file", "app-2:other-file"),
+	)
+
+	When("getting the secure shell authentication information fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.SSHAuthentication{}, v3action.Warnings{"some-warnings"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSCPActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the secure shell authentication information succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v3action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			}, v3action.Warnings{"some-warnings"}, nil)
+		})
+
+		It("copies the file from the app instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Copying some-app:/home/vcap/heap.hprof to local-dir for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+
+			appName, spaceGUID, processType, processIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("some-process-type"))
+			Expect(processIndex).To(Equal(uint(1)))
+
+			Expect(fakeSCPActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+			_, sshOptions, copyOptions, progressBar := fakeSCPActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				SkipHostValidation: true,
+				Username:           "some-username",
+			}))
+			Expect(copyOptions).To(Equal(sharedaction.SecureCopyOptions{
+				Source:      "/home/vcap/heap.hprof",
+				Destination: "local-dir",
+				ToRemote:    false,
+				Recursive:   true,
+			}))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+		})
+
+		When("copying to the home directory of the app instance quietly", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.SecureCopyArgs{Source: "local-file", Destination: "some-app:"}
+				cmd.Quiet = true
+			})
+
+			It("copies the file to the app instance without a progress bar", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, copyOptions, progressBar := fakeSCPActor.ExecuteSecureCopyArgsForCall(0)
+				Expect(copyOptions).To(Equal(sharedaction.SecureCopyOptions{
+					Source:      "local-file",
+					Destination: ".",
+					ToRemote:    true,
+					Recursive:   true,
+				}))
+				Expect(progressBar).To(BeNil())
+			})
+		})
+
+		When("copying fails", func() {
+			BeforeEach(func() {
+				fakeSCPActor.ExecuteSecureCopyReturns(errors.New("some-copy-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-copy-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
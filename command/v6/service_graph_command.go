@@ -0,0 +1,221 @@
+package v6
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ServiceGraphActor
+
+type ServiceGraphActor interface {
+	GetServiceGraphBySpace(spaceGUID string) (v2action.ServiceGraph, v2action.Warnings, error)
+	GetServiceGraphByOrganization(orgGUID string) (v2action.ServiceGraph, v2action.Warnings, error)
+}
+
+type ServiceGraphCommand struct {
+	Org             bool        `long:"org" description:"Show the service instances of every space in the targeted org instead of the targeted space"`
+	DOT             bool        `long:"dot" description:"Output the graph in the Graphviz DOT language"`
+	usage           interface{} `usage:"CF_NAME service-graph [--org] [--dot]\n\nEXAMPLES:\n   CF_NAME service-graph\n   CF_NAME service-graph --org --output json\n   CF_NAME service-graph --dot | dot -Tsvg > services.svg"`
+	relatedCommands interface{} `related_commands:"service, service-keys, services, share-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceGraphActor
+}
+
+func (cmd *ServiceGraphCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor(config)
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ServiceGraphCommand) Execute(args []string) error {
+	if cmd.DOT && cmd.UI.IsStructuredOutput() {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--dot", "--output"}}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, !cmd.Org)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	displayTable := !cmd.DOT && !cmd.UI.IsStructuredOutput()
+	if displayTable {
+		templateValues := map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		}
+		if cmd.Org {
+			cmd.UI.DisplayTextWithFlavor("Getting service graph in org {{.OrgName}} as {{.CurrentUser}}...", templateValues)
+		} else {
+			cmd.UI.DisplayTextWithFlavor("Getting service graph in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	var (
+		graph    v2action.ServiceGraph
+		warnings v2action.Warnings
+	)
+	if cmd.Org {
+		graph, warnings, err = cmd.Actor.GetServiceGraphByOrganization(cmd.Config.TargetedOrganization().GUID)
+	} else {
+		graph, warnings, err = cmd.Actor.GetServiceGraphBySpace(cmd.Config.TargetedSpace().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cmd.DOT:
+		_, err = cmd.UI.GetOut().Write(serviceGraphDOT(graph))
+		return err
+	case cmd.UI.IsStructuredOutput():
+		return cmd.UI.DisplayStructuredOutput(newServiceGraphDocument(graph))
+	}
+
+	instances := graph.ServiceInstances()
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("No services found")
+		return nil
+	}
+
+	header := []string{cmd.UI.TranslateText("service instance")}
+	if cmd.Org {
+		header = append(header, cmd.UI.TranslateText("space"))
+	}
+	header = append(header,
+		cmd.UI.TranslateText("bound apps"),
+		cmd.UI.TranslateText("service keys"),
+		cmd.UI.TranslateText("shared to"),
+		cmd.UI.TranslateText("route services"),
+		cmd.UI.TranslateText("dependents"),
+	)
+	table := [][]string{header}
+
+	for _, instance := range instances {
+		row := []string{instance.Name}
+		if cmd.Org {
+			row = append(row, instance.Space)
+		}
+
+		dependents := 0
+		for _, nodeType := range []v2action.ServiceGraphNodeType{
+			v2action.ServiceGraphApplication,
+			v2action.ServiceGraphServiceKey,
+			v2action.ServiceGraphSpace,
+			v2action.ServiceGraphRoute,
+		} {
+			nodes := graph.Dependents(instance.GUID, nodeType)
+			dependents += len(nodes)
+			row = append(row, serviceGraphNodeNames(nodes))
+		}
+		row = append(row, strconv.Itoa(dependents))
+
+		table = append(table, row)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func serviceGraphNodeNames(nodes []v2action.ServiceGraphNode) string {
+	var names []string
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// serviceGraphDOT renders the graph in the Graphviz DOT language, with an
+// edge from every dependent to the service instance it depends on.
+func serviceGraphDOT(graph v2action.ServiceGraph) []byte {
+	shapes := map[v2action.ServiceGraphNodeType]string{
+		v2action.ServiceGraphApplication:     "box",
+		v2action.ServiceGraphServiceInstance: "cylinder",
+		v2action.ServiceGraphServiceKey:      "note",
+		v2action.ServiceGraphSpace:           "folder",
+		v2action.ServiceGraphRoute:           "cds",
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("digraph services {\n")
+	buffer.WriteString("  rankdir=LR;\n")
+	for _, node := range graph.Nodes {
+		label := fmt.Sprintf("%s\n%s", node.Type, node.Name)
+		if node.Space != "" {
+			label = fmt.Sprintf("%s\n(%s)", label, node.Space)
+		}
+		fmt.Fprintf(&buffer, "  %s [label=%s, shape=%s];\n", strconv.Quote(node.GUID), strconv.Quote(label), shapes[node.Type])
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&buffer, "  %s -> %s [label=%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(string(edge.Type)))
+	}
+	buffer.WriteString("}\n")
+	return buffer.Bytes()
+}
+
+type serviceGraphDocument struct {
+	Nodes []serviceGraphNodeDocument `json:"nodes" yaml:"nodes"`
+	Edges []serviceGraphEdgeDocument `json:"edges" yaml:"edges"`
+}
+
+type serviceGraphNodeDocument struct {
+	GUID  string `json:"guid" yaml:"guid"`
+	Type  string `json:"type" yaml:"type"`
+	Name  string `json:"name" yaml:"name"`
+	Space string `json:"space,omitempty" yaml:"space,omitempty"`
+}
+
+type serviceGraphEdgeDocument struct {
+	From string `json:"from" yaml:"from"`
+	To   string `json:"to" yaml:"to"`
+	Type string `json:"type" yaml:"type"`
+}
+
+func newServiceGraphDocument(graph v2action.ServiceGraph) serviceGraphDocument {
+	doc := serviceGraphDocument{
+		Nodes: []serviceGraphNodeDocument{},
+		Edges: []serviceGraphEdgeDocument{},
+	}
+	for _, node := range graph.Nodes {
+		doc.Nodes = append(doc.Nodes, serviceGraphNodeDocument{
+			GUID:  node.GUID,
+			Type:  string(node.Type),
+			Name:  node.Name,
+			Space: node.Space,
+		})
+	}
+	for _, edge := range graph.Edges {
+		doc.Edges = append(doc.Edges, serviceGraphEdgeDocument{
+			From: edge.From,
+			To:   edge.To,
+			Type: string(edge.Type),
+		})
+	}
+	return doc
+}
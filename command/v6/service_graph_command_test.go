@@ -0,0 +1,181 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-graph Command", func() {
+	var (
+		cmd             ServiceGraphCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeServiceGraphActor
+		graph           v2action.ServiceGraph
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeServiceGraphActor)
+
+		cmd = ServiceGraphCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		graph = v2action.ServiceGraph{
+			Nodes: []v2action.ServiceGraphNode{
+				{GUID: "db-guid", Type: v2action.ServiceGraphServiceInstance, Name: "db", Space: "some-space"},
+				{GUID: "web-guid", Type: v2action.ServiceGraphApplication, Name: "web", Space: "some-space"},
+				{GUID: "worker-guid", Type: v2action.ServiceGraphApplication, Name: "worker", Space: "some-space"},
+				{GUID: "key-guid", Type: v2action.ServiceGraphServiceKey, Name: "db-key", Space: "some-space"},
+				{GUID: "other-space-guid", Type: v2action.ServiceGraphSpace, Name: "other-org/other-space"},
+			},
+			Edges: []v2action.ServiceGraphEdge{
+				{From: "web-guid", To: "db-guid", Type: v2action.ServiceGraphBinding},
+				{From: "worker-guid", To: "db-guid", Type: v2action.ServiceGraphBinding},
+				{From: "key-guid", To: "db-guid", Type: v2action.ServiceGraphKey},
+				{From: "other-space-guid", To: "db-guid", Type: v2action.ServiceGraphShare},
+			},
+		}
+		fakeActor.GetServiceGraphBySpaceReturns(graph, v2action.Warnings{"graph-warning"}, nil)
+		fakeActor.GetServiceGraphByOrganizationReturns(graph, v2action.Warnings{"graph-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+		})
+	})
+
+	It("displays the dependents of each service instance in the targeted space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+		Expect(fakeActor.GetServiceGraphBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting service graph in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`service instance\s+bound apps\s+service keys\s+shared to\s+route services\s+dependents`))
+		Expect(testUI.Out).To(Say(`db\s+web, worker\s+db-key\s+other-org/other-space\s+4`))
+		Expect(testUI.Err).To(Say("graph-warning"))
+	})
+
+	When("--org is provided", func() {
+		BeforeEach(func() {
+			cmd.Org = true
+		})
+
+		It("displays the service instances of every space in the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+			Expect(fakeActor.GetServiceGraphByOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting service graph in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`service instance\s+space\s+bound apps`))
+			Expect(testUI.Out).To(Say(`db\s+some-space\s+web, worker`))
+		})
+	})
+
+	When("there are no service instances", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceGraphBySpaceReturns(v2action.ServiceGraph{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No services found"))
+		})
+	})
+
+	When("getting the graph fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceGraphBySpaceReturns(v2action.ServiceGraph{}, v2action.Warnings{"graph-warning"}, errors.New("graph-error"))
+		})
+
+		It("returns the error and displays the warnings", func() {
+			Expect(executeErr).To(MatchError("graph-error"))
+			Expect(testUI.Err).To(Say("graph-warning"))
+		})
+	})
+
+	When("--dot is provided", func() {
+		BeforeEach(func() {
+			cmd.DOT = true
+		})
+
+		It("displays the graph in the DOT language", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting service graph"))
+			Expect(testUI.Out).To(Say(`digraph services \{`))
+			Expect(testUI.Out).To(Say(`"db-guid" \[label="service instance\\ndb\\n\(some-space\)", shape=cylinder\];`))
+			Expect(testUI.Out).To(Say(`"web-guid" -> "db-guid" \[label="binding"\];`))
+			Expect(testUI.Out).To(Say(`"other-space-guid" -> "db-guid" \[label="share"\];`))
+			Expect(testUI.Out).To(Say(`\}`))
+		})
+
+		When("the output format is also set", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--dot", "--output"}}))
+			})
+		})
+	})
+
+	When("the output format is JSON", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputFormatJSON
+			graph.Nodes = graph.Nodes[:2]
+			graph.Edges = graph.Edges[:1]
+			fakeActor.GetServiceGraphBySpaceReturns(graph, nil, nil)
+		})
+
+		It("displays the nodes and edges as a JSON document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+				"nodes": [
+					{"guid": "db-guid", "type": "service instance", "name": "db", "space": "some-space"},
+					{"guid": "web-guid", "type": "app", "name": "web", "space": "some-space"}
+				],
+				"edges": [
+					{"from": "web-guid", "to": "db-guid", "type": "binding"}
+				]
+			}`))
+		})
+	})
+})
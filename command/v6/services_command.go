@@ -53,13 +53,15 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
-		map[string]interface{}{
-			"OrgName":     cmd.Config.TargetedOrganization().Name,
-			"SpaceName":   cmd.Config.TargetedSpace().Name,
-			"CurrentUser": user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"OrgName":     cmd.Config.TargetedOrganization().Name,
+				"SpaceName":   cmd.Config.TargetedSpace().Name,
+				"CurrentUser": user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	instanceSummaries, warnings, err := cmd.Actor.GetServiceInstancesSummaryBySpace(cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -67,6 +69,11 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		sortServiceInstances(instanceSummaries)
+		return cmd.displayServicesDocument(instanceSummaries)
+	}
+
 	if len(instanceSummaries) == 0 {
 		cmd.UI.DisplayText("No services found")
 		return nil
@@ -110,6 +117,54 @@ func (cmd ServicesCommand) Execute(args []string) error {
 	return nil
 }
 
+type servicesDocument struct {
+	Services []serviceInstanceDocument `json:"services" yaml:"services"`
+}
+
+type serviceInstanceDocument struct {
+	Name          string                `json:"name" yaml:"name"`
+	GUID          string                `json:"guid" yaml:"guid"`
+	Service       string                `json:"service" yaml:"service"`
+	Plan          string                `json:"plan" yaml:"plan"`
+	BoundApps     []string              `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation lastOperationDocument `json:"last_operation" yaml:"last_operation"`
+	Broker        string                `json:"broker" yaml:"broker"`
+}
+
+type lastOperationDocument struct {
+	Type  string `json:"type" yaml:"type"`
+	State string `json:"state" yaml:"state"`
+}
+
+func (cmd ServicesCommand) displayServicesDocument(instanceSummaries []v2action.ServiceInstanceSummary) error {
+	doc := servicesDocument{Services: []serviceInstanceDocument{}}
+	for _, summary := range instanceSummaries {
+		serviceLabel := summary.Service.Label
+		if summary.ServiceInstance.Type == constant.ServiceInstanceTypeUserProvidedService {
+			serviceLabel = "user-provided"
+		}
+
+		boundAppNames := []string{}
+		for _, boundApplication := range summary.BoundApplications {
+			boundAppNames = append(boundAppNames, boundApplication.AppName)
+		}
+
+		doc.Services = append(doc.Services, serviceInstanceDocument{
+			Name:      summary.Name,
+			GUID:      summary.GUID,
+			Service:   serviceLabel,
+			Plan:      summary.ServicePlan.Name,
+			BoundApps: boundAppNames,
+			LastOperation: lastOperationDocument{
+				Type:  summary.LastOperation.Type,
+				State: string(summary.LastOperation.State),
+			},
+			Broker: summary.Service.ServiceBrokerName,
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
+
 func sortServiceInstances(instanceSummaries []v2action.ServiceInstanceSummary) {
 	sort.Slice(instanceSummaries, func(i, j int) bool {
 		return sorting.LessIgnoreCase(instanceSummaries[i].Name, instanceSummaries[j].Name)
@@ -0,0 +1,83 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+//go:generate counterfeiter . CanaryDeploymentActor
+
+type CanaryDeploymentActor interface {
+	CancelDeployment(deploymentGUID string) (v3action.Warnings, error)
+	ContinueDeployment(deploymentGUID string) (v3action.Warnings, error)
+	PollCanaryDeployment(deploymentGUID string, warningsChannel chan<- v3action.Warnings) (bool, error)
+	PollDeploymentRollback(deploymentGUID string, warningsChannel chan<- v3action.Warnings) error
+	RunCanaryProbe(probe v3action.CanaryProbe) error
+}
+
+// GateCanaryDeployment waits for a canary deployment to pause, then runs the
+// probe and continues the deployment if it passes or rolls it back if it
+// fails. Without a probe, the deployment is left paused for the user to
+// continue or roll back. It returns true if the deployment is no longer
+// paused.
+func GateCanaryDeployment(ui command.UI, config command.Config, actor CanaryDeploymentActor, appName string, deploymentGUID string, probe v3action.CanaryProbe, warnings chan<- v3action.Warnings) (bool, error) {
+	ui.DisplayText("Waiting for the canary instance to start...")
+	paused, err := actor.PollCanaryDeployment(deploymentGUID, warnings)
+	if err != nil {
+		return false, err
+	}
+	if !paused {
+		return true, nil
+	}
+
+	if probe.URL == "" && probe.Command == "" {
+		ui.DisplayText("Deployment paused. Use '{{.BinaryName}} v3-continue-zdt-push {{.AppName}}' to continue or '{{.BinaryName}} v3-rollback-zdt-push {{.AppName}}' to roll back.", map[string]interface{}{
+			"AppName":    appName,
+			"BinaryName": config.BinaryName(),
+		})
+		return false, nil
+	}
+
+	probeName := probe.URL
+	if probeName == "" {
+		probeName = probe.Command
+	}
+	ui.DisplayText("Running canary probe {{.Probe}}...", map[string]interface{}{
+		"Probe": probeName,
+	})
+
+	err = actor.RunCanaryProbe(probe)
+	if probeErr, ok := err.(actionerror.CanaryProbeFailedError); ok {
+		ui.DisplayText("Canary probe failed, rolling back...")
+		cancelWarnings, cancelErr := actor.CancelDeployment(deploymentGUID)
+		ui.DisplayWarnings(cancelWarnings)
+		if cancelErr != nil {
+			return false, cancelErr
+		}
+
+		err = actor.PollDeploymentRollback(deploymentGUID, warnings)
+		if err != nil {
+			return false, err
+		}
+
+		return true, translatableerror.CanaryProbeFailedError{
+			AppName: appName,
+			Probe:   probeErr.Probe,
+			Reason:  probeErr.Reason,
+		}
+	} else if err != nil {
+		return false, err
+	}
+	ui.DisplayOK()
+
+	ui.DisplayText("Continuing deployment...")
+	continueWarnings, err := actor.ContinueDeployment(deploymentGUID)
+	ui.DisplayWarnings(continueWarnings)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,139 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/command/v6/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("GateCanaryDeployment", func() {
+	var (
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *sharedfakes.FakeCanaryDeploymentActor
+		probe      v3action.CanaryProbe
+		warnings   chan v3action.Warnings
+
+		finished   bool
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor = new(sharedfakes.FakeCanaryDeploymentActor)
+		fakeActor.PollCanaryDeploymentReturns(true, nil)
+		probe = v3action.CanaryProbe{URL: "https://some-app.example.com/health"}
+		warnings = make(chan v3action.Warnings)
+	})
+
+	JustBeforeEach(func() {
+		finished, executeErr = GateCanaryDeployment(testUI, fakeConfig, fakeActor, "some-app", "some-deployment-guid", probe, warnings)
+	})
+
+	It("waits for the canary instance", func() {
+		Expect(testUI.Out).To(Say("Waiting for the canary instance to start..."))
+		Expect(fakeActor.PollCanaryDeploymentCallCount()).To(Equal(1))
+		deploymentGUID, _ := fakeActor.PollCanaryDeploymentArgsForCall(0)
+		Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+	})
+
+	When("the probe passes", func() {
+		BeforeEach(func() {
+			fakeActor.ContinueDeploymentReturns(v3action.Warnings{"continue-warning"}, nil)
+		})
+
+		It("continues the deployment", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(finished).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(`Running canary probe https://some-app\.example\.com/health\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Continuing deployment..."))
+			Expect(testUI.Err).To(Say("continue-warning"))
+
+			Expect(fakeActor.RunCanaryProbeArgsForCall(0)).To(Equal(probe))
+			Expect(fakeActor.ContinueDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			Expect(fakeActor.CancelDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the probe fails", func() {
+		BeforeEach(func() {
+			fakeActor.RunCanaryProbeReturns(actionerror.CanaryProbeFailedError{Probe: probe.URL, Reason: "503 Service Unavailable"})
+			fakeActor.CancelDeploymentReturns(v3action.Warnings{"cancel-warning"}, nil)
+		})
+
+		It("rolls back the deployment and returns a CanaryProbeFailedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.CanaryProbeFailedError{
+				AppName: "some-app",
+				Probe:   probe.URL,
+				Reason:  "503 Service Unavailable",
+			}))
+
+			Expect(testUI.Out).To(Say("Canary probe failed, rolling back..."))
+			Expect(testUI.Err).To(Say("cancel-warning"))
+			Expect(fakeActor.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			Expect(fakeActor.PollDeploymentRollbackCallCount()).To(Equal(1))
+			Expect(fakeActor.ContinueDeploymentCallCount()).To(Equal(0))
+		})
+
+		When("rolling back fails", func() {
+			BeforeEach(func() {
+				fakeActor.CancelDeploymentReturns(nil, errors.New("cancel-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("cancel-error"))
+				Expect(fakeActor.PollDeploymentRollbackCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("no probe is provided", func() {
+		BeforeEach(func() {
+			probe = v3action.CanaryProbe{}
+		})
+
+		It("leaves the deployment paused", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(finished).To(BeFalse())
+			Expect(testUI.Out).To(Say(`Deployment paused\. Use 'faceman v3-continue-zdt-push some-app' to continue or 'faceman v3-rollback-zdt-push some-app' to roll back\.`))
+			Expect(fakeActor.RunCanaryProbeCallCount()).To(Equal(0))
+			Expect(fakeActor.ContinueDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the deployment finishes without pausing", func() {
+		BeforeEach(func() {
+			fakeActor.PollCanaryDeploymentReturns(false, nil)
+		})
+
+		It("does not run the probe", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(finished).To(BeTrue())
+			Expect(fakeActor.RunCanaryProbeCallCount()).To(Equal(0))
+		})
+	})
+
+	When("waiting for the canary fails", func() {
+		BeforeEach(func() {
+			fakeActor.PollCanaryDeploymentReturns(false, actionerror.StartupTimeoutError{})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{}))
+		})
+	})
+})
@@ -28,7 +28,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, newCCRetryRequest(config))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -76,7 +76,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(newUAARetryRequest(config))
 
 	err = uaaClient.SetupResources(ccClient.AuthorizationEndpoint())
 	if err != nil {
@@ -121,3 +121,23 @@ func NewRouterClient(config command.Config, ui command.UI, uaaClient *uaa.Client
 	routerClient := router.NewClient(routerConfig)
 	return routerClient, nil
 }
+
+func newCCRetryRequest(config command.Config) *ccWrapper.RetryRequest {
+	return ccWrapper.NewRetryRequestWithPolicy(ccWrapper.RetryPolicy{
+		MaxRetries:     config.RequestRetryCount(),
+		BaseDelay:      config.RequestRetryBaseDelay(),
+		MaxDelay:       config.RequestRetryMaxDelay(),
+		Jitter:         config.RequestRetryJitter(),
+		RetrySafePOSTs: config.RequestRetrySafePOSTs(),
+	})
+}
+
+func newUAARetryRequest(config command.Config) *uaaWrapper.RetryRequest {
+	return uaaWrapper.NewRetryRequestWithPolicy(uaaWrapper.RetryPolicy{
+		MaxRetries:     config.RequestRetryCount(),
+		BaseDelay:      config.RequestRetryBaseDelay(),
+		MaxDelay:       config.RequestRetryMaxDelay(),
+		Jitter:         config.RequestRetryJitter(),
+		RetrySafePOSTs: config.RequestRetrySafePOSTs(),
+	})
+}
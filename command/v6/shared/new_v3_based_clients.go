@@ -25,7 +25,7 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool, minV
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, newCCRetryRequest(config))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
@@ -79,7 +79,7 @@ func NewV3BasedClients(config command.Config, ui command.UI, targetCF bool, minV
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(newUAARetryRequest(config))
 
 	err = uaaClient.SetupResources(ccClient.UAA())
 	if err != nil {
@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// PollServiceOperation displays every last operation state received until the
+// channels are closed, and returns the first error received.
+func PollServiceOperation(ui command.UI, lastOperations <-chan v2action.LastOperation, apiWarnings <-chan string, apiErrs <-chan error) error {
+	for lastOperations != nil || apiWarnings != nil || apiErrs != nil {
+		select {
+		case lastOperation, ok := <-lastOperations:
+			if !ok {
+				lastOperations = nil
+				break
+			}
+
+			templateValues := map[string]interface{}{
+				"Type":        lastOperation.Type,
+				"State":       lastOperation.State,
+				"Description": lastOperation.Description,
+			}
+			if lastOperation.Description == "" {
+				ui.DisplayText("{{.Type}} {{.State}}", templateValues)
+			} else {
+				ui.DisplayText("{{.Type}} {{.State}}: {{.Description}}", templateValues)
+			}
+		case warning, ok := <-apiWarnings:
+			if !ok {
+				apiWarnings = nil
+				break
+			}
+
+			ui.DisplayWarning(warning)
+		case apiErr, ok := <-apiErrs:
+			if !ok {
+				apiErrs = nil
+				break
+			}
+
+			return apiErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Poll Service Operation", func() {
+	var (
+		testUI         *ui.UI
+		lastOperations chan v2action.LastOperation
+		apiWarnings    chan string
+		apiErrs        chan error
+		err            error
+		block          chan bool
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+
+		lastOperations = make(chan v2action.LastOperation)
+		apiWarnings = make(chan string)
+		apiErrs = make(chan error)
+		block = make(chan bool)
+
+		err = errors.New("This should never occur.")
+	})
+
+	JustBeforeEach(func() {
+		go func() {
+			err = PollServiceOperation(testUI, lastOperations, apiWarnings, apiErrs)
+			close(block)
+		}()
+	})
+
+	When("the operation succeeds", func() {
+		It("displays each state and warning and returns no error", func() {
+			lastOperations <- v2action.LastOperation{Type: "create", State: constant.LastOperationInProgress, Description: "provisioning"}
+			apiWarnings <- "some-warning"
+			lastOperations <- v2action.LastOperation{Type: "create", State: constant.LastOperationSucceeded}
+			close(lastOperations)
+			close(apiWarnings)
+			close(apiErrs)
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("create in progress: provisioning"))
+			Expect(testUI.Out).To(Say("create succeeded"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	When("an error is received", func() {
+		It("returns the error", func() {
+			lastOperations <- v2action.LastOperation{Type: "delete", State: constant.LastOperationFailed, Description: "broker said no"}
+			apiErrs <- actionerror.ServiceOperationFailedError{Operation: "delete", Description: "broker said no"}
+
+			Eventually(block).Should(BeClosed())
+			Expect(err).To(MatchError(actionerror.ServiceOperationFailedError{Operation: "delete", Description: "broker said no"}))
+			Expect(testUI.Out).To(Say("delete failed: broker said no"))
+		})
+	})
+})
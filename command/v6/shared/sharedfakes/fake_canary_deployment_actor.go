@@ -0,0 +1,422 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package sharedfakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	shared "code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+type FakeCanaryDeploymentActor struct {
+	CancelDeploymentStub        func(string) (v3action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	cancelDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	ContinueDeploymentStub        func(string) (v3action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	PollCanaryDeploymentStub        func(string, chan<- v3action.Warnings) (bool, error)
+	pollCanaryDeploymentMutex       sync.RWMutex
+	pollCanaryDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollCanaryDeploymentReturns struct {
+		result1 bool
+		result2 error
+	}
+	pollCanaryDeploymentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PollDeploymentRollbackStub        func(string, chan<- v3action.Warnings) error
+	pollDeploymentRollbackMutex       sync.RWMutex
+	pollDeploymentRollbackArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollDeploymentRollbackReturns struct {
+		result1 error
+	}
+	pollDeploymentRollbackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCanaryProbeStub        func(v3action.CanaryProbe) error
+	runCanaryProbeMutex       sync.RWMutex
+	runCanaryProbeArgsForCall []struct {
+		arg1 v3action.CanaryProbe
+	}
+	runCanaryProbeReturns struct {
+		result1 error
+	}
+	runCanaryProbeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCanaryDeploymentActor) CancelDeployment(arg1 string) (v3action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CancelDeployment", []interface{}{arg1})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cancelDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCanaryDeploymentActor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeCanaryDeploymentActor) CancelDeploymentCalls(stub func(string) (v3action.Warnings, error)) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = stub
+}
+
+func (fake *FakeCanaryDeploymentActor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	argsForCall := fake.cancelDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCanaryDeploymentActor) CancelDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryDeploymentActor) CancelDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryDeploymentActor) ContinueDeployment(arg1 string) (v3action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCanaryDeploymentActor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeCanaryDeploymentActor) ContinueDeploymentCalls(stub func(string) (v3action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeCanaryDeploymentActor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCanaryDeploymentActor) ContinueDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryDeploymentActor) ContinueDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryDeploymentActor) PollCanaryDeployment(arg1 string, arg2 chan<- v3action.Warnings) (bool, error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollCanaryDeploymentReturnsOnCall[len(fake.pollCanaryDeploymentArgsForCall)]
+	fake.pollCanaryDeploymentArgsForCall = append(fake.pollCanaryDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollCanaryDeployment", []interface{}{arg1, arg2})
+	fake.pollCanaryDeploymentMutex.Unlock()
+	if fake.PollCanaryDeploymentStub != nil {
+		return fake.PollCanaryDeploymentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollCanaryDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCanaryDeploymentActor) PollCanaryDeploymentCallCount() int {
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	return len(fake.pollCanaryDeploymentArgsForCall)
+}
+
+func (fake *FakeCanaryDeploymentActor) PollCanaryDeploymentCalls(stub func(string, chan<- v3action.Warnings) (bool, error)) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = stub
+}
+
+func (fake *FakeCanaryDeploymentActor) PollCanaryDeploymentArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	argsForCall := fake.pollCanaryDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCanaryDeploymentActor) PollCanaryDeploymentReturns(result1 bool, result2 error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = nil
+	fake.pollCanaryDeploymentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryDeploymentActor) PollCanaryDeploymentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = nil
+	if fake.pollCanaryDeploymentReturnsOnCall == nil {
+		fake.pollCanaryDeploymentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pollCanaryDeploymentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCanaryDeploymentActor) PollDeploymentRollback(arg1 string, arg2 chan<- v3action.Warnings) error {
+	fake.pollDeploymentRollbackMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentRollbackReturnsOnCall[len(fake.pollDeploymentRollbackArgsForCall)]
+	fake.pollDeploymentRollbackArgsForCall = append(fake.pollDeploymentRollbackArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeploymentRollback", []interface{}{arg1, arg2})
+	fake.pollDeploymentRollbackMutex.Unlock()
+	if fake.PollDeploymentRollbackStub != nil {
+		return fake.PollDeploymentRollbackStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pollDeploymentRollbackReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCanaryDeploymentActor) PollDeploymentRollbackCallCount() int {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	return len(fake.pollDeploymentRollbackArgsForCall)
+}
+
+func (fake *FakeCanaryDeploymentActor) PollDeploymentRollbackCalls(stub func(string, chan<- v3action.Warnings) error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = stub
+}
+
+func (fake *FakeCanaryDeploymentActor) PollDeploymentRollbackArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	argsForCall := fake.pollDeploymentRollbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCanaryDeploymentActor) PollDeploymentRollbackReturns(result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	fake.pollDeploymentRollbackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCanaryDeploymentActor) PollDeploymentRollbackReturnsOnCall(i int, result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	if fake.pollDeploymentRollbackReturnsOnCall == nil {
+		fake.pollDeploymentRollbackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentRollbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCanaryDeploymentActor) RunCanaryProbe(arg1 v3action.CanaryProbe) error {
+	fake.runCanaryProbeMutex.Lock()
+	ret, specificReturn := fake.runCanaryProbeReturnsOnCall[len(fake.runCanaryProbeArgsForCall)]
+	fake.runCanaryProbeArgsForCall = append(fake.runCanaryProbeArgsForCall, struct {
+		arg1 v3action.CanaryProbe
+	}{arg1})
+	fake.recordInvocation("RunCanaryProbe", []interface{}{arg1})
+	fake.runCanaryProbeMutex.Unlock()
+	if fake.RunCanaryProbeStub != nil {
+		return fake.RunCanaryProbeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runCanaryProbeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCanaryDeploymentActor) RunCanaryProbeCallCount() int {
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	return len(fake.runCanaryProbeArgsForCall)
+}
+
+func (fake *FakeCanaryDeploymentActor) RunCanaryProbeCalls(stub func(v3action.CanaryProbe) error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = stub
+}
+
+func (fake *FakeCanaryDeploymentActor) RunCanaryProbeArgsForCall(i int) v3action.CanaryProbe {
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	argsForCall := fake.runCanaryProbeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCanaryDeploymentActor) RunCanaryProbeReturns(result1 error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = nil
+	fake.runCanaryProbeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCanaryDeploymentActor) RunCanaryProbeReturnsOnCall(i int, result1 error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = nil
+	if fake.runCanaryProbeReturnsOnCall == nil {
+		fake.runCanaryProbeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCanaryProbeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCanaryDeploymentActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCanaryDeploymentActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ shared.CanaryDeploymentActor = new(FakeCanaryDeploymentActor)
@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// DefaultSSHMaxInFlight is the number of instances a command is run on at the
+// same time when --max-in-flight is not provided.
+const DefaultSSHMaxInFlight = 10
+
+// DisplaySSHInstanceResults displays the exit code of the command on each
+// instance, and returns an error if the command did not succeed on all of
+// them.
+func DisplaySSHInstanceResults(commandUI command.UI, results []sharedaction.SSHInstanceResult) error {
+	table := [][]string{
+		{
+			commandUI.TranslateText("instance"),
+			commandUI.TranslateText("exit code"),
+			commandUI.TranslateText("details"),
+		},
+	}
+
+	var failed int
+	for _, result := range results {
+		exitCode := strconv.Itoa(result.ExitStatus)
+		var details string
+		switch {
+		case result.Err != nil:
+			exitCode = "-"
+			details = result.Err.Error()
+			failed++
+		case result.Signal != "":
+			details = commandUI.TranslateText("terminated by signal {{.Signal}}", map[string]interface{}{
+				"Signal": result.Signal,
+			})
+			failed++
+		case result.ExitStatus != 0:
+			failed++
+		}
+
+		table = append(table, []string{"#" + strconv.Itoa(result.Index), exitCode, details})
+	}
+
+	commandUI.DisplayNewline()
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failed > 0 {
+		return translatableerror.SSHCommandFailedOnInstancesError{Failed: failed, Total: len(results)}
+	}
+	return nil
+}
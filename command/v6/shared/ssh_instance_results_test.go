@@ -0,0 +1,66 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("DisplaySSHInstanceResults", func() {
+	var (
+		testUI  *ui.UI
+		results []sharedaction.SSHInstanceResult
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = DisplaySSHInstanceResults(testUI, results)
+	})
+
+	When("the command succeeded on every instance", func() {
+		BeforeEach(func() {
+			results = []sharedaction.SSHInstanceResult{{Index: 0}, {Index: 1}}
+		})
+
+		It("displays the exit code of each instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`instance\s+exit code\s+details`))
+			Expect(testUI.Out).To(Say(`#0\s+0`))
+			Expect(testUI.Out).To(Say(`#1\s+0`))
+		})
+	})
+
+	When("the command failed on some instances", func() {
+		BeforeEach(func() {
+			results = []sharedaction.SSHInstanceResult{
+				{Index: 0},
+				{Index: 1, ExitStatus: 2},
+				{Index: 2, ExitStatus: 143, Signal: "TERM"},
+				{Index: 3, Err: errors.New("connection refused")},
+			}
+		})
+
+		It("displays the exit code, signal or error of each instance", func() {
+			Expect(testUI.Out).To(Say(`#0\s+0`))
+			Expect(testUI.Out).To(Say(`#1\s+2`))
+			Expect(testUI.Out).To(Say(`#2\s+143\s+terminated by signal TERM`))
+			Expect(testUI.Out).To(Say(`#3\s+-\s+connection refused`))
+		})
+
+		It("returns an SSHCommandFailedOnInstancesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedOnInstancesError{Failed: 3, Total: 4}))
+		})
+	})
+})
@@ -49,11 +49,13 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -61,6 +63,10 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displaySpacesDocument(spaces)
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
@@ -77,3 +83,24 @@ func (cmd SpacesCommand) displaySpaces(spaces []v2action.Space) {
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+type spacesDocument struct {
+	Organization string          `json:"organization" yaml:"organization"`
+	Spaces       []spaceDocument `json:"spaces" yaml:"spaces"`
+}
+
+type spaceDocument struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+func (cmd SpacesCommand) displaySpacesDocument(spaces []v2action.Space) error {
+	doc := spacesDocument{
+		Organization: cmd.Config.TargetedOrganization().Name,
+		Spaces:       []spaceDocument{},
+	}
+	for _, space := range spaces {
+		doc.Spaces = append(doc.Spaces, spaceDocument{Name: space.Name, GUID: space.GUID})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
@@ -1,29 +1,105 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
 type SSHCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	AppInstanceIndex    int          `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
-	Command             string       `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
-	DisablePseudoTTY    bool         `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY      bool         `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPort           string       `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
-	RemotePseudoTTY     bool         `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation  bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
-	SkipRemoteExecution bool         `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-	usage               interface{}  `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]"`
-	relatedCommands     interface{}  `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
+	RequiredArgs        flag.AppName         `positional-args:"yes"`
+	AllInstances        bool                 `long:"all-instances" description:"Run the command on every running instance of the app"`
+	AppInstanceIndex    int                  `long:"app-instance-index" short:"i" description:"Application instance index (Default: 0)"`
+	Commands            []string             `long:"command" short:"c" description:"Command to run. This flag can be defined more than once."`
+	DisablePseudoTTY    bool                 `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	DynamicPort         string               `short:"D" description:"Dynamic SOCKS5 port forward specification. This flag can be defined more than once."`
+	ForcePseudoTTY      bool                 `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPort           string               `short:"L" description:"Local port forward specification. This flag can be defined more than once."`
+	MaxInFlight         flag.PositiveInteger `long:"max-in-flight" description:"Maximum number of instances to run the command on at the same time with --all-instances (Default: 10)"`
+	RemotePort          string               `short:"R" description:"Remote port forward specification. This flag can be defined more than once."`
+	RemotePseudoTTY     bool                 `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation  bool                 `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	SkipRemoteExecution bool                 `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+	usage               interface{}          `usage:"CF_NAME ssh APP_NAME [-i INDEX] [-c COMMAND]... [-L [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-R [BIND_ADDRESS:]PORT:HOST:HOST_PORT] [-D [BIND_ADDRESS:]PORT] [--skip-host-validation] [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]\n\n   CF_NAME ssh APP_NAME --all-instances [--max-in-flight NUM_INSTANCES] -c COMMAND... [--skip-host-validation]"`
+	relatedCommands     interface{}          `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3SSHActor
+	SSHActor    SSHActor
 }
 
-func (SSHCommand) Setup(config command.Config, ui command.UI) error {
+// Setup only creates the clients when --all-instances is provided; every
+// other use of ssh is handled by the legacy command.
+func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.AllInstances {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+
 	return nil
 }
 
-func (SSHCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd SSHCommand) Execute(args []string) error {
+	if !cmd.AllInstances {
+		if cmd.MaxInFlight.Value > 0 {
+			return translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--all-instances"}
+		}
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	v3Cmd := V3SSHCommand{
+		RequiredArgs:        cmd.RequiredArgs,
+		AllInstances:        cmd.AllInstances,
+		Commands:            cmd.Commands,
+		ForcePseudoTTY:      cmd.ForcePseudoTTY,
+		MaxInFlight:         cmd.MaxInFlight,
+		ProcessType:         "web",
+		RequestPseudoTTY:    cmd.RemotePseudoTTY,
+		SkipHostValidation:  cmd.SkipHostValidation,
+		SkipRemoteExecution: cmd.SkipRemoteExecution,
+
+		UI:          cmd.UI,
+		Config:      cmd.Config,
+		SharedActor: cmd.SharedActor,
+		Actor:       cmd.Actor,
+		SSHActor:    cmd.SSHActor,
+	}
+	if cmd.LocalPort != "" {
+		v3Cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{}}
+	}
+	if cmd.RemotePort != "" {
+		v3Cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{{}}
+	}
+	if cmd.DynamicPort != "" {
+		v3Cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{{}}
+	}
+
+	err = v3Cmd.validateAllInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	return v3Cmd.executeOnAllInstances()
 }
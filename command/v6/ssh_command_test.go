@@ -0,0 +1,121 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ssh Command", func() {
+	var (
+		cmd             SSHCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeV3SSHActor
+		fakeSSHActor    *v6fakes.FakeSSHActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeV3SSHActor)
+		fakeSSHActor = new(v6fakes.FakeSSHActor)
+
+		cmd = SSHCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Commands:     []string{"uptime"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSHActor:    fakeSSHActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("--all-instances is not provided", func() {
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+
+		When("--max-in-flight is provided", func() {
+			BeforeEach(func() {
+				cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--all-instances"}))
+			})
+		})
+	})
+
+	When("--all-instances is provided", func() {
+		BeforeEach(func() {
+			cmd.AllInstances = true
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+			fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns(
+				v3action.SSHAuthentication{Endpoint: "some-endpoint"},
+				[]sharedaction.SSHInstance{{Index: 0, Username: "cf:some-process-guid/0"}},
+				v3action.Warnings{"some-warnings"},
+				nil,
+			)
+			fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.SSHInstanceResult{{Index: 0}})
+		})
+
+		It("runs the command on every running web instance", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(testUI.Out).To(Say(`Running command on 1 instances of process web of app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`#0\s+0`))
+
+			_, _, processType := fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+			Expect(processType).To(Equal("web"))
+
+			Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+			_, _, sshOptions, _, maxInFlight, _, _ := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+			Expect(sshOptions.Commands).To(Equal([]string{"uptime"}))
+			Expect(maxInFlight).To(Equal(10))
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			})
+		})
+
+		When("port forwarding is requested", func() {
+			BeforeEach(func() {
+				cmd.LocalPort = "8080:localhost:8080"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "-L"}}))
+				Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
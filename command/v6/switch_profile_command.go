@@ -0,0 +1,44 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type SwitchProfileCommand struct {
+	RequiredArgs    flag.ProfileName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME switch-profile PROFILE_NAME"`
+	relatedCommands interface{}      `related_commands:"create-profile, profiles, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *SwitchProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd SwitchProfileCommand) Execute(args []string) error {
+	profile, found := cmd.Config.GetProfile(cmd.RequiredArgs.ProfileName)
+	if !found {
+		return translatableerror.ProfileNotFoundError{ProfileName: cmd.RequiredArgs.ProfileName}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Switching to profile {{.ProfileName}}...", map[string]interface{}{
+		"ProfileName": profile.Name,
+	})
+
+	cmd.Config.SwitchProfile(profile.Name)
+	cmd.UI.DisplayOK()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("api endpoint:"), profile.Target},
+		{cmd.UI.TranslateText("org:"), profile.TargetedOrganization.Name},
+		{cmd.UI.TranslateText("space:"), profile.TargetedSpace.Name},
+	}, 3)
+
+	return nil
+}
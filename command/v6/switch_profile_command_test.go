@@ -0,0 +1,67 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("switch-profile Command", func() {
+	var (
+		cmd        SwitchProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = SwitchProfileCommand{
+			RequiredArgs: flag.ProfileName{ProfileName: "prod"},
+			UI:           testUI,
+			Config:       fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the profile exists", func() {
+		BeforeEach(func() {
+			fakeConfig.GetProfileReturns(configv3.Profile{
+				Name:                 "prod",
+				Target:               "https://api.prod.com",
+				TargetedOrganization: configv3.Organization{Name: "some-org"},
+				TargetedSpace:        configv3.Space{Name: "some-space"},
+			}, true)
+		})
+
+		It("switches to the profile and displays its target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SwitchProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.SwitchProfileArgsForCall(0)).To(Equal("prod"))
+
+			Expect(testUI.Out).To(Say("Switching to profile prod..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`api endpoint:\s+https://api.prod.com`))
+			Expect(testUI.Out).To(Say(`org:\s+some-org`))
+			Expect(testUI.Out).To(Say(`space:\s+some-space`))
+		})
+	})
+
+	When("the profile does not exist", func() {
+		It("returns a ProfileNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ProfileNotFoundError{ProfileName: "prod"}))
+			Expect(fakeConfig.SwitchProfileCallCount()).To(Equal(0))
+		})
+	})
+})
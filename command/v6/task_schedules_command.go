@@ -0,0 +1,140 @@
+package v6
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . TaskSchedulesActor
+
+type TaskSchedulesActor interface {
+	GetTaskSchedulesBySpace(spaceGUID string) ([]v3action.TaskSchedule, error)
+}
+
+type TaskSchedulesCommand struct {
+	usage           interface{} `usage:"CF_NAME task-schedules"`
+	relatedCommands interface{} `related_commands:"delete-task-schedule, run-due-tasks, schedule-task, tasks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskSchedulesActor
+}
+
+func (cmd *TaskSchedulesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, _, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config, nil, nil)
+
+	return nil
+}
+
+func (cmd TaskSchedulesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting task schedules in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   space.Name,
+			"CurrentUser": user.Name,
+		})
+	}
+
+	schedules, err := cmd.Actor.GetTaskSchedulesBySpace(space.GUID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayTaskSchedulesDocument(schedules)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(schedules) == 0 {
+		cmd.UI.DisplayText("No task schedules found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("schedule"),
+			cmd.UI.TranslateText("next run"),
+			cmd.UI.TranslateText("last run"),
+			cmd.UI.TranslateText("command"),
+		},
+	}
+	for _, schedule := range schedules {
+		table = append(table, []string{
+			schedule.AppName,
+			schedule.Name,
+			schedule.Schedule,
+			formatTaskScheduleTime(schedule.NextRunAt()),
+			formatTaskScheduleTime(schedule.LastRunAt),
+			schedule.Command,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+type taskSchedulesDocument struct {
+	Schedules []taskScheduleDocument `json:"schedules" yaml:"schedules"`
+}
+
+type taskScheduleDocument struct {
+	App      string `json:"app" yaml:"app"`
+	Name     string `json:"name" yaml:"name"`
+	Schedule string `json:"schedule" yaml:"schedule"`
+	NextRun  string `json:"next_run,omitempty" yaml:"next_run,omitempty"`
+	LastRun  string `json:"last_run,omitempty" yaml:"last_run,omitempty"`
+	Command  string `json:"command" yaml:"command"`
+}
+
+func (cmd TaskSchedulesCommand) displayTaskSchedulesDocument(schedules []v3action.TaskSchedule) error {
+	doc := taskSchedulesDocument{Schedules: []taskScheduleDocument{}}
+	for _, schedule := range schedules {
+		doc.Schedules = append(doc.Schedules, taskScheduleDocument{
+			App:      schedule.AppName,
+			Name:     schedule.Name,
+			Schedule: schedule.Schedule,
+			NextRun:  formatRFC3339(schedule.NextRunAt()),
+			LastRun:  formatRFC3339(schedule.LastRunAt),
+			Command:  schedule.Command,
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
+
+func formatRFC3339(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,112 @@
+package v6_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task-schedules Command", func() {
+	var (
+		cmd             TaskSchedulesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeTaskSchedulesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeTaskSchedulesActor)
+
+		cmd = TaskSchedulesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("there are no task schedules", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting task schedules in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("No task schedules found."))
+			Expect(fakeActor.GetTaskSchedulesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("there are task schedules", func() {
+		BeforeEach(func() {
+			created := time.Date(2019, time.March, 13, 10, 30, 0, 0, time.UTC)
+			fakeActor.GetTaskSchedulesBySpaceReturns([]v3action.TaskSchedule{
+				{AppName: "app-1", Name: "hourly", Schedule: "@hourly", Command: "bin/sync", CreatedAt: created},
+				{AppName: "app-2", Name: "nightly", Schedule: "0 3 * * *", Command: "bin/cleanup", CreatedAt: created, LastRunAt: created.Add(-7 * time.Hour)},
+			}, nil)
+		})
+
+		It("displays the task schedules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`app\s+name\s+schedule\s+next run\s+last run\s+command`))
+			Expect(testUI.Out).To(Say(`app-1\s+hourly\s+@hourly\s+Wed, 13 Mar 2019 11:00:00 UTC\s+bin/sync`))
+			Expect(testUI.Out).To(Say(`app-2\s+nightly\s+0 3 \* \* \*\s+Thu, 14 Mar 2019 03:00:00 UTC\s+Wed, 13 Mar 2019 03:30:00 UTC\s+bin/cleanup`))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the task schedules as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting task schedules"))
+				Expect(testUI.Out).To(Say(`"app": "app-1",\s+"name": "hourly",\s+"schedule": "@hourly",\s+"next_run": "2019-03-13T11:00:00Z",\s+"command": "bin/sync"`))
+				Expect(testUI.Out).To(Say(`"last_run": "2019-03-13T03:30:00Z"`))
+			})
+		})
+	})
+
+	When("getting the task schedules returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskSchedulesBySpaceReturns(nil, errors.New("load error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("load error"))
+		})
+	})
+})
@@ -71,12 +71,14 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"AppName":     cmd.RequiredArgs.AppName,
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"SpaceName":   space.Name,
-		"CurrentUser": user.Name,
-	})
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   space.Name,
+			"CurrentUser": user.Name,
+		})
+	}
 
 	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
 	cmd.UI.DisplayWarnings(warnings)
@@ -84,6 +86,10 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayTasksDocument(tasks)
+	}
+
 	cmd.UI.DisplayOK()
 
 	table := [][]string{
@@ -118,3 +124,35 @@ func (cmd TasksCommand) Execute(args []string) error {
 
 	return nil
 }
+
+type tasksDocument struct {
+	App   string         `json:"app" yaml:"app"`
+	Tasks []taskDocument `json:"tasks" yaml:"tasks"`
+}
+
+type taskDocument struct {
+	ID        int64  `json:"id" yaml:"id"`
+	GUID      string `json:"guid" yaml:"guid"`
+	Name      string `json:"name" yaml:"name"`
+	State     string `json:"state" yaml:"state"`
+	StartTime string `json:"start_time" yaml:"start_time"`
+	Command   string `json:"command,omitempty" yaml:"command,omitempty"`
+}
+
+func (cmd TasksCommand) displayTasksDocument(tasks []v3action.Task) error {
+	doc := tasksDocument{
+		App:   cmd.RequiredArgs.AppName,
+		Tasks: []taskDocument{},
+	}
+	for _, task := range tasks {
+		doc.Tasks = append(doc.Tasks, taskDocument{
+			ID:        task.SequenceID,
+			GUID:      task.GUID,
+			Name:      task.Name,
+			State:     string(task.State),
+			StartTime: task.CreatedAt,
+			Command:   task.Command,
+		})
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
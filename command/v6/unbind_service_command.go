@@ -2,12 +2,14 @@ package v6
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
@@ -15,11 +17,14 @@ import (
 
 type UnbindServiceActor interface {
 	UnbindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (v2action.ServiceBinding, v2action.Warnings, error)
+	PollServiceBindingOperation(serviceBindingGUID string, timeout time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)
 }
 
 type UnbindServiceCommand struct {
 	RequiredArgs    flag.BindServiceArgs `positional-args:"yes"`
-	usage           interface{}          `usage:"CF_NAME unbind-service APP_NAME SERVICE_INSTANCE"`
+	Wait            bool                 `long:"wait" description:"Wait for the service broker to finish deleting an asynchronous binding"`
+	WaitTimeout     flag.PositiveInteger `long:"wait-timeout" description:"Maximum time (in seconds) to wait with --wait (Default: no limit)"`
+	usage           interface{}          `usage:"CF_NAME unbind-service APP_NAME SERVICE_INSTANCE [--wait [--wait-timeout SECONDS]]"`
 	relatedCommands interface{}          `related_commands:"apps, delete-service, services"`
 
 	UI          command.UI
@@ -43,6 +48,10 @@ func (cmd *UnbindServiceCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd UnbindServiceCommand) Execute(args []string) error {
+	if cmd.WaitTimeout.Value > 0 && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{Arg1: "--wait-timeout", Arg2: "--wait"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -75,9 +84,17 @@ func (cmd UnbindServiceCommand) Execute(args []string) error {
 		}
 	}
 
+	if serviceBinding.IsInProgress() && cmd.Wait {
+		lastOperations, warnings, errs := cmd.Actor.PollServiceBindingOperation(serviceBinding.GUID, time.Duration(cmd.WaitTimeout.Value)*time.Second)
+		err = shared.PollServiceOperation(cmd.UI, lastOperations, warnings, errs)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayOK()
 
-	if serviceBinding.IsInProgress() {
+	if serviceBinding.IsInProgress() && !cmd.Wait {
 		cmd.UI.DisplayText("Unbinding in progress. Use '{{.CFCommand}} {{.ServiceName}}' to check operation status.", map[string]interface{}{
 			"CFCommand":   fmt.Sprintf("%s service", cmd.Config.BinaryName()),
 			"ServiceName": cmd.RequiredArgs.ServiceInstanceName,
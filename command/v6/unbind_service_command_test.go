@@ -2,12 +2,15 @@ package v6_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -179,9 +182,69 @@ var _ = Describe("unbind-service Command", func() {
 							Expect(serviceInstanceName).To(Equal("some-service"))
 							Expect(spaceGUID).To(Equal("some-space-guid"))
 						})
+
+						When("--wait is provided", func() {
+							BeforeEach(func() {
+								cmd.Wait = true
+								cmd.WaitTimeout = flag.PositiveInteger{Value: 30}
+								fakeActor.UnbindServiceBySpaceReturns(
+									v2action.ServiceBinding{GUID: "some-binding-guid", LastOperation: ccv2.LastOperation{State: constant.LastOperationInProgress}},
+									nil,
+									nil)
+
+								lastOperations := make(chan v2action.LastOperation, 1)
+								warnings := make(chan string)
+								errs := make(chan error)
+								lastOperations <- v2action.LastOperation{Type: "delete", State: constant.LastOperationSucceeded}
+								close(lastOperations)
+								close(warnings)
+								close(errs)
+								fakeActor.PollServiceBindingOperationReturns(lastOperations, warnings, errs)
+							})
+
+							It("waits for the binding to be deleted", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								guid, timeout := fakeActor.PollServiceBindingOperationArgsForCall(0)
+								Expect(guid).To(Equal("some-binding-guid"))
+								Expect(timeout).To(Equal(30 * time.Second))
+
+								Expect(testUI.Out).To(Say("delete succeeded"))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Out).ToNot(Say("Unbinding in progress"))
+							})
+						})
+
+						When("--wait is provided and the unbind times out", func() {
+							BeforeEach(func() {
+								cmd.Wait = true
+								lastOperations := make(chan v2action.LastOperation)
+								warnings := make(chan string)
+								errs := make(chan error, 1)
+								errs <- actionerror.ServiceOperationTimeoutError{Operation: "delete", Timeout: time.Minute}
+								close(lastOperations)
+								close(warnings)
+								close(errs)
+								fakeActor.PollServiceBindingOperationReturns(lastOperations, warnings, errs)
+							})
+
+							It("returns the error", func() {
+								Expect(executeErr).To(MatchError(actionerror.ServiceOperationTimeoutError{Operation: "delete", Timeout: time.Minute}))
+							})
+						})
 					})
 				})
 			})
 		})
 	})
+
+	When("--wait-timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.WaitTimeout = flag.PositiveInteger{Value: 60}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--wait-timeout", Arg2: "--wait"}))
+		})
+	})
 })
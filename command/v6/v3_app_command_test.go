@@ -239,6 +239,26 @@ var _ = Describe("app Command", func() {
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(withObfuscatedValues).To(BeFalse())
 			})
+
+			When("the output format is YAML", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatYAML
+				})
+
+				It("prints the application summary as a YAML document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Showing health and status"))
+					Expect(testUI.Out).To(Say(`name: some-app`))
+					Expect(testUI.Out).To(Say(`state: started`))
+					Expect(testUI.Out).To(Say(`stack: cflinuxfs2`))
+					Expect(testUI.Out).To(Say(`buildpacks:\n- some-detect-output\n- some-buildpack`))
+					Expect(testUI.Out).To(Say(`processes:\n- type: web`))
+					Expect(testUI.Out).To(Say(`- type: console`))
+
+					Expect(testUI.Err).To(Say("warnings:\n- warning-1\n- warning-2"))
+				})
+			})
 		})
 	})
 })
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/document"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -61,12 +62,14 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	summaries, warnings, err := cmd.Actor.GetApplicationsWithProcessesBySpace(cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -74,6 +77,10 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.displayAppsDocument(summaries)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -111,3 +118,26 @@ func (cmd V3AppsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+type appsDocument struct {
+	Apps []document.App `json:"apps" yaml:"apps"`
+}
+
+func (cmd V3AppsCommand) displayAppsDocument(summaries []v3action.ApplicationWithProcessSummary) error {
+	doc := appsDocument{Apps: []document.App{}}
+	for _, summary := range summaries {
+		var routes v2action.Routes
+		if len(summary.ProcessSummaries) > 0 {
+			var warnings v2action.Warnings
+			var err error
+			routes, warnings, err = cmd.V2AppActor.GetApplicationRoutes(summary.GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
+		}
+
+		doc.Apps = append(doc.Apps, shared.NewAppListDocument(summary, routes))
+	}
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
@@ -0,0 +1,112 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-continue-zdt-push Command", func() {
+	var (
+		cmd             V3ContinueZdtPushCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeV3ContinueZdtPushActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeV3ContinueZdtPushActor)
+
+		cmd = V3ContinueZdtPushCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionCanaryDeploymentsV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	When("the API version is below the canary minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionZeroDowntimePushV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinVersionZeroDowntimePushV3,
+				MinimumVersion: ccversion.MinVersionCanaryDeploymentsV3,
+			}))
+			Expect(fakeActor.ContinueDeploymentByAppNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.ContinueDeploymentByAppNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("continuing the deployment succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.ContinueDeploymentByAppNameAndSpaceReturns(v3action.Warnings{"continue-warning"}, nil)
+		})
+
+		It("continues the current deployment of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Continuing deployment for app some-app in org some-org / space some-space as banana\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("continue-warning"))
+
+			appName, spaceGUID := fakeActor.ContinueDeploymentByAppNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("continuing the deployment fails", func() {
+		BeforeEach(func() {
+			fakeActor.ContinueDeploymentByAppNameAndSpaceReturns(v3action.Warnings{"continue-warning"}, errors.New("not paused"))
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("not paused"))
+			Expect(testUI.Err).To(Say("continue-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+)
+
+//go:generate counterfeiter . V3RollbackZdtPushActor
+
+type V3RollbackZdtPushActor interface {
+	PollDeploymentRollback(deploymentGUID string, warningsChannel chan<- v3action.Warnings) error
+	RollbackDeploymentByAppNameAndSpace(appName string, spaceGUID string) (string, v3action.Warnings, error)
+}
+
+type V3RollbackZdtPushCommand struct {
+	RequiredArgs flag.AppName `positional-args:"yes"`
+	usage        interface{}  `usage:"CF_NAME v3-rollback-zdt-push APP_NAME"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3RollbackZdtPushActor
+}
+
+func (cmd *V3RollbackZdtPushCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v3action.NewActor(ccClient, config, sharedActor, uaaClient)
+	cmd.SharedActor = sharedActor
+
+	return nil
+}
+
+func (cmd V3RollbackZdtPushCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back deployment for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	deploymentGUID, warnings, err := cmd.Actor.RollbackDeploymentByAppNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Waiting for rollback to complete...")
+
+	warningsChannel := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-warningsChannel:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Actor.PollDeploymentRollback(deploymentGUID, warningsChannel)
+	done <- true
+	if err != nil {
+		if _, ok := err.(actionerror.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,115 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-rollback-zdt-push Command", func() {
+	var (
+		cmd             V3RollbackZdtPushCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeV3RollbackZdtPushActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeV3RollbackZdtPushActor)
+
+		cmd = V3RollbackZdtPushCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.RollbackDeploymentByAppNameAndSpaceReturns("some-deployment-guid", v3action.Warnings{"rollback-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.RollbackDeploymentByAppNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the rollback completes", func() {
+		BeforeEach(func() {
+			fakeActor.PollDeploymentRollbackStub = func(_ string, warningsChannel chan<- v3action.Warnings) error {
+				warningsChannel <- v3action.Warnings{"poll-warning"}
+				return nil
+			}
+		})
+
+		It("rolls back the current deployment and waits for it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Rolling back deployment for app some-app in org some-org / space some-space as banana\.\.\.`))
+			Expect(testUI.Out).To(Say(`Waiting for rollback to complete\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("rollback-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+
+			appName, spaceGUID := fakeActor.RollbackDeploymentByAppNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			deploymentGUID, _ := fakeActor.PollDeploymentRollbackArgsForCall(0)
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+		})
+	})
+
+	When("canceling the deployment fails", func() {
+		BeforeEach(func() {
+			fakeActor.RollbackDeploymentByAppNameAndSpaceReturns("", v3action.Warnings{"rollback-warning"}, errors.New("cancel-error"))
+		})
+
+		It("returns the error without waiting", func() {
+			Expect(executeErr).To(MatchError("cancel-error"))
+			Expect(testUI.Err).To(Say("rollback-warning"))
+			Expect(fakeActor.PollDeploymentRollbackCallCount()).To(Equal(0))
+		})
+	})
+
+	When("waiting for the rollback times out", func() {
+		BeforeEach(func() {
+			fakeActor.PollDeploymentRollbackReturns(actionerror.StartupTimeoutError{})
+		})
+
+		It("returns a StartupTimeoutError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+				AppName:    "some-app",
+				BinaryName: "faceman",
+			}))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v6
 
 import (
+	"io"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
@@ -14,27 +16,34 @@ import (
 
 type SSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellOnInstances(newSSHClient func() sharedaction.SecureShellClient, passcodeGetter sharedaction.SSHPasscodeGetter, sshOptions sharedaction.SSHOptions, instances []sharedaction.SSHInstance, maxInFlight int, stdout io.Writer, stderr io.Writer) []sharedaction.SSHInstanceResult
 }
 
 //go:generate counterfeiter . V3SSHActor
 
 type V3SSHActor interface {
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v3action.SSHAuthentication, v3action.Warnings, error)
+	GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType(appName string, spaceGUID string, processType string) (v3action.SSHAuthentication, []sharedaction.SSHInstance, v3action.Warnings, error)
+	GetSSHPasscode() (string, error)
 }
 
 type V3SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every running instance of the process"`
+	ProcessIndex            uint                            `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic SOCKS5 port forward specification"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	MaxInFlight             flag.PositiveInteger            `long:"max-in-flight" description:"Maximum number of instances to run the command on at the same time with --all-instances (Default: 10)"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME v3-ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]...\n   [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n\n   CF_NAME v3-ssh APP_NAME --all-instances [--process PROCESS] [--max-in-flight NUM_INSTANCES]\n   -c COMMAND... [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -78,11 +87,30 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = cmd.validateAllInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	if cmd.AllInstances {
+		return cmd.executeOnAllInstances()
+	}
+
 	var forwardSpecs []sharedaction.LocalPortForward
 	for _, spec := range cmd.LocalPortForwardSpecs {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardAddresses []string
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardAddresses = append(dynamicForwardAddresses, spec.LocalAddress)
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -97,15 +125,17 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                    cmd.Commands,
+			DynamicPortForwardAddresses: dynamicForwardAddresses,
+			Endpoint:                    sshAuth.Endpoint,
+			HostKeyFingerprint:          sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:       forwardSpecs,
+			Passcode:                    sshAuth.Passcode,
+			RemotePortForwardSpecs:      remoteForwardSpecs,
+			SkipHostValidation:          cmd.SkipHostValidation,
+			SkipRemoteExecution:         cmd.SkipRemoteExecution,
+			TTYOption:                   ttyOption,
+			Username:                    sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -114,6 +144,99 @@ func (cmd V3SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd V3SSHCommand) executeOnAllInstances() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	sshAuth, instances, warnings, err := cmd.Actor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running command on {{.InstanceCount}} instances of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"InstanceCount": len(instances),
+		"ProcessType":   cmd.ProcessType,
+		"AppName":       cmd.RequiredArgs.AppName,
+		"OrgName":       cmd.Config.TargetedOrganization().Name,
+		"SpaceName":     cmd.Config.TargetedSpace().Name,
+		"Username":      user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	maxInFlight := shared.DefaultSSHMaxInFlight
+	if cmd.MaxInFlight.Value > 0 {
+		maxInFlight = int(cmd.MaxInFlight.Value)
+	}
+
+	results := cmd.SSHActor.ExecuteSecureShellOnInstances(
+		newV3SecureShellClient,
+		cmd.Actor,
+		sharedaction.SSHOptions{
+			Commands:           cmd.Commands,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			SkipHostValidation: cmd.SkipHostValidation,
+		},
+		instances,
+		maxInFlight,
+		cmd.UI.GetOut(),
+		cmd.UI.GetErr(),
+	)
+
+	return shared.DisplaySSHInstanceResults(cmd.UI, results)
+}
+
+// validateAllInstancesFlags returns an error if --all-instances is used
+// without a command, or with flags that only make sense for one instance.
+func (cmd V3SSHCommand) validateAllInstancesFlags() error {
+	if !cmd.AllInstances {
+		if cmd.MaxInFlight.Value > 0 {
+			return translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--all-instances"}
+		}
+		return nil
+	}
+
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}
+	}
+
+	conflicts := []string{"--all-instances"}
+	if len(cmd.LocalPortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-L")
+	}
+	if len(cmd.RemotePortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-R")
+	}
+	if len(cmd.DynamicPortForwardSpecs) > 0 {
+		conflicts = append(conflicts, "-D")
+	}
+	if cmd.SkipRemoteExecution {
+		conflicts = append(conflicts, "--skip-remote-execution")
+	}
+	if cmd.ForcePseudoTTY {
+		conflicts = append(conflicts, "--force-pseudo-tty")
+	}
+	if cmd.RequestPseudoTTY {
+		conflicts = append(conflicts, "--request-pseudo-tty")
+	}
+
+	if len(conflicts) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: conflicts}
+	}
+	return nil
+}
+
+func newV3SecureShellClient() sharedaction.SecureShellClient {
+	return clissh.NewDefaultSecureShell()
+}
+
 func (cmd V3SSHCommand) parseForwardSpecs() ([]sharedaction.LocalPortForward, error) {
 	return nil, nil
 }
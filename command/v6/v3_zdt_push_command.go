@@ -19,7 +19,10 @@ import (
 //go:generate counterfeiter . V3ZeroDowntimeVersionActor
 
 type V3ZeroDowntimeVersionActor interface {
+	shared.CanaryDeploymentActor
+
 	ZeroDowntimePollStart(appGUID string, warningsChannel chan<- v3action.Warnings) error
+	CreateCanaryDeployment(appGUID string, dropletGUID string, maxInFlight int) (string, v3action.Warnings, error)
 	CreateDeployment(appGUID string, deploymentGUID string) (string, v3action.Warnings, error)
 	PollDeployment(deploymentGUID string, warningsChannel chan<- v3action.Warnings) error
 	CloudControllerAPIVersion() string
@@ -45,9 +48,13 @@ type V3ZeroDowntimePushCommand struct {
 	NoRoute             bool                        `long:"no-route" description:"Do not map a route to this app"`
 	NoStart             bool                        `long:"no-start" description:"Do not stage and start the app after pushing"`
 	WaitUntilDeployed   bool                        `long:"wait-for-deploy-complete" description:"Wait for the entire deployment to complete"`
+	Strategy            flag.DeploymentStrategy     `long:"strategy" choice:"rolling" choice:"canary" default:"rolling" description:"Deployment strategy; canary pauses the deployment once an instance of the new version is running (requires CF API 3.173.0 or later)"`
+	MaxInFlight         flag.PositiveInteger        `long:"max-in-flight" description:"Number of canary instances to start before the deployment pauses; requires --strategy canary (Default: 1)"`
+	CanaryProbeURL      string                      `long:"canary-probe-url" description:"URL that must respond with a 2xx status for a paused canary deployment to continue; the deployment is rolled back otherwise"`
+	CanaryProbeCommand  string                      `long:"canary-probe-command" description:"Command that must exit successfully for a paused canary deployment to continue; the deployment is rolled back otherwise"`
 	AppPath             flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	dockerPassword      interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage               interface{}                 `usage:"CF_NAME v3-zdt-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start]\n   CF_NAME v3-zdt-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start]"`
+	usage               interface{}                 `usage:"CF_NAME v3-zdt-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start] [--strategy canary [--max-in-flight NUM] [--canary-probe-url URL | --canary-probe-command COMMAND]]\n   CF_NAME v3-zdt-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start] [--strategy canary [--max-in-flight NUM] [--canary-probe-url URL | --canary-probe-command COMMAND]]"`
 	envCFStagingTimeout interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -117,6 +124,11 @@ func (cmd V3ZeroDowntimePushCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = minimumCanaryVersionCheck(cmd.ZdtActor.CloudControllerAPIVersion(), cmd.Strategy)
+	if err != nil {
+		return err
+	}
+
 	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -183,6 +195,8 @@ func (cmd V3ZeroDowntimePushCommand) Execute(args []string) error {
 
 	switch app.State {
 	case constant.ApplicationStopped:
+		warnCanaryIgnored(cmd.UI, cmd.Strategy, cmd.RequiredArgs.AppName)
+
 		err = cmd.setApplicationDroplet(dropletGUID, user.Name)
 		if err != nil {
 			return err
@@ -203,6 +217,20 @@ func (cmd V3ZeroDowntimePushCommand) Execute(args []string) error {
 			return err
 		}
 
+		if constant.DeploymentStrategy(cmd.Strategy) == constant.DeploymentStrategyCanary {
+			var continued bool
+			continued, err = shared.GateCanaryDeployment(cmd.UI, cmd.Config, cmd.ZdtActor, cmd.RequiredArgs.AppName, deploymentGUID, cmd.canaryProbe(), warnings)
+			if err == nil && !continued {
+				done <- true
+				return nil
+			}
+			if err == nil && cmd.WaitUntilDeployed {
+				cmd.UI.DisplayText("Waiting for app to start...")
+				err = cmd.ZdtActor.PollDeployment(deploymentGUID, warnings)
+			}
+			break
+		}
+
 		cmd.UI.DisplayText("Waiting for app to start...")
 		if cmd.WaitUntilDeployed {
 			err = cmd.ZdtActor.PollDeployment(deploymentGUID, warnings) //
@@ -253,9 +281,55 @@ func (cmd V3ZeroDowntimePushCommand) validateArgs() error {
 	case cmd.DockerUsername != "" && cmd.Config.DockerPassword() == "":
 		return translatableerror.DockerPasswordNotSetError{}
 	}
+	return validateCanaryFlags(cmd.Strategy, cmd.MaxInFlight, cmd.CanaryProbeURL, cmd.CanaryProbeCommand)
+}
+
+func (cmd V3ZeroDowntimePushCommand) canaryProbe() v3action.CanaryProbe {
+	return v3action.CanaryProbe{URL: cmd.CanaryProbeURL, Command: cmd.CanaryProbeCommand}
+}
+
+// minimumCanaryVersionCheck checks that Cloud Controller supports canary
+// deployments when the canary strategy is requested.
+func minimumCanaryVersionCheck(apiVersion string, strategy flag.DeploymentStrategy) error {
+	if constant.DeploymentStrategy(strategy) != constant.DeploymentStrategyCanary {
+		return nil
+	}
+	return command.MinimumCCAPIVersionCheck(apiVersion, ccversion.MinVersionCanaryDeploymentsV3, "Option '--strategy canary'")
+}
+
+func validateCanaryFlags(strategy flag.DeploymentStrategy, maxInFlight flag.PositiveInteger, probeURL string, probeCommand string) error {
+	switch {
+	case probeURL != "" && probeCommand != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--canary-probe-url", "--canary-probe-command"},
+		}
+	case constant.DeploymentStrategy(strategy) != constant.DeploymentStrategyCanary && probeURL != "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--strategy canary", Arg2: "--canary-probe-url",
+		}
+	case constant.DeploymentStrategy(strategy) != constant.DeploymentStrategyCanary && probeCommand != "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--strategy canary", Arg2: "--canary-probe-command",
+		}
+	case constant.DeploymentStrategy(strategy) != constant.DeploymentStrategyCanary && maxInFlight.Value > 0:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--strategy canary", Arg2: "--max-in-flight",
+		}
+	}
 	return nil
 }
 
+// warnCanaryIgnored warns that a stopped app is started without a deployment,
+// so the canary options have no effect.
+func warnCanaryIgnored(ui command.UI, strategy flag.DeploymentStrategy, appName string) {
+	if constant.DeploymentStrategy(strategy) != constant.DeploymentStrategyCanary {
+		return
+	}
+	ui.DisplayWarning("App {{.AppName}} is stopped, so it is started without a deployment; --strategy canary and the canary options are ignored.", map[string]interface{}{
+		"AppName": appName,
+	})
+}
+
 func (cmd V3ZeroDowntimePushCommand) createApplication(userName string) (v3action.Application, error) {
 	appToCreate := v3action.Application{
 		Name: cmd.RequiredArgs.AppName,
@@ -437,7 +511,16 @@ func (cmd V3ZeroDowntimePushCommand) createDeployment(appGUID string, userName s
 		"CurrentUser":  userName,
 	})
 
-	deploymentGUID, warnings, err := cmd.ZdtActor.CreateDeployment(appGUID, dropletGUID)
+	var (
+		deploymentGUID string
+		warnings       v3action.Warnings
+		err            error
+	)
+	if constant.DeploymentStrategy(cmd.Strategy) == constant.DeploymentStrategyCanary {
+		deploymentGUID, warnings, err = cmd.ZdtActor.CreateCanaryDeployment(appGUID, dropletGUID, int(cmd.MaxInFlight.Value))
+	} else {
+		deploymentGUID, warnings, err = cmd.ZdtActor.CreateDeployment(appGUID, dropletGUID)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return "", err
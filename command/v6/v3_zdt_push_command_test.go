@@ -131,6 +131,21 @@ var _ = Describe("v3-zdt-push Command", func() {
 		})
 	})
 
+	When("the canary strategy is requested and the API version is below the canary minimum", func() {
+		BeforeEach(func() {
+			cmd.Strategy = "canary"
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				Command:        "Option '--strategy canary'",
+				CurrentVersion: ccversion.MinVersionZeroDowntimePushV3,
+				MinimumVersion: ccversion.MinVersionCanaryDeploymentsV3,
+			}))
+			Expect(fakeZdtActor.CreateCanaryDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the API version is the oldest supported by the CLI", func() {
 		BeforeEach(func() {
 			fakeZdtActor.CloudControllerAPIVersionReturns(ccversion.MinSupportedV3ClientVersion)
@@ -198,6 +213,45 @@ var _ = Describe("v3-zdt-push Command", func() {
 			}),
 	)
 
+	DescribeTable("canary flag combinations",
+		func(strategy string, probeURL string, probeCommand string, expectedErr error) {
+			cmd.Strategy = flag.DeploymentStrategy(strategy)
+			cmd.CanaryProbeURL = probeURL
+			cmd.CanaryProbeCommand = probeCommand
+			Expect(cmd.Execute(nil)).To(MatchError(expectedErr))
+		},
+		Entry("probe URL without canary strategy",
+			"rolling", "https://example.com", "",
+			translatableerror.RequiredFlagsError{
+				Arg1: "--strategy canary",
+				Arg2: "--canary-probe-url",
+			}),
+		Entry("probe command without canary strategy",
+			"", "", "./smoke-test",
+			translatableerror.RequiredFlagsError{
+				Arg1: "--strategy canary",
+				Arg2: "--canary-probe-command",
+			}),
+		Entry("probe URL and probe command",
+			"canary", "https://example.com", "./smoke-test",
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--canary-probe-url", "--canary-probe-command"},
+			}),
+	)
+
+	When("--max-in-flight is given without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--strategy canary",
+				Arg2: "--max-in-flight",
+			}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -941,6 +995,20 @@ var _ = Describe("v3-zdt-push Command", func() {
 						Expect(fakeZdtActor.PollStartCallCount()).To(Equal(1))
 					})
 
+					Context("when the canary strategy is used", func() {
+						BeforeEach(func() {
+							cmd.Strategy = "canary"
+							fakeZdtActor.CloudControllerAPIVersionReturns(ccversion.MinVersionCanaryDeploymentsV3)
+						})
+
+						It("warns that the canary options are ignored and starts the app", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("App some-app is stopped, so it is started without a deployment; --strategy canary and the canary options are ignored."))
+							Expect(fakeZdtActor.CreateCanaryDeploymentCallCount()).To(Equal(0))
+							Expect(fakeZdtActor.RestartApplicationCallCount()).To(Equal(1))
+						})
+					})
+
 					Context("when the wait-for-deploy-complete flag is not provided", func() {
 						Context("when polling the start fails", func() {
 							BeforeEach(func() {
@@ -1127,6 +1195,58 @@ var _ = Describe("v3-zdt-push Command", func() {
 						Expect(fakeZdtActor.CreateDeploymentCallCount()).To(Equal(1))
 					})
 
+					Context("when the canary strategy is used", func() {
+						BeforeEach(func() {
+							cmd.Strategy = "canary"
+							cmd.CanaryProbeCommand = "./smoke-test"
+							cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+							fakeZdtActor.CloudControllerAPIVersionReturns(ccversion.MinVersionCanaryDeploymentsV3)
+							fakeZdtActor.CreateCanaryDeploymentReturns("some-deployment-guid", v3action.Warnings{"canary-warning"}, nil)
+							fakeZdtActor.PollCanaryDeploymentReturns(true, nil)
+						})
+
+						It("creates a canary deployment and continues it once the probe passes", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say("canary-warning"))
+
+							Expect(fakeZdtActor.CreateDeploymentCallCount()).To(Equal(0))
+							Expect(fakeZdtActor.CreateCanaryDeploymentCallCount()).To(Equal(1))
+							appGUID, _, maxInFlight := fakeZdtActor.CreateCanaryDeploymentArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(maxInFlight).To(Equal(2))
+
+							Expect(fakeZdtActor.RunCanaryProbeArgsForCall(0)).To(Equal(v3action.CanaryProbe{Command: "./smoke-test"}))
+							Expect(fakeZdtActor.ContinueDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+							Expect(fakeZdtActor.ZeroDowntimePollStartCallCount()).To(Equal(0))
+							Expect(fakeZdtActor.PollDeploymentCallCount()).To(Equal(0))
+						})
+
+						Context("when the wait-for-deploy-complete flag is provided", func() {
+							BeforeEach(func() {
+								cmd.WaitUntilDeployed = true
+							})
+
+							It("waits for the deployment to complete after continuing it", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(fakeZdtActor.ContinueDeploymentCallCount()).To(Equal(1))
+								Expect(fakeZdtActor.PollDeploymentCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("when no probe is provided", func() {
+							BeforeEach(func() {
+								cmd.CanaryProbeCommand = ""
+							})
+
+							It("leaves the deployment paused without displaying the app summary", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`Deployment paused\. Use 'faceman v3-continue-zdt-push some-app' to continue or 'faceman v3-rollback-zdt-push some-app' to roll back\.`))
+								Expect(testUI.Out).ToNot(Say("Showing health and status"))
+								Expect(fakeZdtActor.ContinueDeploymentCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when the wait-for-deploy-complete flag is not provided", func() {
 						Context("when polling the start fails", func() {
 							BeforeEach(func() {
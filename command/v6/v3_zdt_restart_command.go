@@ -3,6 +3,7 @@ package v6
 import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -12,7 +13,10 @@ import (
 //go:generate counterfeiter . V3ZeroDowntimeRestartActor
 
 type V3ZeroDowntimeRestartActor interface {
+	shared.CanaryDeploymentActor
+
 	ZeroDowntimePollStart(appGUID string, warningsChannel chan<- v3action.Warnings) error
+	CreateCanaryDeployment(appGUID string, dropletGUID string, maxInFlight int) (string, v3action.Warnings, error)
 	CreateDeployment(appGUID, dropletGUID string) (string, v3action.Warnings, error)
 
 	CloudControllerAPIVersion() string
@@ -21,8 +25,12 @@ type V3ZeroDowntimeRestartActor interface {
 }
 
 type V3ZeroDowntimeRestartCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME v3-zdt-restart APP_NAME"`
+	RequiredArgs       flag.AppName            `positional-args:"yes"`
+	Strategy           flag.DeploymentStrategy `long:"strategy" choice:"rolling" choice:"canary" default:"rolling" description:"Deployment strategy; canary pauses the deployment once an instance of the new version is running (requires CF API 3.173.0 or later)"`
+	MaxInFlight        flag.PositiveInteger    `long:"max-in-flight" description:"Number of canary instances to start before the deployment pauses; requires --strategy canary (Default: 1)"`
+	CanaryProbeURL     string                  `long:"canary-probe-url" description:"URL that must respond with a 2xx status for a paused canary deployment to continue; the deployment is rolled back otherwise"`
+	CanaryProbeCommand string                  `long:"canary-probe-command" description:"Command that must exit successfully for a paused canary deployment to continue; the deployment is rolled back otherwise"`
+	usage              interface{}             `usage:"CF_NAME v3-zdt-restart APP_NAME [--strategy canary [--max-in-flight NUM] [--canary-probe-url URL | --canary-probe-command COMMAND]]"`
 
 	UI          command.UI
 	Config      command.Config
@@ -47,7 +55,17 @@ func (cmd *V3ZeroDowntimeRestartCommand) Setup(config command.Config, ui command
 func (cmd V3ZeroDowntimeRestartCommand) Execute(args []string) error {
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
-	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionZeroDowntimePushV3)
+	err := validateCanaryFlags(cmd.Strategy, cmd.MaxInFlight, cmd.CanaryProbeURL, cmd.CanaryProbeCommand)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionZeroDowntimePushV3)
+	if err != nil {
+		return err
+	}
+
+	err = minimumCanaryVersionCheck(cmd.Actor.CloudControllerAPIVersion(), cmd.Strategy)
 	if err != nil {
 		return err
 	}
@@ -69,6 +87,8 @@ func (cmd V3ZeroDowntimeRestartCommand) Execute(args []string) error {
 	}
 
 	if app.Stopped() {
+		warnCanaryIgnored(cmd.UI, cmd.Strategy, cmd.RequiredArgs.AppName)
+
 		cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
 			"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -89,14 +109,19 @@ func (cmd V3ZeroDowntimeRestartCommand) Execute(args []string) error {
 			"CurrentUser":  user.Name,
 		})
 
-		_, warnings, err = cmd.Actor.CreateDeployment(app.GUID, "")
+		canary := constant.DeploymentStrategy(cmd.Strategy) == constant.DeploymentStrategyCanary
+
+		var deploymentGUID string
+		if canary {
+			deploymentGUID, warnings, err = cmd.Actor.CreateCanaryDeployment(app.GUID, "", int(cmd.MaxInFlight.Value))
+		} else {
+			deploymentGUID, warnings, err = cmd.Actor.CreateDeployment(app.GUID, "")
+		}
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
 		}
 
-		cmd.UI.DisplayText("Waiting for app to start...")
-
 		warnings := make(chan v3action.Warnings)
 		done := make(chan bool)
 		go func() {
@@ -110,10 +135,20 @@ func (cmd V3ZeroDowntimeRestartCommand) Execute(args []string) error {
 			}
 		}()
 
-		err = cmd.Actor.ZeroDowntimePollStart(app.GUID, warnings)
-		done <- true
-		if err != nil {
-			return err
+		if canary {
+			var continued bool
+			continued, err = shared.GateCanaryDeployment(cmd.UI, cmd.Config, cmd.Actor, cmd.RequiredArgs.AppName, deploymentGUID, v3action.CanaryProbe{URL: cmd.CanaryProbeURL, Command: cmd.CanaryProbeCommand}, warnings)
+			done <- true
+			if err != nil || !continued {
+				return err
+			}
+		} else {
+			cmd.UI.DisplayText("Waiting for app to start...")
+			err = cmd.Actor.ZeroDowntimePollStart(app.GUID, warnings)
+			done <- true
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -72,6 +72,49 @@ var _ = Describe("v3-zdt-restart Command", func() {
 		})
 	})
 
+	When("the canary strategy is requested and the API version is below the canary minimum", func() {
+		BeforeEach(func() {
+			cmd.Strategy = "canary"
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionZeroDowntimePushV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				Command:        "Option '--strategy canary'",
+				CurrentVersion: ccversion.MinVersionZeroDowntimePushV3,
+				MinimumVersion: ccversion.MinVersionCanaryDeploymentsV3,
+			}))
+			Expect(fakeActor.CreateCanaryDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a canary probe is provided without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.CanaryProbeCommand = "./smoke-test"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--strategy canary",
+				Arg2: "--canary-probe-command",
+			}))
+			Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--max-in-flight is provided without the canary strategy", func() {
+		BeforeEach(func() {
+			cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--strategy canary",
+				Arg2: "--max-in-flight",
+			}))
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionZeroDowntimePushV3)
@@ -174,6 +217,20 @@ var _ = Describe("v3-zdt-restart Command", func() {
 						Expect(executeErr).To(MatchError("lol error"))
 					})
 				})
+
+				When("the canary strategy is used", func() {
+					BeforeEach(func() {
+						cmd.Strategy = "canary"
+						fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionCanaryDeploymentsV3)
+					})
+
+					It("warns that the canary options are ignored and starts the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("App some-app is stopped, so it is started without a deployment; --strategy canary and the canary options are ignored."))
+						Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+						Expect(fakeActor.CreateCanaryDeploymentCallCount()).To(Equal(0))
+					})
+				})
 			})
 
 			When("the app fails to start", func() {
@@ -185,6 +242,49 @@ var _ = Describe("v3-zdt-restart Command", func() {
 					Expect(executeErr).To(MatchError("lol error"))
 				})
 			})
+
+			When("the canary strategy is used", func() {
+				BeforeEach(func() {
+					cmd.Strategy = "canary"
+					fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionCanaryDeploymentsV3)
+					cmd.CanaryProbeURL = "https://some-app.example.com/health"
+					cmd.MaxInFlight = flag.PositiveInteger{Value: 3}
+					fakeActor.CreateCanaryDeploymentReturns("some-deployment-guid", v3action.Warnings{"deploy-warning-1"}, nil)
+					fakeActor.PollCanaryDeploymentReturns(true, nil)
+				})
+
+				It("creates a canary deployment and continues it once the probe passes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.CreateCanaryDeploymentCallCount()).To(Equal(1))
+					appGUID, dropletGUID, maxInFlight := fakeActor.CreateCanaryDeploymentArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(dropletGUID).To(BeEmpty())
+					Expect(maxInFlight).To(Equal(3))
+					Expect(fakeActor.CreateDeploymentCallCount()).To(Equal(0))
+
+					Expect(testUI.Out).To(Say("Waiting for the canary instance to start..."))
+					Expect(fakeActor.RunCanaryProbeArgsForCall(0)).To(Equal(v3action.CanaryProbe{URL: "https://some-app.example.com/health"}))
+					Expect(fakeActor.ContinueDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+					Expect(fakeActor.ZeroDowntimePollStartCallCount()).To(Equal(0))
+				})
+
+				When("the probe fails", func() {
+					BeforeEach(func() {
+						fakeActor.RunCanaryProbeReturns(actionerror.CanaryProbeFailedError{Probe: "https://some-app.example.com/health", Reason: "500 Internal Server Error"})
+					})
+
+					It("rolls back the deployment and returns the error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.CanaryProbeFailedError{
+							AppName: "some-app",
+							Probe:   "https://some-app.example.com/health",
+							Reason:  "500 Internal Server Error",
+						}))
+						Expect(fakeActor.CancelDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+						Expect(fakeActor.ContinueDeploymentCallCount()).To(Equal(0))
+					})
+				})
+			})
 		})
 
 		When("it fails to get the app", func() {
@@ -0,0 +1,121 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeAppsActor struct {
+	GetSpaceApplicationSummariesStub        func(string) ([]v2action.SpaceApplicationSummary, v2action.Warnings, error)
+	getSpaceApplicationSummariesMutex       sync.RWMutex
+	getSpaceApplicationSummariesArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceApplicationSummariesReturns struct {
+		result1 []v2action.SpaceApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceApplicationSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.SpaceApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAppsActor) GetSpaceApplicationSummaries(arg1 string) ([]v2action.SpaceApplicationSummary, v2action.Warnings, error) {
+	fake.getSpaceApplicationSummariesMutex.Lock()
+	ret, specificReturn := fake.getSpaceApplicationSummariesReturnsOnCall[len(fake.getSpaceApplicationSummariesArgsForCall)]
+	fake.getSpaceApplicationSummariesArgsForCall = append(fake.getSpaceApplicationSummariesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetSpaceApplicationSummaries", []interface{}{arg1})
+	fake.getSpaceApplicationSummariesMutex.Unlock()
+	if fake.GetSpaceApplicationSummariesStub != nil {
+		return fake.GetSpaceApplicationSummariesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceApplicationSummariesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAppsActor) GetSpaceApplicationSummariesCallCount() int {
+	fake.getSpaceApplicationSummariesMutex.RLock()
+	defer fake.getSpaceApplicationSummariesMutex.RUnlock()
+	return len(fake.getSpaceApplicationSummariesArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetSpaceApplicationSummariesCalls(stub func(string) ([]v2action.SpaceApplicationSummary, v2action.Warnings, error)) {
+	fake.getSpaceApplicationSummariesMutex.Lock()
+	defer fake.getSpaceApplicationSummariesMutex.Unlock()
+	fake.GetSpaceApplicationSummariesStub = stub
+}
+
+func (fake *FakeAppsActor) GetSpaceApplicationSummariesArgsForCall(i int) string {
+	fake.getSpaceApplicationSummariesMutex.RLock()
+	defer fake.getSpaceApplicationSummariesMutex.RUnlock()
+	argsForCall := fake.getSpaceApplicationSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAppsActor) GetSpaceApplicationSummariesReturns(result1 []v2action.SpaceApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceApplicationSummariesMutex.Lock()
+	defer fake.getSpaceApplicationSummariesMutex.Unlock()
+	fake.GetSpaceApplicationSummariesStub = nil
+	fake.getSpaceApplicationSummariesReturns = struct {
+		result1 []v2action.SpaceApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetSpaceApplicationSummariesReturnsOnCall(i int, result1 []v2action.SpaceApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceApplicationSummariesMutex.Lock()
+	defer fake.getSpaceApplicationSummariesMutex.Unlock()
+	fake.GetSpaceApplicationSummariesStub = nil
+	if fake.getSpaceApplicationSummariesReturnsOnCall == nil {
+		fake.getSpaceApplicationSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SpaceApplicationSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceApplicationSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.SpaceApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceApplicationSummariesMutex.RLock()
+	defer fake.getSpaceApplicationSummariesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAppsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.AppsActor = new(FakeAppsActor)
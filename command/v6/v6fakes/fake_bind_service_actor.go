@@ -3,6 +3,7 @@ package v6fakes
 
 import (
 	sync "sync"
+	time "time"
 
 	v2action "code.cloudfoundry.org/cli/actor/v2action"
 	v6 "code.cloudfoundry.org/cli/command/v6"
@@ -38,6 +39,22 @@ type FakeBindServiceActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PollServiceBindingOperationStub        func(string, time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)
+	pollServiceBindingOperationMutex       sync.RWMutex
+	pollServiceBindingOperationArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	pollServiceBindingOperationReturns struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}
+	pollServiceBindingOperationReturnsOnCall map[int]struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -164,6 +181,73 @@ func (fake *FakeBindServiceActor) CloudControllerAPIVersionReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeBindServiceActor) PollServiceBindingOperation(arg1 string, arg2 time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceBindingOperationReturnsOnCall[len(fake.pollServiceBindingOperationArgsForCall)]
+	fake.pollServiceBindingOperationArgsForCall = append(fake.pollServiceBindingOperationArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("PollServiceBindingOperation", []interface{}{arg1, arg2})
+	fake.pollServiceBindingOperationMutex.Unlock()
+	if fake.PollServiceBindingOperationStub != nil {
+		return fake.PollServiceBindingOperationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollServiceBindingOperationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingOperationCallCount() int {
+	fake.pollServiceBindingOperationMutex.RLock()
+	defer fake.pollServiceBindingOperationMutex.RUnlock()
+	return len(fake.pollServiceBindingOperationArgsForCall)
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingOperationCalls(stub func(string, time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	defer fake.pollServiceBindingOperationMutex.Unlock()
+	fake.PollServiceBindingOperationStub = stub
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingOperationArgsForCall(i int) (string, time.Duration) {
+	fake.pollServiceBindingOperationMutex.RLock()
+	defer fake.pollServiceBindingOperationMutex.RUnlock()
+	argsForCall := fake.pollServiceBindingOperationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingOperationReturns(result1 <-chan v2action.LastOperation, result2 <-chan string, result3 <-chan error) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	defer fake.pollServiceBindingOperationMutex.Unlock()
+	fake.PollServiceBindingOperationStub = nil
+	fake.pollServiceBindingOperationReturns = struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBindServiceActor) PollServiceBindingOperationReturnsOnCall(i int, result1 <-chan v2action.LastOperation, result2 <-chan string, result3 <-chan error) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	defer fake.pollServiceBindingOperationMutex.Unlock()
+	fake.PollServiceBindingOperationStub = nil
+	if fake.pollServiceBindingOperationReturnsOnCall == nil {
+		fake.pollServiceBindingOperationReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.LastOperation
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.pollServiceBindingOperationReturnsOnCall[i] = struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -171,6 +255,8 @@ func (fake *FakeBindServiceActor) Invocations() map[string][][]interface{} {
 	defer fake.bindServiceBySpaceMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.pollServiceBindingOperationMutex.RLock()
+	defer fake.pollServiceBindingOperationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,6 +3,7 @@ package v6fakes
 
 import (
 	sync "sync"
+	time "time"
 
 	v2action "code.cloudfoundry.org/cli/actor/v2action"
 	v6 "code.cloudfoundry.org/cli/command/v6"
@@ -30,6 +31,22 @@ type FakeCreateServiceActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	PollServiceInstanceOperationStub        func(string, time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)
+	pollServiceInstanceOperationMutex       sync.RWMutex
+	pollServiceInstanceOperationArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	pollServiceInstanceOperationReturns struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}
+	pollServiceInstanceOperationReturnsOnCall map[int]struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -111,11 +128,80 @@ func (fake *FakeCreateServiceActor) CreateServiceInstanceReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperation(arg1 string, arg2 time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceInstanceOperationReturnsOnCall[len(fake.pollServiceInstanceOperationArgsForCall)]
+	fake.pollServiceInstanceOperationArgsForCall = append(fake.pollServiceInstanceOperationArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("PollServiceInstanceOperation", []interface{}{arg1, arg2})
+	fake.pollServiceInstanceOperationMutex.Unlock()
+	if fake.PollServiceInstanceOperationStub != nil {
+		return fake.PollServiceInstanceOperationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollServiceInstanceOperationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationCallCount() int {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	return len(fake.pollServiceInstanceOperationArgsForCall)
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationCalls(stub func(string, time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	defer fake.pollServiceInstanceOperationMutex.Unlock()
+	fake.PollServiceInstanceOperationStub = stub
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationArgsForCall(i int) (string, time.Duration) {
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
+	argsForCall := fake.pollServiceInstanceOperationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationReturns(result1 <-chan v2action.LastOperation, result2 <-chan string, result3 <-chan error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	defer fake.pollServiceInstanceOperationMutex.Unlock()
+	fake.PollServiceInstanceOperationStub = nil
+	fake.pollServiceInstanceOperationReturns = struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateServiceActor) PollServiceInstanceOperationReturnsOnCall(i int, result1 <-chan v2action.LastOperation, result2 <-chan string, result3 <-chan error) {
+	fake.pollServiceInstanceOperationMutex.Lock()
+	defer fake.pollServiceInstanceOperationMutex.Unlock()
+	fake.PollServiceInstanceOperationStub = nil
+	if fake.pollServiceInstanceOperationReturnsOnCall == nil {
+		fake.pollServiceInstanceOperationReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.LastOperation
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.pollServiceInstanceOperationReturnsOnCall[i] = struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCreateServiceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createServiceInstanceMutex.RLock()
 	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.pollServiceInstanceOperationMutex.RLock()
+	defer fake.pollServiceInstanceOperationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
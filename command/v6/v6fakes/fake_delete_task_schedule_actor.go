@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeDeleteTaskScheduleActor struct {
+	DeleteTaskScheduleStub        func(string, string, string) error
+	deleteTaskScheduleMutex       sync.RWMutex
+	deleteTaskScheduleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteTaskScheduleReturns struct {
+		result1 error
+	}
+	deleteTaskScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeleteTaskScheduleActor) DeleteTaskSchedule(arg1 string, arg2 string, arg3 string) error {
+	fake.deleteTaskScheduleMutex.Lock()
+	ret, specificReturn := fake.deleteTaskScheduleReturnsOnCall[len(fake.deleteTaskScheduleArgsForCall)]
+	fake.deleteTaskScheduleArgsForCall = append(fake.deleteTaskScheduleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteTaskSchedule", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskScheduleMutex.Unlock()
+	if fake.DeleteTaskScheduleStub != nil {
+		return fake.DeleteTaskScheduleStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteTaskScheduleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeleteTaskScheduleActor) DeleteTaskScheduleCallCount() int {
+	fake.deleteTaskScheduleMutex.RLock()
+	defer fake.deleteTaskScheduleMutex.RUnlock()
+	return len(fake.deleteTaskScheduleArgsForCall)
+}
+
+func (fake *FakeDeleteTaskScheduleActor) DeleteTaskScheduleCalls(stub func(string, string, string) error) {
+	fake.deleteTaskScheduleMutex.Lock()
+	defer fake.deleteTaskScheduleMutex.Unlock()
+	fake.DeleteTaskScheduleStub = stub
+}
+
+func (fake *FakeDeleteTaskScheduleActor) DeleteTaskScheduleArgsForCall(i int) (string, string, string) {
+	fake.deleteTaskScheduleMutex.RLock()
+	defer fake.deleteTaskScheduleMutex.RUnlock()
+	argsForCall := fake.deleteTaskScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeleteTaskScheduleActor) DeleteTaskScheduleReturns(result1 error) {
+	fake.deleteTaskScheduleMutex.Lock()
+	defer fake.deleteTaskScheduleMutex.Unlock()
+	fake.DeleteTaskScheduleStub = nil
+	fake.deleteTaskScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeleteTaskScheduleActor) DeleteTaskScheduleReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskScheduleMutex.Lock()
+	defer fake.deleteTaskScheduleMutex.Unlock()
+	fake.DeleteTaskScheduleStub = nil
+	if fake.deleteTaskScheduleReturnsOnCall == nil {
+		fake.deleteTaskScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeleteTaskScheduleActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteTaskScheduleMutex.RLock()
+	defer fake.deleteTaskScheduleMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeleteTaskScheduleActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.DeleteTaskScheduleActor = new(FakeDeleteTaskScheduleActor)
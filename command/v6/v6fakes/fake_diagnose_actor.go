@@ -0,0 +1,126 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v2v3action "code.cloudfoundry.org/cli/actor/v2v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeDiagnoseActor struct {
+	GetApplicationDiagnosisByNameAndSpaceStub        func(string, string, v2action.NOAAClient) (v2v3action.ApplicationDiagnosis, v2v3action.Warnings, error)
+	getApplicationDiagnosisByNameAndSpaceMutex       sync.RWMutex
+	getApplicationDiagnosisByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.NOAAClient
+	}
+	getApplicationDiagnosisByNameAndSpaceReturns struct {
+		result1 v2v3action.ApplicationDiagnosis
+		result2 v2v3action.Warnings
+		result3 error
+	}
+	getApplicationDiagnosisByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2v3action.ApplicationDiagnosis
+		result2 v2v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiagnoseActor) GetApplicationDiagnosisByNameAndSpace(arg1 string, arg2 string, arg3 v2action.NOAAClient) (v2v3action.ApplicationDiagnosis, v2v3action.Warnings, error) {
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationDiagnosisByNameAndSpaceReturnsOnCall[len(fake.getApplicationDiagnosisByNameAndSpaceArgsForCall)]
+	fake.getApplicationDiagnosisByNameAndSpaceArgsForCall = append(fake.getApplicationDiagnosisByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.NOAAClient
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetApplicationDiagnosisByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationDiagnosisByNameAndSpaceStub != nil {
+		return fake.GetApplicationDiagnosisByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationDiagnosisByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDiagnoseActor) GetApplicationDiagnosisByNameAndSpaceCallCount() int {
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationDiagnosisByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationDiagnosisByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeDiagnoseActor) GetApplicationDiagnosisByNameAndSpaceCalls(stub func(string, string, v2action.NOAAClient) (v2v3action.ApplicationDiagnosis, v2v3action.Warnings, error)) {
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationDiagnosisByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationDiagnosisByNameAndSpaceStub = stub
+}
+
+func (fake *FakeDiagnoseActor) GetApplicationDiagnosisByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient) {
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationDiagnosisByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationDiagnosisByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiagnoseActor) GetApplicationDiagnosisByNameAndSpaceReturns(result1 v2v3action.ApplicationDiagnosis, result2 v2v3action.Warnings, result3 error) {
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationDiagnosisByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationDiagnosisByNameAndSpaceStub = nil
+	fake.getApplicationDiagnosisByNameAndSpaceReturns = struct {
+		result1 v2v3action.ApplicationDiagnosis
+		result2 v2v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiagnoseActor) GetApplicationDiagnosisByNameAndSpaceReturnsOnCall(i int, result1 v2v3action.ApplicationDiagnosis, result2 v2v3action.Warnings, result3 error) {
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationDiagnosisByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationDiagnosisByNameAndSpaceStub = nil
+	if fake.getApplicationDiagnosisByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationDiagnosisByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2v3action.ApplicationDiagnosis
+			result2 v2v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDiagnosisByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2v3action.ApplicationDiagnosis
+		result2 v2v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiagnoseActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationDiagnosisByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationDiagnosisByNameAndSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDiagnoseActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.DiagnoseActor = new(FakeDiagnoseActor)
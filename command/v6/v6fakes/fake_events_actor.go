@@ -0,0 +1,291 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeEventsActor struct {
+	GetApplicationByNameAndSpaceStub        func(string, string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetEventsStub        func(v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)
+	getEventsMutex       sync.RWMutex
+	getEventsArgsForCall []struct {
+		arg1 v2action.EventFilter
+	}
+	getEventsReturns struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	getEventsReturnsOnCall map[int]struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	PollEventsStub        func(v2action.EventFilter, <-chan bool) (<-chan v2action.Event, <-chan string, <-chan error)
+	pollEventsMutex       sync.RWMutex
+	pollEventsArgsForCall []struct {
+		arg1 v2action.EventFilter
+		arg2 <-chan bool
+	}
+	pollEventsReturns struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}
+	pollEventsReturnsOnCall map[int]struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v2action.Application, v2action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetEvents(arg1 v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error) {
+	fake.getEventsMutex.Lock()
+	ret, specificReturn := fake.getEventsReturnsOnCall[len(fake.getEventsArgsForCall)]
+	fake.getEventsArgsForCall = append(fake.getEventsArgsForCall, struct {
+		arg1 v2action.EventFilter
+	}{arg1})
+	fake.recordInvocation("GetEvents", []interface{}{arg1})
+	fake.getEventsMutex.Unlock()
+	if fake.GetEventsStub != nil {
+		return fake.GetEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEventsActor) GetEventsCallCount() int {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	return len(fake.getEventsArgsForCall)
+}
+
+func (fake *FakeEventsActor) GetEventsCalls(stub func(v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = stub
+}
+
+func (fake *FakeEventsActor) GetEventsArgsForCall(i int) v2action.EventFilter {
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	argsForCall := fake.getEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEventsActor) GetEventsReturns(result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	fake.getEventsReturns = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) GetEventsReturnsOnCall(i int, result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.getEventsMutex.Lock()
+	defer fake.getEventsMutex.Unlock()
+	fake.GetEventsStub = nil
+	if fake.getEventsReturnsOnCall == nil {
+		fake.getEventsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Event
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getEventsReturnsOnCall[i] = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) PollEvents(arg1 v2action.EventFilter, arg2 <-chan bool) (<-chan v2action.Event, <-chan string, <-chan error) {
+	fake.pollEventsMutex.Lock()
+	ret, specificReturn := fake.pollEventsReturnsOnCall[len(fake.pollEventsArgsForCall)]
+	fake.pollEventsArgsForCall = append(fake.pollEventsArgsForCall, struct {
+		arg1 v2action.EventFilter
+		arg2 <-chan bool
+	}{arg1, arg2})
+	fake.recordInvocation("PollEvents", []interface{}{arg1, arg2})
+	fake.pollEventsMutex.Unlock()
+	if fake.PollEventsStub != nil {
+		return fake.PollEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeEventsActor) PollEventsCallCount() int {
+	fake.pollEventsMutex.RLock()
+	defer fake.pollEventsMutex.RUnlock()
+	return len(fake.pollEventsArgsForCall)
+}
+
+func (fake *FakeEventsActor) PollEventsCalls(stub func(v2action.EventFilter, <-chan bool) (<-chan v2action.Event, <-chan string, <-chan error)) {
+	fake.pollEventsMutex.Lock()
+	defer fake.pollEventsMutex.Unlock()
+	fake.PollEventsStub = stub
+}
+
+func (fake *FakeEventsActor) PollEventsArgsForCall(i int) (v2action.EventFilter, <-chan bool) {
+	fake.pollEventsMutex.RLock()
+	defer fake.pollEventsMutex.RUnlock()
+	argsForCall := fake.pollEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEventsActor) PollEventsReturns(result1 <-chan v2action.Event, result2 <-chan string, result3 <-chan error) {
+	fake.pollEventsMutex.Lock()
+	defer fake.pollEventsMutex.Unlock()
+	fake.PollEventsStub = nil
+	fake.pollEventsReturns = struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) PollEventsReturnsOnCall(i int, result1 <-chan v2action.Event, result2 <-chan string, result3 <-chan error) {
+	fake.pollEventsMutex.Lock()
+	defer fake.pollEventsMutex.Unlock()
+	fake.PollEventsStub = nil
+	if fake.pollEventsReturnsOnCall == nil {
+		fake.pollEventsReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.Event
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.pollEventsReturnsOnCall[i] = struct {
+		result1 <-chan v2action.Event
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEventsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getEventsMutex.RLock()
+	defer fake.getEventsMutex.RUnlock()
+	fake.pollEventsMutex.RLock()
+	defer fake.pollEventsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEventsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.EventsActor = new(FakeEventsActor)
@@ -0,0 +1,361 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeExportOrgActor struct {
+	GetFeatureFlagsStub        func() ([]v2action.FeatureFlag, v2action.Warnings, error)
+	getFeatureFlagsMutex       sync.RWMutex
+	getFeatureFlagsArgsForCall []struct {
+	}
+	getFeatureFlagsReturns struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	getFeatureFlagsReturnsOnCall map[int]struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationByNameStub        func(string) (v2action.Organization, v2action.Warnings, error)
+	getOrganizationByNameMutex       sync.RWMutex
+	getOrganizationByNameArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationByNameReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationConfigStub        func(string) (v2action.OrganizationConfig, v2action.Warnings, error)
+	getOrganizationConfigMutex       sync.RWMutex
+	getOrganizationConfigArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationConfigReturns struct {
+		result1 v2action.OrganizationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationConfigReturnsOnCall map[int]struct {
+		result1 v2action.OrganizationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationSpacesStub        func(string) ([]v2action.Space, v2action.Warnings, error)
+	getOrganizationSpacesMutex       sync.RWMutex
+	getOrganizationSpacesArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationSpacesReturns struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExportOrgActor) GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error) {
+	fake.getFeatureFlagsMutex.Lock()
+	ret, specificReturn := fake.getFeatureFlagsReturnsOnCall[len(fake.getFeatureFlagsArgsForCall)]
+	fake.getFeatureFlagsArgsForCall = append(fake.getFeatureFlagsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetFeatureFlags", []interface{}{})
+	fake.getFeatureFlagsMutex.Unlock()
+	if fake.GetFeatureFlagsStub != nil {
+		return fake.GetFeatureFlagsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getFeatureFlagsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeExportOrgActor) GetFeatureFlagsCallCount() int {
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
+	return len(fake.getFeatureFlagsArgsForCall)
+}
+
+func (fake *FakeExportOrgActor) GetFeatureFlagsCalls(stub func() ([]v2action.FeatureFlag, v2action.Warnings, error)) {
+	fake.getFeatureFlagsMutex.Lock()
+	defer fake.getFeatureFlagsMutex.Unlock()
+	fake.GetFeatureFlagsStub = stub
+}
+
+func (fake *FakeExportOrgActor) GetFeatureFlagsReturns(result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.getFeatureFlagsMutex.Lock()
+	defer fake.getFeatureFlagsMutex.Unlock()
+	fake.GetFeatureFlagsStub = nil
+	fake.getFeatureFlagsReturns = struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetFeatureFlagsReturnsOnCall(i int, result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.getFeatureFlagsMutex.Lock()
+	defer fake.getFeatureFlagsMutex.Unlock()
+	fake.GetFeatureFlagsStub = nil
+	if fake.getFeatureFlagsReturnsOnCall == nil {
+		fake.getFeatureFlagsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.FeatureFlag
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagsReturnsOnCall[i] = struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationByName(arg1 string) (v2action.Organization, v2action.Warnings, error) {
+	fake.getOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
+	fake.getOrganizationByNameArgsForCall = append(fake.getOrganizationByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationByName", []interface{}{arg1})
+	fake.getOrganizationByNameMutex.Unlock()
+	if fake.GetOrganizationByNameStub != nil {
+		return fake.GetOrganizationByNameStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationByNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationByNameCallCount() int {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return len(fake.getOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationByNameCalls(stub func(string) (v2action.Organization, v2action.Warnings, error)) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = stub
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationByNameArgsForCall(i int) string {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	argsForCall := fake.getOrganizationByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationByNameReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = nil
+	fake.getOrganizationByNameReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationByNameReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationByNameMutex.Lock()
+	defer fake.getOrganizationByNameMutex.Unlock()
+	fake.GetOrganizationByNameStub = nil
+	if fake.getOrganizationByNameReturnsOnCall == nil {
+		fake.getOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationConfig(arg1 string) (v2action.OrganizationConfig, v2action.Warnings, error) {
+	fake.getOrganizationConfigMutex.Lock()
+	ret, specificReturn := fake.getOrganizationConfigReturnsOnCall[len(fake.getOrganizationConfigArgsForCall)]
+	fake.getOrganizationConfigArgsForCall = append(fake.getOrganizationConfigArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationConfig", []interface{}{arg1})
+	fake.getOrganizationConfigMutex.Unlock()
+	if fake.GetOrganizationConfigStub != nil {
+		return fake.GetOrganizationConfigStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationConfigCallCount() int {
+	fake.getOrganizationConfigMutex.RLock()
+	defer fake.getOrganizationConfigMutex.RUnlock()
+	return len(fake.getOrganizationConfigArgsForCall)
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationConfigCalls(stub func(string) (v2action.OrganizationConfig, v2action.Warnings, error)) {
+	fake.getOrganizationConfigMutex.Lock()
+	defer fake.getOrganizationConfigMutex.Unlock()
+	fake.GetOrganizationConfigStub = stub
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationConfigArgsForCall(i int) string {
+	fake.getOrganizationConfigMutex.RLock()
+	defer fake.getOrganizationConfigMutex.RUnlock()
+	argsForCall := fake.getOrganizationConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationConfigReturns(result1 v2action.OrganizationConfig, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationConfigMutex.Lock()
+	defer fake.getOrganizationConfigMutex.Unlock()
+	fake.GetOrganizationConfigStub = nil
+	fake.getOrganizationConfigReturns = struct {
+		result1 v2action.OrganizationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationConfigReturnsOnCall(i int, result1 v2action.OrganizationConfig, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationConfigMutex.Lock()
+	defer fake.getOrganizationConfigMutex.Unlock()
+	fake.GetOrganizationConfigStub = nil
+	if fake.getOrganizationConfigReturnsOnCall == nil {
+		fake.getOrganizationConfigReturnsOnCall = make(map[int]struct {
+			result1 v2action.OrganizationConfig
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationConfigReturnsOnCall[i] = struct {
+		result1 v2action.OrganizationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationSpaces(arg1 string) ([]v2action.Space, v2action.Warnings, error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
+	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationSpaces", []interface{}{arg1})
+	fake.getOrganizationSpacesMutex.Unlock()
+	if fake.GetOrganizationSpacesStub != nil {
+		return fake.GetOrganizationSpacesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationSpacesCallCount() int {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return len(fake.getOrganizationSpacesArgsForCall)
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationSpacesCalls(stub func(string) ([]v2action.Space, v2action.Warnings, error)) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = stub
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationSpacesArgsForCall(i int) string {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	argsForCall := fake.getOrganizationSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationSpacesReturns(result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	fake.getOrganizationSpacesReturns = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	if fake.getOrganizationSpacesReturnsOnCall == nil {
+		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getFeatureFlagsMutex.RLock()
+	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.getOrganizationConfigMutex.RLock()
+	defer fake.getOrganizationConfigMutex.RUnlock()
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeExportOrgActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.ExportOrgActor = new(FakeExportOrgActor)
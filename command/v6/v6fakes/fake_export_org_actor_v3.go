@@ -0,0 +1,206 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeExportOrgActorV3 struct {
+	GetEffectiveIsolationSegmentBySpaceStub        func(string, string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
+	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getEffectiveIsolationSegmentBySpaceReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getEffectiveIsolationSegmentBySpaceReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentsByOrganizationStub        func(string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentsByOrganizationMutex       sync.RWMutex
+	getIsolationSegmentsByOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	getIsolationSegmentsByOrganizationReturns struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentsByOrganizationReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExportOrgActorV3) GetEffectiveIsolationSegmentBySpace(arg1 string, arg2 string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
+	fake.getEffectiveIsolationSegmentBySpaceArgsForCall = append(fake.getEffectiveIsolationSegmentBySpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetEffectiveIsolationSegmentBySpace", []interface{}{arg1, arg2})
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	if fake.GetEffectiveIsolationSegmentBySpaceStub != nil {
+		return fake.GetEffectiveIsolationSegmentBySpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEffectiveIsolationSegmentBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeExportOrgActorV3) GetEffectiveIsolationSegmentBySpaceCallCount() int {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	return len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)
+}
+
+func (fake *FakeExportOrgActorV3) GetEffectiveIsolationSegmentBySpaceCalls(stub func(string, string) (v3action.IsolationSegment, v3action.Warnings, error)) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	fake.GetEffectiveIsolationSegmentBySpaceStub = stub
+}
+
+func (fake *FakeExportOrgActorV3) GetEffectiveIsolationSegmentBySpaceArgsForCall(i int) (string, string) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	argsForCall := fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeExportOrgActorV3) GetEffectiveIsolationSegmentBySpaceReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	fake.getEffectiveIsolationSegmentBySpaceReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActorV3) GetEffectiveIsolationSegmentBySpaceReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	if fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall == nil {
+		fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActorV3) GetIsolationSegmentsByOrganization(arg1 string) ([]v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsByOrganizationReturnsOnCall[len(fake.getIsolationSegmentsByOrganizationArgsForCall)]
+	fake.getIsolationSegmentsByOrganizationArgsForCall = append(fake.getIsolationSegmentsByOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetIsolationSegmentsByOrganization", []interface{}{arg1})
+	fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	if fake.GetIsolationSegmentsByOrganizationStub != nil {
+		return fake.GetIsolationSegmentsByOrganizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIsolationSegmentsByOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeExportOrgActorV3) GetIsolationSegmentsByOrganizationCallCount() int {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return len(fake.getIsolationSegmentsByOrganizationArgsForCall)
+}
+
+func (fake *FakeExportOrgActorV3) GetIsolationSegmentsByOrganizationCalls(stub func(string) ([]v3action.IsolationSegment, v3action.Warnings, error)) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	fake.GetIsolationSegmentsByOrganizationStub = stub
+}
+
+func (fake *FakeExportOrgActorV3) GetIsolationSegmentsByOrganizationArgsForCall(i int) string {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	argsForCall := fake.getIsolationSegmentsByOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeExportOrgActorV3) GetIsolationSegmentsByOrganizationReturns(result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	fake.getIsolationSegmentsByOrganizationReturns = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActorV3) GetIsolationSegmentsByOrganizationReturnsOnCall(i int, result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	if fake.getIsolationSegmentsByOrganizationReturnsOnCall == nil {
+		fake.getIsolationSegmentsByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentsByOrganizationReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportOrgActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeExportOrgActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.ExportOrgActorV3 = new(FakeExportOrgActorV3)
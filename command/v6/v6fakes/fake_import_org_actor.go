@@ -0,0 +1,209 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeImportOrgActor struct {
+	ApplyOrganizationConfigStub        func(v2action.OrganizationConfig) (v2action.Organization, []v2action.ConfigChange, v2action.Warnings, error)
+	applyOrganizationConfigMutex       sync.RWMutex
+	applyOrganizationConfigArgsForCall []struct {
+		arg1 v2action.OrganizationConfig
+	}
+	applyOrganizationConfigReturns struct {
+		result1 v2action.Organization
+		result2 []v2action.ConfigChange
+		result3 v2action.Warnings
+		result4 error
+	}
+	applyOrganizationConfigReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 []v2action.ConfigChange
+		result3 v2action.Warnings
+		result4 error
+	}
+	GetOrganizationSpacesStub        func(string) ([]v2action.Space, v2action.Warnings, error)
+	getOrganizationSpacesMutex       sync.RWMutex
+	getOrganizationSpacesArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationSpacesReturns struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportOrgActor) ApplyOrganizationConfig(arg1 v2action.OrganizationConfig) (v2action.Organization, []v2action.ConfigChange, v2action.Warnings, error) {
+	fake.applyOrganizationConfigMutex.Lock()
+	ret, specificReturn := fake.applyOrganizationConfigReturnsOnCall[len(fake.applyOrganizationConfigArgsForCall)]
+	fake.applyOrganizationConfigArgsForCall = append(fake.applyOrganizationConfigArgsForCall, struct {
+		arg1 v2action.OrganizationConfig
+	}{arg1})
+	fake.recordInvocation("ApplyOrganizationConfig", []interface{}{arg1})
+	fake.applyOrganizationConfigMutex.Unlock()
+	if fake.ApplyOrganizationConfigStub != nil {
+		return fake.ApplyOrganizationConfigStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.applyOrganizationConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeImportOrgActor) ApplyOrganizationConfigCallCount() int {
+	fake.applyOrganizationConfigMutex.RLock()
+	defer fake.applyOrganizationConfigMutex.RUnlock()
+	return len(fake.applyOrganizationConfigArgsForCall)
+}
+
+func (fake *FakeImportOrgActor) ApplyOrganizationConfigCalls(stub func(v2action.OrganizationConfig) (v2action.Organization, []v2action.ConfigChange, v2action.Warnings, error)) {
+	fake.applyOrganizationConfigMutex.Lock()
+	defer fake.applyOrganizationConfigMutex.Unlock()
+	fake.ApplyOrganizationConfigStub = stub
+}
+
+func (fake *FakeImportOrgActor) ApplyOrganizationConfigArgsForCall(i int) v2action.OrganizationConfig {
+	fake.applyOrganizationConfigMutex.RLock()
+	defer fake.applyOrganizationConfigMutex.RUnlock()
+	argsForCall := fake.applyOrganizationConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImportOrgActor) ApplyOrganizationConfigReturns(result1 v2action.Organization, result2 []v2action.ConfigChange, result3 v2action.Warnings, result4 error) {
+	fake.applyOrganizationConfigMutex.Lock()
+	defer fake.applyOrganizationConfigMutex.Unlock()
+	fake.ApplyOrganizationConfigStub = nil
+	fake.applyOrganizationConfigReturns = struct {
+		result1 v2action.Organization
+		result2 []v2action.ConfigChange
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeImportOrgActor) ApplyOrganizationConfigReturnsOnCall(i int, result1 v2action.Organization, result2 []v2action.ConfigChange, result3 v2action.Warnings, result4 error) {
+	fake.applyOrganizationConfigMutex.Lock()
+	defer fake.applyOrganizationConfigMutex.Unlock()
+	fake.ApplyOrganizationConfigStub = nil
+	if fake.applyOrganizationConfigReturnsOnCall == nil {
+		fake.applyOrganizationConfigReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 []v2action.ConfigChange
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.applyOrganizationConfigReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 []v2action.ConfigChange
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeImportOrgActor) GetOrganizationSpaces(arg1 string) ([]v2action.Space, v2action.Warnings, error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
+	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationSpaces", []interface{}{arg1})
+	fake.getOrganizationSpacesMutex.Unlock()
+	if fake.GetOrganizationSpacesStub != nil {
+		return fake.GetOrganizationSpacesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeImportOrgActor) GetOrganizationSpacesCallCount() int {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return len(fake.getOrganizationSpacesArgsForCall)
+}
+
+func (fake *FakeImportOrgActor) GetOrganizationSpacesCalls(stub func(string) ([]v2action.Space, v2action.Warnings, error)) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = stub
+}
+
+func (fake *FakeImportOrgActor) GetOrganizationSpacesArgsForCall(i int) string {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	argsForCall := fake.getOrganizationSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImportOrgActor) GetOrganizationSpacesReturns(result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	fake.getOrganizationSpacesReturns = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	if fake.getOrganizationSpacesReturnsOnCall == nil {
+		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyOrganizationConfigMutex.RLock()
+	defer fake.applyOrganizationConfigMutex.RUnlock()
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImportOrgActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.ImportOrgActor = new(FakeImportOrgActor)
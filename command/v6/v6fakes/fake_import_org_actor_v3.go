@@ -0,0 +1,529 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeImportOrgActorV3 struct {
+	AssignIsolationSegmentToSpaceByNameAndSpaceStub        func(string, string) (v3action.Warnings, error)
+	assignIsolationSegmentToSpaceByNameAndSpaceMutex       sync.RWMutex
+	assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	assignIsolationSegmentToSpaceByNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	EntitleIsolationSegmentToOrganizationByNameStub        func(string, string) (v3action.Warnings, error)
+	entitleIsolationSegmentToOrganizationByNameMutex       sync.RWMutex
+	entitleIsolationSegmentToOrganizationByNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	entitleIsolationSegmentToOrganizationByNameReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	entitleIsolationSegmentToOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	GetEffectiveIsolationSegmentBySpaceStub        func(string, string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
+	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getEffectiveIsolationSegmentBySpaceReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getEffectiveIsolationSegmentBySpaceReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentByNameStub        func(string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentByNameMutex       sync.RWMutex
+	getIsolationSegmentByNameArgsForCall []struct {
+		arg1 string
+	}
+	getIsolationSegmentByNameReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentByNameReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentsByOrganizationStub        func(string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentsByOrganizationMutex       sync.RWMutex
+	getIsolationSegmentsByOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	getIsolationSegmentsByOrganizationReturns struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentsByOrganizationReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	SetOrganizationDefaultIsolationSegmentStub        func(string, string) (v3action.Warnings, error)
+	setOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
+	setOrganizationDefaultIsolationSegmentArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setOrganizationDefaultIsolationSegmentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	setOrganizationDefaultIsolationSegmentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportOrgActorV3) AssignIsolationSegmentToSpaceByNameAndSpace(arg1 string, arg2 string) (v3action.Warnings, error) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)]
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall = append(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("AssignIsolationSegmentToSpaceByNameAndSpace", []interface{}{arg1, arg2})
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Unlock()
+	if fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub != nil {
+		return fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportOrgActorV3) AssignIsolationSegmentToSpaceByNameAndSpaceCallCount() int {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	return len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeImportOrgActorV3) AssignIsolationSegmentToSpaceByNameAndSpaceCalls(stub func(string, string) (v3action.Warnings, error)) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Unlock()
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = stub
+}
+
+func (fake *FakeImportOrgActorV3) AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportOrgActorV3) AssignIsolationSegmentToSpaceByNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Unlock()
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = nil
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportOrgActorV3) AssignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Unlock()
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = nil
+	if fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall == nil {
+		fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportOrgActorV3) EntitleIsolationSegmentToOrganizationByName(arg1 string, arg2 string) (v3action.Warnings, error) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall[len(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall)]
+	fake.entitleIsolationSegmentToOrganizationByNameArgsForCall = append(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("EntitleIsolationSegmentToOrganizationByName", []interface{}{arg1, arg2})
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Unlock()
+	if fake.EntitleIsolationSegmentToOrganizationByNameStub != nil {
+		return fake.EntitleIsolationSegmentToOrganizationByNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.entitleIsolationSegmentToOrganizationByNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportOrgActorV3) EntitleIsolationSegmentToOrganizationByNameCallCount() int {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	return len(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeImportOrgActorV3) EntitleIsolationSegmentToOrganizationByNameCalls(stub func(string, string) (v3action.Warnings, error)) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Lock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.Unlock()
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = stub
+}
+
+func (fake *FakeImportOrgActorV3) EntitleIsolationSegmentToOrganizationByNameArgsForCall(i int) (string, string) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	argsForCall := fake.entitleIsolationSegmentToOrganizationByNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportOrgActorV3) EntitleIsolationSegmentToOrganizationByNameReturns(result1 v3action.Warnings, result2 error) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Lock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.Unlock()
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = nil
+	fake.entitleIsolationSegmentToOrganizationByNameReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportOrgActorV3) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Lock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.Unlock()
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = nil
+	if fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall == nil {
+		fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportOrgActorV3) GetEffectiveIsolationSegmentBySpace(arg1 string, arg2 string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
+	fake.getEffectiveIsolationSegmentBySpaceArgsForCall = append(fake.getEffectiveIsolationSegmentBySpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetEffectiveIsolationSegmentBySpace", []interface{}{arg1, arg2})
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	if fake.GetEffectiveIsolationSegmentBySpaceStub != nil {
+		return fake.GetEffectiveIsolationSegmentBySpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getEffectiveIsolationSegmentBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeImportOrgActorV3) GetEffectiveIsolationSegmentBySpaceCallCount() int {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	return len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)
+}
+
+func (fake *FakeImportOrgActorV3) GetEffectiveIsolationSegmentBySpaceCalls(stub func(string, string) (v3action.IsolationSegment, v3action.Warnings, error)) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	fake.GetEffectiveIsolationSegmentBySpaceStub = stub
+}
+
+func (fake *FakeImportOrgActorV3) GetEffectiveIsolationSegmentBySpaceArgsForCall(i int) (string, string) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	argsForCall := fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportOrgActorV3) GetEffectiveIsolationSegmentBySpaceReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	fake.getEffectiveIsolationSegmentBySpaceReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActorV3) GetEffectiveIsolationSegmentBySpaceReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	if fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall == nil {
+		fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentByName(arg1 string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{arg1})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIsolationSegmentByNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentByNameCalls(stub func(string) (v3action.IsolationSegment, v3action.Warnings, error)) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	defer fake.getIsolationSegmentByNameMutex.Unlock()
+	fake.GetIsolationSegmentByNameStub = stub
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	argsForCall := fake.getIsolationSegmentByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentByNameReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	defer fake.getIsolationSegmentByNameMutex.Unlock()
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentByNameReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	defer fake.getIsolationSegmentByNameMutex.Unlock()
+	fake.GetIsolationSegmentByNameStub = nil
+	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
+		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentByNameReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentsByOrganization(arg1 string) ([]v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsByOrganizationReturnsOnCall[len(fake.getIsolationSegmentsByOrganizationArgsForCall)]
+	fake.getIsolationSegmentsByOrganizationArgsForCall = append(fake.getIsolationSegmentsByOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetIsolationSegmentsByOrganization", []interface{}{arg1})
+	fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	if fake.GetIsolationSegmentsByOrganizationStub != nil {
+		return fake.GetIsolationSegmentsByOrganizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getIsolationSegmentsByOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentsByOrganizationCallCount() int {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return len(fake.getIsolationSegmentsByOrganizationArgsForCall)
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentsByOrganizationCalls(stub func(string) ([]v3action.IsolationSegment, v3action.Warnings, error)) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	fake.GetIsolationSegmentsByOrganizationStub = stub
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentsByOrganizationArgsForCall(i int) string {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	argsForCall := fake.getIsolationSegmentsByOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentsByOrganizationReturns(result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	fake.getIsolationSegmentsByOrganizationReturns = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActorV3) GetIsolationSegmentsByOrganizationReturnsOnCall(i int, result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	if fake.getIsolationSegmentsByOrganizationReturnsOnCall == nil {
+		fake.getIsolationSegmentsByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentsByOrganizationReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImportOrgActorV3) SetOrganizationDefaultIsolationSegment(arg1 string, arg2 string) (v3action.Warnings, error) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.setOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.setOrganizationDefaultIsolationSegmentArgsForCall)]
+	fake.setOrganizationDefaultIsolationSegmentArgsForCall = append(fake.setOrganizationDefaultIsolationSegmentArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetOrganizationDefaultIsolationSegment", []interface{}{arg1, arg2})
+	fake.setOrganizationDefaultIsolationSegmentMutex.Unlock()
+	if fake.SetOrganizationDefaultIsolationSegmentStub != nil {
+		return fake.SetOrganizationDefaultIsolationSegmentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setOrganizationDefaultIsolationSegmentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportOrgActorV3) SetOrganizationDefaultIsolationSegmentCallCount() int {
+	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return len(fake.setOrganizationDefaultIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeImportOrgActorV3) SetOrganizationDefaultIsolationSegmentCalls(stub func(string, string) (v3action.Warnings, error)) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.Lock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.Unlock()
+	fake.SetOrganizationDefaultIsolationSegmentStub = stub
+}
+
+func (fake *FakeImportOrgActorV3) SetOrganizationDefaultIsolationSegmentArgsForCall(i int) (string, string) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	argsForCall := fake.setOrganizationDefaultIsolationSegmentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportOrgActorV3) SetOrganizationDefaultIsolationSegmentReturns(result1 v3action.Warnings, result2 error) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.Lock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.Unlock()
+	fake.SetOrganizationDefaultIsolationSegmentStub = nil
+	fake.setOrganizationDefaultIsolationSegmentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportOrgActorV3) SetOrganizationDefaultIsolationSegmentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.Lock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.Unlock()
+	fake.SetOrganizationDefaultIsolationSegmentStub = nil
+	if fake.setOrganizationDefaultIsolationSegmentReturnsOnCall == nil {
+		fake.setOrganizationDefaultIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.setOrganizationDefaultIsolationSegmentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportOrgActorV3) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImportOrgActorV3) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.ImportOrgActorV3 = new(FakeImportOrgActorV3)
@@ -2,11 +2,12 @@
 package v6fakes
 
 import (
-	"sync"
+	sync "sync"
+	time "time"
 
-	"code.cloudfoundry.org/cli/actor/v3action"
-	"code.cloudfoundry.org/cli/api/uaa/constant"
-	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	constant "code.cloudfoundry.org/cli/api/uaa/constant"
+	coreconfig "code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	v6 "code.cloudfoundry.org/cli/command/v6"
 )
 
@@ -24,6 +25,29 @@ type FakeLoginActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithAuthorizationCodeStub        func(v3action.AuthorizationCodeLogin, time.Duration) error
+	authenticateWithAuthorizationCodeMutex       sync.RWMutex
+	authenticateWithAuthorizationCodeArgsForCall []struct {
+		arg1 v3action.AuthorizationCodeLogin
+		arg2 time.Duration
+	}
+	authenticateWithAuthorizationCodeReturns struct {
+		result1 error
+	}
+	authenticateWithAuthorizationCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateWithDeviceCodeStub        func(v3action.DeviceCodeLogin) error
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		arg1 v3action.DeviceCodeLogin
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct {
@@ -57,6 +81,30 @@ type FakeLoginActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	StartAuthorizationCodeLoginStub        func() (v3action.AuthorizationCodeLogin, error)
+	startAuthorizationCodeLoginMutex       sync.RWMutex
+	startAuthorizationCodeLoginArgsForCall []struct {
+	}
+	startAuthorizationCodeLoginReturns struct {
+		result1 v3action.AuthorizationCodeLogin
+		result2 error
+	}
+	startAuthorizationCodeLoginReturnsOnCall map[int]struct {
+		result1 v3action.AuthorizationCodeLogin
+		result2 error
+	}
+	StartDeviceCodeLoginStub        func() (v3action.DeviceCodeLogin, error)
+	startDeviceCodeLoginMutex       sync.RWMutex
+	startDeviceCodeLoginArgsForCall []struct {
+	}
+	startDeviceCodeLoginReturns struct {
+		result1 v3action.DeviceCodeLogin
+		result2 error
+	}
+	startDeviceCodeLoginReturnsOnCall map[int]struct {
+		result1 v3action.DeviceCodeLogin
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -123,6 +171,127 @@ func (fake *FakeLoginActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeLoginActor) AuthenticateWithAuthorizationCode(arg1 v3action.AuthorizationCodeLogin, arg2 time.Duration) error {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithAuthorizationCodeReturnsOnCall[len(fake.authenticateWithAuthorizationCodeArgsForCall)]
+	fake.authenticateWithAuthorizationCodeArgsForCall = append(fake.authenticateWithAuthorizationCodeArgsForCall, struct {
+		arg1 v3action.AuthorizationCodeLogin
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("AuthenticateWithAuthorizationCode", []interface{}{arg1, arg2})
+	fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	if fake.AuthenticateWithAuthorizationCodeStub != nil {
+		return fake.AuthenticateWithAuthorizationCodeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithAuthorizationCodeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithAuthorizationCodeCallCount() int {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	return len(fake.authenticateWithAuthorizationCodeArgsForCall)
+}
+
+func (fake *FakeLoginActor) AuthenticateWithAuthorizationCodeCalls(stub func(v3action.AuthorizationCodeLogin, time.Duration) error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = stub
+}
+
+func (fake *FakeLoginActor) AuthenticateWithAuthorizationCodeArgsForCall(i int) (v3action.AuthorizationCodeLogin, time.Duration) {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithAuthorizationCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeLoginActor) AuthenticateWithAuthorizationCodeReturns(result1 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	fake.authenticateWithAuthorizationCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithAuthorizationCodeReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	if fake.authenticateWithAuthorizationCodeReturnsOnCall == nil {
+		fake.authenticateWithAuthorizationCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithAuthorizationCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceCode(arg1 v3action.DeviceCodeLogin) error {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		arg1 v3action.DeviceCodeLogin
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{arg1})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if fake.AuthenticateWithDeviceCodeStub != nil {
+		return fake.AuthenticateWithDeviceCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithDeviceCodeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceCodeCalls(stub func(v3action.DeviceCodeLogin) error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = stub
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceCodeArgsForCall(i int) v3action.DeviceCodeLogin {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceCodeReturns(result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLoginActor) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeLoginActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -290,17 +459,135 @@ func (fake *FakeLoginActor) SetTargetReturnsOnCall(i int, result1 v3action.Warni
 	}{result1, result2}
 }
 
+func (fake *FakeLoginActor) StartAuthorizationCodeLogin() (v3action.AuthorizationCodeLogin, error) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	ret, specificReturn := fake.startAuthorizationCodeLoginReturnsOnCall[len(fake.startAuthorizationCodeLoginArgsForCall)]
+	fake.startAuthorizationCodeLoginArgsForCall = append(fake.startAuthorizationCodeLoginArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartAuthorizationCodeLogin", []interface{}{})
+	fake.startAuthorizationCodeLoginMutex.Unlock()
+	if fake.StartAuthorizationCodeLoginStub != nil {
+		return fake.StartAuthorizationCodeLoginStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startAuthorizationCodeLoginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) StartAuthorizationCodeLoginCallCount() int {
+	fake.startAuthorizationCodeLoginMutex.RLock()
+	defer fake.startAuthorizationCodeLoginMutex.RUnlock()
+	return len(fake.startAuthorizationCodeLoginArgsForCall)
+}
+
+func (fake *FakeLoginActor) StartAuthorizationCodeLoginCalls(stub func() (v3action.AuthorizationCodeLogin, error)) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	defer fake.startAuthorizationCodeLoginMutex.Unlock()
+	fake.StartAuthorizationCodeLoginStub = stub
+}
+
+func (fake *FakeLoginActor) StartAuthorizationCodeLoginReturns(result1 v3action.AuthorizationCodeLogin, result2 error) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	defer fake.startAuthorizationCodeLoginMutex.Unlock()
+	fake.StartAuthorizationCodeLoginStub = nil
+	fake.startAuthorizationCodeLoginReturns = struct {
+		result1 v3action.AuthorizationCodeLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) StartAuthorizationCodeLoginReturnsOnCall(i int, result1 v3action.AuthorizationCodeLogin, result2 error) {
+	fake.startAuthorizationCodeLoginMutex.Lock()
+	defer fake.startAuthorizationCodeLoginMutex.Unlock()
+	fake.StartAuthorizationCodeLoginStub = nil
+	if fake.startAuthorizationCodeLoginReturnsOnCall == nil {
+		fake.startAuthorizationCodeLoginReturnsOnCall = make(map[int]struct {
+			result1 v3action.AuthorizationCodeLogin
+			result2 error
+		})
+	}
+	fake.startAuthorizationCodeLoginReturnsOnCall[i] = struct {
+		result1 v3action.AuthorizationCodeLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) StartDeviceCodeLogin() (v3action.DeviceCodeLogin, error) {
+	fake.startDeviceCodeLoginMutex.Lock()
+	ret, specificReturn := fake.startDeviceCodeLoginReturnsOnCall[len(fake.startDeviceCodeLoginArgsForCall)]
+	fake.startDeviceCodeLoginArgsForCall = append(fake.startDeviceCodeLoginArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartDeviceCodeLogin", []interface{}{})
+	fake.startDeviceCodeLoginMutex.Unlock()
+	if fake.StartDeviceCodeLoginStub != nil {
+		return fake.StartDeviceCodeLoginStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startDeviceCodeLoginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLoginActor) StartDeviceCodeLoginCallCount() int {
+	fake.startDeviceCodeLoginMutex.RLock()
+	defer fake.startDeviceCodeLoginMutex.RUnlock()
+	return len(fake.startDeviceCodeLoginArgsForCall)
+}
+
+func (fake *FakeLoginActor) StartDeviceCodeLoginCalls(stub func() (v3action.DeviceCodeLogin, error)) {
+	fake.startDeviceCodeLoginMutex.Lock()
+	defer fake.startDeviceCodeLoginMutex.Unlock()
+	fake.StartDeviceCodeLoginStub = stub
+}
+
+func (fake *FakeLoginActor) StartDeviceCodeLoginReturns(result1 v3action.DeviceCodeLogin, result2 error) {
+	fake.startDeviceCodeLoginMutex.Lock()
+	defer fake.startDeviceCodeLoginMutex.Unlock()
+	fake.StartDeviceCodeLoginStub = nil
+	fake.startDeviceCodeLoginReturns = struct {
+		result1 v3action.DeviceCodeLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLoginActor) StartDeviceCodeLoginReturnsOnCall(i int, result1 v3action.DeviceCodeLogin, result2 error) {
+	fake.startDeviceCodeLoginMutex.Lock()
+	defer fake.startDeviceCodeLoginMutex.Unlock()
+	fake.StartDeviceCodeLoginStub = nil
+	if fake.startDeviceCodeLoginReturnsOnCall == nil {
+		fake.startDeviceCodeLoginReturnsOnCall = make(map[int]struct {
+			result1 v3action.DeviceCodeLogin
+			result2 error
+		})
+	}
+	fake.startDeviceCodeLoginReturnsOnCall[i] = struct {
+		result1 v3action.DeviceCodeLogin
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLoginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.getLoginPromptsMutex.RLock()
 	defer fake.getLoginPromptsMutex.RUnlock()
 	fake.setTargetMutex.RLock()
 	defer fake.setTargetMutex.RUnlock()
+	fake.startAuthorizationCodeLoginMutex.RLock()
+	defer fake.startAuthorizationCodeLoginMutex.RUnlock()
+	fake.startDeviceCodeLoginMutex.RLock()
+	defer fake.startDeviceCodeLoginMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,643 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeRotateServiceKeyActor struct {
+	CreateSuccessorServiceKeyStub        func(string, string, string, map[string]interface{}) (v2action.ServiceKeyRotation, v2action.Warnings, error)
+	createSuccessorServiceKeyMutex       sync.RWMutex
+	createSuccessorServiceKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]interface{}
+	}
+	createSuccessorServiceKeyReturns struct {
+		result1 v2action.ServiceKeyRotation
+		result2 v2action.Warnings
+		result3 error
+	}
+	createSuccessorServiceKeyReturnsOnCall map[int]struct {
+		result1 v2action.ServiceKeyRotation
+		result2 v2action.Warnings
+		result3 error
+	}
+	DeleteServiceKeyStub        func(string) (v2action.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		arg1 string
+	}
+	deleteServiceKeyReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GetApplicationByNameAndSpaceStub        func(string, string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	RebindServiceInstanceToApplicationStub        func(v2action.Application, string) (v2action.ServiceBindingReplacement, v2action.Warnings, error)
+	rebindServiceInstanceToApplicationMutex       sync.RWMutex
+	rebindServiceInstanceToApplicationArgsForCall []struct {
+		arg1 v2action.Application
+		arg2 string
+	}
+	rebindServiceInstanceToApplicationReturns struct {
+		result1 v2action.ServiceBindingReplacement
+		result2 v2action.Warnings
+		result3 error
+	}
+	rebindServiceInstanceToApplicationReturnsOnCall map[int]struct {
+		result1 v2action.ServiceBindingReplacement
+		result2 v2action.Warnings
+		result3 error
+	}
+	RestageApplicationStub        func(v2action.Application, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	restageApplicationMutex       sync.RWMutex
+	restageApplicationArgsForCall []struct {
+		arg1 v2action.Application
+		arg2 v2action.NOAAClient
+	}
+	restageApplicationReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}
+	restageApplicationReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}
+	RestartApplicationStub        func(v2action.Application, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	restartApplicationMutex       sync.RWMutex
+	restartApplicationArgsForCall []struct {
+		arg1 v2action.Application
+		arg2 v2action.NOAAClient
+	}
+	restartApplicationReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}
+	restartApplicationReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}
+	RestoreServiceBindingStub        func(v2action.ServiceBindingReplacement) (v2action.Warnings, error)
+	restoreServiceBindingMutex       sync.RWMutex
+	restoreServiceBindingArgsForCall []struct {
+		arg1 v2action.ServiceBindingReplacement
+	}
+	restoreServiceBindingReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	restoreServiceBindingReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRotateServiceKeyActor) CreateSuccessorServiceKey(arg1 string, arg2 string, arg3 string, arg4 map[string]interface{}) (v2action.ServiceKeyRotation, v2action.Warnings, error) {
+	fake.createSuccessorServiceKeyMutex.Lock()
+	ret, specificReturn := fake.createSuccessorServiceKeyReturnsOnCall[len(fake.createSuccessorServiceKeyArgsForCall)]
+	fake.createSuccessorServiceKeyArgsForCall = append(fake.createSuccessorServiceKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]interface{}
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateSuccessorServiceKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createSuccessorServiceKeyMutex.Unlock()
+	if fake.CreateSuccessorServiceKeyStub != nil {
+		return fake.CreateSuccessorServiceKeyStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createSuccessorServiceKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRotateServiceKeyActor) CreateSuccessorServiceKeyCallCount() int {
+	fake.createSuccessorServiceKeyMutex.RLock()
+	defer fake.createSuccessorServiceKeyMutex.RUnlock()
+	return len(fake.createSuccessorServiceKeyArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) CreateSuccessorServiceKeyCalls(stub func(string, string, string, map[string]interface{}) (v2action.ServiceKeyRotation, v2action.Warnings, error)) {
+	fake.createSuccessorServiceKeyMutex.Lock()
+	defer fake.createSuccessorServiceKeyMutex.Unlock()
+	fake.CreateSuccessorServiceKeyStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) CreateSuccessorServiceKeyArgsForCall(i int) (string, string, string, map[string]interface{}) {
+	fake.createSuccessorServiceKeyMutex.RLock()
+	defer fake.createSuccessorServiceKeyMutex.RUnlock()
+	argsForCall := fake.createSuccessorServiceKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeRotateServiceKeyActor) CreateSuccessorServiceKeyReturns(result1 v2action.ServiceKeyRotation, result2 v2action.Warnings, result3 error) {
+	fake.createSuccessorServiceKeyMutex.Lock()
+	defer fake.createSuccessorServiceKeyMutex.Unlock()
+	fake.CreateSuccessorServiceKeyStub = nil
+	fake.createSuccessorServiceKeyReturns = struct {
+		result1 v2action.ServiceKeyRotation
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRotateServiceKeyActor) CreateSuccessorServiceKeyReturnsOnCall(i int, result1 v2action.ServiceKeyRotation, result2 v2action.Warnings, result3 error) {
+	fake.createSuccessorServiceKeyMutex.Lock()
+	defer fake.createSuccessorServiceKeyMutex.Unlock()
+	fake.CreateSuccessorServiceKeyStub = nil
+	if fake.createSuccessorServiceKeyReturnsOnCall == nil {
+		fake.createSuccessorServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceKeyRotation
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createSuccessorServiceKeyReturnsOnCall[i] = struct {
+		result1 v2action.ServiceKeyRotation
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRotateServiceKeyActor) DeleteServiceKey(arg1 string) (v2action.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{arg1})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteServiceKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRotateServiceKeyActor) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) DeleteServiceKeyCalls(stub func(string) (v2action.Warnings, error)) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	argsForCall := fake.deleteServiceKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRotateServiceKeyActor) DeleteServiceKeyReturns(result1 v2action.Warnings, result2 error) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRotateServiceKeyActor) DeleteServiceKeyReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRotateServiceKeyActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRotateServiceKeyActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v2action.Application, v2action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRotateServiceKeyActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRotateServiceKeyActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRotateServiceKeyActor) RebindServiceInstanceToApplication(arg1 v2action.Application, arg2 string) (v2action.ServiceBindingReplacement, v2action.Warnings, error) {
+	fake.rebindServiceInstanceToApplicationMutex.Lock()
+	ret, specificReturn := fake.rebindServiceInstanceToApplicationReturnsOnCall[len(fake.rebindServiceInstanceToApplicationArgsForCall)]
+	fake.rebindServiceInstanceToApplicationArgsForCall = append(fake.rebindServiceInstanceToApplicationArgsForCall, struct {
+		arg1 v2action.Application
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RebindServiceInstanceToApplication", []interface{}{arg1, arg2})
+	fake.rebindServiceInstanceToApplicationMutex.Unlock()
+	if fake.RebindServiceInstanceToApplicationStub != nil {
+		return fake.RebindServiceInstanceToApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.rebindServiceInstanceToApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRotateServiceKeyActor) RebindServiceInstanceToApplicationCallCount() int {
+	fake.rebindServiceInstanceToApplicationMutex.RLock()
+	defer fake.rebindServiceInstanceToApplicationMutex.RUnlock()
+	return len(fake.rebindServiceInstanceToApplicationArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) RebindServiceInstanceToApplicationCalls(stub func(v2action.Application, string) (v2action.ServiceBindingReplacement, v2action.Warnings, error)) {
+	fake.rebindServiceInstanceToApplicationMutex.Lock()
+	defer fake.rebindServiceInstanceToApplicationMutex.Unlock()
+	fake.RebindServiceInstanceToApplicationStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) RebindServiceInstanceToApplicationArgsForCall(i int) (v2action.Application, string) {
+	fake.rebindServiceInstanceToApplicationMutex.RLock()
+	defer fake.rebindServiceInstanceToApplicationMutex.RUnlock()
+	argsForCall := fake.rebindServiceInstanceToApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRotateServiceKeyActor) RebindServiceInstanceToApplicationReturns(result1 v2action.ServiceBindingReplacement, result2 v2action.Warnings, result3 error) {
+	fake.rebindServiceInstanceToApplicationMutex.Lock()
+	defer fake.rebindServiceInstanceToApplicationMutex.Unlock()
+	fake.RebindServiceInstanceToApplicationStub = nil
+	fake.rebindServiceInstanceToApplicationReturns = struct {
+		result1 v2action.ServiceBindingReplacement
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRotateServiceKeyActor) RebindServiceInstanceToApplicationReturnsOnCall(i int, result1 v2action.ServiceBindingReplacement, result2 v2action.Warnings, result3 error) {
+	fake.rebindServiceInstanceToApplicationMutex.Lock()
+	defer fake.rebindServiceInstanceToApplicationMutex.Unlock()
+	fake.RebindServiceInstanceToApplicationStub = nil
+	if fake.rebindServiceInstanceToApplicationReturnsOnCall == nil {
+		fake.rebindServiceInstanceToApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceBindingReplacement
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.rebindServiceInstanceToApplicationReturnsOnCall[i] = struct {
+		result1 v2action.ServiceBindingReplacement
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRotateServiceKeyActor) RestageApplication(arg1 v2action.Application, arg2 v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+	fake.restageApplicationMutex.Lock()
+	ret, specificReturn := fake.restageApplicationReturnsOnCall[len(fake.restageApplicationArgsForCall)]
+	fake.restageApplicationArgsForCall = append(fake.restageApplicationArgsForCall, struct {
+		arg1 v2action.Application
+		arg2 v2action.NOAAClient
+	}{arg1, arg2})
+	fake.recordInvocation("RestageApplication", []interface{}{arg1, arg2})
+	fake.restageApplicationMutex.Unlock()
+	if fake.RestageApplicationStub != nil {
+		return fake.RestageApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	fakeReturns := fake.restageApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeRotateServiceKeyActor) RestageApplicationCallCount() int {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	return len(fake.restageApplicationArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) RestageApplicationCalls(stub func(v2action.Application, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)) {
+	fake.restageApplicationMutex.Lock()
+	defer fake.restageApplicationMutex.Unlock()
+	fake.RestageApplicationStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) RestageApplicationArgsForCall(i int) (v2action.Application, v2action.NOAAClient) {
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	argsForCall := fake.restageApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRotateServiceKeyActor) RestageApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan v2action.ApplicationStateChange, result4 <-chan string, result5 <-chan error) {
+	fake.restageApplicationMutex.Lock()
+	defer fake.restageApplicationMutex.Unlock()
+	fake.RestageApplicationStub = nil
+	fake.restageApplicationReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeRotateServiceKeyActor) RestageApplicationReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan v2action.ApplicationStateChange, result4 <-chan string, result5 <-chan error) {
+	fake.restageApplicationMutex.Lock()
+	defer fake.restageApplicationMutex.Unlock()
+	fake.RestageApplicationStub = nil
+	if fake.restageApplicationReturnsOnCall == nil {
+		fake.restageApplicationReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan v2action.ApplicationStateChange
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.restageApplicationReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeRotateServiceKeyActor) RestartApplication(arg1 v2action.Application, arg2 v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+	fake.restartApplicationMutex.Lock()
+	ret, specificReturn := fake.restartApplicationReturnsOnCall[len(fake.restartApplicationArgsForCall)]
+	fake.restartApplicationArgsForCall = append(fake.restartApplicationArgsForCall, struct {
+		arg1 v2action.Application
+		arg2 v2action.NOAAClient
+	}{arg1, arg2})
+	fake.recordInvocation("RestartApplication", []interface{}{arg1, arg2})
+	fake.restartApplicationMutex.Unlock()
+	if fake.RestartApplicationStub != nil {
+		return fake.RestartApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	fakeReturns := fake.restartApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeRotateServiceKeyActor) RestartApplicationCallCount() int {
+	fake.restartApplicationMutex.RLock()
+	defer fake.restartApplicationMutex.RUnlock()
+	return len(fake.restartApplicationArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) RestartApplicationCalls(stub func(v2action.Application, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)) {
+	fake.restartApplicationMutex.Lock()
+	defer fake.restartApplicationMutex.Unlock()
+	fake.RestartApplicationStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) RestartApplicationArgsForCall(i int) (v2action.Application, v2action.NOAAClient) {
+	fake.restartApplicationMutex.RLock()
+	defer fake.restartApplicationMutex.RUnlock()
+	argsForCall := fake.restartApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRotateServiceKeyActor) RestartApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan v2action.ApplicationStateChange, result4 <-chan string, result5 <-chan error) {
+	fake.restartApplicationMutex.Lock()
+	defer fake.restartApplicationMutex.Unlock()
+	fake.RestartApplicationStub = nil
+	fake.restartApplicationReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeRotateServiceKeyActor) RestartApplicationReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan v2action.ApplicationStateChange, result4 <-chan string, result5 <-chan error) {
+	fake.restartApplicationMutex.Lock()
+	defer fake.restartApplicationMutex.Unlock()
+	fake.RestartApplicationStub = nil
+	if fake.restartApplicationReturnsOnCall == nil {
+		fake.restartApplicationReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan v2action.ApplicationStateChange
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.restartApplicationReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeRotateServiceKeyActor) RestoreServiceBinding(arg1 v2action.ServiceBindingReplacement) (v2action.Warnings, error) {
+	fake.restoreServiceBindingMutex.Lock()
+	ret, specificReturn := fake.restoreServiceBindingReturnsOnCall[len(fake.restoreServiceBindingArgsForCall)]
+	fake.restoreServiceBindingArgsForCall = append(fake.restoreServiceBindingArgsForCall, struct {
+		arg1 v2action.ServiceBindingReplacement
+	}{arg1})
+	fake.recordInvocation("RestoreServiceBinding", []interface{}{arg1})
+	fake.restoreServiceBindingMutex.Unlock()
+	if fake.RestoreServiceBindingStub != nil {
+		return fake.RestoreServiceBindingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.restoreServiceBindingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRotateServiceKeyActor) RestoreServiceBindingCallCount() int {
+	fake.restoreServiceBindingMutex.RLock()
+	defer fake.restoreServiceBindingMutex.RUnlock()
+	return len(fake.restoreServiceBindingArgsForCall)
+}
+
+func (fake *FakeRotateServiceKeyActor) RestoreServiceBindingCalls(stub func(v2action.ServiceBindingReplacement) (v2action.Warnings, error)) {
+	fake.restoreServiceBindingMutex.Lock()
+	defer fake.restoreServiceBindingMutex.Unlock()
+	fake.RestoreServiceBindingStub = stub
+}
+
+func (fake *FakeRotateServiceKeyActor) RestoreServiceBindingArgsForCall(i int) v2action.ServiceBindingReplacement {
+	fake.restoreServiceBindingMutex.RLock()
+	defer fake.restoreServiceBindingMutex.RUnlock()
+	argsForCall := fake.restoreServiceBindingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRotateServiceKeyActor) RestoreServiceBindingReturns(result1 v2action.Warnings, result2 error) {
+	fake.restoreServiceBindingMutex.Lock()
+	defer fake.restoreServiceBindingMutex.Unlock()
+	fake.RestoreServiceBindingStub = nil
+	fake.restoreServiceBindingReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRotateServiceKeyActor) RestoreServiceBindingReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.restoreServiceBindingMutex.Lock()
+	defer fake.restoreServiceBindingMutex.Unlock()
+	fake.RestoreServiceBindingStub = nil
+	if fake.restoreServiceBindingReturnsOnCall == nil {
+		fake.restoreServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.restoreServiceBindingReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRotateServiceKeyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createSuccessorServiceKeyMutex.RLock()
+	defer fake.createSuccessorServiceKeyMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.rebindServiceInstanceToApplicationMutex.RLock()
+	defer fake.rebindServiceInstanceToApplicationMutex.RUnlock()
+	fake.restageApplicationMutex.RLock()
+	defer fake.restageApplicationMutex.RUnlock()
+	fake.restartApplicationMutex.RLock()
+	defer fake.restartApplicationMutex.RUnlock()
+	fake.restoreServiceBindingMutex.RLock()
+	defer fake.restoreServiceBindingMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRotateServiceKeyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.RotateServiceKeyActor = new(FakeRotateServiceKeyActor)
@@ -0,0 +1,206 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeRoutesActor struct {
+	GetOrganizationRouteSummariesStub        func(string) ([]v2action.RouteSummary, v2action.Warnings, error)
+	getOrganizationRouteSummariesMutex       sync.RWMutex
+	getOrganizationRouteSummariesArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationRouteSummariesReturns struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationRouteSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceRouteSummariesStub        func(string, string) ([]v2action.RouteSummary, v2action.Warnings, error)
+	getSpaceRouteSummariesMutex       sync.RWMutex
+	getSpaceRouteSummariesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceRouteSummariesReturns struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRouteSummariesReturnsOnCall map[int]struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummaries(arg1 string) ([]v2action.RouteSummary, v2action.Warnings, error) {
+	fake.getOrganizationRouteSummariesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationRouteSummariesReturnsOnCall[len(fake.getOrganizationRouteSummariesArgsForCall)]
+	fake.getOrganizationRouteSummariesArgsForCall = append(fake.getOrganizationRouteSummariesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationRouteSummaries", []interface{}{arg1})
+	fake.getOrganizationRouteSummariesMutex.Unlock()
+	if fake.GetOrganizationRouteSummariesStub != nil {
+		return fake.GetOrganizationRouteSummariesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationRouteSummariesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesCallCount() int {
+	fake.getOrganizationRouteSummariesMutex.RLock()
+	defer fake.getOrganizationRouteSummariesMutex.RUnlock()
+	return len(fake.getOrganizationRouteSummariesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesCalls(stub func(string) ([]v2action.RouteSummary, v2action.Warnings, error)) {
+	fake.getOrganizationRouteSummariesMutex.Lock()
+	defer fake.getOrganizationRouteSummariesMutex.Unlock()
+	fake.GetOrganizationRouteSummariesStub = stub
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesArgsForCall(i int) string {
+	fake.getOrganizationRouteSummariesMutex.RLock()
+	defer fake.getOrganizationRouteSummariesMutex.RUnlock()
+	argsForCall := fake.getOrganizationRouteSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesReturns(result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationRouteSummariesMutex.Lock()
+	defer fake.getOrganizationRouteSummariesMutex.Unlock()
+	fake.GetOrganizationRouteSummariesStub = nil
+	fake.getOrganizationRouteSummariesReturns = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetOrganizationRouteSummariesReturnsOnCall(i int, result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationRouteSummariesMutex.Lock()
+	defer fake.getOrganizationRouteSummariesMutex.Unlock()
+	fake.GetOrganizationRouteSummariesStub = nil
+	if fake.getOrganizationRouteSummariesReturnsOnCall == nil {
+		fake.getOrganizationRouteSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.RouteSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationRouteSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummaries(arg1 string, arg2 string) ([]v2action.RouteSummary, v2action.Warnings, error) {
+	fake.getSpaceRouteSummariesMutex.Lock()
+	ret, specificReturn := fake.getSpaceRouteSummariesReturnsOnCall[len(fake.getSpaceRouteSummariesArgsForCall)]
+	fake.getSpaceRouteSummariesArgsForCall = append(fake.getSpaceRouteSummariesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetSpaceRouteSummaries", []interface{}{arg1, arg2})
+	fake.getSpaceRouteSummariesMutex.Unlock()
+	if fake.GetSpaceRouteSummariesStub != nil {
+		return fake.GetSpaceRouteSummariesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceRouteSummariesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesCallCount() int {
+	fake.getSpaceRouteSummariesMutex.RLock()
+	defer fake.getSpaceRouteSummariesMutex.RUnlock()
+	return len(fake.getSpaceRouteSummariesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesCalls(stub func(string, string) ([]v2action.RouteSummary, v2action.Warnings, error)) {
+	fake.getSpaceRouteSummariesMutex.Lock()
+	defer fake.getSpaceRouteSummariesMutex.Unlock()
+	fake.GetSpaceRouteSummariesStub = stub
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesArgsForCall(i int) (string, string) {
+	fake.getSpaceRouteSummariesMutex.RLock()
+	defer fake.getSpaceRouteSummariesMutex.RUnlock()
+	argsForCall := fake.getSpaceRouteSummariesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesReturns(result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceRouteSummariesMutex.Lock()
+	defer fake.getSpaceRouteSummariesMutex.Unlock()
+	fake.GetSpaceRouteSummariesStub = nil
+	fake.getSpaceRouteSummariesReturns = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRouteSummariesReturnsOnCall(i int, result1 []v2action.RouteSummary, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceRouteSummariesMutex.Lock()
+	defer fake.getSpaceRouteSummariesMutex.Unlock()
+	fake.GetSpaceRouteSummariesStub = nil
+	if fake.getSpaceRouteSummariesReturnsOnCall == nil {
+		fake.getSpaceRouteSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.RouteSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRouteSummariesReturnsOnCall[i] = struct {
+		result1 []v2action.RouteSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationRouteSummariesMutex.RLock()
+	defer fake.getOrganizationRouteSummariesMutex.RUnlock()
+	fake.getSpaceRouteSummariesMutex.RLock()
+	defer fake.getSpaceRouteSummariesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRoutesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.RoutesActor = new(FakeRoutesActor)
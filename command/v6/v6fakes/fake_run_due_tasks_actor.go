@@ -0,0 +1,202 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+	time "time"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeRunDueTasksActor struct {
+	GetDueTaskSchedulesStub        func(time.Time) ([]v3action.TaskSchedule, error)
+	getDueTaskSchedulesMutex       sync.RWMutex
+	getDueTaskSchedulesArgsForCall []struct {
+		arg1 time.Time
+	}
+	getDueTaskSchedulesReturns struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}
+	getDueTaskSchedulesReturnsOnCall map[int]struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}
+	RunTaskScheduleStub        func(v3action.TaskSchedule, time.Time) (v3action.Task, v3action.Warnings, error)
+	runTaskScheduleMutex       sync.RWMutex
+	runTaskScheduleArgsForCall []struct {
+		arg1 v3action.TaskSchedule
+		arg2 time.Time
+	}
+	runTaskScheduleReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	runTaskScheduleReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRunDueTasksActor) GetDueTaskSchedules(arg1 time.Time) ([]v3action.TaskSchedule, error) {
+	fake.getDueTaskSchedulesMutex.Lock()
+	ret, specificReturn := fake.getDueTaskSchedulesReturnsOnCall[len(fake.getDueTaskSchedulesArgsForCall)]
+	fake.getDueTaskSchedulesArgsForCall = append(fake.getDueTaskSchedulesArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("GetDueTaskSchedules", []interface{}{arg1})
+	fake.getDueTaskSchedulesMutex.Unlock()
+	if fake.GetDueTaskSchedulesStub != nil {
+		return fake.GetDueTaskSchedulesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getDueTaskSchedulesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRunDueTasksActor) GetDueTaskSchedulesCallCount() int {
+	fake.getDueTaskSchedulesMutex.RLock()
+	defer fake.getDueTaskSchedulesMutex.RUnlock()
+	return len(fake.getDueTaskSchedulesArgsForCall)
+}
+
+func (fake *FakeRunDueTasksActor) GetDueTaskSchedulesCalls(stub func(time.Time) ([]v3action.TaskSchedule, error)) {
+	fake.getDueTaskSchedulesMutex.Lock()
+	defer fake.getDueTaskSchedulesMutex.Unlock()
+	fake.GetDueTaskSchedulesStub = stub
+}
+
+func (fake *FakeRunDueTasksActor) GetDueTaskSchedulesArgsForCall(i int) time.Time {
+	fake.getDueTaskSchedulesMutex.RLock()
+	defer fake.getDueTaskSchedulesMutex.RUnlock()
+	argsForCall := fake.getDueTaskSchedulesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRunDueTasksActor) GetDueTaskSchedulesReturns(result1 []v3action.TaskSchedule, result2 error) {
+	fake.getDueTaskSchedulesMutex.Lock()
+	defer fake.getDueTaskSchedulesMutex.Unlock()
+	fake.GetDueTaskSchedulesStub = nil
+	fake.getDueTaskSchedulesReturns = struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRunDueTasksActor) GetDueTaskSchedulesReturnsOnCall(i int, result1 []v3action.TaskSchedule, result2 error) {
+	fake.getDueTaskSchedulesMutex.Lock()
+	defer fake.getDueTaskSchedulesMutex.Unlock()
+	fake.GetDueTaskSchedulesStub = nil
+	if fake.getDueTaskSchedulesReturnsOnCall == nil {
+		fake.getDueTaskSchedulesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.TaskSchedule
+			result2 error
+		})
+	}
+	fake.getDueTaskSchedulesReturnsOnCall[i] = struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRunDueTasksActor) RunTaskSchedule(arg1 v3action.TaskSchedule, arg2 time.Time) (v3action.Task, v3action.Warnings, error) {
+	fake.runTaskScheduleMutex.Lock()
+	ret, specificReturn := fake.runTaskScheduleReturnsOnCall[len(fake.runTaskScheduleArgsForCall)]
+	fake.runTaskScheduleArgsForCall = append(fake.runTaskScheduleArgsForCall, struct {
+		arg1 v3action.TaskSchedule
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("RunTaskSchedule", []interface{}{arg1, arg2})
+	fake.runTaskScheduleMutex.Unlock()
+	if fake.RunTaskScheduleStub != nil {
+		return fake.RunTaskScheduleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.runTaskScheduleReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRunDueTasksActor) RunTaskScheduleCallCount() int {
+	fake.runTaskScheduleMutex.RLock()
+	defer fake.runTaskScheduleMutex.RUnlock()
+	return len(fake.runTaskScheduleArgsForCall)
+}
+
+func (fake *FakeRunDueTasksActor) RunTaskScheduleCalls(stub func(v3action.TaskSchedule, time.Time) (v3action.Task, v3action.Warnings, error)) {
+	fake.runTaskScheduleMutex.Lock()
+	defer fake.runTaskScheduleMutex.Unlock()
+	fake.RunTaskScheduleStub = stub
+}
+
+func (fake *FakeRunDueTasksActor) RunTaskScheduleArgsForCall(i int) (v3action.TaskSchedule, time.Time) {
+	fake.runTaskScheduleMutex.RLock()
+	defer fake.runTaskScheduleMutex.RUnlock()
+	argsForCall := fake.runTaskScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRunDueTasksActor) RunTaskScheduleReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.runTaskScheduleMutex.Lock()
+	defer fake.runTaskScheduleMutex.Unlock()
+	fake.RunTaskScheduleStub = nil
+	fake.runTaskScheduleReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunDueTasksActor) RunTaskScheduleReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.runTaskScheduleMutex.Lock()
+	defer fake.runTaskScheduleMutex.Unlock()
+	fake.RunTaskScheduleStub = nil
+	if fake.runTaskScheduleReturnsOnCall == nil {
+		fake.runTaskScheduleReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.runTaskScheduleReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunDueTasksActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getDueTaskSchedulesMutex.RLock()
+	defer fake.getDueTaskSchedulesMutex.RUnlock()
+	fake.runTaskScheduleMutex.RLock()
+	defer fake.runTaskScheduleMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRunDueTasksActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.RunDueTasksActor = new(FakeRunDueTasksActor)
@@ -25,6 +25,36 @@ type FakeRunTaskActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetStreamingLogsStub        func(string, v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		arg1 string
+		arg2 v3action.NOAAClient
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsReturnsOnCall map[int]struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}
+	PollTaskStub        func(string, v3action.Task) (v3action.Task, v3action.Warnings, error)
+	pollTaskMutex       sync.RWMutex
+	pollTaskArgsForCall []struct {
+		arg1 string
+		arg2 v3action.Task
+	}
+	pollTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	pollTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	RunTaskStub        func(string, v3action.Task) (v3action.Task, v3action.Warnings, error)
 	runTaskMutex       sync.RWMutex
 	runTaskArgsForCall []struct {
@@ -112,6 +142,137 @@ func (fake *FakeRunTaskActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRunTaskActor) GetStreamingLogs(arg1 string, arg2 v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsReturnsOnCall[len(fake.getStreamingLogsArgsForCall)]
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		arg1 string
+		arg2 v3action.NOAAClient
+	}{arg1, arg2})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{arg1, arg2})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getStreamingLogsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsCalls(stub func(string, v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error)) {
+	fake.getStreamingLogsMutex.Lock()
+	defer fake.getStreamingLogsMutex.Unlock()
+	fake.GetStreamingLogsStub = stub
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsArgsForCall(i int) (string, v3action.NOAAClient) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsReturns(result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	defer fake.getStreamingLogsMutex.Unlock()
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActor) GetStreamingLogsReturnsOnCall(i int, result1 <-chan *v3action.LogMessage, result2 <-chan error) {
+	fake.getStreamingLogsMutex.Lock()
+	defer fake.getStreamingLogsMutex.Unlock()
+	fake.GetStreamingLogsStub = nil
+	if fake.getStreamingLogsReturnsOnCall == nil {
+		fake.getStreamingLogsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v3action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsReturnsOnCall[i] = struct {
+		result1 <-chan *v3action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActor) PollTask(arg1 string, arg2 v3action.Task) (v3action.Task, v3action.Warnings, error) {
+	fake.pollTaskMutex.Lock()
+	ret, specificReturn := fake.pollTaskReturnsOnCall[len(fake.pollTaskArgsForCall)]
+	fake.pollTaskArgsForCall = append(fake.pollTaskArgsForCall, struct {
+		arg1 string
+		arg2 v3action.Task
+	}{arg1, arg2})
+	fake.recordInvocation("PollTask", []interface{}{arg1, arg2})
+	fake.pollTaskMutex.Unlock()
+	if fake.PollTaskStub != nil {
+		return fake.PollTaskStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollTaskReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRunTaskActor) PollTaskCallCount() int {
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
+	return len(fake.pollTaskArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) PollTaskCalls(stub func(string, v3action.Task) (v3action.Task, v3action.Warnings, error)) {
+	fake.pollTaskMutex.Lock()
+	defer fake.pollTaskMutex.Unlock()
+	fake.PollTaskStub = stub
+}
+
+func (fake *FakeRunTaskActor) PollTaskArgsForCall(i int) (string, v3action.Task) {
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
+	argsForCall := fake.pollTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRunTaskActor) PollTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.pollTaskMutex.Lock()
+	defer fake.pollTaskMutex.Unlock()
+	fake.PollTaskStub = nil
+	fake.pollTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) PollTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.pollTaskMutex.Lock()
+	defer fake.pollTaskMutex.Unlock()
+	fake.PollTaskStub = nil
+	if fake.pollTaskReturnsOnCall == nil {
+		fake.pollTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.pollTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRunTaskActor) RunTask(arg1 string, arg2 v3action.Task) (v3action.Task, v3action.Warnings, error) {
 	fake.runTaskMutex.Lock()
 	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
@@ -184,6 +345,10 @@ func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	fake.pollTaskMutex.RLock()
+	defer fake.pollTaskMutex.RUnlock()
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,196 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeScheduleTaskActor struct {
+	CreateTaskScheduleStub        func(v3action.TaskSchedule) error
+	createTaskScheduleMutex       sync.RWMutex
+	createTaskScheduleArgsForCall []struct {
+		arg1 v3action.TaskSchedule
+	}
+	createTaskScheduleReturns struct {
+		result1 error
+	}
+	createTaskScheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetApplicationByNameAndSpaceStub        func(string, string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeScheduleTaskActor) CreateTaskSchedule(arg1 v3action.TaskSchedule) error {
+	fake.createTaskScheduleMutex.Lock()
+	ret, specificReturn := fake.createTaskScheduleReturnsOnCall[len(fake.createTaskScheduleArgsForCall)]
+	fake.createTaskScheduleArgsForCall = append(fake.createTaskScheduleArgsForCall, struct {
+		arg1 v3action.TaskSchedule
+	}{arg1})
+	fake.recordInvocation("CreateTaskSchedule", []interface{}{arg1})
+	fake.createTaskScheduleMutex.Unlock()
+	if fake.CreateTaskScheduleStub != nil {
+		return fake.CreateTaskScheduleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createTaskScheduleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeScheduleTaskActor) CreateTaskScheduleCallCount() int {
+	fake.createTaskScheduleMutex.RLock()
+	defer fake.createTaskScheduleMutex.RUnlock()
+	return len(fake.createTaskScheduleArgsForCall)
+}
+
+func (fake *FakeScheduleTaskActor) CreateTaskScheduleCalls(stub func(v3action.TaskSchedule) error) {
+	fake.createTaskScheduleMutex.Lock()
+	defer fake.createTaskScheduleMutex.Unlock()
+	fake.CreateTaskScheduleStub = stub
+}
+
+func (fake *FakeScheduleTaskActor) CreateTaskScheduleArgsForCall(i int) v3action.TaskSchedule {
+	fake.createTaskScheduleMutex.RLock()
+	defer fake.createTaskScheduleMutex.RUnlock()
+	argsForCall := fake.createTaskScheduleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeScheduleTaskActor) CreateTaskScheduleReturns(result1 error) {
+	fake.createTaskScheduleMutex.Lock()
+	defer fake.createTaskScheduleMutex.Unlock()
+	fake.CreateTaskScheduleStub = nil
+	fake.createTaskScheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduleTaskActor) CreateTaskScheduleReturnsOnCall(i int, result1 error) {
+	fake.createTaskScheduleMutex.Lock()
+	defer fake.createTaskScheduleMutex.Unlock()
+	fake.CreateTaskScheduleStub = nil
+	if fake.createTaskScheduleReturnsOnCall == nil {
+		fake.createTaskScheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createTaskScheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduleTaskActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeScheduleTaskActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeScheduleTaskActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v3action.Application, v3action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeScheduleTaskActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeScheduleTaskActor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeScheduleTaskActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeScheduleTaskActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createTaskScheduleMutex.RLock()
+	defer fake.createTaskScheduleMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeScheduleTaskActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.ScheduleTaskActor = new(FakeScheduleTaskActor)
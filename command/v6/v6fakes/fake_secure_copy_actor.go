@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+	sftp "code.cloudfoundry.org/cli/util/clissh/sftp"
+)
+
+type FakeSecureCopyActor struct {
+	ExecuteSecureCopyStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.SecureCopyOptions, sftp.ProgressBar) error
+	executeSecureCopyMutex       sync.RWMutex
+	executeSecureCopyArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 sharedaction.SecureCopyOptions
+		arg4 sftp.ProgressBar
+	}
+	executeSecureCopyReturns struct {
+		result1 error
+	}
+	executeSecureCopyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecureCopyActor) ExecuteSecureCopy(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions, arg3 sharedaction.SecureCopyOptions, arg4 sftp.ProgressBar) error {
+	fake.executeSecureCopyMutex.Lock()
+	ret, specificReturn := fake.executeSecureCopyReturnsOnCall[len(fake.executeSecureCopyArgsForCall)]
+	fake.executeSecureCopyArgsForCall = append(fake.executeSecureCopyArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 sharedaction.SecureCopyOptions
+		arg4 sftp.ProgressBar
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ExecuteSecureCopy", []interface{}{arg1, arg2, arg3, arg4})
+	fake.executeSecureCopyMutex.Unlock()
+	if fake.ExecuteSecureCopyStub != nil {
+		return fake.ExecuteSecureCopyStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureCopyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureCopyActor) ExecuteSecureCopyCallCount() int {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return len(fake.executeSecureCopyArgsForCall)
+}
+
+func (fake *FakeSecureCopyActor) ExecuteSecureCopyCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.SecureCopyOptions, sftp.ProgressBar) error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = stub
+}
+
+func (fake *FakeSecureCopyActor) ExecuteSecureCopyArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.SecureCopyOptions, sftp.ProgressBar) {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	argsForCall := fake.executeSecureCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSecureCopyActor) ExecuteSecureCopyReturns(result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	fake.executeSecureCopyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureCopyActor) ExecuteSecureCopyReturnsOnCall(i int, result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	if fake.executeSecureCopyReturnsOnCall == nil {
+		fake.executeSecureCopyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureCopyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureCopyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSecureCopyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.SecureCopyActor = new(FakeSecureCopyActor)
@@ -0,0 +1,204 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeServiceGraphActor struct {
+	GetServiceGraphByOrganizationStub        func(string) (v2action.ServiceGraph, v2action.Warnings, error)
+	getServiceGraphByOrganizationMutex       sync.RWMutex
+	getServiceGraphByOrganizationArgsForCall []struct {
+		arg1 string
+	}
+	getServiceGraphByOrganizationReturns struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceGraphByOrganizationReturnsOnCall map[int]struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceGraphBySpaceStub        func(string) (v2action.ServiceGraph, v2action.Warnings, error)
+	getServiceGraphBySpaceMutex       sync.RWMutex
+	getServiceGraphBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getServiceGraphBySpaceReturns struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceGraphBySpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphByOrganization(arg1 string) (v2action.ServiceGraph, v2action.Warnings, error) {
+	fake.getServiceGraphByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getServiceGraphByOrganizationReturnsOnCall[len(fake.getServiceGraphByOrganizationArgsForCall)]
+	fake.getServiceGraphByOrganizationArgsForCall = append(fake.getServiceGraphByOrganizationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceGraphByOrganization", []interface{}{arg1})
+	fake.getServiceGraphByOrganizationMutex.Unlock()
+	if fake.GetServiceGraphByOrganizationStub != nil {
+		return fake.GetServiceGraphByOrganizationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceGraphByOrganizationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphByOrganizationCallCount() int {
+	fake.getServiceGraphByOrganizationMutex.RLock()
+	defer fake.getServiceGraphByOrganizationMutex.RUnlock()
+	return len(fake.getServiceGraphByOrganizationArgsForCall)
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphByOrganizationCalls(stub func(string) (v2action.ServiceGraph, v2action.Warnings, error)) {
+	fake.getServiceGraphByOrganizationMutex.Lock()
+	defer fake.getServiceGraphByOrganizationMutex.Unlock()
+	fake.GetServiceGraphByOrganizationStub = stub
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphByOrganizationArgsForCall(i int) string {
+	fake.getServiceGraphByOrganizationMutex.RLock()
+	defer fake.getServiceGraphByOrganizationMutex.RUnlock()
+	argsForCall := fake.getServiceGraphByOrganizationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphByOrganizationReturns(result1 v2action.ServiceGraph, result2 v2action.Warnings, result3 error) {
+	fake.getServiceGraphByOrganizationMutex.Lock()
+	defer fake.getServiceGraphByOrganizationMutex.Unlock()
+	fake.GetServiceGraphByOrganizationStub = nil
+	fake.getServiceGraphByOrganizationReturns = struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphByOrganizationReturnsOnCall(i int, result1 v2action.ServiceGraph, result2 v2action.Warnings, result3 error) {
+	fake.getServiceGraphByOrganizationMutex.Lock()
+	defer fake.getServiceGraphByOrganizationMutex.Unlock()
+	fake.GetServiceGraphByOrganizationStub = nil
+	if fake.getServiceGraphByOrganizationReturnsOnCall == nil {
+		fake.getServiceGraphByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceGraph
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceGraphByOrganizationReturnsOnCall[i] = struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphBySpace(arg1 string) (v2action.ServiceGraph, v2action.Warnings, error) {
+	fake.getServiceGraphBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceGraphBySpaceReturnsOnCall[len(fake.getServiceGraphBySpaceArgsForCall)]
+	fake.getServiceGraphBySpaceArgsForCall = append(fake.getServiceGraphBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetServiceGraphBySpace", []interface{}{arg1})
+	fake.getServiceGraphBySpaceMutex.Unlock()
+	if fake.GetServiceGraphBySpaceStub != nil {
+		return fake.GetServiceGraphBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceGraphBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphBySpaceCallCount() int {
+	fake.getServiceGraphBySpaceMutex.RLock()
+	defer fake.getServiceGraphBySpaceMutex.RUnlock()
+	return len(fake.getServiceGraphBySpaceArgsForCall)
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphBySpaceCalls(stub func(string) (v2action.ServiceGraph, v2action.Warnings, error)) {
+	fake.getServiceGraphBySpaceMutex.Lock()
+	defer fake.getServiceGraphBySpaceMutex.Unlock()
+	fake.GetServiceGraphBySpaceStub = stub
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphBySpaceArgsForCall(i int) string {
+	fake.getServiceGraphBySpaceMutex.RLock()
+	defer fake.getServiceGraphBySpaceMutex.RUnlock()
+	argsForCall := fake.getServiceGraphBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphBySpaceReturns(result1 v2action.ServiceGraph, result2 v2action.Warnings, result3 error) {
+	fake.getServiceGraphBySpaceMutex.Lock()
+	defer fake.getServiceGraphBySpaceMutex.Unlock()
+	fake.GetServiceGraphBySpaceStub = nil
+	fake.getServiceGraphBySpaceReturns = struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceGraphActor) GetServiceGraphBySpaceReturnsOnCall(i int, result1 v2action.ServiceGraph, result2 v2action.Warnings, result3 error) {
+	fake.getServiceGraphBySpaceMutex.Lock()
+	defer fake.getServiceGraphBySpaceMutex.Unlock()
+	fake.GetServiceGraphBySpaceStub = nil
+	if fake.getServiceGraphBySpaceReturnsOnCall == nil {
+		fake.getServiceGraphBySpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceGraph
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceGraphBySpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceGraph
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceGraphActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getServiceGraphByOrganizationMutex.RLock()
+	defer fake.getServiceGraphByOrganizationMutex.RUnlock()
+	fake.getServiceGraphBySpaceMutex.RLock()
+	defer fake.getServiceGraphBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceGraphActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.ServiceGraphActor = new(FakeServiceGraphActor)
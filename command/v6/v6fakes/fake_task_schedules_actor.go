@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeTaskSchedulesActor struct {
+	GetTaskSchedulesBySpaceStub        func(string) ([]v3action.TaskSchedule, error)
+	getTaskSchedulesBySpaceMutex       sync.RWMutex
+	getTaskSchedulesBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getTaskSchedulesBySpaceReturns struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}
+	getTaskSchedulesBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskSchedulesActor) GetTaskSchedulesBySpace(arg1 string) ([]v3action.TaskSchedule, error) {
+	fake.getTaskSchedulesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getTaskSchedulesBySpaceReturnsOnCall[len(fake.getTaskSchedulesBySpaceArgsForCall)]
+	fake.getTaskSchedulesBySpaceArgsForCall = append(fake.getTaskSchedulesBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetTaskSchedulesBySpace", []interface{}{arg1})
+	fake.getTaskSchedulesBySpaceMutex.Unlock()
+	if fake.GetTaskSchedulesBySpaceStub != nil {
+		return fake.GetTaskSchedulesBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getTaskSchedulesBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskSchedulesActor) GetTaskSchedulesBySpaceCallCount() int {
+	fake.getTaskSchedulesBySpaceMutex.RLock()
+	defer fake.getTaskSchedulesBySpaceMutex.RUnlock()
+	return len(fake.getTaskSchedulesBySpaceArgsForCall)
+}
+
+func (fake *FakeTaskSchedulesActor) GetTaskSchedulesBySpaceCalls(stub func(string) ([]v3action.TaskSchedule, error)) {
+	fake.getTaskSchedulesBySpaceMutex.Lock()
+	defer fake.getTaskSchedulesBySpaceMutex.Unlock()
+	fake.GetTaskSchedulesBySpaceStub = stub
+}
+
+func (fake *FakeTaskSchedulesActor) GetTaskSchedulesBySpaceArgsForCall(i int) string {
+	fake.getTaskSchedulesBySpaceMutex.RLock()
+	defer fake.getTaskSchedulesBySpaceMutex.RUnlock()
+	argsForCall := fake.getTaskSchedulesBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskSchedulesActor) GetTaskSchedulesBySpaceReturns(result1 []v3action.TaskSchedule, result2 error) {
+	fake.getTaskSchedulesBySpaceMutex.Lock()
+	defer fake.getTaskSchedulesBySpaceMutex.Unlock()
+	fake.GetTaskSchedulesBySpaceStub = nil
+	fake.getTaskSchedulesBySpaceReturns = struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskSchedulesActor) GetTaskSchedulesBySpaceReturnsOnCall(i int, result1 []v3action.TaskSchedule, result2 error) {
+	fake.getTaskSchedulesBySpaceMutex.Lock()
+	defer fake.getTaskSchedulesBySpaceMutex.Unlock()
+	fake.GetTaskSchedulesBySpaceStub = nil
+	if fake.getTaskSchedulesBySpaceReturnsOnCall == nil {
+		fake.getTaskSchedulesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.TaskSchedule
+			result2 error
+		})
+	}
+	fake.getTaskSchedulesBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.TaskSchedule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskSchedulesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getTaskSchedulesBySpaceMutex.RLock()
+	defer fake.getTaskSchedulesBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTaskSchedulesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.TaskSchedulesActor = new(FakeTaskSchedulesActor)
@@ -3,12 +3,29 @@ package v6fakes
 
 import (
 	sync "sync"
+	time "time"
 
 	v2action "code.cloudfoundry.org/cli/actor/v2action"
 	v6 "code.cloudfoundry.org/cli/command/v6"
 )
 
 type FakeUnbindServiceActor struct {
+	PollServiceBindingOperationStub        func(string, time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)
+	pollServiceBindingOperationMutex       sync.RWMutex
+	pollServiceBindingOperationArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	pollServiceBindingOperationReturns struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}
+	pollServiceBindingOperationReturnsOnCall map[int]struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}
 	UnbindServiceBySpaceStub        func(string, string, string) (v2action.ServiceBinding, v2action.Warnings, error)
 	unbindServiceBySpaceMutex       sync.RWMutex
 	unbindServiceBySpaceArgsForCall []struct {
@@ -30,6 +47,73 @@ type FakeUnbindServiceActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUnbindServiceActor) PollServiceBindingOperation(arg1 string, arg2 time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	ret, specificReturn := fake.pollServiceBindingOperationReturnsOnCall[len(fake.pollServiceBindingOperationArgsForCall)]
+	fake.pollServiceBindingOperationArgsForCall = append(fake.pollServiceBindingOperationArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("PollServiceBindingOperation", []interface{}{arg1, arg2})
+	fake.pollServiceBindingOperationMutex.Unlock()
+	if fake.PollServiceBindingOperationStub != nil {
+		return fake.PollServiceBindingOperationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pollServiceBindingOperationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUnbindServiceActor) PollServiceBindingOperationCallCount() int {
+	fake.pollServiceBindingOperationMutex.RLock()
+	defer fake.pollServiceBindingOperationMutex.RUnlock()
+	return len(fake.pollServiceBindingOperationArgsForCall)
+}
+
+func (fake *FakeUnbindServiceActor) PollServiceBindingOperationCalls(stub func(string, time.Duration) (<-chan v2action.LastOperation, <-chan string, <-chan error)) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	defer fake.pollServiceBindingOperationMutex.Unlock()
+	fake.PollServiceBindingOperationStub = stub
+}
+
+func (fake *FakeUnbindServiceActor) PollServiceBindingOperationArgsForCall(i int) (string, time.Duration) {
+	fake.pollServiceBindingOperationMutex.RLock()
+	defer fake.pollServiceBindingOperationMutex.RUnlock()
+	argsForCall := fake.pollServiceBindingOperationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUnbindServiceActor) PollServiceBindingOperationReturns(result1 <-chan v2action.LastOperation, result2 <-chan string, result3 <-chan error) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	defer fake.pollServiceBindingOperationMutex.Unlock()
+	fake.PollServiceBindingOperationStub = nil
+	fake.pollServiceBindingOperationReturns = struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUnbindServiceActor) PollServiceBindingOperationReturnsOnCall(i int, result1 <-chan v2action.LastOperation, result2 <-chan string, result3 <-chan error) {
+	fake.pollServiceBindingOperationMutex.Lock()
+	defer fake.pollServiceBindingOperationMutex.Unlock()
+	fake.PollServiceBindingOperationStub = nil
+	if fake.pollServiceBindingOperationReturnsOnCall == nil {
+		fake.pollServiceBindingOperationReturnsOnCall = make(map[int]struct {
+			result1 <-chan v2action.LastOperation
+			result2 <-chan string
+			result3 <-chan error
+		})
+	}
+	fake.pollServiceBindingOperationReturnsOnCall[i] = struct {
+		result1 <-chan v2action.LastOperation
+		result2 <-chan string
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeUnbindServiceActor) UnbindServiceBySpace(arg1 string, arg2 string, arg3 string) (v2action.ServiceBinding, v2action.Warnings, error) {
 	fake.unbindServiceBySpaceMutex.Lock()
 	ret, specificReturn := fake.unbindServiceBySpaceReturnsOnCall[len(fake.unbindServiceBySpaceArgsForCall)]
@@ -101,6 +185,8 @@ func (fake *FakeUnbindServiceActor) UnbindServiceBySpaceReturnsOnCall(i int, res
 func (fake *FakeUnbindServiceActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.pollServiceBindingOperationMutex.RLock()
+	defer fake.pollServiceBindingOperationMutex.RUnlock()
 	fake.unbindServiceBySpaceMutex.RLock()
 	defer fake.unbindServiceBySpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
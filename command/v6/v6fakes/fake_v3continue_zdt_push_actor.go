@@ -0,0 +1,182 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeV3ContinueZdtPushActor struct {
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct {
+	}
+	cloudControllerAPIVersionReturns struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ContinueDeploymentByAppNameAndSpaceStub        func(string, string) (v3action.Warnings, error)
+	continueDeploymentByAppNameAndSpaceMutex       sync.RWMutex
+	continueDeploymentByAppNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	continueDeploymentByAppNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	continueDeploymentByAppNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3ContinueZdtPushActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cloudControllerAPIVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3ContinueZdtPushActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeV3ContinueZdtPushActor) CloudControllerAPIVersionCalls(stub func() string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = stub
+}
+
+func (fake *FakeV3ContinueZdtPushActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV3ContinueZdtPushActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	defer fake.cloudControllerAPIVersionMutex.Unlock()
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV3ContinueZdtPushActor) ContinueDeploymentByAppNameAndSpace(arg1 string, arg2 string) (v3action.Warnings, error) {
+	fake.continueDeploymentByAppNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentByAppNameAndSpaceReturnsOnCall[len(fake.continueDeploymentByAppNameAndSpaceArgsForCall)]
+	fake.continueDeploymentByAppNameAndSpaceArgsForCall = append(fake.continueDeploymentByAppNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ContinueDeploymentByAppNameAndSpace", []interface{}{arg1, arg2})
+	fake.continueDeploymentByAppNameAndSpaceMutex.Unlock()
+	if fake.ContinueDeploymentByAppNameAndSpaceStub != nil {
+		return fake.ContinueDeploymentByAppNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentByAppNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ContinueZdtPushActor) ContinueDeploymentByAppNameAndSpaceCallCount() int {
+	fake.continueDeploymentByAppNameAndSpaceMutex.RLock()
+	defer fake.continueDeploymentByAppNameAndSpaceMutex.RUnlock()
+	return len(fake.continueDeploymentByAppNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3ContinueZdtPushActor) ContinueDeploymentByAppNameAndSpaceCalls(stub func(string, string) (v3action.Warnings, error)) {
+	fake.continueDeploymentByAppNameAndSpaceMutex.Lock()
+	defer fake.continueDeploymentByAppNameAndSpaceMutex.Unlock()
+	fake.ContinueDeploymentByAppNameAndSpaceStub = stub
+}
+
+func (fake *FakeV3ContinueZdtPushActor) ContinueDeploymentByAppNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.continueDeploymentByAppNameAndSpaceMutex.RLock()
+	defer fake.continueDeploymentByAppNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.continueDeploymentByAppNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3ContinueZdtPushActor) ContinueDeploymentByAppNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentByAppNameAndSpaceMutex.Lock()
+	defer fake.continueDeploymentByAppNameAndSpaceMutex.Unlock()
+	fake.ContinueDeploymentByAppNameAndSpaceStub = nil
+	fake.continueDeploymentByAppNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ContinueZdtPushActor) ContinueDeploymentByAppNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentByAppNameAndSpaceMutex.Lock()
+	defer fake.continueDeploymentByAppNameAndSpaceMutex.Unlock()
+	fake.ContinueDeploymentByAppNameAndSpaceStub = nil
+	if fake.continueDeploymentByAppNameAndSpaceReturnsOnCall == nil {
+		fake.continueDeploymentByAppNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentByAppNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ContinueZdtPushActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.continueDeploymentByAppNameAndSpaceMutex.RLock()
+	defer fake.continueDeploymentByAppNameAndSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV3ContinueZdtPushActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.V3ContinueZdtPushActor = new(FakeV3ContinueZdtPushActor)
@@ -0,0 +1,198 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v3action "code.cloudfoundry.org/cli/actor/v3action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeV3RollbackZdtPushActor struct {
+	PollDeploymentRollbackStub        func(string, chan<- v3action.Warnings) error
+	pollDeploymentRollbackMutex       sync.RWMutex
+	pollDeploymentRollbackArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollDeploymentRollbackReturns struct {
+		result1 error
+	}
+	pollDeploymentRollbackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RollbackDeploymentByAppNameAndSpaceStub        func(string, string) (string, v3action.Warnings, error)
+	rollbackDeploymentByAppNameAndSpaceMutex       sync.RWMutex
+	rollbackDeploymentByAppNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	rollbackDeploymentByAppNameAndSpaceReturns struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
+	rollbackDeploymentByAppNameAndSpaceReturnsOnCall map[int]struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3RollbackZdtPushActor) PollDeploymentRollback(arg1 string, arg2 chan<- v3action.Warnings) error {
+	fake.pollDeploymentRollbackMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentRollbackReturnsOnCall[len(fake.pollDeploymentRollbackArgsForCall)]
+	fake.pollDeploymentRollbackArgsForCall = append(fake.pollDeploymentRollbackArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeploymentRollback", []interface{}{arg1, arg2})
+	fake.pollDeploymentRollbackMutex.Unlock()
+	if fake.PollDeploymentRollbackStub != nil {
+		return fake.PollDeploymentRollbackStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pollDeploymentRollbackReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3RollbackZdtPushActor) PollDeploymentRollbackCallCount() int {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	return len(fake.pollDeploymentRollbackArgsForCall)
+}
+
+func (fake *FakeV3RollbackZdtPushActor) PollDeploymentRollbackCalls(stub func(string, chan<- v3action.Warnings) error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = stub
+}
+
+func (fake *FakeV3RollbackZdtPushActor) PollDeploymentRollbackArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	argsForCall := fake.pollDeploymentRollbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3RollbackZdtPushActor) PollDeploymentRollbackReturns(result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	fake.pollDeploymentRollbackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3RollbackZdtPushActor) PollDeploymentRollbackReturnsOnCall(i int, result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	if fake.pollDeploymentRollbackReturnsOnCall == nil {
+		fake.pollDeploymentRollbackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentRollbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3RollbackZdtPushActor) RollbackDeploymentByAppNameAndSpace(arg1 string, arg2 string) (string, v3action.Warnings, error) {
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentByAppNameAndSpaceReturnsOnCall[len(fake.rollbackDeploymentByAppNameAndSpaceArgsForCall)]
+	fake.rollbackDeploymentByAppNameAndSpaceArgsForCall = append(fake.rollbackDeploymentByAppNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RollbackDeploymentByAppNameAndSpace", []interface{}{arg1, arg2})
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.Unlock()
+	if fake.RollbackDeploymentByAppNameAndSpaceStub != nil {
+		return fake.RollbackDeploymentByAppNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.rollbackDeploymentByAppNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV3RollbackZdtPushActor) RollbackDeploymentByAppNameAndSpaceCallCount() int {
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.RLock()
+	defer fake.rollbackDeploymentByAppNameAndSpaceMutex.RUnlock()
+	return len(fake.rollbackDeploymentByAppNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3RollbackZdtPushActor) RollbackDeploymentByAppNameAndSpaceCalls(stub func(string, string) (string, v3action.Warnings, error)) {
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.Lock()
+	defer fake.rollbackDeploymentByAppNameAndSpaceMutex.Unlock()
+	fake.RollbackDeploymentByAppNameAndSpaceStub = stub
+}
+
+func (fake *FakeV3RollbackZdtPushActor) RollbackDeploymentByAppNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.RLock()
+	defer fake.rollbackDeploymentByAppNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentByAppNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3RollbackZdtPushActor) RollbackDeploymentByAppNameAndSpaceReturns(result1 string, result2 v3action.Warnings, result3 error) {
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.Lock()
+	defer fake.rollbackDeploymentByAppNameAndSpaceMutex.Unlock()
+	fake.RollbackDeploymentByAppNameAndSpaceStub = nil
+	fake.rollbackDeploymentByAppNameAndSpaceReturns = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RollbackZdtPushActor) RollbackDeploymentByAppNameAndSpaceReturnsOnCall(i int, result1 string, result2 v3action.Warnings, result3 error) {
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.Lock()
+	defer fake.rollbackDeploymentByAppNameAndSpaceMutex.Unlock()
+	fake.RollbackDeploymentByAppNameAndSpaceStub = nil
+	if fake.rollbackDeploymentByAppNameAndSpaceReturnsOnCall == nil {
+		fake.rollbackDeploymentByAppNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.rollbackDeploymentByAppNameAndSpaceReturnsOnCall[i] = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3RollbackZdtPushActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	fake.rollbackDeploymentByAppNameAndSpaceMutex.RLock()
+	defer fake.rollbackDeploymentByAppNameAndSpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV3RollbackZdtPushActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.V3RollbackZdtPushActor = new(FakeV3RollbackZdtPushActor)
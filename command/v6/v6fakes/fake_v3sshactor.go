@@ -4,11 +4,24 @@ package v6fakes
 import (
 	sync "sync"
 
+	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
 	v3action "code.cloudfoundry.org/cli/actor/v3action"
 	v6 "code.cloudfoundry.org/cli/command/v6"
 )
 
 type FakeV3SSHActor struct {
+	GetSSHPasscodeStub        func() (string, error)
+	getSSHPasscodeMutex       sync.RWMutex
+	getSSHPasscodeArgsForCall []struct {
+	}
+	getSSHPasscodeReturns struct {
+		result1 string
+		result2 error
+	}
+	getSSHPasscodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub        func(string, string, string, uint) (v3action.SSHAuthentication, v3action.Warnings, error)
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
@@ -27,10 +40,84 @@ type FakeV3SSHActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub        func(string, string, string) (v3action.SSHAuthentication, []sharedaction.SSHInstance, v3action.Warnings, error)
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex       sync.RWMutex
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns struct {
+		result1 v3action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v3action.Warnings
+		result4 error
+	}
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall map[int]struct {
+		result1 v3action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v3action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3SSHActor) GetSSHPasscode() (string, error) {
+	fake.getSSHPasscodeMutex.Lock()
+	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
+	fake.getSSHPasscodeArgsForCall = append(fake.getSSHPasscodeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetSSHPasscode", []interface{}{})
+	fake.getSSHPasscodeMutex.Unlock()
+	if fake.GetSSHPasscodeStub != nil {
+		return fake.GetSSHPasscodeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getSSHPasscodeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3SSHActor) GetSSHPasscodeCallCount() int {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return len(fake.getSSHPasscodeArgsForCall)
+}
+
+func (fake *FakeV3SSHActor) GetSSHPasscodeCalls(stub func() (string, error)) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = stub
+}
+
+func (fake *FakeV3SSHActor) GetSSHPasscodeReturns(result1 string, result2 error) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = nil
+	fake.getSSHPasscodeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3SSHActor) GetSSHPasscodeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = nil
+	if fake.getSSHPasscodeReturnsOnCall == nil {
+		fake.getSSHPasscodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getSSHPasscodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3SSHActor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(arg1 string, arg2 string, arg3 string, arg4 uint) (v3action.SSHAuthentication, v3action.Warnings, error) {
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
 	ret, specificReturn := fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
@@ -100,11 +187,86 @@ func (fake *FakeV3SSHActor) GetSecureShellConfigurationByApplicationNameSpacePro
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType(arg1 string, arg2 string, arg3 string) (v3action.SSHAuthentication, []sharedaction.SSHInstance, v3action.Warnings, error) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall[len(fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall)]
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall = append(fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType", []interface{}{arg1, arg2, arg3})
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	if fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub != nil {
+		return fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeCallCount() int {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall)
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeCalls(stub func(string, string, string) (v3action.SSHAuthentication, []sharedaction.SSHInstance, v3action.Warnings, error)) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub = stub
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(i int) (string, string, string) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns(result1 v3action.SSHAuthentication, result2 []sharedaction.SSHInstance, result3 v3action.Warnings, result4 error) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub = nil
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns = struct {
+		result1 v3action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v3action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeV3SSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall(i int, result1 v3action.SSHAuthentication, result2 []sharedaction.SSHInstance, result3 v3action.Warnings, result4 error) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub = nil
+	if fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall == nil {
+		fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall = make(map[int]struct {
+			result1 v3action.SSHAuthentication
+			result2 []sharedaction.SSHInstance
+			result3 v3action.Warnings
+			result4 error
+		})
+	}
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall[i] = struct {
+		result1 v3action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v3action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeV3SSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -9,6 +9,19 @@ import (
 )
 
 type FakeV3ZeroDowntimeRestartActor struct {
+	CancelDeploymentStub        func(string) (v3action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	cancelDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct {
@@ -19,6 +32,36 @@ type FakeV3ZeroDowntimeRestartActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ContinueDeploymentStub        func(string) (v3action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	CreateCanaryDeploymentStub        func(string, string, int) (string, v3action.Warnings, error)
+	createCanaryDeploymentMutex       sync.RWMutex
+	createCanaryDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	createCanaryDeploymentReturns struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
+	createCanaryDeploymentReturnsOnCall map[int]struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
 	CreateDeploymentStub        func(string, string) (string, v3action.Warnings, error)
 	createDeploymentMutex       sync.RWMutex
 	createDeploymentArgsForCall []struct {
@@ -51,6 +94,43 @@ type FakeV3ZeroDowntimeRestartActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	PollCanaryDeploymentStub        func(string, chan<- v3action.Warnings) (bool, error)
+	pollCanaryDeploymentMutex       sync.RWMutex
+	pollCanaryDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollCanaryDeploymentReturns struct {
+		result1 bool
+		result2 error
+	}
+	pollCanaryDeploymentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PollDeploymentRollbackStub        func(string, chan<- v3action.Warnings) error
+	pollDeploymentRollbackMutex       sync.RWMutex
+	pollDeploymentRollbackArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollDeploymentRollbackReturns struct {
+		result1 error
+	}
+	pollDeploymentRollbackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCanaryProbeStub        func(v3action.CanaryProbe) error
+	runCanaryProbeMutex       sync.RWMutex
+	runCanaryProbeArgsForCall []struct {
+		arg1 v3action.CanaryProbe
+	}
+	runCanaryProbeReturns struct {
+		result1 error
+	}
+	runCanaryProbeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartApplicationStub        func(string) (v3action.Application, v3action.Warnings, error)
 	startApplicationMutex       sync.RWMutex
 	startApplicationArgsForCall []struct {
@@ -82,6 +162,69 @@ type FakeV3ZeroDowntimeRestartActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3ZeroDowntimeRestartActor) CancelDeployment(arg1 string) (v3action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CancelDeployment", []interface{}{arg1})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cancelDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CancelDeploymentCalls(stub func(string) (v3action.Warnings, error)) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	argsForCall := fake.cancelDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CancelDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CancelDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3ZeroDowntimeRestartActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -134,6 +277,137 @@ func (fake *FakeV3ZeroDowntimeRestartActor) CloudControllerAPIVersionReturnsOnCa
 	}{result1}
 }
 
+func (fake *FakeV3ZeroDowntimeRestartActor) ContinueDeployment(arg1 string) (v3action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) ContinueDeploymentCalls(stub func(string) (v3action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) ContinueDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) ContinueDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CreateCanaryDeployment(arg1 string, arg2 string, arg3 int) (string, v3action.Warnings, error) {
+	fake.createCanaryDeploymentMutex.Lock()
+	ret, specificReturn := fake.createCanaryDeploymentReturnsOnCall[len(fake.createCanaryDeploymentArgsForCall)]
+	fake.createCanaryDeploymentArgsForCall = append(fake.createCanaryDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateCanaryDeployment", []interface{}{arg1, arg2, arg3})
+	fake.createCanaryDeploymentMutex.Unlock()
+	if fake.CreateCanaryDeploymentStub != nil {
+		return fake.CreateCanaryDeploymentStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createCanaryDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CreateCanaryDeploymentCallCount() int {
+	fake.createCanaryDeploymentMutex.RLock()
+	defer fake.createCanaryDeploymentMutex.RUnlock()
+	return len(fake.createCanaryDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CreateCanaryDeploymentCalls(stub func(string, string, int) (string, v3action.Warnings, error)) {
+	fake.createCanaryDeploymentMutex.Lock()
+	defer fake.createCanaryDeploymentMutex.Unlock()
+	fake.CreateCanaryDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CreateCanaryDeploymentArgsForCall(i int) (string, string, int) {
+	fake.createCanaryDeploymentMutex.RLock()
+	defer fake.createCanaryDeploymentMutex.RUnlock()
+	argsForCall := fake.createCanaryDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CreateCanaryDeploymentReturns(result1 string, result2 v3action.Warnings, result3 error) {
+	fake.createCanaryDeploymentMutex.Lock()
+	defer fake.createCanaryDeploymentMutex.Unlock()
+	fake.CreateCanaryDeploymentStub = nil
+	fake.createCanaryDeploymentReturns = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) CreateCanaryDeploymentReturnsOnCall(i int, result1 string, result2 v3action.Warnings, result3 error) {
+	fake.createCanaryDeploymentMutex.Lock()
+	defer fake.createCanaryDeploymentMutex.Unlock()
+	fake.CreateCanaryDeploymentStub = nil
+	if fake.createCanaryDeploymentReturnsOnCall == nil {
+		fake.createCanaryDeploymentReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createCanaryDeploymentReturnsOnCall[i] = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3ZeroDowntimeRestartActor) CreateDeployment(arg1 string, arg2 string) (string, v3action.Warnings, error) {
 	fake.createDeploymentMutex.Lock()
 	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
@@ -268,6 +542,191 @@ func (fake *FakeV3ZeroDowntimeRestartActor) GetApplicationByNameAndSpaceReturnsO
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3ZeroDowntimeRestartActor) PollCanaryDeployment(arg1 string, arg2 chan<- v3action.Warnings) (bool, error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollCanaryDeploymentReturnsOnCall[len(fake.pollCanaryDeploymentArgsForCall)]
+	fake.pollCanaryDeploymentArgsForCall = append(fake.pollCanaryDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollCanaryDeployment", []interface{}{arg1, arg2})
+	fake.pollCanaryDeploymentMutex.Unlock()
+	if fake.PollCanaryDeploymentStub != nil {
+		return fake.PollCanaryDeploymentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollCanaryDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollCanaryDeploymentCallCount() int {
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	return len(fake.pollCanaryDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollCanaryDeploymentCalls(stub func(string, chan<- v3action.Warnings) (bool, error)) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollCanaryDeploymentArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	argsForCall := fake.pollCanaryDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollCanaryDeploymentReturns(result1 bool, result2 error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = nil
+	fake.pollCanaryDeploymentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollCanaryDeploymentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = nil
+	if fake.pollCanaryDeploymentReturnsOnCall == nil {
+		fake.pollCanaryDeploymentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pollCanaryDeploymentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollDeploymentRollback(arg1 string, arg2 chan<- v3action.Warnings) error {
+	fake.pollDeploymentRollbackMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentRollbackReturnsOnCall[len(fake.pollDeploymentRollbackArgsForCall)]
+	fake.pollDeploymentRollbackArgsForCall = append(fake.pollDeploymentRollbackArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeploymentRollback", []interface{}{arg1, arg2})
+	fake.pollDeploymentRollbackMutex.Unlock()
+	if fake.PollDeploymentRollbackStub != nil {
+		return fake.PollDeploymentRollbackStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pollDeploymentRollbackReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollDeploymentRollbackCallCount() int {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	return len(fake.pollDeploymentRollbackArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollDeploymentRollbackCalls(stub func(string, chan<- v3action.Warnings) error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollDeploymentRollbackArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	argsForCall := fake.pollDeploymentRollbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollDeploymentRollbackReturns(result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	fake.pollDeploymentRollbackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) PollDeploymentRollbackReturnsOnCall(i int, result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	if fake.pollDeploymentRollbackReturnsOnCall == nil {
+		fake.pollDeploymentRollbackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentRollbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) RunCanaryProbe(arg1 v3action.CanaryProbe) error {
+	fake.runCanaryProbeMutex.Lock()
+	ret, specificReturn := fake.runCanaryProbeReturnsOnCall[len(fake.runCanaryProbeArgsForCall)]
+	fake.runCanaryProbeArgsForCall = append(fake.runCanaryProbeArgsForCall, struct {
+		arg1 v3action.CanaryProbe
+	}{arg1})
+	fake.recordInvocation("RunCanaryProbe", []interface{}{arg1})
+	fake.runCanaryProbeMutex.Unlock()
+	if fake.RunCanaryProbeStub != nil {
+		return fake.RunCanaryProbeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runCanaryProbeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) RunCanaryProbeCallCount() int {
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	return len(fake.runCanaryProbeArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) RunCanaryProbeCalls(stub func(v3action.CanaryProbe) error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) RunCanaryProbeArgsForCall(i int) v3action.CanaryProbe {
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	argsForCall := fake.runCanaryProbeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) RunCanaryProbeReturns(result1 error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = nil
+	fake.runCanaryProbeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3ZeroDowntimeRestartActor) RunCanaryProbeReturnsOnCall(i int, result1 error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = nil
+	if fake.runCanaryProbeReturnsOnCall == nil {
+		fake.runCanaryProbeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCanaryProbeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3ZeroDowntimeRestartActor) StartApplication(arg1 string) (v3action.Application, v3action.Warnings, error) {
 	fake.startApplicationMutex.Lock()
 	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
@@ -398,12 +857,24 @@ func (fake *FakeV3ZeroDowntimeRestartActor) ZeroDowntimePollStartReturnsOnCall(i
 func (fake *FakeV3ZeroDowntimeRestartActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	fake.createCanaryDeploymentMutex.RLock()
+	defer fake.createCanaryDeploymentMutex.RUnlock()
 	fake.createDeploymentMutex.RLock()
 	defer fake.createDeploymentMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
 	fake.zeroDowntimePollStartMutex.RLock()
@@ -9,6 +9,19 @@ import (
 )
 
 type FakeV3ZeroDowntimeVersionActor struct {
+	CancelDeploymentStub        func(string) (v3action.Warnings, error)
+	cancelDeploymentMutex       sync.RWMutex
+	cancelDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	cancelDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	cancelDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct {
@@ -19,6 +32,19 @@ type FakeV3ZeroDowntimeVersionActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ContinueDeploymentStub        func(string) (v3action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	CreateAndUploadBitsPackageByApplicationNameAndSpaceStub        func(string, string, string) (v3action.Package, v3action.Warnings, error)
 	createAndUploadBitsPackageByApplicationNameAndSpaceMutex       sync.RWMutex
 	createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall []struct {
@@ -52,6 +78,23 @@ type FakeV3ZeroDowntimeVersionActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	CreateCanaryDeploymentStub        func(string, string, int) (string, v3action.Warnings, error)
+	createCanaryDeploymentMutex       sync.RWMutex
+	createCanaryDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	createCanaryDeploymentReturns struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
+	createCanaryDeploymentReturnsOnCall map[int]struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}
 	CreateDeploymentStub        func(string, string) (string, v3action.Warnings, error)
 	createDeploymentMutex       sync.RWMutex
 	createDeploymentArgsForCall []struct {
@@ -135,6 +178,20 @@ type FakeV3ZeroDowntimeVersionActor struct {
 		result3 v3action.Warnings
 		result4 error
 	}
+	PollCanaryDeploymentStub        func(string, chan<- v3action.Warnings) (bool, error)
+	pollCanaryDeploymentMutex       sync.RWMutex
+	pollCanaryDeploymentArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollCanaryDeploymentReturns struct {
+		result1 bool
+		result2 error
+	}
+	pollCanaryDeploymentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PollDeploymentStub        func(string, chan<- v3action.Warnings) error
 	pollDeploymentMutex       sync.RWMutex
 	pollDeploymentArgsForCall []struct {
@@ -147,6 +204,18 @@ type FakeV3ZeroDowntimeVersionActor struct {
 	pollDeploymentReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PollDeploymentRollbackStub        func(string, chan<- v3action.Warnings) error
+	pollDeploymentRollbackMutex       sync.RWMutex
+	pollDeploymentRollbackArgsForCall []struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}
+	pollDeploymentRollbackReturns struct {
+		result1 error
+	}
+	pollDeploymentRollbackReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PollStartStub        func(string, chan<- v3action.Warnings) error
 	pollStartMutex       sync.RWMutex
 	pollStartArgsForCall []struct {
@@ -172,6 +241,17 @@ type FakeV3ZeroDowntimeVersionActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	RunCanaryProbeStub        func(v3action.CanaryProbe) error
+	runCanaryProbeMutex       sync.RWMutex
+	runCanaryProbeArgsForCall []struct {
+		arg1 v3action.CanaryProbe
+	}
+	runCanaryProbeReturns struct {
+		result1 error
+	}
+	runCanaryProbeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetApplicationDropletByApplicationNameAndSpaceStub        func(string, string, string) (v3action.Warnings, error)
 	setApplicationDropletByApplicationNameAndSpaceMutex       sync.RWMutex
 	setApplicationDropletByApplicationNameAndSpaceArgsForCall []struct {
@@ -234,6 +314,69 @@ type FakeV3ZeroDowntimeVersionActor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV3ZeroDowntimeVersionActor) CancelDeployment(arg1 string) (v3action.Warnings, error) {
+	fake.cancelDeploymentMutex.Lock()
+	ret, specificReturn := fake.cancelDeploymentReturnsOnCall[len(fake.cancelDeploymentArgsForCall)]
+	fake.cancelDeploymentArgsForCall = append(fake.cancelDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CancelDeployment", []interface{}{arg1})
+	fake.cancelDeploymentMutex.Unlock()
+	if fake.CancelDeploymentStub != nil {
+		return fake.CancelDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cancelDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CancelDeploymentCallCount() int {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	return len(fake.cancelDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CancelDeploymentCalls(stub func(string) (v3action.Warnings, error)) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CancelDeploymentArgsForCall(i int) string {
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
+	argsForCall := fake.cancelDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CancelDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	fake.cancelDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CancelDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.cancelDeploymentMutex.Lock()
+	defer fake.cancelDeploymentMutex.Unlock()
+	fake.CancelDeploymentStub = nil
+	if fake.cancelDeploymentReturnsOnCall == nil {
+		fake.cancelDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.cancelDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3ZeroDowntimeVersionActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -286,6 +429,69 @@ func (fake *FakeV3ZeroDowntimeVersionActor) CloudControllerAPIVersionReturnsOnCa
 	}{result1}
 }
 
+func (fake *FakeV3ZeroDowntimeVersionActor) ContinueDeployment(arg1 string) (v3action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if fake.ContinueDeploymentStub != nil {
+		return fake.ContinueDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.continueDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) ContinueDeploymentCalls(stub func(string) (v3action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) ContinueDeploymentReturns(result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) ContinueDeploymentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3ZeroDowntimeVersionActor) CreateAndUploadBitsPackageByApplicationNameAndSpace(arg1 string, arg2 string, arg3 string) (v3action.Package, v3action.Warnings, error) {
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createAndUploadBitsPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadBitsPackageByApplicationNameAndSpaceArgsForCall)]
@@ -421,6 +627,74 @@ func (fake *FakeV3ZeroDowntimeVersionActor) CreateApplicationInSpaceReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3ZeroDowntimeVersionActor) CreateCanaryDeployment(arg1 string, arg2 string, arg3 int) (string, v3action.Warnings, error) {
+	fake.createCanaryDeploymentMutex.Lock()
+	ret, specificReturn := fake.createCanaryDeploymentReturnsOnCall[len(fake.createCanaryDeploymentArgsForCall)]
+	fake.createCanaryDeploymentArgsForCall = append(fake.createCanaryDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateCanaryDeployment", []interface{}{arg1, arg2, arg3})
+	fake.createCanaryDeploymentMutex.Unlock()
+	if fake.CreateCanaryDeploymentStub != nil {
+		return fake.CreateCanaryDeploymentStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createCanaryDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CreateCanaryDeploymentCallCount() int {
+	fake.createCanaryDeploymentMutex.RLock()
+	defer fake.createCanaryDeploymentMutex.RUnlock()
+	return len(fake.createCanaryDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CreateCanaryDeploymentCalls(stub func(string, string, int) (string, v3action.Warnings, error)) {
+	fake.createCanaryDeploymentMutex.Lock()
+	defer fake.createCanaryDeploymentMutex.Unlock()
+	fake.CreateCanaryDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CreateCanaryDeploymentArgsForCall(i int) (string, string, int) {
+	fake.createCanaryDeploymentMutex.RLock()
+	defer fake.createCanaryDeploymentMutex.RUnlock()
+	argsForCall := fake.createCanaryDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CreateCanaryDeploymentReturns(result1 string, result2 v3action.Warnings, result3 error) {
+	fake.createCanaryDeploymentMutex.Lock()
+	defer fake.createCanaryDeploymentMutex.Unlock()
+	fake.CreateCanaryDeploymentStub = nil
+	fake.createCanaryDeploymentReturns = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) CreateCanaryDeploymentReturnsOnCall(i int, result1 string, result2 v3action.Warnings, result3 error) {
+	fake.createCanaryDeploymentMutex.Lock()
+	defer fake.createCanaryDeploymentMutex.Unlock()
+	fake.CreateCanaryDeploymentStub = nil
+	if fake.createCanaryDeploymentReturnsOnCall == nil {
+		fake.createCanaryDeploymentReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.createCanaryDeploymentReturnsOnCall[i] = struct {
+		result1 string
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3ZeroDowntimeVersionActor) CreateDeployment(arg1 string, arg2 string) (string, v3action.Warnings, error) {
 	fake.createDeploymentMutex.Lock()
 	ret, specificReturn := fake.createDeploymentReturnsOnCall[len(fake.createDeploymentArgsForCall)]
@@ -760,6 +1034,70 @@ func (fake *FakeV3ZeroDowntimeVersionActor) GetStreamingLogsForApplicationByName
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeV3ZeroDowntimeVersionActor) PollCanaryDeployment(arg1 string, arg2 chan<- v3action.Warnings) (bool, error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollCanaryDeploymentReturnsOnCall[len(fake.pollCanaryDeploymentArgsForCall)]
+	fake.pollCanaryDeploymentArgsForCall = append(fake.pollCanaryDeploymentArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollCanaryDeployment", []interface{}{arg1, arg2})
+	fake.pollCanaryDeploymentMutex.Unlock()
+	if fake.PollCanaryDeploymentStub != nil {
+		return fake.PollCanaryDeploymentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pollCanaryDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollCanaryDeploymentCallCount() int {
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	return len(fake.pollCanaryDeploymentArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollCanaryDeploymentCalls(stub func(string, chan<- v3action.Warnings) (bool, error)) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollCanaryDeploymentArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
+	argsForCall := fake.pollCanaryDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollCanaryDeploymentReturns(result1 bool, result2 error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = nil
+	fake.pollCanaryDeploymentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollCanaryDeploymentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pollCanaryDeploymentMutex.Lock()
+	defer fake.pollCanaryDeploymentMutex.Unlock()
+	fake.PollCanaryDeploymentStub = nil
+	if fake.pollCanaryDeploymentReturnsOnCall == nil {
+		fake.pollCanaryDeploymentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pollCanaryDeploymentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3ZeroDowntimeVersionActor) PollDeployment(arg1 string, arg2 chan<- v3action.Warnings) error {
 	fake.pollDeploymentMutex.Lock()
 	ret, specificReturn := fake.pollDeploymentReturnsOnCall[len(fake.pollDeploymentArgsForCall)]
@@ -821,6 +1159,67 @@ func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentRollback(arg1 string, arg2 chan<- v3action.Warnings) error {
+	fake.pollDeploymentRollbackMutex.Lock()
+	ret, specificReturn := fake.pollDeploymentRollbackReturnsOnCall[len(fake.pollDeploymentRollbackArgsForCall)]
+	fake.pollDeploymentRollbackArgsForCall = append(fake.pollDeploymentRollbackArgsForCall, struct {
+		arg1 string
+		arg2 chan<- v3action.Warnings
+	}{arg1, arg2})
+	fake.recordInvocation("PollDeploymentRollback", []interface{}{arg1, arg2})
+	fake.pollDeploymentRollbackMutex.Unlock()
+	if fake.PollDeploymentRollbackStub != nil {
+		return fake.PollDeploymentRollbackStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pollDeploymentRollbackReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentRollbackCallCount() int {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	return len(fake.pollDeploymentRollbackArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentRollbackCalls(stub func(string, chan<- v3action.Warnings) error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentRollbackArgsForCall(i int) (string, chan<- v3action.Warnings) {
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
+	argsForCall := fake.pollDeploymentRollbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentRollbackReturns(result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	fake.pollDeploymentRollbackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) PollDeploymentRollbackReturnsOnCall(i int, result1 error) {
+	fake.pollDeploymentRollbackMutex.Lock()
+	defer fake.pollDeploymentRollbackMutex.Unlock()
+	fake.PollDeploymentRollbackStub = nil
+	if fake.pollDeploymentRollbackReturnsOnCall == nil {
+		fake.pollDeploymentRollbackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pollDeploymentRollbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3ZeroDowntimeVersionActor) PollStart(arg1 string, arg2 chan<- v3action.Warnings) error {
 	fake.pollStartMutex.Lock()
 	ret, specificReturn := fake.pollStartReturnsOnCall[len(fake.pollStartArgsForCall)]
@@ -945,6 +1344,66 @@ func (fake *FakeV3ZeroDowntimeVersionActor) RestartApplicationReturnsOnCall(i in
 	}{result1, result2}
 }
 
+func (fake *FakeV3ZeroDowntimeVersionActor) RunCanaryProbe(arg1 v3action.CanaryProbe) error {
+	fake.runCanaryProbeMutex.Lock()
+	ret, specificReturn := fake.runCanaryProbeReturnsOnCall[len(fake.runCanaryProbeArgsForCall)]
+	fake.runCanaryProbeArgsForCall = append(fake.runCanaryProbeArgsForCall, struct {
+		arg1 v3action.CanaryProbe
+	}{arg1})
+	fake.recordInvocation("RunCanaryProbe", []interface{}{arg1})
+	fake.runCanaryProbeMutex.Unlock()
+	if fake.RunCanaryProbeStub != nil {
+		return fake.RunCanaryProbeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runCanaryProbeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) RunCanaryProbeCallCount() int {
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	return len(fake.runCanaryProbeArgsForCall)
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) RunCanaryProbeCalls(stub func(v3action.CanaryProbe) error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = stub
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) RunCanaryProbeArgsForCall(i int) v3action.CanaryProbe {
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
+	argsForCall := fake.runCanaryProbeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) RunCanaryProbeReturns(result1 error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = nil
+	fake.runCanaryProbeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeV3ZeroDowntimeVersionActor) RunCanaryProbeReturnsOnCall(i int, result1 error) {
+	fake.runCanaryProbeMutex.Lock()
+	defer fake.runCanaryProbeMutex.Unlock()
+	fake.RunCanaryProbeStub = nil
+	if fake.runCanaryProbeReturnsOnCall == nil {
+		fake.runCanaryProbeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCanaryProbeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeV3ZeroDowntimeVersionActor) SetApplicationDropletByApplicationNameAndSpace(arg1 string, arg2 string, arg3 string) (v3action.Warnings, error) {
 	fake.setApplicationDropletByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.setApplicationDropletByApplicationNameAndSpaceReturnsOnCall[len(fake.setApplicationDropletByApplicationNameAndSpaceArgsForCall)]
@@ -1207,12 +1666,18 @@ func (fake *FakeV3ZeroDowntimeVersionActor) ZeroDowntimePollStartReturnsOnCall(i
 func (fake *FakeV3ZeroDowntimeVersionActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeploymentMutex.RLock()
+	defer fake.cancelDeploymentMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createCanaryDeploymentMutex.RLock()
+	defer fake.createCanaryDeploymentMutex.RUnlock()
 	fake.createDeploymentMutex.RLock()
 	defer fake.createDeploymentMutex.RUnlock()
 	fake.createDockerPackageByApplicationNameAndSpaceMutex.RLock()
@@ -1223,12 +1688,18 @@ func (fake *FakeV3ZeroDowntimeVersionActor) Invocations() map[string][][]interfa
 	defer fake.getCurrentDropletByApplicationMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.pollCanaryDeploymentMutex.RLock()
+	defer fake.pollCanaryDeploymentMutex.RUnlock()
 	fake.pollDeploymentMutex.RLock()
 	defer fake.pollDeploymentMutex.RUnlock()
+	fake.pollDeploymentRollbackMutex.RLock()
+	defer fake.pollDeploymentRollbackMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
 	fake.restartApplicationMutex.RLock()
 	defer fake.restartApplicationMutex.RUnlock()
+	fake.runCanaryProbeMutex.RLock()
+	defer fake.runCanaryProbeMutex.RUnlock()
 	fake.setApplicationDropletByApplicationNameAndSpaceMutex.RLock()
 	defer fake.setApplicationDropletByApplicationNameAndSpaceMutex.RUnlock()
 	fake.stagePackageMutex.RLock()
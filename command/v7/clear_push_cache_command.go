@@ -0,0 +1,38 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+)
+
+//go:generate counterfeiter . BitsCache
+
+type BitsCache interface {
+	Clear() error
+}
+
+type ClearPushCacheCommand struct {
+	usage           interface{} `usage:"CF_NAME clear-push-cache"`
+	relatedCommands interface{} `related_commands:"push"`
+
+	UI        command.UI
+	BitsCache BitsCache
+}
+
+func (cmd *ClearPushCacheCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.BitsCache = sharedaction.NewBitsCache(config.BitsCacheDir())
+	return nil
+}
+
+func (cmd ClearPushCacheCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Clearing the local cache of file checksums and app archives...")
+
+	err := cmd.BitsCache.Clear()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,53 @@
+package v7_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("clear-push-cache Command", func() {
+	var (
+		cmd           ClearPushCacheCommand
+		testUI        *ui.UI
+		fakeBitsCache *v7fakes.FakeBitsCache
+		executeErr    error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeBitsCache = new(v7fakes.FakeBitsCache)
+
+		cmd = ClearPushCacheCommand{
+			UI:        testUI,
+			BitsCache: fakeBitsCache,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("clears the cache", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeBitsCache.ClearCallCount()).To(Equal(1))
+		Expect(testUI.Out).To(Say(`Clearing the local cache of file checksums and app archives\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	When("clearing the cache fails", func() {
+		BeforeEach(func() {
+			fakeBitsCache.ClearReturns(errors.New("clear-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("clear-error"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,223 @@
+package v7
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . DiffManifestActor
+
+type DiffManifestActor interface {
+	DiffApplicationManifest(appName string, rawManifest []byte, spaceGUID string) (v7action.ManifestDiff, v7action.Warnings, error)
+}
+
+type DiffManifestCommand struct {
+	OptionalArgs     flag.OptionalAppName          `positional-args:"yes"`
+	PathToManifest   flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest" required:"true"`
+	Vars             []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}                   `usage:"CF_NAME diff-manifest [APP_NAME] -f MANIFEST_PATH [--vars-file VARS_FILE_PATH]... [--var KEY=VALUE]...\n\n   Exits with a non-zero status when applying the manifest would change an app."`
+	relatedCommands  interface{}                   `related_commands:"create-app-manifest, push, v3-apply-manifest"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          DiffManifestActor
+	ManifestParser ManifestParser
+}
+
+func (cmd *DiffManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+	cmd.ManifestParser = manifestparser.NewParser()
+
+	return nil
+}
+
+func (cmd DiffManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var pathsToVarsFiles []string
+	for _, path := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
+	}
+
+	err = cmd.ManifestParser.InterpolateAndParse(string(cmd.PathToManifest), pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return err
+	}
+
+	apps, err := cmd.ManifestParser.Apps(cmd.OptionalArgs.AppName)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Diffing manifest {{.ManifestPath}} against org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ManifestPath": cmd.PathToManifest,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"SpaceName":    cmd.Config.TargetedSpace().Name,
+			"Username":     user.Name,
+		})
+	}
+
+	var diffs []v7action.ManifestDiff
+	for _, app := range apps {
+		rawManifest, manifestErr := cmd.ManifestParser.RawAppManifest(app.Name)
+		if manifestErr != nil {
+			return manifestErr
+		}
+
+		diff, warnings, diffErr := cmd.Actor.DiffApplicationManifest(app.Name, rawManifest, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if diffErr != nil {
+			return diffErr
+		}
+		diffs = append(diffs, diff)
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		err = cmd.displayDiffDocument(diffs)
+	} else {
+		err = cmd.displayDiffs(diffs)
+	}
+	if err != nil {
+		return err
+	}
+
+	var driftedApps []string
+	for _, diff := range diffs {
+		if diff.HasDrift() {
+			driftedApps = append(driftedApps, diff.AppName)
+		}
+	}
+
+	if len(driftedApps) > 0 {
+		return translatableerror.ManifestDriftError{AppNames: driftedApps}
+	}
+
+	return nil
+}
+
+func (cmd DiffManifestCommand) displayDiffs(diffs []v7action.ManifestDiff) error {
+	for _, diff := range diffs {
+		cmd.UI.DisplayNewline()
+
+		switch {
+		case !diff.AppExists:
+			cmd.UI.DisplayText("App {{.AppName}} does not exist and would be created:", map[string]interface{}{
+				"AppName": diff.AppName,
+			})
+		case diff.HasDrift():
+			cmd.UI.DisplayText("App {{.AppName}} would change:", map[string]interface{}{
+				"AppName": diff.AppName,
+			})
+		default:
+			cmd.UI.DisplayText("App {{.AppName}} matches the manifest.", map[string]interface{}{
+				"AppName": diff.AppName,
+			})
+			continue
+		}
+
+		err := cmd.UI.DisplayChangesForPush([]ui.Change{
+			{Header: "instances:", CurrentValue: diff.Current.Instances, NewValue: diff.Desired.Instances},
+			{Header: "memory:", CurrentValue: formatMemory(diff.Current), NewValue: formatMemory(diff.Desired)},
+			{Header: "env:", CurrentValue: diff.Current.Env, NewValue: diff.Desired.Env},
+			{Header: "routes:", CurrentValue: diff.Current.Routes, NewValue: diff.Desired.Routes},
+			{Header: "services:", CurrentValue: diff.Current.Services, NewValue: diff.Desired.Services},
+			{Header: "buildpacks:", CurrentValue: diff.Current.Buildpacks, NewValue: diff.Desired.Buildpacks},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type manifestDiffDocument struct {
+	Applications []appManifestDiffDocument `json:"applications" yaml:"applications"`
+}
+
+type appManifestDiffDocument struct {
+	Name    string                `json:"name" yaml:"name"`
+	Exists  bool                  `json:"exists" yaml:"exists"`
+	Drift   bool                  `json:"drift" yaml:"drift"`
+	Changes []fieldChangeDocument `json:"changes" yaml:"changes"`
+}
+
+type fieldChangeDocument struct {
+	Field   string      `json:"field" yaml:"field"`
+	Current interface{} `json:"current" yaml:"current"`
+	Desired interface{} `json:"desired" yaml:"desired"`
+}
+
+func (cmd DiffManifestCommand) displayDiffDocument(diffs []v7action.ManifestDiff) error {
+	doc := manifestDiffDocument{Applications: []appManifestDiffDocument{}}
+	for _, diff := range diffs {
+		appDoc := appManifestDiffDocument{
+			Name:    diff.AppName,
+			Exists:  diff.AppExists,
+			Drift:   diff.HasDrift(),
+			Changes: []fieldChangeDocument{},
+		}
+
+		fields := []fieldChangeDocument{
+			{Field: "instances", Current: nullIntValue(diff.Current.Instances), Desired: nullIntValue(diff.Desired.Instances)},
+			{Field: "memory", Current: formatMemory(diff.Current), Desired: formatMemory(diff.Desired)},
+			{Field: "env", Current: diff.Current.Env, Desired: diff.Desired.Env},
+			{Field: "routes", Current: diff.Current.Routes, Desired: diff.Desired.Routes},
+			{Field: "services", Current: diff.Current.Services, Desired: diff.Desired.Services},
+			{Field: "buildpacks", Current: diff.Current.Buildpacks, Desired: diff.Desired.Buildpacks},
+		}
+		for _, field := range fields {
+			if fmt.Sprint(field.Current) != fmt.Sprint(field.Desired) {
+				appDoc.Changes = append(appDoc.Changes, field)
+			}
+		}
+
+		doc.Applications = append(doc.Applications, appDoc)
+	}
+
+	return cmd.UI.DisplayStructuredOutput(doc)
+}
+
+func formatMemory(state v7action.ManifestApplicationState) string {
+	if !state.MemoryInMB.IsSet {
+		return ""
+	}
+	return fmt.Sprintf("%dM", state.MemoryInMB.Value)
+}
+
+func nullIntValue(value types.NullInt) interface{} {
+	if !value.IsSet {
+		return nil
+	}
+	return value.Value
+}
@@ -0,0 +1,210 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-manifest Command", func() {
+	var (
+		cmd                *DiffManifestCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v7fakes.FakeDiffManifestActor
+		fakeManifestParser *v7fakes.FakeManifestParser
+		binaryName         string
+		executeErr         error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDiffManifestActor)
+		fakeManifestParser = new(v7fakes.FakeManifestParser)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = &DiffManifestCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			ManifestParser: fakeManifestParser,
+			PathToManifest: "/some/path/manifest.yml",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeManifestParser.AppsReturns([]manifestparser.Application{
+				{ApplicationModel: manifestparser.ApplicationModel{Name: "some-app"}},
+			}, nil)
+			fakeManifestParser.RawAppManifestReturns([]byte("some-manifest"), nil)
+		})
+
+		It("parses the manifest and diffs each app", func() {
+			Expect(fakeManifestParser.InterpolateAndParseCallCount()).To(Equal(1))
+			path, _, _ := fakeManifestParser.InterpolateAndParseArgsForCall(0)
+			Expect(path).To(Equal("/some/path/manifest.yml"))
+
+			Expect(fakeManifestParser.RawAppManifestArgsForCall(0)).To(Equal("some-app"))
+
+			Expect(fakeActor.DiffApplicationManifestCallCount()).To(Equal(1))
+			appName, rawManifest, spaceGUID := fakeActor.DiffApplicationManifestArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(rawManifest).To(Equal([]byte("some-manifest")))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		When("the app matches the manifest", func() {
+			BeforeEach(func() {
+				state := v7action.ManifestApplicationState{Instances: types.NullInt{IsSet: true, Value: 1}}
+				fakeActor.DiffApplicationManifestReturns(
+					v7action.ManifestDiff{AppName: "some-app", AppExists: true, Current: state, Desired: state},
+					v7action.Warnings{"diff-warning"},
+					nil,
+				)
+			})
+
+			It("displays that there are no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Diffing manifest /some/path/manifest.yml against org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`App some-app matches the manifest\.`))
+				Expect(testUI.Err).To(Say("diff-warning"))
+			})
+		})
+
+		When("applying the manifest would change the app", func() {
+			BeforeEach(func() {
+				fakeActor.DiffApplicationManifestReturns(
+					v7action.ManifestDiff{
+						AppName:   "some-app",
+						AppExists: true,
+						Current: v7action.ManifestApplicationState{
+							Instances: types.NullInt{IsSet: true, Value: 1},
+							Routes:    []string{"old.example.com"},
+						},
+						Desired: v7action.ManifestApplicationState{
+							Instances: types.NullInt{IsSet: true, Value: 3},
+							Routes:    []string{"new.example.com"},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("displays the changes and returns a ManifestDriftError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{AppNames: []string{"some-app"}}))
+
+				Expect(testUI.Out).To(Say(`App some-app would change:`))
+				Expect(testUI.Out).To(Say(`-\s+instances:\s+1`))
+				Expect(testUI.Out).To(Say(`\+\s+instances:\s+3`))
+				Expect(testUI.Out).To(Say(`routes:`))
+				Expect(testUI.Out).To(Say(`\+\s+new.example.com`))
+				Expect(testUI.Out).To(Say(`-\s+old.example.com`))
+			})
+
+			When("the output format is JSON", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+				})
+
+				It("displays the changes as JSON", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{AppNames: []string{"some-app"}}))
+					Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+						"applications": [{
+							"name": "some-app",
+							"exists": true,
+							"drift": true,
+							"changes": [
+								{"field": "instances", "current": 1, "desired": 3},
+								{"field": "routes", "current": ["old.example.com"], "desired": ["new.example.com"]}
+							]
+						}]
+					}`))
+				})
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DiffApplicationManifestReturns(
+					v7action.ManifestDiff{
+						AppName: "some-app",
+						Desired: v7action.ManifestApplicationState{Instances: types.NullInt{IsSet: true, Value: 2}},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("displays that the app would be created", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{AppNames: []string{"some-app"}}))
+				Expect(testUI.Out).To(Say(`App some-app does not exist and would be created:`))
+			})
+		})
+
+		When("diffing the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.DiffApplicationManifestReturns(v7action.ManifestDiff{}, v7action.Warnings{"diff-warning"}, errors.New("diff-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("diff-error"))
+				Expect(testUI.Err).To(Say("diff-warning"))
+			})
+		})
+
+		When("parsing the manifest fails", func() {
+			BeforeEach(func() {
+				fakeManifestParser.InterpolateAndParseReturns(errors.New("parse-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("parse-error"))
+				Expect(fakeActor.DiffApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -178,6 +178,28 @@ var _ = Describe("env Command", func() {
 						Expect(testUI.Out).To(Say(`india: 1`))
 					})
 				})
+
+				When("the output format is JSON", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+					})
+
+					It("displays the environment variable groups as a JSON document", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting env variables"))
+						Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+							"app": "some-app",
+							"system": {"system-name": {"mysql": ["system-value"]}},
+							"application": {"application-name": "application-value"},
+							"user_provided": {"user-name": "user-value"},
+							"running": {"running-name": "running-value"},
+							"staging": {"staging-name": "staging-value"}
+						}`))
+
+						Expect(testUI.Err).To(Say(`{"warnings":\["get-warning-1","get-warning-2"\]}`))
+					})
+				})
 			})
 
 			When("getting the environment returns empty env vars for all groups", func() {
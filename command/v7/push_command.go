@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -45,6 +46,8 @@ type PushActor interface {
 	UpdateApplicationSettings(pushPlans []v7pushaction.PushPlan) ([]v7pushaction.PushPlan, v7pushaction.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) (<-chan v7pushaction.PushPlan, <-chan v7pushaction.Event, <-chan v7pushaction.Warnings, <-chan error)
+	// ActualizeConcurrently applies any necessary changes to several apps at the same time.
+	ActualizeConcurrently(plans []v7pushaction.PushPlan, maxInFlight int, failFast bool, progressBar v7pushaction.ProgressBar, start v7pushaction.StartFunc) <-chan v7pushaction.AppEvent
 }
 
 //go:generate counterfeiter . V7ActorForPush
@@ -71,11 +74,14 @@ type PushCommand struct {
 	Disk                    flag.Megabytes                `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	DockerImage             flag.DockerImage              `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername          string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	FailFast                bool                          `long:"fail-fast" description:"When pushing apps in parallel, do not start pushing more apps after an app fails to push"`
 	HealthCheckHTTPEndpoint string                        `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
 	HealthCheckType         flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                `long:"instances" short:"i" description:"Number of instances"`
 	PathToManifest          flag.PathWithExistenceCheck   `long:"manifest" short:"f" description:"Path to manifest"`
+	MaxInFlight             flag.PositiveInteger          `long:"max-in-flight" description:"Maximum number of apps from the manifest to push at the same time (Default: 1)"`
 	Memory                  flag.Megabytes                `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoCache                 bool                          `long:"no-cache" description:"Do not use or update the local cache of file checksums and app archives"`
 	NoManifest              bool                          `long:"no-manifest" description:""`
 	NoRoute                 bool                          `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                          `long:"no-start" description:"Do not stage and start the app after pushing"`
@@ -85,7 +91,7 @@ type PushCommand struct {
 	Vars                    []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                   `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND]\n   [-f MANIFEST_PATH | --no-manifest] [--no-start] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT]\n   [-u (process | port | http)]   [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n  CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route ] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                   `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND]\n   [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-cache] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT]\n   [-u (process | port | http)]   [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n  CF_NAME push -f MANIFEST_PATH [--max-in-flight NUM_APPS] [--fail-fast]\n \n  CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route ] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -107,6 +113,9 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.ProgressBar = progressbar.NewProgressBar()
 
 	sharedActor := sharedaction.NewActor(config)
+	if !cmd.NoCache {
+		sharedActor.BitsCache = sharedaction.NewBitsCache(config.BitsCacheDir())
+	}
 	cmd.SharedActor = sharedActor
 
 	ccClient, uaaClient, err := v6shared.NewV3BasedClients(config, ui, true, "")
@@ -213,6 +222,10 @@ func (cmd PushCommand) Execute(args []string) error {
 	}
 	log.WithField("number of plans", len(pushPlans)).Debug("completed generating plan")
 
+	if cmd.MaxInFlight.Value > 1 && len(pushPlans) > 1 {
+		return cmd.actualizeConcurrently(pushPlans)
+	}
+
 	for _, plan := range pushPlans {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
 		planStream, eventStream, warningsStream, errorStream := cmd.Actor.Actualize(plan, cmd.ProgressBar)
@@ -240,6 +253,146 @@ func (cmd PushCommand) Execute(args []string) error {
 	return nil
 }
 
+// actualizeConcurrently pushes the apps with up to --max-in-flight apps being
+// pushed at the same time. Output is prefixed with the name of the app it
+// belongs to, and staging logs are not displayed because the logs of the
+// different apps would be interleaved.
+func (cmd PushCommand) actualizeConcurrently(pushPlans []v7pushaction.PushPlan) error {
+	maxInFlight := int(cmd.MaxInFlight.Value)
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Pushing {{.NumApps}} apps, {{.MaxInFlight}} at a time...", map[string]interface{}{
+		"NumApps":     len(pushPlans),
+		"MaxInFlight": maxInFlight,
+	})
+
+	var start v7pushaction.StartFunc
+	if !cmd.NoStart {
+		start = cmd.startConcurrently
+	}
+
+	failures := map[string]error{}
+	skipped := map[string]bool{}
+	appEventStream := cmd.Actor.ActualizeConcurrently(pushPlans, maxInFlight, cmd.FailFast, noopProgressBar{}, start)
+	for appEvent := range appEventStream {
+		appName := appEvent.AppName
+		switch {
+		case appEvent.Plan != nil:
+			// The actor passes the updated plan to startConcurrently.
+		case appEvent.Err != nil:
+			cmd.displayAppText(appName, "Failed to push")
+			if _, alreadyFailed := failures[appName]; !alreadyFailed {
+				failures[appName] = translatableerror.ConvertToTranslatableError(appEvent.Err)
+			}
+		case len(appEvent.Warnings) > 0:
+			cmd.displayAppWarnings(appName, appEvent.Warnings)
+		case appEvent.Event == v7pushaction.Complete:
+			cmd.displayAppText(appName, "Pushed")
+		case appEvent.Event == v7pushaction.Skipped:
+			skipped[appName] = true
+			cmd.displayAppText(appName, "Skipped because another app failed to push")
+		default:
+			cmd.processConcurrentEvent(appName, appEvent.Event)
+		}
+	}
+
+	var pushErr translatableerror.AppsFailedToPushError
+	for _, plan := range pushPlans {
+		appName := plan.Application.Name
+		if skipped[appName] {
+			continue
+		}
+
+		err, failed := failures[appName]
+		if !failed {
+			err = cmd.displayAppSummary(plan)
+		}
+		if err != nil {
+			pushErr.Failures = append(pushErr.Failures, translatableerror.AppPushFailure{
+				AppName: appName,
+				Err:     translatableerror.ConvertToTranslatableError(err),
+			})
+		}
+	}
+
+	if len(pushErr.Failures) > 0 {
+		return pushErr
+	}
+
+	return nil
+}
+
+// startConcurrently restarts the app of an actualized plan, prefixing the
+// output with the app name. It is called from several goroutines at once.
+func (cmd PushCommand) startConcurrently(plan v7pushaction.PushPlan) error {
+	appName := plan.Application.Name
+	cmd.displayAppText(appName, "Waiting for app to start...")
+
+	warnings, anyProcessCrashed, err := cmd.restartApp(appName, plan.Application.GUID)
+	cmd.displayAppWarnings(appName, warnings)
+	switch {
+	case err != nil:
+		return err
+	case anyProcessCrashed:
+		return translatableerror.ApplicationUnableToStartError{
+			AppName:    appName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+
+	cmd.displayAppText(appName, "Started")
+	return nil
+}
+
+func (cmd PushCommand) processConcurrentEvent(appName string, event v7pushaction.Event) {
+	switch event {
+	case v7pushaction.CreatingAndMappingRoutes:
+		cmd.displayAppText(appName, "Mapping routes...")
+	case v7pushaction.CreatingArchive:
+		cmd.displayAppText(appName, "Packaging files to upload...")
+	case v7pushaction.UploadingApplicationWithArchive:
+		cmd.displayAppText(appName, "Uploading files...")
+	case v7pushaction.RetryUpload:
+		cmd.displayAppText(appName, "Retrying upload due to an error...")
+	case v7pushaction.UploadWithArchiveComplete:
+		cmd.displayAppText(appName, "Waiting for API to complete processing files...")
+	case v7pushaction.StoppingApplication:
+		cmd.displayAppText(appName, "Stopping Application...")
+	case v7pushaction.StoppingApplicationComplete:
+		cmd.displayAppText(appName, "Application Stopped")
+	case v7pushaction.StartingStaging:
+		cmd.displayAppText(appName, "Staging app...")
+	case v7pushaction.StagingComplete:
+		cmd.displayAppText(appName, "Staging complete")
+	default:
+		log.WithField("event", event).Debug("ignoring event")
+	}
+}
+
+func (cmd PushCommand) displayAppText(appName string, text string) {
+	cmd.UI.DisplayText("{{.AppName}}: {{.Text}}", map[string]interface{}{
+		"AppName": appName,
+		"Text":    cmd.UI.TranslateText(text),
+	})
+}
+
+func (cmd PushCommand) displayAppWarnings(appName string, warnings []string) {
+	for _, warning := range warnings {
+		cmd.UI.DisplayWarning("{{.AppName}}: {{.Warning}}", map[string]interface{}{
+			"AppName": appName,
+			"Warning": warning,
+		})
+	}
+}
+
+// noopProgressBar does not display any progress. It is used when pushing apps
+// concurrently, where a progress bar per upload would garble the output.
+type noopProgressBar struct{}
+
+func (noopProgressBar) NewProgressBarWrapper(reader io.Reader, _ int64) io.Reader {
+	return reader
+}
+
 func (cmd PushCommand) announcePushing(appNames []string, user configv3.User) {
 	tokens := map[string]interface{}{
 		"AppName":   strings.Join(appNames, ", "),
@@ -267,23 +420,34 @@ func (cmd PushCommand) appRestarter(appName, appGUID string) (bool, error) {
 				"AppName": appName,
 			},
 		)
-		warnings, restartErr := cmd.VersionActor.RestartApplication(appGUID)
+		var warnings v7action.Warnings
+		var err error
+		warnings, anyProcessCrashed, err = cmd.restartApp(appName, appGUID)
 		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return anyProcessCrashed, err
+		}
+	}
+	return anyProcessCrashed, nil
+}
 
-		if restartErr != nil {
-			if _, ok := restartErr.(actionerror.StartupTimeoutError); ok {
-				return anyProcessCrashed, translatableerror.StartupTimeoutError{
-					AppName:    appName,
-					BinaryName: cmd.Config.BinaryName(),
-				}
-			} else if _, ok := restartErr.(actionerror.AllInstancesCrashedError); ok {
-				anyProcessCrashed = true
-			} else {
-				return anyProcessCrashed, restartErr
+// restartApp restarts the app and reports whether all of its instances
+// crashed.
+func (cmd PushCommand) restartApp(appName, appGUID string) (v7action.Warnings, bool, error) {
+	warnings, restartErr := cmd.VersionActor.RestartApplication(appGUID)
+	if restartErr != nil {
+		if _, ok := restartErr.(actionerror.StartupTimeoutError); ok {
+			return warnings, false, translatableerror.StartupTimeoutError{
+				AppName:    appName,
+				BinaryName: cmd.Config.BinaryName(),
 			}
+		} else if _, ok := restartErr.(actionerror.AllInstancesCrashedError); ok {
+			return warnings, true, nil
+		} else {
+			return warnings, false, restartErr
 		}
 	}
-	return anyProcessCrashed, nil
+	return warnings, false, nil
 }
 
 func (cmd PushCommand) displayAppSummary(plan v7pushaction.PushPlan) error {
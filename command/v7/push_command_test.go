@@ -688,6 +688,134 @@ var _ = Describe("push Command", func() {
 											})
 										})
 									})
+
+									Describe("delegating to Actor.ActualizeConcurrently", func() {
+										var appEvents []v7pushaction.AppEvent
+
+										BeforeEach(func() {
+											cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+											cmd.FailFast = true
+
+											appEvents = []v7pushaction.AppEvent{
+												{AppName: appName1, Plan: &v7pushaction.PushPlan{Application: v7action.Application{Name: appName1, GUID: "first-app-guid"}}},
+												{AppName: appName2, Warnings: v7pushaction.Warnings{"second-app-warning"}},
+												{AppName: appName1, Event: v7pushaction.CreatingArchive},
+												{AppName: appName2, Err: errors.New("second-app-error")},
+												{AppName: appName1, Event: v7pushaction.Complete},
+											}
+											fakeActor.ActualizeConcurrentlyStub = func(_ []v7pushaction.PushPlan, _ int, _ bool, _ v7pushaction.ProgressBar, start v7pushaction.StartFunc) <-chan v7pushaction.AppEvent {
+												appEventStream := make(chan v7pushaction.AppEvent)
+												go func() {
+													defer close(appEventStream)
+													for _, appEvent := range appEvents {
+														appEventStream <- appEvent
+													}
+													if start != nil {
+														err := start(v7pushaction.PushPlan{Application: v7action.Application{Name: appName1, GUID: "first-app-guid"}})
+														if err != nil {
+															appEventStream <- v7pushaction.AppEvent{AppName: appName1, Err: err}
+														}
+													}
+												}()
+												return appEventStream
+											}
+										})
+
+										It("actualizes the plans concurrently instead of one at a time", func() {
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+											Expect(fakeActor.ActualizeConcurrentlyCallCount()).To(Equal(1))
+											plans, maxInFlight, failFast, _, start := fakeActor.ActualizeConcurrentlyArgsForCall(0)
+											Expect(plans).To(HaveLen(2))
+											Expect(maxInFlight).To(Equal(2))
+											Expect(failFast).To(BeTrue())
+											Expect(start).ToNot(BeNil())
+										})
+
+										It("displays the events prefixed with the app name", func() {
+											Expect(testUI.Out).To(Say(`Pushing 2 apps, 2 at a time\.\.\.`))
+											Expect(testUI.Out).To(Say(`first-app: Packaging files to upload\.\.\.`))
+											Expect(testUI.Out).To(Say(`second-app: Failed to push`))
+											Expect(testUI.Out).To(Say(`first-app: Pushed`))
+											Expect(testUI.Err).To(Say(`second-app: second-app-warning`))
+										})
+
+										It("displays the start output prefixed with the app name", func() {
+											Expect(testUI.Out).To(Say(`first-app: Waiting for app to start\.\.\.`))
+											Expect(testUI.Out).To(Say(`first-app: Started`))
+										})
+
+										It("restarts the apps that were pushed and displays their summary", func() {
+											Expect(fakeVersionActor.RestartApplicationCallCount()).To(Equal(1))
+											Expect(fakeVersionActor.RestartApplicationArgsForCall(0)).To(Equal("first-app-guid"))
+
+											Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+											appName, _, _, _ := fakeVersionActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+											Expect(appName).To(Equal(appName1))
+										})
+
+										It("returns the aggregated failures", func() {
+											Expect(executeErr).To(MatchError(translatableerror.AppsFailedToPushError{
+												Failures: []translatableerror.AppPushFailure{
+													{AppName: appName2, Err: errors.New("second-app-error")},
+												},
+											}))
+										})
+
+										When("an app fails to start", func() {
+											BeforeEach(func() {
+												fakeVersionActor.RestartApplicationReturns(nil, actionerror.AllInstancesCrashedError{})
+											})
+
+											It("reports the app as failed", func() {
+												Expect(executeErr).To(MatchError(translatableerror.AppsFailedToPushError{
+													Failures: []translatableerror.AppPushFailure{
+														{AppName: appName1, Err: translatableerror.ApplicationUnableToStartError{AppName: appName1, BinaryName: binaryName}},
+														{AppName: appName2, Err: errors.New("second-app-error")},
+													},
+												}))
+												Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+											})
+										})
+
+										When("--no-start is provided", func() {
+											BeforeEach(func() {
+												cmd.NoStart = true
+											})
+
+											It("does not start the apps", func() {
+												_, _, _, _, start := fakeActor.ActualizeConcurrentlyArgsForCall(0)
+												Expect(start).To(BeNil())
+												Expect(fakeVersionActor.RestartApplicationCallCount()).To(Equal(0))
+											})
+										})
+
+										When("an app is skipped", func() {
+											BeforeEach(func() {
+												appEvents = append(appEvents, v7pushaction.AppEvent{AppName: appName1, Event: v7pushaction.Skipped})
+												cmd.NoStart = true
+											})
+
+											It("does not display its summary", func() {
+												Expect(testUI.Out).To(Say(`first-app: Skipped because another app failed to push`))
+												Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+											})
+										})
+
+										When("getting the summary of an app fails", func() {
+											BeforeEach(func() {
+												fakeVersionActor.GetApplicationSummaryByNameAndSpaceReturns(v7action.ApplicationSummary{}, nil, errors.New("summary-error"))
+											})
+
+											It("adds the error to the aggregated failures", func() {
+												Expect(executeErr).To(MatchError(translatableerror.AppsFailedToPushError{
+													Failures: []translatableerror.AppPushFailure{
+														{AppName: appName1, Err: errors.New("summary-error")},
+														{AppName: appName2, Err: errors.New("second-app-error")},
+													},
+												}))
+											})
+										})
+									})
 								})
 
 								When("flag overrides are specified", func() {
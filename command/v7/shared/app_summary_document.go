@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command/document"
+)
+
+// NewAppDocument converts an application summary into a document.App.
+func NewAppDocument(summary v7action.ApplicationSummary) document.App {
+	droplet := ccv3.Droplet{
+		CreatedAt: summary.CurrentDroplet.CreatedAt,
+		Stack:     summary.CurrentDroplet.Stack,
+		Image:     summary.CurrentDroplet.Image,
+	}
+	for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+		droplet.Buildpacks = append(droplet.Buildpacks, ccv3.DropletBuildpack(buildpack))
+	}
+
+	doc := document.NewApp(summary.Name, summary.GUID, summary.State, summary.LifecycleType, droplet, summary.Routes, ProcessDocuments(summary.ProcessSummaries))
+	if name, exists := summary.GetIsolationSegmentName(); exists {
+		doc.IsolationSegment = name
+	}
+	return doc
+}
+
+// ProcessDocuments converts process summaries into process documents, with web
+// processes first.
+func ProcessDocuments(processSummaries v7action.ProcessSummaries) []document.Process {
+	processSummaries.Sort()
+
+	processes := []document.Process{}
+	for _, process := range processSummaries {
+		var instances []ccv3.ProcessInstance
+		for _, instance := range process.InstanceDetails {
+			instances = append(instances, ccv3.ProcessInstance(instance))
+		}
+		processes = append(processes, document.NewProcess(ccv3.Process(process.Process), instances))
+	}
+
+	return processes
+}
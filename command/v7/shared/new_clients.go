@@ -25,7 +25,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, newCCRetryRequest(config))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
@@ -79,7 +79,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(newUAARetryRequest(config))
 
 	err = uaaClient.SetupResources(ccClient.UAA())
 	if err != nil {
@@ -91,3 +91,23 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 
 	return ccClient, uaaClient, nil
 }
+
+func newCCRetryRequest(config command.Config) *ccWrapper.RetryRequest {
+	return ccWrapper.NewRetryRequestWithPolicy(ccWrapper.RetryPolicy{
+		MaxRetries:     config.RequestRetryCount(),
+		BaseDelay:      config.RequestRetryBaseDelay(),
+		MaxDelay:       config.RequestRetryMaxDelay(),
+		Jitter:         config.RequestRetryJitter(),
+		RetrySafePOSTs: config.RequestRetrySafePOSTs(),
+	})
+}
+
+func newUAARetryRequest(config command.Config) *uaaWrapper.RetryRequest {
+	return uaaWrapper.NewRetryRequestWithPolicy(uaaWrapper.RetryPolicy{
+		MaxRetries:     config.RequestRetryCount(),
+		BaseDelay:      config.RequestRetryBaseDelay(),
+		MaxDelay:       config.RequestRetryMaxDelay(),
+		Jitter:         config.RequestRetryJitter(),
+		RetrySafePOSTs: config.RequestRetrySafePOSTs(),
+	})
+}
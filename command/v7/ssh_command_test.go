@@ -155,6 +155,27 @@ var _ = Describe("ssh Command", func() {
 							}))
 						})
 					})
+
+					When("working with remote and dynamic port forwarding", func() {
+						BeforeEach(func() {
+							cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+								{RemoteAddress: "localhost:9229", LocalAddress: "localhost:9229"},
+							}
+							cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+								{LocalAddress: "localhost:1080"},
+							}
+						})
+
+						It("passes along the forwarding information", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+							Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+								{RemoteAddress: "localhost:9229", LocalAddress: "localhost:9229"},
+							}))
+							Expect(sshOptionsArg.DynamicPortForwardAddresses).To(Equal([]string{"localhost:1080"}))
+						})
+					})
 				})
 
 				When("executing the secure shell fails", func() {
@@ -181,6 +202,146 @@ var _ = Describe("ssh Command", func() {
 					Expect(testUI.Err).To(Say("some-warnings"))
 				})
 			})
+
+			When("--all-instances is provided", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.SkipRemoteExecution = false
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+					fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+					fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+					fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns(
+						v7action.SSHAuthentication{
+							Endpoint:           "some-endpoint",
+							HostKeyFingerprint: "some-fingerprint",
+						},
+						[]sharedaction.SSHInstance{
+							{Index: 0, Username: "cf:some-process-guid/0"},
+							{Index: 2, Username: "cf:some-process-guid/2"},
+						},
+						v7action.Warnings{"some-warnings"},
+						nil,
+					)
+				})
+
+				When("the command succeeds on every instance", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.SSHInstanceResult{
+							{Index: 0},
+							{Index: 2},
+						})
+					})
+
+					It("runs the command on every running instance and displays the results", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(testUI.Out).To(Say(`Running command on 2 instances of process some-process-type of app some-app in org some-org / space some-space as steve\.\.\.`))
+						Expect(testUI.Out).To(Say(`instance\s+exit code\s+details`))
+						Expect(testUI.Out).To(Say(`#0\s+0`))
+						Expect(testUI.Out).To(Say(`#2\s+0`))
+
+						Expect(fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(1))
+						appNameArg, spaceGUIDArg, processTypeArg := fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(0)
+						Expect(appNameArg).To(Equal(appName))
+						Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+						Expect(processTypeArg).To(Equal("some-process-type"))
+
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+						newSSHClient, passcodeGetter, sshOptions, instances, maxInFlight, _, _ := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+						Expect(newSSHClient()).ToNot(BeNil())
+						Expect(passcodeGetter).To(Equal(fakeActor))
+						Expect(sshOptions).To(Equal(sharedaction.SSHOptions{
+							Commands:           []string{"some", "commands"},
+							Endpoint:           "some-endpoint",
+							HostKeyFingerprint: "some-fingerprint",
+							SkipHostValidation: true,
+						}))
+						Expect(instances).To(Equal([]sharedaction.SSHInstance{
+							{Index: 0, Username: "cf:some-process-guid/0"},
+							{Index: 2, Username: "cf:some-process-guid/2"},
+						}))
+						Expect(maxInFlight).To(Equal(10))
+					})
+
+					When("--max-in-flight is provided", func() {
+						BeforeEach(func() {
+							cmd.MaxInFlight = flag.PositiveInteger{Value: 3}
+						})
+
+						It("limits the number of concurrent sessions", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+							_, _, _, _, maxInFlight, _, _ := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+							Expect(maxInFlight).To(Equal(3))
+						})
+					})
+				})
+
+				When("the command fails on some instances", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.SSHInstanceResult{
+							{Index: 0},
+							{Index: 2, ExitStatus: 3},
+						})
+					})
+
+					It("displays the results and returns an error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedOnInstancesError{Failed: 1, Total: 2}))
+						Expect(testUI.Out).To(Say(`#0\s+0`))
+						Expect(testUI.Out).To(Say(`#2\s+3`))
+					})
+				})
+
+				When("getting the running instances fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns(
+							v7action.SSHAuthentication{},
+							nil,
+							v7action.Warnings{"some-warnings"},
+							actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+						)
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				When("no command is provided", func() {
+					BeforeEach(func() {
+						cmd.Commands = nil
+					})
+
+					It("returns a RequiredFlagsError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}))
+					})
+				})
+
+				When("flags for a single session are provided", func() {
+					BeforeEach(func() {
+						cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{LocalAddress: "localhost:8080", RemoteAddress: "localhost:8080"}}
+						cmd.SkipRemoteExecution = true
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "-L", "--skip-remote-execution"}}))
+						Expect(fakeActor.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			When("--max-in-flight is provided without --all-instances", func() {
+				BeforeEach(func() {
+					cmd.MaxInFlight = flag.PositiveInteger{Value: 3}
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--max-in-flight", Arg2: "--all-instances"}))
+				})
+			})
 		})
 	})
 
@@ -0,0 +1,130 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	sync "sync"
+
+	v7action "code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeDiffManifestActor struct {
+	DiffApplicationManifestStub        func(string, []byte, string) (v7action.ManifestDiff, v7action.Warnings, error)
+	diffApplicationManifestMutex       sync.RWMutex
+	diffApplicationManifestArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 string
+	}
+	diffApplicationManifestReturns struct {
+		result1 v7action.ManifestDiff
+		result2 v7action.Warnings
+		result3 error
+	}
+	diffApplicationManifestReturnsOnCall map[int]struct {
+		result1 v7action.ManifestDiff
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiffManifestActor) DiffApplicationManifest(arg1 string, arg2 []byte, arg3 string) (v7action.ManifestDiff, v7action.Warnings, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.diffApplicationManifestMutex.Lock()
+	ret, specificReturn := fake.diffApplicationManifestReturnsOnCall[len(fake.diffApplicationManifestArgsForCall)]
+	fake.diffApplicationManifestArgsForCall = append(fake.diffApplicationManifestArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 string
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("DiffApplicationManifest", []interface{}{arg1, arg2Copy, arg3})
+	fake.diffApplicationManifestMutex.Unlock()
+	if fake.DiffApplicationManifestStub != nil {
+		return fake.DiffApplicationManifestStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.diffApplicationManifestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDiffManifestActor) DiffApplicationManifestCallCount() int {
+	fake.diffApplicationManifestMutex.RLock()
+	defer fake.diffApplicationManifestMutex.RUnlock()
+	return len(fake.diffApplicationManifestArgsForCall)
+}
+
+func (fake *FakeDiffManifestActor) DiffApplicationManifestCalls(stub func(string, []byte, string) (v7action.ManifestDiff, v7action.Warnings, error)) {
+	fake.diffApplicationManifestMutex.Lock()
+	defer fake.diffApplicationManifestMutex.Unlock()
+	fake.DiffApplicationManifestStub = stub
+}
+
+func (fake *FakeDiffManifestActor) DiffApplicationManifestArgsForCall(i int) (string, []byte, string) {
+	fake.diffApplicationManifestMutex.RLock()
+	defer fake.diffApplicationManifestMutex.RUnlock()
+	argsForCall := fake.diffApplicationManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiffManifestActor) DiffApplicationManifestReturns(result1 v7action.ManifestDiff, result2 v7action.Warnings, result3 error) {
+	fake.diffApplicationManifestMutex.Lock()
+	defer fake.diffApplicationManifestMutex.Unlock()
+	fake.DiffApplicationManifestStub = nil
+	fake.diffApplicationManifestReturns = struct {
+		result1 v7action.ManifestDiff
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffManifestActor) DiffApplicationManifestReturnsOnCall(i int, result1 v7action.ManifestDiff, result2 v7action.Warnings, result3 error) {
+	fake.diffApplicationManifestMutex.Lock()
+	defer fake.diffApplicationManifestMutex.Unlock()
+	fake.DiffApplicationManifestStub = nil
+	if fake.diffApplicationManifestReturnsOnCall == nil {
+		fake.diffApplicationManifestReturnsOnCall = make(map[int]struct {
+			result1 v7action.ManifestDiff
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.diffApplicationManifestReturnsOnCall[i] = struct {
+		result1 v7action.ManifestDiff
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffManifestActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.diffApplicationManifestMutex.RLock()
+	defer fake.diffApplicationManifestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDiffManifestActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.DiffManifestActor = new(FakeDiffManifestActor)
@@ -2,9 +2,9 @@
 package v7fakes
 
 import (
-	"sync"
+	sync "sync"
 
-	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	v7pushaction "code.cloudfoundry.org/cli/actor/v7pushaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
 
@@ -27,6 +27,21 @@ type FakePushActor struct {
 		result3 <-chan v7pushaction.Warnings
 		result4 <-chan error
 	}
+	ActualizeConcurrentlyStub        func([]v7pushaction.PushPlan, int, bool, v7pushaction.ProgressBar, v7pushaction.StartFunc) <-chan v7pushaction.AppEvent
+	actualizeConcurrentlyMutex       sync.RWMutex
+	actualizeConcurrentlyArgsForCall []struct {
+		arg1 []v7pushaction.PushPlan
+		arg2 int
+		arg3 bool
+		arg4 v7pushaction.ProgressBar
+		arg5 v7pushaction.StartFunc
+	}
+	actualizeConcurrentlyReturns struct {
+		result1 <-chan v7pushaction.AppEvent
+	}
+	actualizeConcurrentlyReturnsOnCall map[int]struct {
+		result1 <-chan v7pushaction.AppEvent
+	}
 	CreatePushPlansStub        func(string, string, string, v7pushaction.ManifestParser, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -151,6 +166,75 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan v7pushac
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakePushActor) ActualizeConcurrently(arg1 []v7pushaction.PushPlan, arg2 int, arg3 bool, arg4 v7pushaction.ProgressBar, arg5 v7pushaction.StartFunc) <-chan v7pushaction.AppEvent {
+	var arg1Copy []v7pushaction.PushPlan
+	if arg1 != nil {
+		arg1Copy = make([]v7pushaction.PushPlan, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.actualizeConcurrentlyMutex.Lock()
+	ret, specificReturn := fake.actualizeConcurrentlyReturnsOnCall[len(fake.actualizeConcurrentlyArgsForCall)]
+	fake.actualizeConcurrentlyArgsForCall = append(fake.actualizeConcurrentlyArgsForCall, struct {
+		arg1 []v7pushaction.PushPlan
+		arg2 int
+		arg3 bool
+		arg4 v7pushaction.ProgressBar
+		arg5 v7pushaction.StartFunc
+	}{arg1Copy, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ActualizeConcurrently", []interface{}{arg1Copy, arg2, arg3, arg4, arg5})
+	fake.actualizeConcurrentlyMutex.Unlock()
+	if fake.ActualizeConcurrentlyStub != nil {
+		return fake.ActualizeConcurrentlyStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.actualizeConcurrentlyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyCallCount() int {
+	fake.actualizeConcurrentlyMutex.RLock()
+	defer fake.actualizeConcurrentlyMutex.RUnlock()
+	return len(fake.actualizeConcurrentlyArgsForCall)
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyCalls(stub func([]v7pushaction.PushPlan, int, bool, v7pushaction.ProgressBar, v7pushaction.StartFunc) <-chan v7pushaction.AppEvent) {
+	fake.actualizeConcurrentlyMutex.Lock()
+	defer fake.actualizeConcurrentlyMutex.Unlock()
+	fake.ActualizeConcurrentlyStub = stub
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyArgsForCall(i int) ([]v7pushaction.PushPlan, int, bool, v7pushaction.ProgressBar, v7pushaction.StartFunc) {
+	fake.actualizeConcurrentlyMutex.RLock()
+	defer fake.actualizeConcurrentlyMutex.RUnlock()
+	argsForCall := fake.actualizeConcurrentlyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyReturns(result1 <-chan v7pushaction.AppEvent) {
+	fake.actualizeConcurrentlyMutex.Lock()
+	defer fake.actualizeConcurrentlyMutex.Unlock()
+	fake.ActualizeConcurrentlyStub = nil
+	fake.actualizeConcurrentlyReturns = struct {
+		result1 <-chan v7pushaction.AppEvent
+	}{result1}
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyReturnsOnCall(i int, result1 <-chan v7pushaction.AppEvent) {
+	fake.actualizeConcurrentlyMutex.Lock()
+	defer fake.actualizeConcurrentlyMutex.Unlock()
+	fake.ActualizeConcurrentlyStub = nil
+	if fake.actualizeConcurrentlyReturnsOnCall == nil {
+		fake.actualizeConcurrentlyReturnsOnCall = make(map[int]struct {
+			result1 <-chan v7pushaction.AppEvent
+		})
+	}
+	fake.actualizeConcurrentlyReturnsOnCall[i] = struct {
+		result1 <-chan v7pushaction.AppEvent
+	}{result1}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 string, arg4 v7pushaction.ManifestParser, arg5 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -369,6 +453,8 @@ func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.actualizeConcurrentlyMutex.RLock()
+	defer fake.actualizeConcurrentlyMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
 	fake.prepareSpaceMutex.RLock()
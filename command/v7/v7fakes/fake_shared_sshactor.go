@@ -2,9 +2,10 @@
 package v7fakes
 
 import (
-	"sync"
+	io "io"
+	sync "sync"
 
-	"code.cloudfoundry.org/cli/actor/sharedaction"
+	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
 
@@ -21,6 +22,23 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellOnInstancesStub        func(func() sharedaction.SecureShellClient, sharedaction.SSHPasscodeGetter, sharedaction.SSHOptions, []sharedaction.SSHInstance, int, io.Writer, io.Writer) []sharedaction.SSHInstanceResult
+	executeSecureShellOnInstancesMutex       sync.RWMutex
+	executeSecureShellOnInstancesArgsForCall []struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 sharedaction.SSHPasscodeGetter
+		arg3 sharedaction.SSHOptions
+		arg4 []sharedaction.SSHInstance
+		arg5 int
+		arg6 io.Writer
+		arg7 io.Writer
+	}
+	executeSecureShellOnInstancesReturns struct {
+		result1 []sharedaction.SSHInstanceResult
+	}
+	executeSecureShellOnInstancesReturnsOnCall map[int]struct {
+		result1 []sharedaction.SSHInstanceResult
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +104,84 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstances(arg1 func() sharedaction.SecureShellClient, arg2 sharedaction.SSHPasscodeGetter, arg3 sharedaction.SSHOptions, arg4 []sharedaction.SSHInstance, arg5 int, arg6 io.Writer, arg7 io.Writer) []sharedaction.SSHInstanceResult {
+	var arg4Copy []sharedaction.SSHInstance
+	if arg4 != nil {
+		arg4Copy = make([]sharedaction.SSHInstance, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellOnInstancesReturnsOnCall[len(fake.executeSecureShellOnInstancesArgsForCall)]
+	fake.executeSecureShellOnInstancesArgsForCall = append(fake.executeSecureShellOnInstancesArgsForCall, struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 sharedaction.SSHPasscodeGetter
+		arg3 sharedaction.SSHOptions
+		arg4 []sharedaction.SSHInstance
+		arg5 int
+		arg6 io.Writer
+		arg7 io.Writer
+	}{arg1, arg2, arg3, arg4Copy, arg5, arg6, arg7})
+	fake.recordInvocation("ExecuteSecureShellOnInstances", []interface{}{arg1, arg2, arg3, arg4Copy, arg5, arg6, arg7})
+	fake.executeSecureShellOnInstancesMutex.Unlock()
+	if fake.ExecuteSecureShellOnInstancesStub != nil {
+		return fake.ExecuteSecureShellOnInstancesStub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureShellOnInstancesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCallCount() int {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	return len(fake.executeSecureShellOnInstancesArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCalls(stub func(func() sharedaction.SecureShellClient, sharedaction.SSHPasscodeGetter, sharedaction.SSHOptions, []sharedaction.SSHInstance, int, io.Writer, io.Writer) []sharedaction.SSHInstanceResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesArgsForCall(i int) (func() sharedaction.SecureShellClient, sharedaction.SSHPasscodeGetter, sharedaction.SSHOptions, []sharedaction.SSHInstance, int, io.Writer, io.Writer) {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	argsForCall := fake.executeSecureShellOnInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturns(result1 []sharedaction.SSHInstanceResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	fake.executeSecureShellOnInstancesReturns = struct {
+		result1 []sharedaction.SSHInstanceResult
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturnsOnCall(i int, result1 []sharedaction.SSHInstanceResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	if fake.executeSecureShellOnInstancesReturnsOnCall == nil {
+		fake.executeSecureShellOnInstancesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.SSHInstanceResult
+		})
+	}
+	fake.executeSecureShellOnInstancesReturnsOnCall[i] = struct {
+		result1 []sharedaction.SSHInstanceResult
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
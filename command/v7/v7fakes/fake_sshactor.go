@@ -2,13 +2,26 @@
 package v7fakes
 
 import (
-	"sync"
+	sync "sync"
 
-	"code.cloudfoundry.org/cli/actor/v7action"
+	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
+	v7action "code.cloudfoundry.org/cli/actor/v7action"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
 
 type FakeSSHActor struct {
+	GetSSHPasscodeStub        func() (string, error)
+	getSSHPasscodeMutex       sync.RWMutex
+	getSSHPasscodeArgsForCall []struct {
+	}
+	getSSHPasscodeReturns struct {
+		result1 string
+		result2 error
+	}
+	getSSHPasscodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub        func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
@@ -27,10 +40,84 @@ type FakeSSHActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub        func(string, string, string) (v7action.SSHAuthentication, []sharedaction.SSHInstance, v7action.Warnings, error)
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex       sync.RWMutex
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns struct {
+		result1 v7action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v7action.Warnings
+		result4 error
+	}
+	getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall map[int]struct {
+		result1 v7action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v7action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSSHActor) GetSSHPasscode() (string, error) {
+	fake.getSSHPasscodeMutex.Lock()
+	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
+	fake.getSSHPasscodeArgsForCall = append(fake.getSSHPasscodeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetSSHPasscode", []interface{}{})
+	fake.getSSHPasscodeMutex.Unlock()
+	if fake.GetSSHPasscodeStub != nil {
+		return fake.GetSSHPasscodeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getSSHPasscodeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSSHActor) GetSSHPasscodeCallCount() int {
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
+	return len(fake.getSSHPasscodeArgsForCall)
+}
+
+func (fake *FakeSSHActor) GetSSHPasscodeCalls(stub func() (string, error)) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = stub
+}
+
+func (fake *FakeSSHActor) GetSSHPasscodeReturns(result1 string, result2 error) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = nil
+	fake.getSSHPasscodeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSSHActor) GetSSHPasscodeReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getSSHPasscodeMutex.Lock()
+	defer fake.getSSHPasscodeMutex.Unlock()
+	fake.GetSSHPasscodeStub = nil
+	if fake.getSSHPasscodeReturnsOnCall == nil {
+		fake.getSSHPasscodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getSSHPasscodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSSHActor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(arg1 string, arg2 string, arg3 string, arg4 uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
 	ret, specificReturn := fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
@@ -100,11 +187,86 @@ func (fake *FakeSSHActor) GetSecureShellConfigurationByApplicationNameSpaceProce
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType(arg1 string, arg2 string, arg3 string) (v7action.SSHAuthentication, []sharedaction.SSHInstance, v7action.Warnings, error) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall[len(fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall)]
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall = append(fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessType", []interface{}{arg1, arg2, arg3})
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	if fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub != nil {
+		return fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeCallCount() int {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall)
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeCalls(stub func(string, string, string) (v7action.SSHAuthentication, []sharedaction.SSHInstance, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub = stub
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall(i int) (string, string, string) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns(result1 v7action.SSHAuthentication, result2 []sharedaction.SSHInstance, result3 v7action.Warnings, result4 error) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub = nil
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturns = struct {
+		result1 v7action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall(i int, result1 v7action.SSHAuthentication, result2 []sharedaction.SSHInstance, result3 v7action.Warnings, result4 error) {
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeStub = nil
+	if fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall == nil {
+		fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall = make(map[int]struct {
+			result1 v7action.SSHAuthentication
+			result2 []sharedaction.SSHInstance
+			result3 v7action.Warnings
+			result4 error
+		})
+	}
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeReturnsOnCall[i] = struct {
+		result1 v7action.SSHAuthentication
+		result2 []sharedaction.SSHInstance
+		result3 v7action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getSSHPasscodeMutex.RLock()
+	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstancesByApplicationNameSpaceAndProcessTypeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -161,10 +161,14 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
+		Output:  string(common.Commands.Output.Format),
+		Profile: common.Commands.Profile,
 		Verbose: common.Commands.VerboseOrVersion,
 	})
 	if configErr != nil {
-		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
+		switch configErr.(type) {
+		case translatableerror.EmptyConfigError, translatableerror.ProfileNotFoundError:
+		default:
 			return configErr
 		}
 	}
@@ -174,6 +178,10 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		return err
 	}
 
+	if _, ok := configErr.(translatableerror.ProfileNotFoundError); ok {
+		return handleError(configErr, commandUI)
+	}
+
 	err = preventExtraArgs(args)
 	if err != nil {
 		return handleError(err, commandUI)
@@ -246,6 +254,12 @@ func handleError(passedErr error, commandUI UI) error {
 			commandUI.DisplayWarning(typedErr.Error())
 		}
 
+		args, env := common.RemoveLegacyGlobalFlags(os.Args)
+		for name, value := range env {
+			os.Setenv(name, value)
+		}
+		os.Args = args
+
 		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	case *ssh.ExitError:
 		exitStatus := typedErr.ExitStatus()
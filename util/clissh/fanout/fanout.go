@@ -0,0 +1,143 @@
+// Package fanout runs a command on several application instances at the same
+// time, prefixing each line of their output with the index of the instance it
+// came from.
+package fanout
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Result is the outcome of running the command on one instance.
+type Result struct {
+	Index int
+
+	// ExitStatus is the exit status of the command. It is only meaningful when
+	// Err is nil.
+	ExitStatus int
+
+	// Signal is the signal that terminated the command, if any.
+	Signal string
+
+	// Err is set when the command could not be run on the instance.
+	Err error
+}
+
+// Succeeded returns true if the command ran and exited with status 0.
+func (result Result) Succeeded() bool {
+	return result.Err == nil && result.ExitStatus == 0 && result.Signal == ""
+}
+
+// RunFunc runs the command on the instance with the given index, writing its
+// output to stdout and stderr. A non-zero exit status is reported by
+// returning an error with ExitStatus and Signal methods, such as
+// *ssh.ExitError.
+type RunFunc func(index int, stdout io.Writer, stderr io.Writer) error
+
+// Run calls run for each index in order, with at most maxInFlight calls
+// running at the same time. Each line written by a call is prefixed with "[INDEX] " before
+// it is written to stdout or stderr, and lines from different calls are never
+// interleaved. Results are returned in the order of indexes.
+func Run(indexes []int, maxInFlight int, stdout io.Writer, stderr io.Writer, run RunFunc) []Result {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	outputMutex := &sync.Mutex{}
+	results := make([]Result, len(indexes))
+	slots := make(chan struct{}, maxInFlight)
+	wg := &sync.WaitGroup{}
+
+	for i, index := range indexes {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, index int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			prefix := fmt.Sprintf("[%d] ", index)
+			instanceStdout := &prefixWriter{mutex: outputMutex, writer: stdout, prefix: prefix}
+			instanceStderr := &prefixWriter{mutex: outputMutex, writer: stderr, prefix: prefix}
+
+			err := run(index, instanceStdout, instanceStderr)
+			instanceStdout.Flush()
+			instanceStderr.Flush()
+
+			results[i] = newResult(index, err)
+		}(i, index)
+	}
+
+	wg.Wait()
+	return results
+}
+
+type exitError interface {
+	ExitStatus() int
+	Signal() string
+}
+
+func newResult(index int, err error) Result {
+	result := Result{Index: index}
+	switch typedErr := err.(type) {
+	case nil:
+	case exitError:
+		result.ExitStatus = typedErr.ExitStatus()
+		result.Signal = typedErr.Signal()
+	default:
+		result.Err = err
+	}
+	return result
+}
+
+// prefixWriter buffers output until a complete line has been written, then
+// writes the line with the prefix while holding mutex.
+type prefixWriter struct {
+	mutex  *sync.Mutex
+	writer io.Writer
+	prefix string
+	buffer bytes.Buffer
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buffer.Write(p)
+
+	for {
+		line := w.buffer.Bytes()
+		end := bytes.IndexByte(line, '\n')
+		if end < 0 {
+			break
+		}
+
+		err := w.writeLine(line[:end+1])
+		w.buffer.Next(end + 1)
+		if err != nil {
+			return len(p), err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes any incomplete last line, ending it with a newline.
+func (w *prefixWriter) Flush() {
+	if w.buffer.Len() == 0 {
+		return
+	}
+
+	_ = w.writeLine(append(w.buffer.Bytes(), '\n'))
+	w.buffer.Reset()
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	_, err := io.WriteString(w.writer, w.prefix)
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(line)
+	return err
+}
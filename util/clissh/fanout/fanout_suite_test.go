@@ -0,0 +1,13 @@
+package fanout_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFanout(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fanout Suite")
+}
@@ -0,0 +1,156 @@
+package fanout_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/clissh/fanout"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+type exitError struct {
+	status int
+	signal string
+}
+
+func (e exitError) Error() string   { return fmt.Sprintf("exited with %d", e.status) }
+func (e exitError) ExitStatus() int { return e.status }
+func (e exitError) Signal() string  { return e.signal }
+
+var _ = Describe("Run", func() {
+	var (
+		indexes     []int
+		maxInFlight int
+		stdout      *Buffer
+		stderr      *Buffer
+		run         RunFunc
+
+		results []Result
+	)
+
+	BeforeEach(func() {
+		indexes = []int{0, 1, 2}
+		maxInFlight = 3
+		stdout = NewBuffer()
+		stderr = NewBuffer()
+		run = func(int, io.Writer, io.Writer) error { return nil }
+	})
+
+	JustBeforeEach(func() {
+		results = Run(indexes, maxInFlight, stdout, stderr, run)
+	})
+
+	It("runs on every index and returns the results in order", func() {
+		Expect(results).To(Equal([]Result{{Index: 0}, {Index: 1}, {Index: 2}}))
+		for _, result := range results {
+			Expect(result.Succeeded()).To(BeTrue())
+		}
+	})
+
+	When("the runs write output", func() {
+		BeforeEach(func() {
+			run = func(index int, out io.Writer, errOut io.Writer) error {
+				_, _ = io.WriteString(out, fmt.Sprintf("hello from %d\npartial ", index))
+				_, _ = io.WriteString(out, "line")
+				_, _ = io.WriteString(errOut, fmt.Sprintf("warning from %d\n", index))
+				return nil
+			}
+		})
+
+		It("prefixes every line with the index", func() {
+			output := strings.Split(strings.TrimSpace(string(stdout.Contents())), "\n")
+			Expect(output).To(ConsistOf(
+				"[0] hello from 0", "[0] partial line",
+				"[1] hello from 1", "[1] partial line",
+				"[2] hello from 2", "[2] partial line",
+			))
+
+			errOutput := strings.Split(strings.TrimSpace(string(stderr.Contents())), "\n")
+			Expect(errOutput).To(ConsistOf("[0] warning from 0", "[1] warning from 1", "[2] warning from 2"))
+		})
+	})
+
+	When("the runs write lines a byte at a time", func() {
+		BeforeEach(func() {
+			indexes = []int{0, 1, 2, 3, 4, 5, 6, 7}
+			maxInFlight = 8
+			run = func(index int, out io.Writer, _ io.Writer) error {
+				for _, b := range []byte(fmt.Sprintf("first line of %d\nsecond line of %d\n", index, index)) {
+					_, _ = out.Write([]byte{b})
+				}
+				return nil
+			}
+		})
+
+		It("does not interleave lines from different instances", func() {
+			for _, line := range strings.Split(strings.TrimSpace(string(stdout.Contents())), "\n") {
+				Expect(line).To(MatchRegexp(`^\[(\d)\] (first|second) line of \d$`))
+				Expect(line[1:2]).To(Equal(line[len(line)-1:]))
+			}
+		})
+	})
+
+	When("the command fails on some instances", func() {
+		BeforeEach(func() {
+			run = func(index int, _ io.Writer, _ io.Writer) error {
+				switch index {
+				case 0:
+					return exitError{status: 3}
+				case 1:
+					return errors.New("connection refused")
+				default:
+					return exitError{status: 143, signal: "TERM"}
+				}
+			}
+		})
+
+		It("returns the exit status, signal or error of each instance", func() {
+			Expect(results).To(Equal([]Result{
+				{Index: 0, ExitStatus: 3},
+				{Index: 1, Err: errors.New("connection refused")},
+				{Index: 2, ExitStatus: 143, Signal: "TERM"},
+			}))
+			for _, result := range results {
+				Expect(result.Succeeded()).To(BeFalse())
+			}
+		})
+	})
+
+	When("there are more instances than maxInFlight", func() {
+		var maxRunning int32
+
+		BeforeEach(func() {
+			indexes = []int{0, 1, 2, 3, 4, 5}
+			maxInFlight = 2
+
+			var running int32
+			mutex := &sync.Mutex{}
+			maxRunning = 0
+			run = func(int, io.Writer, io.Writer) error {
+				current := atomic.AddInt32(&running, 1)
+				mutex.Lock()
+				if current > maxRunning {
+					maxRunning = current
+				}
+				mutex.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				return nil
+			}
+		})
+
+		It("runs at most maxInFlight at the same time", func() {
+			Expect(results).To(HaveLen(6))
+			Expect(maxRunning).To(BeEquivalentTo(2))
+		})
+	})
+})
@@ -0,0 +1,383 @@
+// Package sftp is a client for version 3 of the SSH File Transfer Protocol,
+// which application instances serve as the "sftp" SSH subsystem.
+package sftp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"sync"
+)
+
+const (
+	protocolVersion = 3
+
+	// maxDataBytes is the largest read or write request that every server is
+	// required to support.
+	maxDataBytes = 32 * 1024
+
+	// maxRequestsInFlight is the number of read or write requests that a
+	// file keeps outstanding, so that transfers are not limited by the round
+	// trip time.
+	maxRequestsInFlight = 64
+)
+
+const (
+	statusOK               = 0
+	statusEOF              = 1
+	statusNoSuchFile       = 2
+	statusPermissionDenied = 3
+)
+
+// StatusError is a failure reported by the SFTP server.
+type StatusError struct {
+	Code    uint32
+	Message string
+}
+
+func (e StatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("sftp: server returned status %d", e.Code)
+	}
+	return fmt.Sprintf("sftp: %s", e.Message)
+}
+
+// Client sends SFTP requests to the server. Several requests can be in flight
+// at a time; responses are matched to their requests by request ID.
+type Client struct {
+	reader io.Reader
+	writer io.WriteCloser
+
+	writeMutex sync.Mutex
+
+	mutex   sync.Mutex
+	nextID  uint32
+	pending map[uint32]chan response
+	err     error
+}
+
+// response is the type and payload, after the request ID, of the response
+// to a request, or the error that ended the session before it arrived.
+type response struct {
+	packetType byte
+	data       *packetReader
+	err        error
+}
+
+// NewClient negotiates the protocol version with the server that responds on
+// reader to requests written to writer. Closing the client closes writer.
+func NewClient(reader io.Reader, writer io.WriteCloser) (*Client, error) {
+	client := &Client{reader: reader, writer: writer, pending: map[uint32]chan response{}}
+
+	err := client.writePacket(packetInit, appendUint32(nil, protocolVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	packetType, data, err := client.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	if packetType != packetVersion {
+		return nil, unexpectedPacketError(packetType)
+	}
+
+	response := packetReader{data: data}
+	version := response.uint32()
+	if response.err != nil {
+		return nil, response.err
+	}
+	if version < protocolVersion {
+		return nil, fmt.Errorf("sftp: server only supports protocol version %d", version)
+	}
+
+	go client.receive()
+	return client, nil
+}
+
+// Close ends the session with the server.
+func (client *Client) Close() error {
+	return client.writer.Close()
+}
+
+// Stat returns the file info of the remote path, following symbolic links.
+func (client *Client) Stat(remotePath string) (os.FileInfo, error) {
+	packetType, response, err := client.request(packetStat, appendString(nil, remotePath))
+	if err != nil {
+		return nil, err
+	}
+
+	switch packetType {
+	case packetAttrs:
+		attrs := response.attributes()
+		return fileInfo{name: path.Base(remotePath), attrs: attrs}, response.err
+	case packetStatus:
+		return nil, statusError("stat", remotePath, response)
+	default:
+		return nil, unexpectedPacketError(packetType)
+	}
+}
+
+// ReadDir returns the entries of the remote directory sorted by name. The
+// entries describe symbolic links rather than the files they point to.
+func (client *Client) ReadDir(remotePath string) ([]os.FileInfo, error) {
+	handle, err := client.openHandle(packetOpendir, "open", remotePath, appendString(nil, remotePath))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []os.FileInfo
+	for {
+		var batch []os.FileInfo
+		batch, err = client.readDirBatch(remotePath, handle)
+		if err != nil {
+			break
+		}
+		entries = append(entries, batch...)
+	}
+
+	closeErr := client.closeHandle(remotePath, handle)
+	if err != io.EOF {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+
+	sort.Slice(entries, func(i int, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// Mkdir creates the remote directory with the given permissions.
+func (client *Client) Mkdir(remotePath string, perm os.FileMode) error {
+	payload := appendPermissions(appendString(nil, remotePath), perm)
+	packetType, response, err := client.request(packetMkdir, payload)
+	if err != nil {
+		return err
+	}
+	if packetType != packetStatus {
+		return unexpectedPacketError(packetType)
+	}
+	return statusError("mkdir", remotePath, response)
+}
+
+// Open opens the remote file for reading.
+func (client *Client) Open(remotePath string) (*File, error) {
+	payload := appendUint32(appendUint32(appendString(nil, remotePath), openRead), 0)
+	handle, err := client.openHandle(packetOpen, "open", remotePath, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &File{client: client, path: remotePath, handle: handle}, nil
+}
+
+// Create opens the remote file for writing, creating it with the given
+// permissions if it does not exist and truncating it if it does.
+func (client *Client) Create(remotePath string, perm os.FileMode) (*File, error) {
+	payload := appendPermissions(appendUint32(appendString(nil, remotePath), openWrite|openCreate|openTruncate), perm)
+	handle, err := client.openHandle(packetOpen, "create", remotePath, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &File{client: client, path: remotePath, handle: handle}, nil
+}
+
+func (client *Client) openHandle(requestType byte, op string, remotePath string, payload []byte) (string, error) {
+	packetType, response, err := client.request(requestType, payload)
+	if err != nil {
+		return "", err
+	}
+
+	switch packetType {
+	case packetHandle:
+		handle := response.string()
+		return handle, response.err
+	case packetStatus:
+		return "", statusError(op, remotePath, response)
+	default:
+		return "", unexpectedPacketError(packetType)
+	}
+}
+
+func (client *Client) closeHandle(remotePath string, handle string) error {
+	packetType, response, err := client.request(packetClose, appendString(nil, handle))
+	if err != nil {
+		return err
+	}
+	if packetType != packetStatus {
+		return unexpectedPacketError(packetType)
+	}
+	return statusError("close", remotePath, response)
+}
+
+// readDirBatch returns the next directory entries, or io.EOF when there are
+// none left.
+func (client *Client) readDirBatch(remotePath string, handle string) ([]os.FileInfo, error) {
+	packetType, response, err := client.request(packetReaddir, appendString(nil, handle))
+	if err != nil {
+		return nil, err
+	}
+
+	switch packetType {
+	case packetName:
+		count := response.uint32()
+		var entries []os.FileInfo
+		for i := uint32(0); i < count && response.err == nil; i++ {
+			name := response.string()
+			response.string() // long name, as displayed by ls -l
+			attrs := response.attributes()
+			if name != "." && name != ".." {
+				entries = append(entries, fileInfo{name: name, attrs: attrs})
+			}
+		}
+		return entries, response.err
+	case packetStatus:
+		err = statusError("readdir", remotePath, response)
+		if err == nil {
+			err = unexpectedPacketError(packetType)
+		}
+		return nil, err
+	default:
+		return nil, unexpectedPacketError(packetType)
+	}
+}
+
+// request sends the request and returns the type and payload of the
+// response, after its request ID.
+func (client *Client) request(requestType byte, payload []byte) (byte, *packetReader, error) {
+	result, err := client.send(requestType, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	response := <-result
+	return response.packetType, response.data, response.err
+}
+
+// send sends the request without waiting for the response, which is
+// delivered on the returned channel.
+func (client *Client) send(requestType byte, payload []byte) (<-chan response, error) {
+	result := make(chan response, 1)
+
+	client.mutex.Lock()
+	if client.err != nil {
+		err := client.err
+		client.mutex.Unlock()
+		return nil, err
+	}
+	client.nextID++
+	id := client.nextID
+	client.pending[id] = result
+	client.mutex.Unlock()
+
+	err := client.writePacket(requestType, append(appendUint32(nil, id), payload...))
+	if err != nil {
+		client.mutex.Lock()
+		delete(client.pending, id)
+		client.mutex.Unlock()
+		return nil, err
+	}
+	return result, nil
+}
+
+// receive delivers every response to the request it answers, until the
+// session ends. The requests that are still waiting then fail with the error
+// that ended the session. The end of the session is reported as
+// io.ErrUnexpectedEOF, so that it is not mistaken for the end of a file.
+func (client *Client) receive() {
+	err := client.receiveResponses()
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.err = err
+	for id, result := range client.pending {
+		result <- response{err: err}
+		delete(client.pending, id)
+	}
+}
+
+func (client *Client) receiveResponses() error {
+	for {
+		packetType, data, err := client.readPacket()
+		if err != nil {
+			return err
+		}
+
+		reader := &packetReader{data: data}
+		id := reader.uint32()
+		if reader.err != nil {
+			return reader.err
+		}
+
+		client.mutex.Lock()
+		result, ok := client.pending[id]
+		delete(client.pending, id)
+		client.mutex.Unlock()
+		if !ok {
+			return fmt.Errorf("sftp: received response to unknown request %d", id)
+		}
+		result <- response{packetType: packetType, data: reader}
+	}
+}
+
+func (client *Client) writePacket(packetType byte, payload []byte) error {
+	packet := appendUint32(make([]byte, 0, 5+len(payload)), uint32(1+len(payload)))
+	packet = append(packet, packetType)
+	packet = append(packet, payload...)
+
+	client.writeMutex.Lock()
+	defer client.writeMutex.Unlock()
+	_, err := client.writer.Write(packet)
+	return err
+}
+
+func (client *Client) readPacket() (byte, []byte, error) {
+	var header [5]byte
+	_, err := io.ReadFull(client.reader, header[:])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[:4])
+	if length < 1 || length > maxPacketBytes {
+		return 0, nil, fmt.Errorf("sftp: invalid packet length %d", length)
+	}
+
+	data := make([]byte, length-1)
+	_, err = io.ReadFull(client.reader, data)
+	if err != nil {
+		return 0, nil, err
+	}
+	return header[4], data, nil
+}
+
+func statusError(op string, remotePath string, response *packetReader) error {
+	code := response.uint32()
+	message := response.string()
+	if response.err != nil {
+		return response.err
+	}
+
+	switch code {
+	case statusOK:
+		return nil
+	case statusEOF:
+		return io.EOF
+	case statusNoSuchFile:
+		return &os.PathError{Op: op, Path: remotePath, Err: os.ErrNotExist}
+	case statusPermissionDenied:
+		return &os.PathError{Op: op, Path: remotePath, Err: os.ErrPermission}
+	default:
+		return &os.PathError{Op: op, Path: remotePath, Err: StatusError{Code: code, Message: message}}
+	}
+}
+
+func unexpectedPacketError(packetType byte) error {
+	return fmt.Errorf("sftp: unexpected packet type %d", packetType)
+}
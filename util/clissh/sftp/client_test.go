@@ -0,0 +1,361 @@
+package sftp_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing/iotest"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/clissh/sftp"
+	"code.cloudfoundry.org/cli/util/clissh/sftp/sftpfakes"
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		remoteDir string
+		localDir  string
+		client    *Client
+	)
+
+	BeforeEach(func() {
+		var err error
+		remoteDir, err = ioutil.TempDir("", "sftp-remote")
+		Expect(err).ToNot(HaveOccurred())
+		localDir, err = ioutil.TempDir("", "sftp-local")
+		Expect(err).ToNot(HaveOccurred())
+
+		requestReader, requestWriter := io.Pipe()
+		responseReader, responseWriter := io.Pipe()
+		startTestServer(remoteDir, requestReader, responseWriter)
+
+		client, err = NewClient(responseReader, requestWriter)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(client.Close()).To(Succeed())
+		Expect(os.RemoveAll(remoteDir)).To(Succeed())
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	Describe("NewClient", func() {
+		When("the server does not respond with its version", func() {
+			It("returns an error", func() {
+				_, err := NewClient(bytes.NewReader([]byte{0, 0, 0, 5, 101, 0, 0, 0, 0}), nopWriteCloser{})
+				Expect(err).To(MatchError("sftp: unexpected packet type 101"))
+			})
+		})
+
+		When("the server only supports an older version", func() {
+			It("returns an error", func() {
+				_, err := NewClient(bytes.NewReader([]byte{0, 0, 0, 5, 2, 0, 0, 0, 2}), nopWriteCloser{})
+				Expect(err).To(MatchError("sftp: server only supports protocol version 2"))
+			})
+		})
+	})
+
+	Describe("Stat", func() {
+		It("returns the file info", func() {
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "some-file"), []byte("some-content"), 0640)).To(Succeed())
+
+			info, err := client.Stat("some-file")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Name()).To(Equal("some-file"))
+			Expect(info.Size()).To(BeEquivalentTo(12))
+			Expect(info.Mode()).To(Equal(os.FileMode(0640)))
+			Expect(info.IsDir()).To(BeFalse())
+		})
+
+		When("the file does not exist", func() {
+			It("returns a not exist error", func() {
+				_, err := client.Stat("missing")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+				Expect(err).To(MatchError("stat missing: file does not exist"))
+			})
+		})
+	})
+
+	Describe("ReadDir", func() {
+		It("returns the entries sorted by name", func() {
+			Expect(os.Mkdir(filepath.Join(remoteDir, "b-dir"), 0750)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "a-file"), nil, 0600)).To(Succeed())
+
+			entries, err := client.ReadDir(".")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].Name()).To(Equal("a-file"))
+			Expect(entries[1].Name()).To(Equal("b-dir"))
+			Expect(entries[1].Mode()).To(Equal(os.ModeDir | 0750))
+		})
+	})
+
+	Describe("Open and Create", func() {
+		It("reads and writes files larger than a single request", func() {
+			content := bytes.Repeat([]byte("0123456789"), 10000)
+
+			file, err := client.Create("big-file", 0600)
+			Expect(err).ToNot(HaveOccurred())
+			n, err := file.Write(content)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(len(content)))
+			Expect(file.Close()).To(Succeed())
+
+			Expect(ioutil.ReadFile(filepath.Join(remoteDir, "big-file"))).To(Equal(content))
+
+			file, err = client.Open("big-file")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.ReadAll(file)).To(Equal(content))
+			Expect(file.Close()).To(Succeed())
+		})
+
+		It("keeps several read requests in flight", func() {
+			requests := &packetRecorder{packets: make(chan []byte, 1000)}
+			responseReader, responseWriter := io.Pipe()
+			readsInFlight := make(chan int, 1)
+
+			go func() {
+				defer GinkgoRecover()
+				respond := func(packetType byte, request []byte, payload ...[]byte) {
+					body := append([]byte{packetType}, request[5:9]...)
+					for _, p := range payload {
+						body = append(body, p...)
+					}
+					_, err := responseWriter.Write(append(u32(uint32(len(body))), body...))
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				<-requests.packets
+				_, _ = responseWriter.Write(append(u32(5), append([]byte{2}, u32(3)...)...))
+				respond(102, <-requests.packets, str("some-handle"))
+
+				var reads [][]byte
+				for len(reads) < 2 {
+					select {
+					case request := <-requests.packets:
+						reads = append(reads, request)
+						continue
+					case <-time.After(time.Second):
+					}
+					break
+				}
+				readsInFlight <- len(reads)
+
+				respond(103, reads[0], str("some-data"))
+				for _, request := range reads[1:] {
+					respond(101, request, u32(1), str(""), str(""))
+				}
+				for request := range requests.packets {
+					switch request[4] {
+					case 5: // read
+						respond(101, request, u32(1), str(""), str(""))
+					default:
+						respond(101, request, u32(0), str(""), str(""))
+					}
+				}
+			}()
+
+			pipelinedClient, err := NewClient(responseReader, requests)
+			Expect(err).ToNot(HaveOccurred())
+			file, err := pipelinedClient.Open("some-file")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.ReadAll(file)).To(Equal([]byte("some-data")))
+			Expect(file.Close()).To(Succeed())
+			Expect(<-readsInFlight).To(Equal(2))
+			Expect(pipelinedClient.Close()).To(Succeed())
+		})
+	})
+
+	Describe("Upload", func() {
+		var (
+			fakeProgressBar *sftpfakes.FakeProgressBar
+			recursive       bool
+			uploadErr       error
+			localPath       string
+			remotePath      string
+		)
+
+		BeforeEach(func() {
+			fakeProgressBar = new(sftpfakes.FakeProgressBar)
+			fakeProgressBar.NewFileProgressBarWrapperStub = func(reader io.Reader, _ string, _ int64) io.Reader {
+				return reader
+			}
+			recursive = false
+
+			Expect(os.MkdirAll(filepath.Join(localDir, "app", "config"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "app", "main.rb"), []byte("main"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "app", "config", "app.yml"), []byte("config"), 0600)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			uploadErr = client.Upload(localPath, remotePath, recursive, fakeProgressBar)
+		})
+
+		When("uploading a file to a new path", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(localDir, "app", "main.rb")
+				remotePath = "renamed.rb"
+			})
+
+			It("copies the file and reports progress", func() {
+				Expect(uploadErr).ToNot(HaveOccurred())
+				Expect(ioutil.ReadFile(filepath.Join(remoteDir, "renamed.rb"))).To(Equal([]byte("main")))
+
+				Expect(fakeProgressBar.NewFileProgressBarWrapperCallCount()).To(Equal(1))
+				_, name, size := fakeProgressBar.NewFileProgressBarWrapperArgsForCall(0)
+				Expect(name).To(Equal(localPath))
+				Expect(size).To(BeEquivalentTo(4))
+				Expect(fakeProgressBar.FinishFileCallCount()).To(Equal(1))
+			})
+		})
+
+		When("uploading a directory", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(localDir, "app")
+				remotePath = "."
+			})
+
+			It("requires a recursive copy", func() {
+				Expect(uploadErr).To(MatchError(ssherror.RecursiveCopyRequiredError{Path: localPath}))
+			})
+
+			When("copying recursively", func() {
+				BeforeEach(func() {
+					recursive = true
+				})
+
+				It("copies the directory into the existing remote directory", func() {
+					Expect(uploadErr).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(remoteDir, "app", "main.rb"))).To(Equal([]byte("main")))
+					Expect(ioutil.ReadFile(filepath.Join(remoteDir, "app", "config", "app.yml"))).To(Equal([]byte("config")))
+
+					info, err := os.Stat(filepath.Join(remoteDir, "app", "config", "app.yml"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+				})
+
+				When("the directory contains a symbolic link to a parent directory", func() {
+					BeforeEach(func() {
+						Expect(os.Symlink("..", filepath.Join(localDir, "app", "config", "parent"))).To(Succeed())
+					})
+
+					It("skips the link", func() {
+						Expect(uploadErr).ToNot(HaveOccurred())
+						Expect(ioutil.ReadFile(filepath.Join(remoteDir, "app", "config", "app.yml"))).To(Equal([]byte("config")))
+						_, err := os.Lstat(filepath.Join(remoteDir, "app", "config", "parent"))
+						Expect(os.IsNotExist(err)).To(BeTrue())
+					})
+				})
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		var (
+			progressBar ProgressBar
+			recursive   bool
+			downloadErr error
+			remotePath  string
+		)
+
+		BeforeEach(func() {
+			progressBar = nil
+			recursive = false
+
+			Expect(os.MkdirAll(filepath.Join(remoteDir, "logs", "old"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "logs", "heap.hprof"), []byte("heap"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "logs", "old", "app.log"), []byte("log"), 0644)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			downloadErr = client.Download(remotePath, localDir, recursive, progressBar)
+		})
+
+		When("downloading a file into an existing directory", func() {
+			BeforeEach(func() {
+				remotePath = "logs/heap.hprof"
+			})
+
+			It("copies the file into the directory", func() {
+				Expect(downloadErr).ToNot(HaveOccurred())
+				Expect(ioutil.ReadFile(filepath.Join(localDir, "heap.hprof"))).To(Equal([]byte("heap")))
+			})
+
+			When("copying the file fails", func() {
+				BeforeEach(func() {
+					fakeProgressBar := new(sftpfakes.FakeProgressBar)
+					fakeProgressBar.NewFileProgressBarWrapperReturns(iotest.TimeoutReader(bytes.NewReader([]byte("heap"))))
+					progressBar = fakeProgressBar
+				})
+
+				It("returns the error and removes the partial file", func() {
+					Expect(downloadErr).To(MatchError(iotest.ErrTimeout))
+					_, err := os.Stat(filepath.Join(localDir, "heap.hprof"))
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
+		})
+
+		When("the remote file does not exist", func() {
+			BeforeEach(func() {
+				remotePath = "logs/missing"
+			})
+
+			It("returns a not exist error", func() {
+				Expect(os.IsNotExist(downloadErr)).To(BeTrue())
+			})
+		})
+
+		When("downloading a directory recursively", func() {
+			BeforeEach(func() {
+				remotePath = "logs"
+				recursive = true
+			})
+
+			It("copies the directory and its contents", func() {
+				Expect(downloadErr).ToNot(HaveOccurred())
+				Expect(ioutil.ReadFile(filepath.Join(localDir, "logs", "heap.hprof"))).To(Equal([]byte("heap")))
+				Expect(ioutil.ReadFile(filepath.Join(localDir, "logs", "old", "app.log"))).To(Equal([]byte("log")))
+			})
+
+			When("the directory contains a symbolic link to a parent directory", func() {
+				BeforeEach(func() {
+					Expect(os.Symlink("..", filepath.Join(remoteDir, "logs", "old", "parent"))).To(Succeed())
+				})
+
+				It("skips the link", func() {
+					Expect(downloadErr).ToNot(HaveOccurred())
+					Expect(ioutil.ReadFile(filepath.Join(localDir, "logs", "old", "app.log"))).To(Equal([]byte("log")))
+					_, err := os.Lstat(filepath.Join(localDir, "logs", "old", "parent"))
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
+		})
+	})
+})
+
+// packetRecorder records the packets written by the client, which writes
+// every packet with a single call.
+type packetRecorder struct {
+	packets chan []byte
+}
+
+func (recorder *packetRecorder) Write(p []byte) (int, error) {
+	recorder.packets <- append([]byte{}, p...)
+	return len(p), nil
+}
+
+func (recorder *packetRecorder) Close() error {
+	close(recorder.packets)
+	return nil
+}
+
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(p []byte) (int, error) { return len(p), nil }
+func (nopWriteCloser) Close() error                { return nil }
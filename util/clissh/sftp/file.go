@@ -0,0 +1,177 @@
+package sftp
+
+import "io"
+
+// File is an open remote file. It reads or writes sequentially from the
+// start of the file and keeps several requests in flight: reads are requested
+// ahead of the data that has been consumed, and writes return before the
+// server has acknowledged them. A failed write is reported by a later Write
+// or by Close.
+type File struct {
+	client *Client
+	path   string
+	handle string
+
+	// offset is the offset of the next read or write request.
+	offset uint64
+
+	reads    []pendingRead
+	buffered []byte
+	readErr  error
+
+	writes   []<-chan response
+	writeErr error
+}
+
+type pendingRead struct {
+	offset uint64
+	length uint32
+	result <-chan response
+}
+
+// Read reads up to len(p) bytes from the file. It returns io.EOF at the end
+// of the file.
+func (file *File) Read(p []byte) (int, error) {
+	if len(file.buffered) == 0 {
+		err := file.readNext()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, file.buffered)
+	file.buffered = file.buffered[n:]
+	return n, nil
+}
+
+// readNext buffers the data of the oldest read request, after requesting
+// enough data ahead to have maxRequestsInFlight requests outstanding.
+func (file *File) readNext() error {
+	if file.readErr != nil {
+		return file.readErr
+	}
+
+	for len(file.reads) < maxRequestsInFlight {
+		payload := appendUint32(appendUint64(appendString(nil, file.handle), file.offset), maxDataBytes)
+		result, err := file.client.send(packetRead, payload)
+		if err != nil {
+			if len(file.reads) == 0 {
+				file.readErr = err
+				return err
+			}
+			break
+		}
+		file.reads = append(file.reads, pendingRead{offset: file.offset, length: maxDataBytes, result: result})
+		file.offset += maxDataBytes
+	}
+
+	read := file.reads[0]
+	file.reads = file.reads[1:]
+
+	data, err := file.readResponse(<-read.result)
+	if err != nil {
+		file.reads = nil
+		file.readErr = err
+		return err
+	}
+
+	if uint32(len(data)) < read.length {
+		// Servers can return less data than requested before the end of the
+		// file. The requests in flight would leave a gap after this data, so
+		// they are dropped and the rest of the file is requested again.
+		file.reads = nil
+		file.offset = read.offset + uint64(len(data))
+	}
+	file.buffered = data
+	return nil
+}
+
+func (file *File) readResponse(response response) ([]byte, error) {
+	if response.err != nil {
+		return nil, response.err
+	}
+
+	switch response.packetType {
+	case packetData:
+		data := response.data.string()
+		if response.data.err != nil {
+			return nil, response.data.err
+		}
+		if len(data) == 0 {
+			return nil, io.ErrNoProgress
+		}
+		return []byte(data), nil
+	case packetStatus:
+		err := statusError("read", file.path, response.data)
+		if err == nil {
+			err = unexpectedPacketError(response.packetType)
+		}
+		return nil, err
+	default:
+		return nil, unexpectedPacketError(response.packetType)
+	}
+}
+
+// Write writes p to the file, splitting it into as many requests as the
+// protocol requires.
+func (file *File) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		if file.writeErr != nil {
+			return written, file.writeErr
+		}
+		if len(file.writes) == maxRequestsInFlight {
+			file.waitForWrite()
+			continue
+		}
+
+		chunk := p[written:]
+		if len(chunk) > maxDataBytes {
+			chunk = chunk[:maxDataBytes]
+		}
+
+		payload := appendString(appendUint64(appendString(nil, file.handle), file.offset), string(chunk))
+		result, err := file.client.send(packetWrite, payload)
+		if err != nil {
+			return written, err
+		}
+		file.writes = append(file.writes, result)
+
+		written += len(chunk)
+		file.offset += uint64(len(chunk))
+	}
+	return written, nil
+}
+
+// waitForWrite waits for the server to acknowledge the oldest write request
+// and records the first write that failed.
+func (file *File) waitForWrite() {
+	response := <-file.writes[0]
+	file.writes = file.writes[1:]
+
+	err := response.err
+	if err == nil {
+		if response.packetType == packetStatus {
+			err = statusError("write", file.path, response.data)
+		} else {
+			err = unexpectedPacketError(response.packetType)
+		}
+	}
+	if file.writeErr == nil {
+		file.writeErr = err
+	}
+}
+
+// Close waits for the outstanding writes and closes the remote file. It
+// returns the error of the first write that failed, if any.
+func (file *File) Close() error {
+	for len(file.writes) > 0 {
+		file.waitForWrite()
+	}
+
+	err := file.client.closeHandle(file.path, file.handle)
+	if file.writeErr != nil {
+		return file.writeErr
+	}
+	return err
+}
@@ -0,0 +1,178 @@
+package sftp
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	packetInit     = 1
+	packetVersion  = 2
+	packetOpen     = 3
+	packetClose    = 4
+	packetRead     = 5
+	packetWrite    = 6
+	packetOpendir  = 11
+	packetReaddir  = 12
+	packetMkdir    = 14
+	packetStat     = 17
+	packetStatus   = 101
+	packetHandle   = 102
+	packetData     = 103
+	packetName     = 104
+	packetAttrs    = 105
+	maxPacketBytes = 256 * 1024
+)
+
+const (
+	openRead     = 0x01
+	openWrite    = 0x02
+	openCreate   = 0x08
+	openTruncate = 0x10
+)
+
+const (
+	attrSize        = 0x01
+	attrUIDGID      = 0x02
+	attrPermissions = 0x04
+	attrTimes       = 0x08
+	attrExtended    = 0x80000000
+)
+
+const (
+	modeTypeMask  = 0170000
+	modeDirectory = 0040000
+	modeSymlink   = 0120000
+	modeNamedPipe = 0010000
+	modeSocket    = 0140000
+	modeCharacter = 0020000
+	modeBlock     = 0060000
+)
+
+var errShortPacket = errors.New("sftp: packet is too short")
+
+type attributes struct {
+	flags       uint32
+	size        uint64
+	permissions uint32
+	modTime     uint32
+}
+
+func (attrs attributes) mode() os.FileMode {
+	mode := os.FileMode(attrs.permissions & 0777)
+	switch attrs.permissions & modeTypeMask {
+	case modeDirectory:
+		mode |= os.ModeDir
+	case modeSymlink:
+		mode |= os.ModeSymlink
+	case modeNamedPipe:
+		mode |= os.ModeNamedPipe
+	case modeSocket:
+		mode |= os.ModeSocket
+	case modeCharacter:
+		mode |= os.ModeDevice | os.ModeCharDevice
+	case modeBlock:
+		mode |= os.ModeDevice
+	}
+	return mode
+}
+
+// fileInfo is the os.FileInfo of a remote file.
+type fileInfo struct {
+	name  string
+	attrs attributes
+}
+
+func (info fileInfo) Name() string       { return info.name }
+func (info fileInfo) Size() int64        { return int64(info.attrs.size) }
+func (info fileInfo) Mode() os.FileMode  { return info.attrs.mode() }
+func (info fileInfo) ModTime() time.Time { return time.Unix(int64(info.attrs.modTime), 0) }
+func (info fileInfo) IsDir() bool        { return info.Mode().IsDir() }
+func (info fileInfo) Sys() interface{}   { return nil }
+
+func appendUint32(buf []byte, value uint32) []byte {
+	var raw [4]byte
+	binary.BigEndian.PutUint32(raw[:], value)
+	return append(buf, raw[:]...)
+}
+
+func appendUint64(buf []byte, value uint64) []byte {
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], value)
+	return append(buf, raw[:]...)
+}
+
+func appendString(buf []byte, value string) []byte {
+	return append(appendUint32(buf, uint32(len(value))), value...)
+}
+
+func appendPermissions(buf []byte, perm os.FileMode) []byte {
+	buf = appendUint32(buf, attrPermissions)
+	return appendUint32(buf, uint32(perm.Perm()))
+}
+
+// packetReader decodes the fields of a packet. The first decoding error is
+// kept in err and every later read returns the zero value.
+type packetReader struct {
+	data []byte
+	err  error
+}
+
+func (reader *packetReader) uint32() uint32 {
+	if reader.err != nil || len(reader.data) < 4 {
+		reader.err = errShortPacket
+		return 0
+	}
+	value := binary.BigEndian.Uint32(reader.data)
+	reader.data = reader.data[4:]
+	return value
+}
+
+func (reader *packetReader) uint64() uint64 {
+	if reader.err != nil || len(reader.data) < 8 {
+		reader.err = errShortPacket
+		return 0
+	}
+	value := binary.BigEndian.Uint64(reader.data)
+	reader.data = reader.data[8:]
+	return value
+}
+
+func (reader *packetReader) string() string {
+	length := reader.uint32()
+	if reader.err != nil || uint32(len(reader.data)) < length {
+		reader.err = errShortPacket
+		return ""
+	}
+	value := string(reader.data[:length])
+	reader.data = reader.data[length:]
+	return value
+}
+
+func (reader *packetReader) attributes() attributes {
+	attrs := attributes{flags: reader.uint32()}
+	if attrs.flags&attrSize != 0 {
+		attrs.size = reader.uint64()
+	}
+	if attrs.flags&attrUIDGID != 0 {
+		reader.uint32()
+		reader.uint32()
+	}
+	if attrs.flags&attrPermissions != 0 {
+		attrs.permissions = reader.uint32()
+	}
+	if attrs.flags&attrTimes != 0 {
+		reader.uint32()
+		attrs.modTime = reader.uint32()
+	}
+	if attrs.flags&attrExtended != 0 {
+		count := reader.uint32()
+		for i := uint32(0); i < count && reader.err == nil; i++ {
+			reader.string()
+			reader.string()
+		}
+	}
+	return attrs
+}
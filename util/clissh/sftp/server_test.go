@@ -0,0 +1,210 @@
+package sftp_test
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// testServer is a minimal SFTP server that serves the files under root.
+type testServer struct {
+	root    string
+	reader  io.Reader
+	writer  io.WriteCloser
+	handles map[string]interface{}
+	next    int
+}
+
+func startTestServer(root string, reader io.Reader, writer io.WriteCloser) {
+	server := &testServer{root: root, reader: reader, writer: writer, handles: map[string]interface{}{}}
+	go server.serve()
+}
+
+func (server *testServer) serve() {
+	defer server.writer.Close()
+	for {
+		var header [5]byte
+		if _, err := io.ReadFull(server.reader, header[:]); err != nil {
+			return
+		}
+		data := make([]byte, binary.BigEndian.Uint32(header[:4])-1)
+		if _, err := io.ReadFull(server.reader, data); err != nil {
+			return
+		}
+		server.handle(header[4], &request{data: data})
+	}
+}
+
+type request struct{ data []byte }
+
+func (r *request) uint32() uint32 {
+	value := binary.BigEndian.Uint32(r.data)
+	r.data = r.data[4:]
+	return value
+}
+
+func (r *request) uint64() uint64 {
+	value := binary.BigEndian.Uint64(r.data)
+	r.data = r.data[8:]
+	return value
+}
+
+func (r *request) string() string {
+	length := r.uint32()
+	value := string(r.data[:length])
+	r.data = r.data[length:]
+	return value
+}
+
+func (r *request) permissions() os.FileMode {
+	if flags := r.uint32(); flags&0x04 != 0 {
+		return os.FileMode(r.uint32())
+	}
+	return 0644
+}
+
+func u32(value uint32) []byte {
+	var raw [4]byte
+	binary.BigEndian.PutUint32(raw[:], value)
+	return raw[:]
+}
+
+func str(value string) []byte {
+	return append(u32(uint32(len(value))), value...)
+}
+
+func attrs(info os.FileInfo) []byte {
+	mode := uint32(info.Mode().Perm())
+	if info.IsDir() {
+		mode |= 0040000
+	} else if info.Mode()&os.ModeSymlink != 0 {
+		mode |= 0120000
+	} else {
+		mode |= 0100000
+	}
+	var size [8]byte
+	binary.BigEndian.PutUint64(size[:], uint64(info.Size()))
+	buf := append(u32(0x01|0x04|0x08), size[:]...)
+	buf = append(buf, u32(mode)...)
+	buf = append(buf, u32(uint32(info.ModTime().Unix()))...)
+	return append(buf, u32(uint32(info.ModTime().Unix()))...)
+}
+
+func (server *testServer) send(packetType byte, id uint32, payload ...[]byte) {
+	body := append([]byte{packetType}, u32(id)...)
+	for _, p := range payload {
+		body = append(body, p...)
+	}
+	_, _ = server.writer.Write(append(u32(uint32(len(body))), body...))
+}
+
+func (server *testServer) status(id uint32, err error) {
+	code := uint32(0)
+	switch {
+	case err == io.EOF:
+		code = 1
+	case os.IsNotExist(err):
+		code = 2
+	case os.IsPermission(err):
+		code = 3
+	case err != nil:
+		code = 4
+	}
+	message := ""
+	if err != nil {
+		message = err.Error()
+	}
+	server.send(101, id, u32(code), str(message), str(""))
+}
+
+func (server *testServer) path(name string) string {
+	return filepath.Join(server.root, filepath.FromSlash(name))
+}
+
+func (server *testServer) newHandle(value interface{}) string {
+	server.next++
+	handle := string(rune('a' + server.next))
+	server.handles[handle] = value
+	return handle
+}
+
+func (server *testServer) handle(packetType byte, r *request) {
+	if packetType == 1 {
+		_, _ = server.writer.Write(append(u32(5), append([]byte{2}, u32(3)...)...))
+		return
+	}
+
+	id := r.uint32()
+	switch packetType {
+	case 17: // stat
+		info, err := os.Stat(server.path(r.string()))
+		if err != nil {
+			server.status(id, err)
+			return
+		}
+		server.send(105, id, attrs(info))
+	case 3: // open
+		name := r.string()
+		flags := r.uint32()
+		perm := r.permissions()
+		mode := os.O_RDONLY
+		if flags&0x02 != 0 {
+			mode = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		}
+		file, err := os.OpenFile(server.path(name), mode, perm)
+		if err != nil {
+			server.status(id, err)
+			return
+		}
+		server.send(102, id, str(server.newHandle(file)))
+	case 4: // close
+		handle := r.string()
+		if file, ok := server.handles[handle].(*os.File); ok {
+			file.Close()
+		}
+		delete(server.handles, handle)
+		server.status(id, nil)
+	case 5: // read
+		file := server.handles[r.string()].(*os.File)
+		offset := r.uint64()
+		buf := make([]byte, r.uint32())
+		n, err := file.ReadAt(buf, int64(offset))
+		if n == 0 {
+			server.status(id, err)
+			return
+		}
+		server.send(103, id, str(string(buf[:n])))
+	case 6: // write
+		file := server.handles[r.string()].(*os.File)
+		offset := r.uint64()
+		_, err := file.WriteAt([]byte(r.string()), int64(offset))
+		server.status(id, err)
+	case 11: // opendir
+		entries, err := ioutil.ReadDir(server.path(r.string()))
+		if err != nil {
+			server.status(id, err)
+			return
+		}
+		server.send(102, id, str(server.newHandle(entries)))
+	case 12: // readdir
+		handle := r.string()
+		entries := server.handles[handle].([]os.FileInfo)
+		if len(entries) == 0 {
+			server.status(id, io.EOF)
+			return
+		}
+		server.handles[handle] = []os.FileInfo{}
+		payload := [][]byte{u32(uint32(len(entries)))}
+		for _, entry := range entries {
+			payload = append(payload, str(entry.Name()), str(entry.Name()), attrs(entry))
+		}
+		server.send(104, id, payload...)
+	case 14: // mkdir
+		name := r.string()
+		server.status(id, os.Mkdir(server.path(name), r.permissions()))
+	default:
+		server.status(id, os.ErrInvalid)
+	}
+}
@@ -0,0 +1,13 @@
+package sftp_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSFTP(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SFTP Suite")
+}
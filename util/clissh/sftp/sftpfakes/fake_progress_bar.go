@@ -0,0 +1,144 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package sftpfakes
+
+import (
+	io "io"
+	sync "sync"
+
+	sftp "code.cloudfoundry.org/cli/util/clissh/sftp"
+)
+
+type FakeProgressBar struct {
+	FinishFileStub        func()
+	finishFileMutex       sync.RWMutex
+	finishFileArgsForCall []struct {
+	}
+	NewFileProgressBarWrapperStub        func(io.Reader, string, int64) io.Reader
+	newFileProgressBarWrapperMutex       sync.RWMutex
+	newFileProgressBarWrapperArgsForCall []struct {
+		arg1 io.Reader
+		arg2 string
+		arg3 int64
+	}
+	newFileProgressBarWrapperReturns struct {
+		result1 io.Reader
+	}
+	newFileProgressBarWrapperReturnsOnCall map[int]struct {
+		result1 io.Reader
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProgressBar) FinishFile() {
+	fake.finishFileMutex.Lock()
+	fake.finishFileArgsForCall = append(fake.finishFileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("FinishFile", []interface{}{})
+	fake.finishFileMutex.Unlock()
+	if fake.FinishFileStub != nil {
+		fake.FinishFileStub()
+	}
+}
+
+func (fake *FakeProgressBar) FinishFileCallCount() int {
+	fake.finishFileMutex.RLock()
+	defer fake.finishFileMutex.RUnlock()
+	return len(fake.finishFileArgsForCall)
+}
+
+func (fake *FakeProgressBar) FinishFileCalls(stub func()) {
+	fake.finishFileMutex.Lock()
+	defer fake.finishFileMutex.Unlock()
+	fake.FinishFileStub = stub
+}
+
+func (fake *FakeProgressBar) NewFileProgressBarWrapper(arg1 io.Reader, arg2 string, arg3 int64) io.Reader {
+	fake.newFileProgressBarWrapperMutex.Lock()
+	ret, specificReturn := fake.newFileProgressBarWrapperReturnsOnCall[len(fake.newFileProgressBarWrapperArgsForCall)]
+	fake.newFileProgressBarWrapperArgsForCall = append(fake.newFileProgressBarWrapperArgsForCall, struct {
+		arg1 io.Reader
+		arg2 string
+		arg3 int64
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NewFileProgressBarWrapper", []interface{}{arg1, arg2, arg3})
+	fake.newFileProgressBarWrapperMutex.Unlock()
+	if fake.NewFileProgressBarWrapperStub != nil {
+		return fake.NewFileProgressBarWrapperStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newFileProgressBarWrapperReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeProgressBar) NewFileProgressBarWrapperCallCount() int {
+	fake.newFileProgressBarWrapperMutex.RLock()
+	defer fake.newFileProgressBarWrapperMutex.RUnlock()
+	return len(fake.newFileProgressBarWrapperArgsForCall)
+}
+
+func (fake *FakeProgressBar) NewFileProgressBarWrapperCalls(stub func(io.Reader, string, int64) io.Reader) {
+	fake.newFileProgressBarWrapperMutex.Lock()
+	defer fake.newFileProgressBarWrapperMutex.Unlock()
+	fake.NewFileProgressBarWrapperStub = stub
+}
+
+func (fake *FakeProgressBar) NewFileProgressBarWrapperArgsForCall(i int) (io.Reader, string, int64) {
+	fake.newFileProgressBarWrapperMutex.RLock()
+	defer fake.newFileProgressBarWrapperMutex.RUnlock()
+	argsForCall := fake.newFileProgressBarWrapperArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeProgressBar) NewFileProgressBarWrapperReturns(result1 io.Reader) {
+	fake.newFileProgressBarWrapperMutex.Lock()
+	defer fake.newFileProgressBarWrapperMutex.Unlock()
+	fake.NewFileProgressBarWrapperStub = nil
+	fake.newFileProgressBarWrapperReturns = struct {
+		result1 io.Reader
+	}{result1}
+}
+
+func (fake *FakeProgressBar) NewFileProgressBarWrapperReturnsOnCall(i int, result1 io.Reader) {
+	fake.newFileProgressBarWrapperMutex.Lock()
+	defer fake.newFileProgressBarWrapperMutex.Unlock()
+	fake.NewFileProgressBarWrapperStub = nil
+	if fake.newFileProgressBarWrapperReturnsOnCall == nil {
+		fake.newFileProgressBarWrapperReturnsOnCall = make(map[int]struct {
+			result1 io.Reader
+		})
+	}
+	fake.newFileProgressBarWrapperReturnsOnCall[i] = struct {
+		result1 io.Reader
+	}{result1}
+}
+
+func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.finishFileMutex.RLock()
+	defer fake.finishFileMutex.RUnlock()
+	fake.newFileProgressBarWrapperMutex.RLock()
+	defer fake.newFileProgressBarWrapperMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeProgressBar) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sftp.ProgressBar = new(FakeProgressBar)
@@ -0,0 +1,164 @@
+package sftp
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+)
+
+//go:generate counterfeiter . ProgressBar
+
+// ProgressBar displays the progress of copying one file at a time.
+type ProgressBar interface {
+	NewFileProgressBarWrapper(reader io.Reader, name string, sizeOfFile int64) io.Reader
+	FinishFile()
+}
+
+// Upload copies the local file to the remote path. Directories are copied
+// with their contents when recursive is set; symbolic links inside them are
+// skipped, so that links to parent directories cannot make the copy loop.
+// When the remote path is an existing directory the file is copied into it.
+func (client *Client) Upload(localPath string, remotePath string, recursive bool, progressBar ProgressBar) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	target := remotePath
+	if remoteInfo, statErr := client.Stat(remotePath); statErr == nil && remoteInfo.IsDir() {
+		target = path.Join(remotePath, filepath.Base(localPath))
+	}
+
+	return client.upload(localPath, info, target, recursive, progressBar)
+}
+
+// Download copies the remote file to the local path. Directories are copied
+// with their contents when recursive is set; symbolic links inside them are
+// skipped, so that links to parent directories cannot make the copy loop.
+// When the local path is an existing directory the file is copied into it. A
+// file that fails to download is removed rather than left truncated.
+func (client *Client) Download(remotePath string, localPath string, recursive bool, progressBar ProgressBar) error {
+	info, err := client.Stat(remotePath)
+	if err != nil {
+		return err
+	}
+
+	target := localPath
+	if localInfo, statErr := os.Stat(localPath); statErr == nil && localInfo.IsDir() {
+		target = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	return client.download(remotePath, info, target, recursive, progressBar)
+}
+
+func (client *Client) upload(localPath string, info os.FileInfo, remotePath string, recursive bool, progressBar ProgressBar) error {
+	if info.IsDir() {
+		if !recursive {
+			return ssherror.RecursiveCopyRequiredError{Path: localPath}
+		}
+
+		err := client.Mkdir(remotePath, info.Mode().Perm())
+		if err != nil {
+			if remoteInfo, statErr := client.Stat(remotePath); statErr != nil || !remoteInfo.IsDir() {
+				return err
+			}
+		}
+
+		entries, err := ioutil.ReadDir(localPath)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			// ReadDir describes symbolic links rather than the files they
+			// point to, so links are skipped below like other special files.
+			err = client.upload(filepath.Join(localPath, entry.Name()), entry, path.Join(remotePath, entry.Name()), recursive, progressBar)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	source, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := client.Create(remotePath, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	return copyFile(destination, source, localPath, info.Size(), progressBar)
+}
+
+func (client *Client) download(remotePath string, info os.FileInfo, localPath string, recursive bool, progressBar ProgressBar) error {
+	if info.IsDir() {
+		if !recursive {
+			return ssherror.RecursiveCopyRequiredError{Path: remotePath}
+		}
+
+		err := os.Mkdir(localPath, info.Mode().Perm())
+		if err != nil && !os.IsExist(err) {
+			return err
+		}
+
+		entries, err := client.ReadDir(remotePath)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			err = client.download(path.Join(remotePath, entry.Name()), entry, filepath.Join(localPath, entry.Name()), recursive, progressBar)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	source, err := client.Open(remotePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	err = copyFile(destination, source, remotePath, info.Size(), progressBar)
+	if err != nil {
+		_ = os.Remove(localPath)
+	}
+	return err
+}
+
+// copyFile copies source to destination and closes destination, so that
+// errors writing the end of the file are returned.
+func copyFile(destination io.WriteCloser, source io.Reader, name string, size int64, progressBar ProgressBar) error {
+	if progressBar != nil {
+		source = progressBar.NewFileProgressBarWrapper(source, name, size)
+		defer progressBar.FinishFile()
+	}
+
+	_, err := io.Copy(destination, source)
+	closeErr := destination.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
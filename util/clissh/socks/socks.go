@@ -0,0 +1,158 @@
+// Package socks serves SOCKS5 CONNECT requests so that local clients can
+// reach any address through an SSH connection.
+package socks
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+)
+
+const (
+	version5 = 0x05
+
+	methodNoAuthentication = 0x00
+	methodNoneAcceptable   = 0xff
+
+	commandConnect = 0x01
+
+	addressIPv4   = 0x01
+	addressDomain = 0x03
+	addressIPv6   = 0x04
+
+	replySucceeded           = 0x00
+	replyGeneralFailure      = 0x01
+	replyCommandNotSupported = 0x07
+	replyAddressNotSupported = 0x08
+)
+
+// DialFunc connects to the address requested by the client.
+type DialFunc func(network string, address string) (net.Conn, error)
+
+// Serve negotiates a SOCKS5 CONNECT request on conn, dials the requested
+// address and copies data in both directions until either side closes. Only
+// unauthenticated CONNECT requests are supported. Serve closes conn.
+func Serve(conn net.Conn, dial DialFunc) error {
+	defer conn.Close()
+
+	err := negotiateMethod(conn)
+	if err != nil {
+		return err
+	}
+
+	address, err := readConnectRequest(conn)
+	if err != nil {
+		return err
+	}
+
+	target, err := dial("tcp", address)
+	if err != nil {
+		_ = writeReply(conn, replyGeneralFailure)
+		return fmt.Errorf("connect to %s failed: %s", address, err.Error())
+	}
+	defer target.Close()
+
+	err = writeReply(conn, replySucceeded)
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+	return nil
+}
+
+func negotiateMethod(conn net.Conn) error {
+	var header [2]byte
+	_, err := io.ReadFull(conn, header[:])
+	if err != nil {
+		return err
+	}
+	if header[0] != version5 {
+		return fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(conn, methods)
+	if err != nil {
+		return err
+	}
+
+	for _, method := range methods {
+		if method == methodNoAuthentication {
+			_, err = conn.Write([]byte{version5, methodNoAuthentication})
+			return err
+		}
+	}
+
+	_, _ = conn.Write([]byte{version5, methodNoneAcceptable})
+	return errors.New("SOCKS client does not support unauthenticated connections")
+}
+
+func readConnectRequest(conn net.Conn) (string, error) {
+	var header [4]byte
+	_, err := io.ReadFull(conn, header[:])
+	if err != nil {
+		return "", err
+	}
+	if header[0] != version5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+	if header[1] != commandConnect {
+		_ = writeReply(conn, replyCommandNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d", header[1])
+	}
+
+	var host string
+	switch header[3] {
+	case addressIPv4, addressIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if header[3] == addressIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		_, err = io.ReadFull(conn, ip)
+		host = ip.String()
+	case addressDomain:
+		var length [1]byte
+		_, err = io.ReadFull(conn, length[:])
+		if err == nil {
+			domain := make([]byte, length[0])
+			_, err = io.ReadFull(conn, domain)
+			host = string(domain)
+		}
+	default:
+		_ = writeReply(conn, replyAddressNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", header[3])
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var port [2]byte
+	_, err = io.ReadFull(conn, port[:])
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port[:])))), nil
+}
+
+// writeReply sends the reply with an unspecified bound address, since the
+// connection is made from the far end of the SSH connection.
+func writeReply(conn net.Conn, reply byte) error {
+	_, err := conn.Write([]byte{version5, reply, 0x00, addressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+func copyAndClose(wg *sync.WaitGroup, dest io.WriteCloser, src io.Reader) {
+	_, _ = io.Copy(dest, src)
+	_ = dest.Close()
+	wg.Done()
+}
@@ -0,0 +1,13 @@
+package socks_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSOCKS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SOCKS Suite")
+}
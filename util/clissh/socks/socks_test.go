@@ -0,0 +1,125 @@
+package socks_test
+
+import (
+	"errors"
+	"io"
+	"net"
+
+	. "code.cloudfoundry.org/cli/util/clissh/socks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Serve", func() {
+	var (
+		client      net.Conn
+		server      net.Conn
+		dialedAddrs chan string
+		dialErr     error
+		serveErr    chan error
+	)
+
+	BeforeEach(func() {
+		client, server = net.Pipe()
+		dialedAddrs = make(chan string, 1)
+		dialErr = nil
+		serveErr = make(chan error, 1)
+	})
+
+	JustBeforeEach(func() {
+		addrs, err := dialedAddrs, dialErr
+		dial := func(network string, address string) (net.Conn, error) {
+			addrs <- address
+			if err != nil {
+				return nil, err
+			}
+
+			local, remote := net.Pipe()
+			go func() {
+				defer remote.Close()
+				_, _ = io.Copy(remote, remote)
+			}()
+			return local, nil
+		}
+		conn, errs := server, serveErr
+		go func() { errs <- Serve(conn, dial) }()
+	})
+
+	AfterEach(func() {
+		client.Close()
+	})
+
+	readN := func(n int) []byte {
+		buf := make([]byte, n)
+		_, err := io.ReadFull(client, buf)
+		Expect(err).ToNot(HaveOccurred())
+		return buf
+	}
+
+	write := func(p []byte) {
+		_, err := client.Write(p)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("connects to a domain name and relays data", func() {
+		write([]byte{5, 1, 0})
+		Expect(readN(2)).To(Equal([]byte{5, 0}))
+
+		write(append(append([]byte{5, 1, 0, 3, 11}, "db.internal"...), 0x15, 0x38))
+		Expect(readN(10)).To(Equal([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}))
+		Expect(dialedAddrs).To(Receive(Equal("db.internal:5432")))
+
+		write([]byte("ping"))
+		Expect(readN(4)).To(Equal([]byte("ping")))
+
+		client.Close()
+		Eventually(serveErr).Should(Receive(BeNil()))
+	})
+
+	It("connects to an IPv4 address", func() {
+		write([]byte{5, 1, 0})
+		readN(2)
+		write([]byte{5, 1, 0, 1, 10, 0, 0, 1, 0, 80})
+		Expect(readN(10)[1]).To(Equal(byte(0)))
+		Expect(dialedAddrs).To(Receive(Equal("10.0.0.1:80")))
+	})
+
+	When("dialing the address fails", func() {
+		BeforeEach(func() {
+			dialErr = errors.New("dial-error")
+		})
+
+		It("replies with a failure and returns the error", func() {
+			write([]byte{5, 1, 0})
+			readN(2)
+			write([]byte{5, 1, 0, 1, 10, 0, 0, 1, 0, 80})
+			Expect(readN(10)[1]).To(Equal(byte(1)))
+			Eventually(serveErr).Should(Receive(MatchError("connect to 10.0.0.1:80 failed: dial-error")))
+		})
+	})
+
+	When("the client requires authentication", func() {
+		It("rejects the client", func() {
+			write([]byte{5, 1, 2})
+			Expect(readN(2)).To(Equal([]byte{5, 0xff}))
+			Eventually(serveErr).Should(Receive(MatchError("SOCKS client does not support unauthenticated connections")))
+		})
+	})
+
+	When("the client requests a command other than CONNECT", func() {
+		It("replies that the command is not supported", func() {
+			write([]byte{5, 1, 0})
+			readN(2)
+			write([]byte{5, 2, 0, 1})
+			Expect(readN(10)[1]).To(Equal(byte(7)))
+			Eventually(serveErr).Should(Receive(MatchError("unsupported SOCKS command 2")))
+		})
+	})
+
+	When("the client speaks SOCKS4", func() {
+		It("returns an error", func() {
+			write([]byte{4, 1})
+			Eventually(serveErr).Should(Receive(MatchError("unsupported SOCKS version 4")))
+		})
+	})
+})
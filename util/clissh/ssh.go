@@ -17,7 +17,9 @@ import (
 	"syscall"
 	"time"
 
+	"code.cloudfoundry.org/cli/util/clissh/sftp"
 	"code.cloudfoundry.org/cli/util/clissh/sigwinch"
+	"code.cloudfoundry.org/cli/util/clissh/socks"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"github.com/moby/moby/pkg/term"
 	"golang.org/x/crypto/ssh"
@@ -45,6 +47,13 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+// RemotePortForward forwards connections to RemoteAddress on the application
+// instance to LocalAddress on the local machine.
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
 //go:generate counterfeiter . SecureDialer
 
 type SecureDialer interface {
@@ -57,6 +66,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -82,6 +92,7 @@ type ListenerFactory interface {
 type SecureSession interface {
 	RequestPty(term string, height, width int, termModes ssh.TerminalModes) error
 	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
+	RequestSubsystem(subsystem string) error
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
 	StderrPipe() (io.Reader, error)
@@ -98,6 +109,7 @@ type SecureShell struct {
 	listenerFactory ListenerFactory
 
 	localListeners    []net.Listener
+	remoteListeners   []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -154,6 +166,9 @@ func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -171,7 +186,52 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 	return nil
 }
 
+// RemotePortForward asks the application instance to listen on each remote
+// address and forwards the connections it accepts to the local address.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return err
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		go c.remoteForwardAcceptLoop(listener, spec.LocalAddress)
+	}
+
+	return nil
+}
+
+// DynamicPortForward listens on each local address for SOCKS5 clients and
+// connects them to the addresses they request through the application
+// instance.
+func (c *SecureShell) DynamicPortForward(addresses []string) error {
+	for _, address := range addresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go acceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
 func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func (c *SecureShell) remoteForwardAcceptLoop(listener net.Listener, addr string) {
+	acceptLoop(listener, func(conn net.Conn) {
+		handleRemoteForwardConnection(conn, addr)
+	})
+}
+
+func acceptLoop(listener net.Listener, handle func(conn net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -184,16 +244,41 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
+	}
+}
+
+func (c *SecureShell) handleDynamicForwardConnection(conn net.Conn) {
+	err := socks.Serve(conn, c.secureClient.Dial)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "SOCKS connection failed: %s\n", err.Error())
 	}
 }
 
+func handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
 func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
 	defer conn.Close()
 
 	target, err := c.secureClient.Dial("tcp", targetAddr)
 	if err != nil {
-		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		fmt.Fprintf(os.Stderr, "connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
@@ -318,6 +403,125 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// ExecuteCommand runs the command on the connected instance without a
+// terminal or standard input, and copies its output to stdout and stderr. A
+// non-zero exit status is returned as an *ssh.ExitError.
+func (c *SecureShell) ExecuteCommand(commands []string, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
+// CopyToRemote copies the local file, or directory when recursive is set, to
+// the remote path using the sftp subsystem of the connected instance.
+func (c *SecureShell) CopyToRemote(localPath string, remotePath string, recursive bool, progressBar sftp.ProgressBar) error {
+	client, err := c.newFileTransferClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	return client.Upload(localPath, remotePath, recursive, progressBar)
+}
+
+// CopyFromRemote copies the remote file, or directory when recursive is set,
+// to the local path using the sftp subsystem of the connected instance.
+func (c *SecureShell) CopyFromRemote(remotePath string, localPath string, recursive bool, progressBar sftp.ProgressBar) error {
+	client, err := c.newFileTransferClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	return client.Download(remotePath, localPath, recursive, progressBar)
+}
+
+func (c *SecureShell) newFileTransferClient() (*sftp.Client, error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+
+	client, err := newSFTPClient(session)
+	if err != nil {
+		_ = session.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func newSFTPClient(session SecureSession) (*sftp.Client, error) {
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.RequestSubsystem("sftp")
+	if err != nil {
+		return nil, err
+	}
+
+	return sftp.NewClient(outPipe, sessionWriter{WriteCloser: inPipe, session: session})
+}
+
+// sessionWriter closes the session along with its stdin.
+type sessionWriter struct {
+	io.WriteCloser
+	session SecureSession
+}
+
+func (w sessionWriter) Close() error {
+	_ = w.WriteCloser.Close()
+	return w.session.Close()
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -7,6 +7,7 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -49,6 +50,25 @@ func BlockAcceptOnClose(fake *fake_net.FakeListener) {
 	}
 }
 
+func startEchoServer() net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	go func() {
+		for {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	return listener
+}
+
 var _ = Describe("CLI SSH", func() {
 	var (
 		fakeSecureDialer    *clisshfakes.FakeSecureDialer
@@ -185,6 +205,9 @@ var _ = Describe("CLI SSH", func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -1147,6 +1170,305 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+			forwardSpecs   []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			echoListener = startEchoServer()
+
+			var err error
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:9000",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the application instance and forwards connections to the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9000"))
+
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("hello"))
+			Expect(err).NotTo(HaveOccurred())
+			response := make([]byte, 5)
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal([]byte("hello")))
+		})
+
+		It("stops listening on the application instance when closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+			_, err := remoteListener.Accept()
+			Expect(err).To(HaveOccurred())
+		})
+
+		When("listening on the application instance fails", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			localListener net.Listener
+		)
+
+		BeforeEach(func() {
+			echoListener = startEchoServer()
+
+			var err error
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]string{"localhost:1080"})
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("connects SOCKS clients to the requested address through the application instance", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte{5, 1, 0})
+			Expect(err).NotTo(HaveOccurred())
+			methodReply := make([]byte, 2)
+			_, err = io.ReadFull(conn, methodReply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(methodReply).To(Equal([]byte{5, 0}))
+
+			echoAddr := echoListener.Addr().(*net.TCPAddr)
+			request := append([]byte{5, 1, 0, 1}, echoAddr.IP.To4()...)
+			request = append(request, byte(echoAddr.Port>>8), byte(echoAddr.Port))
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+			connectReply := make([]byte, 10)
+			_, err = io.ReadFull(conn, connectReply)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(connectReply[1]).To(Equal(byte(0)))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			_, dialAddr := fakeSecureClient.DialArgsForCall(0)
+			Expect(dialAddr).To(Equal(echoAddr.String()))
+
+			_, err = conn.Write([]byte("hello"))
+			Expect(err).NotTo(HaveOccurred())
+			response := make([]byte, 5)
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal([]byte("hello")))
+		})
+
+		When("listening locally fails", func() {
+			BeforeEach(func() {
+				localListener.Close()
+				fakeListenerFactory.ListenReturns(nil, errors.New("address already in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("address already in use"))
+			})
+		})
+	})
+
+	Describe("ExecuteCommand", func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			commands = []string{"ls", "-l"}
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+
+			stdoutPipe.ReadStub = bytes.NewBufferString("some output\n").Read
+			stderrPipe.ReadStub = bytes.NewBufferString("some error\n").Read
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			executeErr = secureShell.ExecuteCommand(commands, stdout, stderr)
+		})
+
+		It("runs the command without a terminal or standard input", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("ls -l"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		It("copies the output of the command", func() {
+			Expect(stdout.String()).To(Equal("some output\n"))
+			Expect(stderr.String()).To(Equal("some error\n"))
+		})
+
+		When("the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("exit status 1"))
+			})
+		})
+
+		When("the session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("too many sessions"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("SSH session allocation failed: too many sessions"))
+			})
+		})
+
+		When("starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("start failed"))
+			})
+
+			It("returns the error and closes the session", func() {
+				Expect(executeErr).To(MatchError("start failed"))
+				Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("CopyToRemote", func() {
+		var copyErr error
+
+		BeforeEach(func() {
+			versionPacket := []byte{0, 0, 0, 5, 2, 0, 0, 0, 3}
+			fakeSecureSession.StdoutPipeReturns(bytes.NewReader(versionPacket), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			copyErr = secureShell.CopyToRemote("/some/missing/local-path", "some-remote-path", false, nil)
+		})
+
+		It("starts the sftp subsystem and copies over it", func() {
+			Expect(os.IsNotExist(copyErr)).To(BeTrue())
+
+			Expect(fakeSecureSession.RequestSubsystemCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+			Expect(stdinPipe.WriteCallCount()).To(Equal(1))
+			Expect(stdinPipe.WriteArgsForCall(0)).To(Equal([]byte{0, 0, 0, 5, 1, 0, 0, 0, 3}))
+
+			Expect(stdinPipe.CloseCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("allocating a session fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("session-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(copyErr).To(MatchError("SSH session allocation failed: session-error"))
+			})
+		})
+
+		When("the sftp subsystem cannot be started", func() {
+			BeforeEach(func() {
+				fakeSecureSession.RequestSubsystemReturns(errors.New("subsystem-error"))
+			})
+
+			It("returns the error and closes the session", func() {
+				Expect(copyErr).To(MatchError("subsystem-error"))
+				Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("CopyFromRemote", func() {
+		var copyErr error
+
+		BeforeEach(func() {
+			versionPacket := []byte{0, 0, 0, 5, 2, 0, 0, 0, 3}
+			fakeSecureSession.StdoutPipeReturns(bytes.NewReader(versionPacket), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			copyErr = secureShell.CopyFromRemote("some-remote-path", "some-local-path", false, nil)
+		})
+
+		When("the connection ends before the server responds", func() {
+			It("returns the error and closes the session", func() {
+				Expect(copyErr).To(Equal(io.ErrUnexpectedEOF))
+				Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+				Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
@@ -0,0 +1,13 @@
+package ssherror
+
+import "fmt"
+
+// RecursiveCopyRequiredError is returned when a directory is copied without
+// copying recursively.
+type RecursiveCopyRequiredError struct {
+	Path string
+}
+
+func (e RecursiveCopyRequiredError) Error() string {
+	return fmt.Sprintf("%s is a directory", e.Path)
+}
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strconv"
 
+	"code.cloudfoundry.org/cli/util/credentialhelper"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -24,6 +25,20 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// profileOverride is the name of the profile selected by the '--profile'
+	// flag or $CF_PROFILE, and defaultProfile holds the targeting information
+	// it replaced.
+	profileOverride string
+	defaultProfile  *Profile
+
+	// credentialsErr is the error that prevented the credentials from being
+	// loaded from the credential helper.
+	credentialsErr error
+
+	// knownCredentials are the credentials of each target and profile as of
+	// the last load or write, used to erase those that are no longer in use.
+	knownCredentials map[credentialhelper.Key]credentialhelper.Credentials
 }
 
 // BinaryVersion is the current version of the CF binary.
@@ -31,6 +46,18 @@ func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
 
+// BitsCacheDir returns the directory in which the SHA1s of pushed files and
+// the archives built from them are cached.
+func (config *Config) BitsCacheDir() string {
+	return filepath.Join(configDirectory(), "cache", "bits")
+}
+
+// TaskSchedulesFilePath returns the path of the file in which task schedules
+// are stored.
+func (config *Config) TaskSchedulesFilePath() string {
+	return filepath.Join(configDirectory(), "task-schedules.json")
+}
+
 // IsTTY returns true based off of:
 //   - The $FORCE_TTY is set to true/t/1
 //   - Detected from the STDOUT stream
@@ -2,6 +2,7 @@ package configv3_test
 
 import (
 	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -23,6 +24,30 @@ var _ = Describe("Config", func() {
 		teardown(homeDir)
 	})
 
+	Describe("BitsCacheDir", func() {
+		BeforeEach(func() {
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns a directory under CF_HOME", func() {
+			Expect(config.BitsCacheDir()).To(Equal(filepath.Join(homeDir, ".cf", "cache", "bits")))
+		})
+	})
+
+	Describe("TaskSchedulesFilePath", func() {
+		BeforeEach(func() {
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns a file under CF_HOME", func() {
+			Expect(config.TaskSchedulesFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "task-schedules.json")))
+		})
+	})
+
 	Describe("IsTTY", func() {
 		BeforeEach(func() {
 			Expect(os.Setenv("FORCE_TTY", "true")).ToNot(HaveOccurred())
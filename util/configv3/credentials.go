@@ -0,0 +1,159 @@
+package configv3
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+)
+
+// CredentialHelper returns the name of the credential helper that stores the
+// access token, refresh token and UAA client secret instead of
+// .cf/config.json. This is based off of:
+//   1. The $CF_CREDENTIAL_HELPER environment variable if set
+//   2. The 'CredentialHelper' value in the .cf/config.json if set
+//   3. Defaults to "" when credentials are stored in .cf/config.json
+func (config *Config) CredentialHelper() string {
+	if config.ENV.CFCredentialHelper != "" {
+		return config.ENV.CFCredentialHelper
+	}
+	return config.ConfigFile.CredentialHelper
+}
+
+func (config *Config) newCredentialHelper() credentialhelper.Helper {
+	name := config.CredentialHelper()
+	if name == "" {
+		return nil
+	}
+	return credentialhelper.New(name, configDirectory())
+}
+
+// CredentialsError returns the error that prevented the credentials from
+// being loaded from the credential helper, such as a missing passphrase. It
+// is reported once a command needs credentials, so that commands that do not
+// need them still work.
+func (config *Config) CredentialsError() error {
+	return config.credentialsErr
+}
+
+// loadCredentials fills in the credentials of the default target and of
+// every profile from the credential helper. Credentials still present in
+// .cf/config.json are kept when the helper has none, so that they are moved
+// to the helper on the next write. A missing passphrase is kept for
+// CredentialsError instead of failing.
+func (config *Config) loadCredentials() error {
+	helper := config.newCredentialHelper()
+	if helper == nil {
+		return nil
+	}
+
+	names := sortedProfileNames(config.ConfigFile.Profiles)
+	keys := []credentialhelper.Key{{ServerURL: config.ConfigFile.Target}}
+	for _, name := range names {
+		keys = append(keys, credentialhelper.Key{ServerURL: config.ConfigFile.Profiles[name].Target, Profile: name})
+	}
+
+	allCredentials, err := credentialhelper.GetAll(helper, keys)
+	if _, ok := err.(credentialhelper.MissingPassphraseError); ok {
+		config.credentialsErr = err
+		config.knownCredentials = allCredentialsOf(&config.ConfigFile)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	applyCredentials(allCredentials[keys[0]], &config.ConfigFile.AccessToken, &config.ConfigFile.RefreshToken, &config.ConfigFile.UAAOAuthClientSecret)
+	for i, name := range names {
+		profile := config.ConfigFile.Profiles[name]
+		applyCredentials(allCredentials[keys[i+1]], &profile.AccessToken, &profile.RefreshToken, &profile.UAAOAuthClientSecret)
+		config.ConfigFile.Profiles[name] = profile
+	}
+	config.knownCredentials = allCredentialsOf(&config.ConfigFile)
+
+	return nil
+}
+
+// storeCredentials hands the credentials of the default target and of every
+// profile in configFile to the credential helper and removes them from
+// configFile. Credentials of targets and profiles that are no longer in
+// configFile are erased. When the credentials could not be loaded, only
+// changed credentials are stored; unchanged ones stay in configFile since the
+// helper cannot be reached without the passphrase.
+func (config *Config) storeCredentials(configFile *JSONConfig) error {
+	helper := config.newCredentialHelper()
+	if helper == nil {
+		return nil
+	}
+
+	current := allCredentialsOf(configFile)
+	changes := credentialhelper.Changes(config.knownCredentials, current, config.credentialsErr == nil)
+	for key := range changes {
+		clearCredentials(configFile, key)
+	}
+
+	err := credentialhelper.StoreAll(helper, changes)
+	if err != nil {
+		return err
+	}
+
+	if config.credentialsErr == nil {
+		config.knownCredentials = current
+	}
+	return nil
+}
+
+// allCredentialsOf returns the credentials of the default target and of
+// every profile in configFile.
+func allCredentialsOf(configFile *JSONConfig) map[credentialhelper.Key]credentialhelper.Credentials {
+	allCredentials := map[credentialhelper.Key]credentialhelper.Credentials{
+		{ServerURL: configFile.Target}: {
+			AccessToken:          configFile.AccessToken,
+			RefreshToken:         configFile.RefreshToken,
+			UAAOAuthClientSecret: configFile.UAAOAuthClientSecret,
+		},
+	}
+	for name, profile := range configFile.Profiles {
+		allCredentials[credentialhelper.Key{ServerURL: profile.Target, Profile: name}] = credentialhelper.Credentials{
+			AccessToken:          profile.AccessToken,
+			RefreshToken:         profile.RefreshToken,
+			UAAOAuthClientSecret: profile.UAAOAuthClientSecret,
+		}
+	}
+	return allCredentials
+}
+
+// clearCredentials removes the credentials of key from configFile.
+func clearCredentials(configFile *JSONConfig, key credentialhelper.Key) {
+	if key.Profile == "" {
+		if key.ServerURL == configFile.Target {
+			configFile.AccessToken, configFile.RefreshToken, configFile.UAAOAuthClientSecret = "", "", ""
+		}
+		return
+	}
+
+	profile, found := configFile.Profiles[key.Profile]
+	if found && key.ServerURL == profile.Target {
+		profile.AccessToken, profile.RefreshToken, profile.UAAOAuthClientSecret = "", "", ""
+		configFile.Profiles[key.Profile] = profile
+	}
+}
+
+func applyCredentials(credentials credentialhelper.Credentials, accessToken *string, refreshToken *string, clientSecret *string) {
+	if credentials.AccessToken != "" {
+		*accessToken = credentials.AccessToken
+	}
+	if credentials.RefreshToken != "" {
+		*refreshToken = credentials.RefreshToken
+	}
+	if credentials.UAAOAuthClientSecret != "" {
+		*clientSecret = credentials.UAAOAuthClientSecret
+	}
+}
+
+func sortedProfileNames(profiles map[string]Profile) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
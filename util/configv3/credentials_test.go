@@ -0,0 +1,246 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential helpers", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+		Expect(os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+		Expect(os.Unsetenv("CF_CREDENTIAL_HELPER")).To(Succeed())
+		teardown(homeDir)
+	})
+
+	Describe("CredentialHelper", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			config = &Config{ConfigFile: JSONConfig{CredentialHelper: "config-helper"}}
+		})
+
+		When("CF_CREDENTIAL_HELPER is set", func() {
+			BeforeEach(func() {
+				config.ENV.CFCredentialHelper = "env-helper"
+			})
+
+			It("prefers the environment", func() {
+				Expect(config.CredentialHelper()).To(Equal("env-helper"))
+			})
+		})
+
+		When("CF_CREDENTIAL_HELPER is not set", func() {
+			It("uses the config file", func() {
+				Expect(config.CredentialHelper()).To(Equal("config-helper"))
+			})
+		})
+	})
+
+	When("the encrypted-file helper is configured", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			config = &Config{
+				ConfigFile: JSONConfig{
+					ConfigVersion:        3,
+					Target:               "https://api.foo.com",
+					AccessToken:          "some-access-token",
+					RefreshToken:         "some-refresh-token",
+					UAAOAuthClient:       "some-client",
+					UAAOAuthClientSecret: "some-secret",
+					CredentialHelper:     credentialhelper.EncryptedFileHelperName,
+					Profiles: map[string]Profile{
+						"staging": {
+							Target:       "https://api.staging.com",
+							AccessToken:  "staging-access-token",
+							RefreshToken: "staging-refresh-token",
+						},
+					},
+				},
+			}
+		})
+
+		It("keeps the credentials out of config.json", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+
+			file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(file)).ToNot(ContainSubstring("some-access-token"))
+			Expect(string(file)).ToNot(ContainSubstring("some-refresh-token"))
+			Expect(string(file)).ToNot(ContainSubstring("some-secret"))
+			Expect(string(file)).ToNot(ContainSubstring("staging-access-token"))
+
+			var writtenCFConfig JSONConfig
+			Expect(json.Unmarshal(file, &writtenCFConfig)).To(Succeed())
+			Expect(writtenCFConfig.Target).To(Equal("https://api.foo.com"))
+			Expect(writtenCFConfig.Profiles["staging"].Target).To(Equal("https://api.staging.com"))
+
+			encrypted, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", credentialhelper.EncryptedFileName))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(encrypted)).ToNot(ContainSubstring("some-access-token"))
+		})
+
+		It("does not modify the in-memory config", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+			Expect(config.ConfigFile.AccessToken).To(Equal("some-access-token"))
+			Expect(config.ConfigFile.Profiles["staging"].AccessToken).To(Equal("staging-access-token"))
+		})
+
+		It("loads the credentials back from the helper", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+
+			loaded, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(loaded.ConfigFile.AccessToken).To(Equal("some-access-token"))
+			Expect(loaded.ConfigFile.RefreshToken).To(Equal("some-refresh-token"))
+			Expect(loaded.ConfigFile.UAAOAuthClientSecret).To(Equal("some-secret"))
+			Expect(loaded.ConfigFile.Profiles["staging"].AccessToken).To(Equal("staging-access-token"))
+			Expect(loaded.ConfigFile.Profiles["staging"].RefreshToken).To(Equal("staging-refresh-token"))
+		})
+
+		When("the credentials are cleared", func() {
+			It("erases them from the helper", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config.ConfigFile.AccessToken = ""
+				config.ConfigFile.RefreshToken = ""
+				config.ConfigFile.UAAOAuthClientSecret = ""
+				Expect(WriteConfig(config)).To(Succeed())
+
+				loaded, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loaded.ConfigFile.AccessToken).To(BeEmpty())
+				Expect(loaded.ConfigFile.RefreshToken).To(BeEmpty())
+			})
+		})
+
+		When("the target changes", func() {
+			It("erases the credentials stored for the old target", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				loaded, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				loaded.ConfigFile.Target = "https://api.bar.com"
+				loaded.ConfigFile.AccessToken = "bar-access-token"
+				Expect(WriteConfig(loaded)).To(Succeed())
+
+				helper := credentialhelper.New(credentialhelper.EncryptedFileHelperName, filepath.Join(homeDir, ".cf"))
+				credentials, err := helper.Get(credentialhelper.Key{ServerURL: "https://api.foo.com"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials.Empty()).To(BeTrue())
+
+				credentials, err = helper.Get(credentialhelper.Key{ServerURL: "https://api.bar.com"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credentials.AccessToken).To(Equal("bar-access-token"))
+			})
+		})
+
+		When("the passphrase is missing", func() {
+			BeforeEach(func() {
+				Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+			})
+
+			It("returns an error and does not write config.json", func() {
+				Expect(WriteConfig(config)).To(MatchError(credentialhelper.MissingPassphraseError{}))
+				_, err := os.Stat(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		When("the passphrase is missing when loading the config", func() {
+			BeforeEach(func() {
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+			})
+
+			It("loads the config without credentials and reports the error later", func() {
+				loaded, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loaded.Target()).To(Equal("https://api.foo.com"))
+				Expect(loaded.ConfigFile.AccessToken).To(BeEmpty())
+				Expect(loaded.CredentialsError()).To(MatchError(credentialhelper.MissingPassphraseError{}))
+			})
+
+			It("does not erase the stored credentials when the config is written", func() {
+				loaded, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(loaded)).To(Succeed())
+
+				Expect(os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")).To(Succeed())
+				loaded, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loaded.CredentialsError()).ToNot(HaveOccurred())
+				Expect(loaded.ConfigFile.AccessToken).To(Equal("some-access-token"))
+				Expect(loaded.ConfigFile.Profiles["staging"].AccessToken).To(Equal("staging-access-token"))
+			})
+		})
+	})
+
+	When("config.json still holds plaintext credentials", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"Target": "https://api.foo.com",
+				"AccessToken": "plaintext-access-token"
+			}`)
+			Expect(os.Setenv("CF_CREDENTIAL_HELPER", credentialhelper.EncryptedFileHelperName)).To(Succeed())
+		})
+
+		It("keeps them until they are moved to the helper on the next write", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ConfigFile.AccessToken).To(Equal("plaintext-access-token"))
+
+			Expect(WriteConfig(config)).To(Succeed())
+			file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(file)).ToNot(ContainSubstring("plaintext-access-token"))
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ConfigFile.AccessToken).To(Equal("plaintext-access-token"))
+		})
+
+		When("the passphrase is missing", func() {
+			BeforeEach(func() {
+				Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+			})
+
+			It("writes the config and leaves the credentials in config.json", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CredentialsError()).To(MatchError(credentialhelper.MissingPassphraseError{}))
+
+				config.ConfigFile.ColorEnabled = "false"
+				Expect(WriteConfig(config)).To(Succeed())
+
+				file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(file)).To(ContainSubstring("plaintext-access-token"))
+			})
+
+			It("returns the missing passphrase error when the credentials change", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				config.ConfigFile.AccessToken = "new-access-token"
+				Expect(WriteConfig(config)).To(MatchError(credentialhelper.MissingPassphraseError{}))
+			})
+		})
+	})
+})
@@ -47,6 +47,20 @@ const (
 
 	// DefaultRetryCount is the default number of request retries.
 	DefaultRetryCount = 2
+
+	// DefaultRetryBaseDelay is the default delay before the first request
+	// retry. Failed requests are retried immediately unless a base delay is
+	// configured, as they were before backoff was configurable.
+	DefaultRetryBaseDelay time.Duration = 0
+
+	// DefaultRetryMaxDelay is the default maximum delay between request
+	// retries. With the default base delay it only caps the delay requested by
+	// a Retry-After header.
+	DefaultRetryMaxDelay = 30 * time.Second
+
+	// DefaultRetryJitter is the default fraction of a retry delay that is
+	// randomized. It has no effect unless a base delay is configured.
+	DefaultRetryJitter = 0.5
 )
 
 // NOAARequestRetryCount returns the number of request retries.
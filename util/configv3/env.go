@@ -8,24 +8,31 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName        string
-	CFColor           string
-	CFDialTimeout     string
-	CFHome            string
-	CFLogLevel        string
-	CFPassword        string
-	CFPluginHome      string
-	CFStagingTimeout  string
-	CFStartupTimeout  string
-	CFTrace           string
-	CFUsername        string
-	DockerPassword    string
-	Experimental      string
-	ExperimentalLogin string
-	ForceTTY          string
-	HTTPSProxy        string
-	Lang              string
-	LCAll             string
+	BinaryName         string
+	CFColor            string
+	CFCredentialHelper string
+	CFDialTimeout      string
+	CFHome             string
+	CFLogLevel         string
+	CFOutput           string
+	CFPassword         string
+	CFPluginHome       string
+	CFProfile          string
+	CFRetryBaseDelay   string
+	CFRetryJitter      string
+	CFRetryMaxDelay    string
+	CFRetrySafePOSTs   string
+	CFStagingTimeout   string
+	CFStartupTimeout   string
+	CFTrace            string
+	CFUsername         string
+	DockerPassword     string
+	Experimental       string
+	ExperimentalLogin  string
+	ForceTTY           string
+	HTTPSProxy         string
+	Lang               string
+	LCAll              string
 }
 
 // BinaryName returns the running name of the CF CLI
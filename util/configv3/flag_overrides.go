@@ -2,5 +2,7 @@ package configv3
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
+	Output  string
+	Profile string
 	Verbose bool
 }
@@ -31,6 +31,13 @@ type JSONConfig struct {
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	RetryBaseDelay           string             `json:"RetryBaseDelay,omitempty"`
+	RetryJitter              *float64           `json:"RetryJitter,omitempty"`
+	RetryMaxDelay            string             `json:"RetryMaxDelay,omitempty"`
+	RetrySafePOSTs           bool               `json:"RetrySafePOSTs,omitempty"`
+	CurrentProfile           string             `json:"CurrentProfile,omitempty"`
+	Profiles                 map[string]Profile `json:"Profiles,omitempty"`
+	CredentialHelper         string             `json:"CredentialHelper,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
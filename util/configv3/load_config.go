@@ -94,33 +94,31 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
-	if config.ConfigFile.SSHOAuthClient == "" {
-		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
-	}
-
-	if config.ConfigFile.UAAOAuthClient == "" {
-		config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
-		config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
-	}
-
 	config.ENV = EnvOverride{
-		BinaryName:        filepath.Base(os.Args[0]),
-		CFColor:           os.Getenv("CF_COLOR"),
-		CFDialTimeout:     os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:        os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:        os.Getenv("CF_PASSWORD"),
-		CFPluginHome:      os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout:  os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout:  os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:           os.Getenv("CF_TRACE"),
-		CFUsername:        os.Getenv("CF_USERNAME"),
-		DockerPassword:    os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:      os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ExperimentalLogin: os.Getenv("CF_EXPERIMENTAL_LOGIN"),
-		ForceTTY:          os.Getenv("FORCE_TTY"),
-		HTTPSProxy:        os.Getenv("https_proxy"),
-		Lang:              os.Getenv("LANG"),
-		LCAll:             os.Getenv("LC_ALL"),
+		BinaryName:         filepath.Base(os.Args[0]),
+		CFColor:            os.Getenv("CF_COLOR"),
+		CFCredentialHelper: os.Getenv("CF_CREDENTIAL_HELPER"),
+		CFDialTimeout:      os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:         os.Getenv("CF_LOG_LEVEL"),
+		CFOutput:           os.Getenv("CF_OUTPUT"),
+		CFPassword:         os.Getenv("CF_PASSWORD"),
+		CFPluginHome:       os.Getenv("CF_PLUGIN_HOME"),
+		CFProfile:          os.Getenv("CF_PROFILE"),
+		CFRetryBaseDelay:   os.Getenv("CF_RETRY_BASE_DELAY"),
+		CFRetryJitter:      os.Getenv("CF_RETRY_JITTER"),
+		CFRetryMaxDelay:    os.Getenv("CF_RETRY_MAX_DELAY"),
+		CFRetrySafePOSTs:   os.Getenv("CF_RETRY_SAFE_POSTS"),
+		CFStagingTimeout:   os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:   os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:            os.Getenv("CF_TRACE"),
+		CFUsername:         os.Getenv("CF_USERNAME"),
+		DockerPassword:     os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:       os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ExperimentalLogin:  os.Getenv("CF_EXPERIMENTAL_LOGIN"),
+		ForceTTY:           os.Getenv("FORCE_TTY"),
+		HTTPSProxy:         os.Getenv("https_proxy"),
+		Lang:               os.Getenv("LANG"),
+		LCAll:              os.Getenv("LC_ALL"),
 	}
 
 	err = config.loadPluginConfig()
@@ -132,6 +130,27 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		config.Flags = flags[0]
 	}
 
+	err = config.loadCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	if profileName := config.profileName(); profileName != "" {
+		err = config.overrideProfile(profileName)
+		if err != nil {
+			return &config, err
+		}
+	}
+
+	if config.ConfigFile.SSHOAuthClient == "" {
+		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
+	}
+
+	if config.ConfigFile.UAAOAuthClient == "" {
+		config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
+		config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
+	}
+
 	pwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
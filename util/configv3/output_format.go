@@ -0,0 +1,43 @@
+package configv3
+
+import "strings"
+
+const (
+	// OutputFormatTable renders command output as human readable tables.
+	OutputFormatTable OutputFormat = "table"
+
+	// OutputFormatJSON renders command output as a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML renders command output as a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat represents the format in which display commands render their
+// results.
+type OutputFormat string
+
+// OutputFormat returns the output format based off:
+//   1. The '--output' global flag if set
+//   2. The $CF_OUTPUT environment variable if set (json/yaml/table)
+//   3. Defaults to OutputFormatTable if nothing is set
+func (config *Config) OutputFormat() OutputFormat {
+	if format, ok := parseOutputFormat(config.Flags.Output); ok {
+		return format
+	}
+
+	if format, ok := parseOutputFormat(config.ENV.CFOutput); ok {
+		return format
+	}
+
+	return OutputFormatTable
+}
+
+func parseOutputFormat(val string) (OutputFormat, bool) {
+	switch format := OutputFormat(strings.ToLower(val)); format {
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+		return format, true
+	}
+
+	return "", false
+}
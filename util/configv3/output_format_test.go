@@ -0,0 +1,27 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	DescribeTable("OutputFormat",
+		func(flagVal string, envVal string, expected OutputFormat) {
+			config := Config{
+				ENV:   EnvOverride{CFOutput: envVal},
+				Flags: FlagOverride{Output: flagVal},
+			}
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+		Entry("flag=json  env=yaml  json", "json", "yaml", OutputFormatJSON),
+		Entry("flag=table env=json  table", "table", "json", OutputFormatTable),
+		Entry("flag=unset env=yaml  yaml", "", "yaml", OutputFormatYAML),
+		Entry("flag=unset env=JSON  json", "", "JSON", OutputFormatJSON),
+		Entry("flag=unset env=bogus table", "", "bogus", OutputFormatTable),
+		Entry("flag=unset env=unset falls back to default", "", "", OutputFormatTable),
+	)
+})
@@ -17,6 +17,9 @@ const (
 type PluginRepository struct {
 	Name string `json:"Name"`
 	URL  string `json:"URL"`
+	// PublicKeys are the base64-encoded ed25519 public keys trusted to sign
+	// plugins installed from the repository.
+	PublicKeys []string `json:"PublicKeys,omitempty"`
 }
 
 // AddPluginRepository adds an new repository to the plugin config. It does not
@@ -26,6 +29,33 @@ func (config *Config) AddPluginRepository(name string, url string) {
 		PluginRepository{Name: name, URL: url})
 }
 
+// AddPluginRepositoryPublicKeys trusts the public keys to sign plugins
+// installed from the named repository. Keys that are already trusted are not
+// added again.
+func (config *Config) AddPluginRepositoryPublicKeys(repoName string, publicKeys []string) {
+	for i, repo := range config.ConfigFile.PluginRepositories {
+		if !strings.EqualFold(repo.Name, repoName) {
+			continue
+		}
+
+		for _, publicKey := range publicKeys {
+			if !containsString(repo.PublicKeys, publicKey) {
+				repo.PublicKeys = append(repo.PublicKeys, publicKey)
+			}
+		}
+		config.ConfigFile.PluginRepositories[i] = repo
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // PluginRepositories returns the currently configured plugin repositories from the
 // .cf/config.json.
 func (config *Config) PluginRepositories() []PluginRepository {
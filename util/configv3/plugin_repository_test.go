@@ -44,4 +44,23 @@ var _ = Describe("PluginRepository", func() {
 			Expect(config.PluginRepositories()).To(ContainElement(PluginRepository{Name: "some-repo", URL: "some-URL"}))
 		})
 	})
+
+	Describe("AddPluginRepositoryPublicKeys", func() {
+		It("trusts the keys for the repository without duplicating them", func() {
+			config := Config{
+				ConfigFile: JSONConfig{
+					PluginRepositories: []PluginRepository{
+						{Name: "repo-1", URL: "repo1.com", PublicKeys: []string{"key-1"}},
+						{Name: "repo-2", URL: "repo2.com"},
+					},
+				},
+			}
+
+			config.AddPluginRepositoryPublicKeys("REPO-1", []string{"key-1", "key-2"})
+			Expect(config.PluginRepositories()).To(Equal([]PluginRepository{
+				{Name: "repo-1", URL: "repo1.com", PublicKeys: []string{"key-1", "key-2"}},
+				{Name: "repo-2", URL: "repo2.com"},
+			}))
+		})
+	})
 })
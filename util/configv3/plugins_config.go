@@ -15,6 +15,10 @@ import (
 // PluginsConfig represents the plugin configuration
 type PluginsConfig struct {
 	Plugins map[string]Plugin `json:"Plugins"`
+
+	// PinnedVersions maps plugin names to the semantic version ranges that
+	// upgrade-plugins is limited to, e.g. ">=1.2.0 <2.0.0".
+	PinnedVersions map[string]string `json:"PinnedVersions,omitempty"`
 }
 
 // Plugin represents the plugin as a whole, not be confused with PluginCommand
@@ -110,6 +114,13 @@ func (config *Config) GetPluginCaseInsensitive(pluginName string) (Plugin, bool)
 	return Plugin{}, false
 }
 
+// PluginVersionPin returns the version range the plugin is pinned to and
+// true if it is pinned.
+func (config *Config) PluginVersionPin(pluginName string) (string, bool) {
+	versionRange, pinned := config.pluginsConfig.PinnedVersions[pluginName]
+	return versionRange, pinned
+}
+
 // PluginHome returns the plugin configuration directory to:
 //   1. The $CF_PLUGIN_HOME/.cf/plugins environment variable if set
 //   2. Defaults to the home directory (outlined in LoadConfig)/.cf/plugins
@@ -138,6 +149,19 @@ func (config *Config) RemovePlugin(pluginName string) {
 	delete(config.pluginsConfig.Plugins, pluginName)
 }
 
+// RemovePluginVersionPin unpins the specified plugin idempotently.
+func (config *Config) RemovePluginVersionPin(pluginName string) {
+	delete(config.pluginsConfig.PinnedVersions, pluginName)
+}
+
+// SetPluginVersionPin pins the specified plugin to the version range.
+func (config *Config) SetPluginVersionPin(pluginName string, versionRange string) {
+	if config.pluginsConfig.PinnedVersions == nil {
+		config.pluginsConfig.PinnedVersions = map[string]string{}
+	}
+	config.pluginsConfig.PinnedVersions[pluginName] = versionRange
+}
+
 // WritePluginConfig writes the plugin config to config.json in the plugin home
 // directory.
 func (config *Config) WritePluginConfig() error {
@@ -293,6 +293,64 @@ var _ = Describe("PluginsConfig", func() {
 			})
 		})
 
+		Describe("PluginVersionPin", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				rawConfig := `
+				{
+					"Plugins": {
+						"plugin-1": {}
+					},
+					"PinnedVersions": {
+						"plugin-1": ">=1.0.0 <2.0.0"
+					}
+				}`
+
+				pluginsPath := filepath.Join(homeDir, ".cf", "plugins")
+				setPluginConfig(pluginsPath, rawConfig)
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the version range and true if the plugin is pinned", func() {
+				versionRange, pinned := config.PluginVersionPin("plugin-1")
+				Expect(pinned).To(BeTrue())
+				Expect(versionRange).To(Equal(">=1.0.0 <2.0.0"))
+			})
+
+			It("returns false if the plugin is not pinned", func() {
+				_, pinned := config.PluginVersionPin("plugin-2")
+				Expect(pinned).To(BeFalse())
+			})
+
+			It("sets and removes pins", func() {
+				config.SetPluginVersionPin("plugin-2", "~1.2.0")
+				versionRange, pinned := config.PluginVersionPin("plugin-2")
+				Expect(pinned).To(BeTrue())
+				Expect(versionRange).To(Equal("~1.2.0"))
+
+				config.RemovePluginVersionPin("plugin-1")
+				_, pinned = config.PluginVersionPin("plugin-1")
+				Expect(pinned).To(BeFalse())
+			})
+		})
+
+		Describe("SetPluginVersionPin", func() {
+			When("no plugins are pinned", func() {
+				It("pins the plugin", func() {
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					config.SetPluginVersionPin("plugin-1", "1.x")
+					versionRange, pinned := config.PluginVersionPin("plugin-1")
+					Expect(pinned).To(BeTrue())
+					Expect(versionRange).To(Equal("1.x"))
+				})
+			})
+		})
+
 		Describe("Plugins", func() {
 			BeforeEach(func() {
 				rawConfig := `
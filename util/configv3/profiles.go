@@ -0,0 +1,199 @@
+package configv3
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// Profile is a named copy of the targeting information in .cf/config.json.
+// Switching to a profile targets its foundation without logging in again.
+type Profile struct {
+	Name                     string       `json:"-"`
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken"`
+	RefreshToken             string       `json:"RefreshToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// CurrentProfile returns the name of the profile in use. This is based off
+// of:
+//   1. The '--profile' global flag or the $CF_PROFILE environment variable
+//   2. The 'CurrentProfile' value in the .cf/config.json
+//   3. Defaults to "" when no profile is in use
+func (config *Config) CurrentProfile() string {
+	if config.profileOverride != "" {
+		return config.profileOverride
+	}
+	return config.ConfigFile.CurrentProfile
+}
+
+// DeleteProfile removes the profile with the given name. Deleting the current
+// profile leaves its targeting information in place.
+func (config *Config) DeleteProfile(name string) {
+	delete(config.ConfigFile.Profiles, name)
+
+	if config.ConfigFile.CurrentProfile == name {
+		config.ConfigFile.CurrentProfile = ""
+	}
+}
+
+// GetProfile returns the profile with the given name.
+func (config *Config) GetProfile(name string) (Profile, bool) {
+	profile, found := config.ConfigFile.Profiles[name]
+	profile.Name = name
+	return profile, found
+}
+
+// Profiles returns all the profiles, sorted by name.
+func (config *Config) Profiles() []Profile {
+	profiles := []Profile{}
+	for name := range config.ConfigFile.Profiles {
+		profile, _ := config.GetProfile(name)
+		profiles = append(profiles, profile)
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	return profiles
+}
+
+// SaveProfile stores the current targeting information as the profile with
+// the given name, replacing any existing profile with that name.
+func (config *Config) SaveProfile(name string) {
+	if config.ConfigFile.Profiles == nil {
+		config.ConfigFile.Profiles = map[string]Profile{}
+	}
+	config.ConfigFile.Profiles[name] = config.currentTargetAsProfile()
+}
+
+// SwitchProfile stores the current targeting information in the current
+// profile, then targets the profile with the given name and makes it the
+// current profile.
+func (config *Config) SwitchProfile(name string) {
+	config.clearProfileOverride()
+	config.syncCurrentProfile()
+
+	profile, _ := config.GetProfile(name)
+	config.ConfigFile.CurrentProfile = name
+	config.applyProfile(profile)
+}
+
+// profileName returns the name of the profile selected by the '--profile'
+// flag or the $CF_PROFILE environment variable.
+func (config *Config) profileName() string {
+	if config.Flags.Profile != "" {
+		return config.Flags.Profile
+	}
+	return config.ENV.CFProfile
+}
+
+// overrideProfile targets the profile with the given name for the lifetime of
+// this Config. The targeting information it replaces is restored before the
+// config is written.
+func (config *Config) overrideProfile(name string) error {
+	profile, found := config.GetProfile(name)
+	if !found {
+		return translatableerror.ProfileNotFoundError{ProfileName: name}
+	}
+
+	defaultProfile := config.currentTargetAsProfile()
+	config.defaultProfile = &defaultProfile
+	config.profileOverride = name
+	config.applyProfile(profile)
+	return nil
+}
+
+// clearProfileOverride stores the targeting information of an overriding
+// profile and restores the targeting information it replaced.
+func (config *Config) clearProfileOverride() {
+	if config.defaultProfile == nil {
+		return
+	}
+
+	config.syncCurrentProfile()
+	config.applyProfile(*config.defaultProfile)
+	config.profileOverride = ""
+	config.defaultProfile = nil
+}
+
+// persistedConfigFile returns the JSONConfig that is written to disk: the
+// current profile is updated with the current targeting information and a
+// profile override is undone.
+func (config *Config) persistedConfigFile() JSONConfig {
+	persisted := *config
+	if config.ConfigFile.Profiles != nil {
+		persisted.ConfigFile.Profiles = map[string]Profile{}
+		for name, profile := range config.ConfigFile.Profiles {
+			persisted.ConfigFile.Profiles[name] = profile
+		}
+	}
+
+	persisted.clearProfileOverride()
+	persisted.syncCurrentProfile()
+	return persisted.ConfigFile
+}
+
+func (config *Config) syncCurrentProfile() {
+	name := config.CurrentProfile()
+	if _, found := config.ConfigFile.Profiles[name]; found {
+		config.SaveProfile(name)
+	}
+}
+
+func (config *Config) currentTargetAsProfile() Profile {
+	return Profile{
+		Target:                   config.ConfigFile.Target,
+		APIVersion:               config.ConfigFile.APIVersion,
+		AuthorizationEndpoint:    config.ConfigFile.AuthorizationEndpoint,
+		DopplerEndpoint:          config.ConfigFile.DopplerEndpoint,
+		UAAEndpoint:              config.ConfigFile.UAAEndpoint,
+		RoutingEndpoint:          config.ConfigFile.RoutingEndpoint,
+		AccessToken:              config.ConfigFile.AccessToken,
+		RefreshToken:             config.ConfigFile.RefreshToken,
+		SSHOAuthClient:           config.ConfigFile.SSHOAuthClient,
+		UAAOAuthClient:           config.ConfigFile.UAAOAuthClient,
+		UAAOAuthClientSecret:     config.ConfigFile.UAAOAuthClientSecret,
+		UAAGrantType:             config.ConfigFile.UAAGrantType,
+		TargetedOrganization:     config.ConfigFile.TargetedOrganization,
+		TargetedSpace:            config.ConfigFile.TargetedSpace,
+		SkipSSLValidation:        config.ConfigFile.SkipSSLValidation,
+		MinCLIVersion:            config.ConfigFile.MinCLIVersion,
+		MinRecommendedCLIVersion: config.ConfigFile.MinRecommendedCLIVersion,
+	}
+}
+
+func (config *Config) applyProfile(profile Profile) {
+	config.ConfigFile.Target = profile.Target
+	config.ConfigFile.APIVersion = profile.APIVersion
+	config.ConfigFile.AuthorizationEndpoint = profile.AuthorizationEndpoint
+	config.ConfigFile.DopplerEndpoint = profile.DopplerEndpoint
+	config.ConfigFile.UAAEndpoint = profile.UAAEndpoint
+	config.ConfigFile.RoutingEndpoint = profile.RoutingEndpoint
+	config.ConfigFile.AccessToken = profile.AccessToken
+	config.ConfigFile.RefreshToken = profile.RefreshToken
+	config.ConfigFile.SSHOAuthClient = profile.SSHOAuthClient
+	config.ConfigFile.UAAOAuthClient = profile.UAAOAuthClient
+	config.ConfigFile.UAAOAuthClientSecret = profile.UAAOAuthClientSecret
+	config.ConfigFile.UAAGrantType = profile.UAAGrantType
+	config.ConfigFile.TargetedOrganization = profile.TargetedOrganization
+	config.ConfigFile.TargetedSpace = profile.TargetedSpace
+	config.ConfigFile.SkipSSLValidation = profile.SkipSSLValidation
+	config.ConfigFile.MinCLIVersion = profile.MinCLIVersion
+	config.ConfigFile.MinRecommendedCLIVersion = profile.MinRecommendedCLIVersion
+}
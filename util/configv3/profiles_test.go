@@ -0,0 +1,162 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profiles", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readWrittenConfig := func() JSONConfig {
+		file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var writtenConfig JSONConfig
+		Expect(json.Unmarshal(file, &writtenConfig)).To(Succeed())
+		return writtenConfig
+	}
+
+	Describe("managing profiles", func() {
+		BeforeEach(func() {
+			config = &Config{
+				ConfigFile: JSONConfig{
+					Target:      "https://api.dev.com",
+					AccessToken: "dev-token",
+				},
+			}
+		})
+
+		It("saves, lists, switches and deletes profiles", func() {
+			config.SaveProfile("dev")
+
+			config.ConfigFile.Target = "https://api.prod.com"
+			config.ConfigFile.AccessToken = "prod-token"
+			config.SaveProfile("prod")
+
+			profiles := config.Profiles()
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[0].Name).To(Equal("dev"))
+			Expect(profiles[0].Target).To(Equal("https://api.dev.com"))
+			Expect(profiles[1].Name).To(Equal("prod"))
+
+			config.SwitchProfile("dev")
+			Expect(config.CurrentProfile()).To(Equal("dev"))
+			Expect(config.Target()).To(Equal("https://api.dev.com"))
+			Expect(config.AccessToken()).To(Equal("dev-token"))
+
+			config.SetAccessToken("new-dev-token")
+			config.SwitchProfile("prod")
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+
+			devProfile, found := config.GetProfile("dev")
+			Expect(found).To(BeTrue())
+			Expect(devProfile.AccessToken).To(Equal("new-dev-token"))
+
+			config.DeleteProfile("prod")
+			Expect(config.CurrentProfile()).To(BeEmpty())
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+			_, found = config.GetProfile("prod")
+			Expect(found).To(BeFalse())
+		})
+
+		When("the config is written", func() {
+			BeforeEach(func() {
+				config.SaveProfile("dev")
+				config.SwitchProfile("dev")
+				config.SetAccessToken("refreshed-token")
+			})
+
+			It("updates the current profile", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenConfig := readWrittenConfig()
+				Expect(writtenConfig.CurrentProfile).To(Equal("dev"))
+				Expect(writtenConfig.AccessToken).To(Equal("refreshed-token"))
+				Expect(writtenConfig.Profiles["dev"].AccessToken).To(Equal("refreshed-token"))
+			})
+		})
+	})
+
+	Describe("overriding the profile", func() {
+		var (
+			inFlags FlagOverride
+			loadErr error
+		)
+
+		BeforeEach(func() {
+			inFlags = FlagOverride{}
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"Target": "https://api.dev.com",
+				"AccessToken": "dev-token",
+				"Profiles": {
+					"prod": {
+						"Target": "https://api.prod.com",
+						"AccessToken": "prod-token",
+						"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"}
+					}
+				}
+			}`)
+		})
+
+		JustBeforeEach(func() {
+			config, loadErr = LoadConfig(inFlags)
+		})
+
+		When("the profile flag is set", func() {
+			BeforeEach(func() {
+				inFlags.Profile = "prod"
+			})
+
+			It("targets the profile", func() {
+				Expect(loadErr).ToNot(HaveOccurred())
+				Expect(config.CurrentProfile()).To(Equal("prod"))
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+				Expect(config.TargetedSpace().Name).To(Equal("prod-space"))
+				Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+			})
+
+			It("writes changes to the profile without changing the default target", func() {
+				config.SetAccessToken("new-prod-token")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				writtenConfig := readWrittenConfig()
+				Expect(writtenConfig.CurrentProfile).To(BeEmpty())
+				Expect(writtenConfig.Target).To(Equal("https://api.dev.com"))
+				Expect(writtenConfig.AccessToken).To(Equal("dev-token"))
+				Expect(writtenConfig.Profiles["prod"].AccessToken).To(Equal("new-prod-token"))
+
+				Expect(config.AccessToken()).To(Equal("new-prod-token"))
+			})
+
+			When("the profile does not exist", func() {
+				BeforeEach(func() {
+					inFlags.Profile = "staging"
+				})
+
+				It("returns a ProfileNotFoundError", func() {
+					Expect(loadErr).To(MatchError(translatableerror.ProfileNotFoundError{ProfileName: "staging"}))
+				})
+			})
+		})
+	})
+})
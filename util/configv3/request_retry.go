@@ -0,0 +1,74 @@
+package configv3
+
+import (
+	"strconv"
+	"time"
+)
+
+// RequestRetryBaseDelay returns the delay before the first retry of a failed
+// request. Every subsequent retry doubles the delay. This is based off of:
+//   1. The $CF_RETRY_BASE_DELAY environment variable if set (e.g. 500ms, 2s)
+//   2. The 'RetryBaseDelay' value in the .cf/config.json if set
+//   3. Defaults to DefaultRetryBaseDelay
+func (config *Config) RequestRetryBaseDelay() time.Duration {
+	return config.retryDuration(config.ENV.CFRetryBaseDelay, config.ConfigFile.RetryBaseDelay, DefaultRetryBaseDelay)
+}
+
+// RequestRetryMaxDelay returns the maximum delay between two attempts of a
+// request, including delays requested by the server. This is based off of:
+//   1. The $CF_RETRY_MAX_DELAY environment variable if set (e.g. 10s, 1m)
+//   2. The 'RetryMaxDelay' value in the .cf/config.json if set
+//   3. Defaults to DefaultRetryMaxDelay
+func (config *Config) RequestRetryMaxDelay() time.Duration {
+	return config.retryDuration(config.ENV.CFRetryMaxDelay, config.ConfigFile.RetryMaxDelay, DefaultRetryMaxDelay)
+}
+
+// RequestRetryJitter returns the fraction, between 0 and 1, of each retry
+// delay that is randomized. This is based off of:
+//   1. The $CF_RETRY_JITTER environment variable if set
+//   2. The 'RetryJitter' value in the .cf/config.json if set
+//   3. Defaults to DefaultRetryJitter
+func (config *Config) RequestRetryJitter() float64 {
+	if config.ENV.CFRetryJitter != "" {
+		envVal, err := strconv.ParseFloat(config.ENV.CFRetryJitter, 64)
+		if err == nil && envVal >= 0 && envVal <= 1 {
+			return envVal
+		}
+	}
+
+	if jitter := config.ConfigFile.RetryJitter; jitter != nil && *jitter >= 0 && *jitter <= 1 {
+		return *jitter
+	}
+
+	return DefaultRetryJitter
+}
+
+// RequestRetrySafePOSTs returns true when POST requests that were rejected
+// without being processed (429 and 503) may be retried. This is based off of:
+//   1. The $CF_RETRY_SAFE_POSTS environment variable if set
+//   2. The 'RetrySafePOSTs' value in the .cf/config.json
+func (config *Config) RequestRetrySafePOSTs() bool {
+	if config.ENV.CFRetrySafePOSTs != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFRetrySafePOSTs)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return config.ConfigFile.RetrySafePOSTs
+}
+
+func (*Config) retryDuration(envVal string, configVal string, defaultVal time.Duration) time.Duration {
+	for _, val := range []string{envVal, configVal} {
+		if val == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(val)
+		if err == nil && duration >= 0 {
+			return duration
+		}
+	}
+
+	return defaultVal
+}
@@ -0,0 +1,77 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Retry Config", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	When("nothing is set", func() {
+		It("returns the defaults", func() {
+			Expect(config.RequestRetryBaseDelay()).To(Equal(DefaultRetryBaseDelay))
+			Expect(config.RequestRetryMaxDelay()).To(Equal(DefaultRetryMaxDelay))
+			Expect(config.RequestRetryJitter()).To(Equal(DefaultRetryJitter))
+			Expect(config.RequestRetrySafePOSTs()).To(BeFalse())
+		})
+
+		It("retries immediately unless the server requests a delay", func() {
+			Expect(config.RequestRetryBaseDelay()).To(BeZero())
+		})
+	})
+
+	When("the values are set in the config file", func() {
+		BeforeEach(func() {
+			jitter := 0.25
+			config.ConfigFile.RetryBaseDelay = "1s"
+			config.ConfigFile.RetryMaxDelay = "1m"
+			config.ConfigFile.RetryJitter = &jitter
+			config.ConfigFile.RetrySafePOSTs = true
+		})
+
+		It("returns the config file values", func() {
+			Expect(config.RequestRetryBaseDelay()).To(Equal(time.Second))
+			Expect(config.RequestRetryMaxDelay()).To(Equal(time.Minute))
+			Expect(config.RequestRetryJitter()).To(Equal(0.25))
+			Expect(config.RequestRetrySafePOSTs()).To(BeTrue())
+		})
+
+		When("the environment variables are set", func() {
+			BeforeEach(func() {
+				config.ENV.CFRetryBaseDelay = "100ms"
+				config.ENV.CFRetryMaxDelay = "5s"
+				config.ENV.CFRetryJitter = "0"
+				config.ENV.CFRetrySafePOSTs = "false"
+			})
+
+			It("prefers the environment variables", func() {
+				Expect(config.RequestRetryBaseDelay()).To(Equal(100 * time.Millisecond))
+				Expect(config.RequestRetryMaxDelay()).To(Equal(5 * time.Second))
+				Expect(config.RequestRetryJitter()).To(Equal(0.0))
+				Expect(config.RequestRetrySafePOSTs()).To(BeFalse())
+			})
+		})
+	})
+
+	DescribeTable("invalid values",
+		func(baseDelay string, jitter string) {
+			config.ENV.CFRetryBaseDelay = baseDelay
+			config.ENV.CFRetryJitter = jitter
+			Expect(config.RequestRetryBaseDelay()).To(Equal(DefaultRetryBaseDelay))
+			Expect(config.RequestRetryJitter()).To(Equal(DefaultRetryJitter))
+		},
+
+		Entry("unparsable values", "banana", "banana"),
+		Entry("out of range values", "-1s", "1.5"),
+	)
+})
@@ -12,7 +12,13 @@ import (
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	persisted := c.persistedConfigFile()
+	err := c.storeCredentials(&persisted)
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(persisted, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,145 @@
+// Package credentialhelper stores the tokens and client secret of a target
+// outside of .cf/config.json.
+//
+// A credential helper is either the built-in encrypted-file helper or an
+// external executable named cf-credential-NAME (or given by path) that
+// speaks the following protocol, similar to git and docker credential
+// helpers. The executable is run with a single argument, the action, and
+// exchanges JSON over stdin and stdout:
+//
+//   get    reads a Key and writes the stored Credentials, or {} if there are
+//          none
+//   store  reads a Key and Credentials merged into one object
+//   erase  reads a Key
+//
+// A non-zero exit status is an error; anything written to stderr is used as
+// the error message.
+package credentialhelper
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EncryptedFileHelperName is the name of the built-in helper that encrypts
+// credentials into a file next to config.json.
+const EncryptedFileHelperName = "encrypted-file"
+
+// ExecutablePrefix is prepended to the name of external helpers that are
+// not given by path.
+const ExecutablePrefix = "cf-credential-"
+
+// Key identifies a set of credentials. Profile is empty for the default
+// target.
+type Key struct {
+	ServerURL string `json:"ServerURL"`
+	Profile   string `json:"Profile,omitempty"`
+}
+
+// String returns the key as PROFILE@SERVER_URL, or SERVER_URL for the
+// default target.
+func (key Key) String() string {
+	if key.Profile == "" {
+		return key.ServerURL
+	}
+	return key.Profile + "@" + key.ServerURL
+}
+
+// Credentials are the secrets that are kept out of config.json.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken,omitempty"`
+	RefreshToken         string `json:"RefreshToken,omitempty"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret,omitempty"`
+}
+
+// Empty returns true if none of the credentials are set.
+func (credentials Credentials) Empty() bool {
+	return credentials == Credentials{}
+}
+
+// Helper stores, retrieves and erases credentials.
+type Helper interface {
+	Get(key Key) (Credentials, error)
+	Store(key Key, credentials Credentials) error
+	Erase(key Key) error
+}
+
+// GetAll returns the credentials stored for each of the keys. Keys without
+// stored credentials map to empty Credentials.
+func GetAll(helper Helper, keys []Key) (map[Key]Credentials, error) {
+	allCredentials := map[Key]Credentials{}
+	for _, key := range keys {
+		credentials, err := helper.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		allCredentials[key] = credentials
+	}
+	return allCredentials, nil
+}
+
+// Changes returns the credentials StoreAll needs to bring the helper in line
+// with current, the credentials about to be written, given previous, the
+// credentials known when the config was loaded or last written. Keys of
+// previous that are no longer in use, such as the old target after targeting
+// another API, are erased. When the helper could not be read, only
+// credentials that differ from previous are returned; the others came from
+// config.json and stay there.
+func Changes(previous map[Key]Credentials, current map[Key]Credentials, helperRead bool) map[Key]Credentials {
+	changes := map[Key]Credentials{}
+	for key, credentials := range current {
+		if !helperRead && (credentials.Empty() || credentials == previous[key]) {
+			continue
+		}
+		changes[key] = credentials
+	}
+
+	if helperRead {
+		for key := range previous {
+			if _, found := current[key]; !found {
+				changes[key] = Credentials{}
+			}
+		}
+	}
+	return changes
+}
+
+// StoreAll stores the credentials of each key, erasing the keys whose
+// credentials are empty. Keys are processed in a stable order.
+func StoreAll(helper Helper, allCredentials map[Key]Credentials) error {
+	keys := make([]Key, 0, len(allCredentials))
+	for key := range allCredentials {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i int, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	for _, key := range keys {
+		var err error
+		if credentials := allCredentials[key]; credentials.Empty() {
+			err = helper.Erase(key)
+		} else {
+			err = helper.Store(key, credentials)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// New returns the helper with the given name. configDir is the directory
+// containing config.json, where the encrypted-file helper keeps its file.
+func New(name string, configDir string) Helper {
+	if name == EncryptedFileHelperName {
+		return NewEncryptedFileHelper(filepath.Join(configDir, EncryptedFileName))
+	}
+
+	path := name
+	if !strings.ContainsAny(name, `/\`) {
+		path = ExecutablePrefix + name
+	}
+	return NewExternalHelper(path)
+}
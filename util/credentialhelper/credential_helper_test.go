@@ -0,0 +1,124 @@
+package credentialhelper_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type memoryHelper struct {
+	credentials map[Key]Credentials
+	calls       []string
+	err         error
+}
+
+func (helper *memoryHelper) Get(key Key) (Credentials, error) {
+	helper.calls = append(helper.calls, "get "+key.String())
+	return helper.credentials[key], helper.err
+}
+
+func (helper *memoryHelper) Store(key Key, credentials Credentials) error {
+	helper.calls = append(helper.calls, "store "+key.String())
+	helper.credentials[key] = credentials
+	return helper.err
+}
+
+func (helper *memoryHelper) Erase(key Key) error {
+	helper.calls = append(helper.calls, "erase "+key.String())
+	delete(helper.credentials, key)
+	return helper.err
+}
+
+var _ = Describe("Credential helpers", func() {
+	var (
+		helper     *memoryHelper
+		key        Key
+		profileKey Key
+	)
+
+	BeforeEach(func() {
+		key = Key{ServerURL: "https://api.example.com"}
+		profileKey = Key{ServerURL: "https://api.example.com", Profile: "staging"}
+		helper = &memoryHelper{credentials: map[Key]Credentials{
+			key: {AccessToken: "some-access-token"},
+		}}
+	})
+
+	Describe("GetAll", func() {
+		It("returns the credentials of every key", func() {
+			allCredentials, err := GetAll(helper, []Key{key, profileKey})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allCredentials).To(Equal(map[Key]Credentials{
+				key:        {AccessToken: "some-access-token"},
+				profileKey: {},
+			}))
+		})
+
+		When("the helper fails", func() {
+			BeforeEach(func() {
+				helper.err = errors.New("get-error")
+			})
+
+			It("returns the error", func() {
+				_, err := GetAll(helper, []Key{key})
+				Expect(err).To(MatchError("get-error"))
+			})
+		})
+	})
+
+	Describe("StoreAll", func() {
+		It("stores non-empty credentials and erases empty ones in a stable order", func() {
+			err := StoreAll(helper, map[Key]Credentials{
+				profileKey: {AccessToken: "staging-access-token"},
+				key:        {},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(helper.calls).To(Equal([]string{
+				"erase https://api.example.com",
+				"store staging@https://api.example.com",
+			}))
+			Expect(helper.credentials).To(Equal(map[Key]Credentials{
+				profileKey: {AccessToken: "staging-access-token"},
+			}))
+		})
+	})
+
+	Describe("Changes", func() {
+		var oldKey Key
+
+		BeforeEach(func() {
+			oldKey = Key{ServerURL: "https://api.old.com"}
+		})
+
+		When("the helper was read", func() {
+			It("returns the current credentials and erases keys that are no longer used", func() {
+				changes := Changes(
+					map[Key]Credentials{oldKey: {AccessToken: "old-access-token"}, profileKey: {AccessToken: "staging-access-token"}},
+					map[Key]Credentials{key: {AccessToken: "some-access-token"}, profileKey: {AccessToken: "staging-access-token"}},
+					true,
+				)
+				Expect(changes).To(Equal(map[Key]Credentials{
+					key:        {AccessToken: "some-access-token"},
+					profileKey: {AccessToken: "staging-access-token"},
+					oldKey:     {},
+				}))
+			})
+		})
+
+		When("the helper could not be read", func() {
+			It("returns only the credentials that changed and erases nothing", func() {
+				changes := Changes(
+					map[Key]Credentials{oldKey: {AccessToken: "old-access-token"}, key: {AccessToken: "plaintext-access-token"}},
+					map[Key]Credentials{key: {AccessToken: "plaintext-access-token"}, profileKey: {AccessToken: "new-access-token"}},
+					false,
+				)
+				Expect(changes).To(Equal(map[Key]Credentials{
+					profileKey: {AccessToken: "new-access-token"},
+				}))
+			})
+		})
+	})
+})
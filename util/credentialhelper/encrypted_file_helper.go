@@ -0,0 +1,299 @@
+package credentialhelper
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// EncryptedFileName is the name of the file the encrypted-file helper
+	// uses in the config directory.
+	EncryptedFileName = "credentials.enc"
+
+	// PassphraseEnvVar is the environment variable that holds the passphrase
+	// of the encrypted-file helper.
+	PassphraseEnvVar = "CF_CREDENTIAL_PASSPHRASE"
+
+	encryptedFileVersion = 1
+	keyDerivationRounds  = 100000
+	keyLength            = 32
+	saltLength           = 16
+)
+
+// MissingPassphraseError is returned when the encrypted-file helper is used
+// without a passphrase.
+type MissingPassphraseError struct{}
+
+func (MissingPassphraseError) Error() string {
+	return "the encrypted-file credential helper requires the " + PassphraseEnvVar + " environment variable"
+}
+
+// DecryptionFailedError is returned when the encrypted file cannot be
+// decrypted, usually because the passphrase is wrong.
+type DecryptionFailedError struct {
+	Path string
+}
+
+func (e DecryptionFailedError) Error() string {
+	return "unable to decrypt " + e.Path + "; check " + PassphraseEnvVar
+}
+
+// decryptedFiles caches the decrypted contents of every encrypted file used
+// by the process, keyed by path, so that the key is derived once per process
+// rather than once per credential.
+var decryptedFiles = struct {
+	sync.Mutex
+	files map[string]decryptedFile
+}{files: map[string]decryptedFile{}}
+
+type decryptedFile struct {
+	passphrase  string
+	salt        []byte
+	gcm         cipher.AEAD
+	raw         []byte
+	credentials map[string]Credentials
+}
+
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptedFileHelper keeps all credentials in a single file encrypted with
+// AES-256-GCM, using a key derived from a passphrase with PBKDF2-SHA256.
+type EncryptedFileHelper struct {
+	Path string
+
+	// Passphrase returns the passphrase; it defaults to reading
+	// CF_CREDENTIAL_PASSPHRASE.
+	Passphrase func() string
+}
+
+// NewEncryptedFileHelper returns an EncryptedFileHelper that uses the file at
+// path.
+func NewEncryptedFileHelper(path string) *EncryptedFileHelper {
+	return &EncryptedFileHelper{
+		Path: path,
+		Passphrase: func() string {
+			return os.Getenv(PassphraseEnvVar)
+		},
+	}
+}
+
+func (helper EncryptedFileHelper) Get(key Key) (Credentials, error) {
+	allCredentials, err := helper.load()
+	if err != nil {
+		return Credentials{}, err
+	}
+	return allCredentials[key.String()], nil
+}
+
+func (helper EncryptedFileHelper) Store(key Key, credentials Credentials) error {
+	allCredentials, err := helper.load()
+	if err != nil {
+		return err
+	}
+
+	if existing, found := allCredentials[key.String()]; found && existing == credentials {
+		return nil
+	}
+	allCredentials[key.String()] = credentials
+	return helper.save(allCredentials)
+}
+
+func (helper EncryptedFileHelper) Erase(key Key) error {
+	allCredentials, err := helper.load()
+	if err != nil {
+		return err
+	}
+
+	if _, found := allCredentials[key.String()]; !found {
+		return nil
+	}
+	delete(allCredentials, key.String())
+	return helper.save(allCredentials)
+}
+
+// load returns a copy of the decrypted credentials. The file is only
+// decrypted again when it changed on disk since it was last read or written
+// by the process.
+func (helper EncryptedFileHelper) load() (map[string]Credentials, error) {
+	passphrase := helper.Passphrase()
+	if passphrase == "" {
+		return nil, MissingPassphraseError{}
+	}
+
+	decryptedFiles.Lock()
+	defer decryptedFiles.Unlock()
+
+	decrypted, err := helper.decrypt(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	allCredentials := map[string]Credentials{}
+	for key, credentials := range decrypted.credentials {
+		allCredentials[key] = credentials
+	}
+	return allCredentials, nil
+}
+
+// decrypt returns the decrypted file, from the cache when it is up to date.
+// The caller must hold the decryptedFiles lock.
+func (helper EncryptedFileHelper) decrypt(passphrase string) (decryptedFile, error) {
+	cached, isCached := decryptedFiles.files[helper.Path]
+	if isCached && cached.passphrase != passphrase {
+		isCached = false
+	}
+
+	raw, err := ioutil.ReadFile(helper.Path)
+	if os.IsNotExist(err) {
+		return decryptedFile{passphrase: passphrase, credentials: map[string]Credentials{}}, nil
+	} else if err != nil {
+		return decryptedFile{}, err
+	}
+
+	if isCached && bytes.Equal(cached.raw, raw) {
+		return cached, nil
+	}
+
+	var file encryptedFile
+	err = json.Unmarshal(raw, &file)
+	if err != nil || file.Version != encryptedFileVersion {
+		return decryptedFile{}, DecryptionFailedError{Path: helper.Path}
+	}
+
+	decrypted := decryptedFile{
+		passphrase:  passphrase,
+		salt:        file.Salt,
+		raw:         raw,
+		credentials: map[string]Credentials{},
+	}
+	if isCached && bytes.Equal(cached.salt, file.Salt) {
+		decrypted.gcm = cached.gcm
+	} else {
+		decrypted.gcm, err = newGCM(passphrase, file.Salt)
+		if err != nil {
+			return decryptedFile{}, err
+		}
+	}
+
+	plaintext, err := decrypted.gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return decryptedFile{}, DecryptionFailedError{Path: helper.Path}
+	}
+
+	err = json.Unmarshal(plaintext, &decrypted.credentials)
+	if err != nil {
+		return decryptedFile{}, DecryptionFailedError{Path: helper.Path}
+	}
+
+	decryptedFiles.files[helper.Path] = decrypted
+	return decrypted, nil
+}
+
+// save encrypts and writes the credentials. The salt, and therefore the
+// derived key, of the existing file is kept; only the nonce changes.
+func (helper EncryptedFileHelper) save(allCredentials map[string]Credentials) error {
+	passphrase := helper.Passphrase()
+	if passphrase == "" {
+		return MissingPassphraseError{}
+	}
+
+	decryptedFiles.Lock()
+	defer decryptedFiles.Unlock()
+
+	plaintext, err := json.Marshal(allCredentials)
+	if err != nil {
+		return err
+	}
+
+	decrypted, isCached := decryptedFiles.files[helper.Path]
+	if !isCached || decrypted.passphrase != passphrase {
+		decrypted = decryptedFile{
+			passphrase: passphrase,
+			salt:       make([]byte, saltLength),
+		}
+		_, err = io.ReadFull(rand.Reader, decrypted.salt)
+		if err != nil {
+			return err
+		}
+
+		decrypted.gcm, err = newGCM(passphrase, decrypted.salt)
+		if err != nil {
+			return err
+		}
+	}
+
+	file := encryptedFile{
+		Version: encryptedFileVersion,
+		Salt:    decrypted.salt,
+		Nonce:   make([]byte, decrypted.gcm.NonceSize()),
+	}
+	_, err = io.ReadFull(rand.Reader, file.Nonce)
+	if err != nil {
+		return err
+	}
+	file.Ciphertext = decrypted.gcm.Seal(nil, file.Nonce, plaintext, nil)
+
+	raw, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	err = writeFileAtomically(helper.Path, raw)
+	if err != nil {
+		delete(decryptedFiles.files, helper.Path)
+		return err
+	}
+
+	decrypted.raw = raw
+	decrypted.credentials = allCredentials
+	decryptedFiles.files[helper.Path] = decrypted
+	return nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if len(salt) != saltLength {
+		return nil, errors.New("invalid salt")
+	}
+
+	block, err := aes.NewCipher(pbkdf2.Key([]byte(passphrase), salt, keyDerivationRounds, keyLength, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), "temp-credentials")
+	if err != nil {
+		return err
+	}
+	_, err = tempFile.Write(data)
+	tempFile.Close()
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
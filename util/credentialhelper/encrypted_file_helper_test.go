@@ -0,0 +1,154 @@
+package credentialhelper_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+	"golang.org/x/crypto/pbkdf2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptedFileHelper", func() {
+	var (
+		dir         string
+		path        string
+		passphrase  string
+		helper      *EncryptedFileHelper
+		key         Key
+		credentials Credentials
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "encrypted-file-helper")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, EncryptedFileName)
+
+		passphrase = "some-passphrase"
+		helper = NewEncryptedFileHelper(path)
+		helper.Passphrase = func() string { return passphrase }
+
+		key = Key{ServerURL: "https://api.example.com"}
+		credentials = Credentials{
+			AccessToken:  "bearer some-access-token",
+			RefreshToken: "some-refresh-token",
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("returns stored credentials without writing them in plaintext", func() {
+		Expect(helper.Store(key, credentials)).To(Succeed())
+
+		raw, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(raw)).ToNot(ContainSubstring("some-access-token"))
+		Expect(string(raw)).ToNot(ContainSubstring("some-refresh-token"))
+
+		Expect(helper.Get(key)).To(Equal(credentials))
+	})
+
+	It("keeps the credentials of profiles separate", func() {
+		profileKey := Key{ServerURL: "https://api.example.com", Profile: "staging"}
+		profileCredentials := Credentials{AccessToken: "bearer staging-token"}
+
+		Expect(helper.Store(key, credentials)).To(Succeed())
+		Expect(helper.Store(profileKey, profileCredentials)).To(Succeed())
+
+		Expect(helper.Get(key)).To(Equal(credentials))
+		Expect(helper.Get(profileKey)).To(Equal(profileCredentials))
+	})
+
+	It("returns empty credentials when nothing is stored", func() {
+		Expect(helper.Get(key)).To(Equal(Credentials{}))
+	})
+
+	It("erases stored credentials", func() {
+		Expect(helper.Store(key, credentials)).To(Succeed())
+		Expect(helper.Erase(key)).To(Succeed())
+		Expect(helper.Get(key)).To(Equal(Credentials{}))
+	})
+
+	When("the passphrase is wrong", func() {
+		BeforeEach(func() {
+			Expect(helper.Store(key, credentials)).To(Succeed())
+			passphrase = "some-other-passphrase"
+		})
+
+		It("returns a DecryptionFailedError", func() {
+			_, err := helper.Get(key)
+			Expect(err).To(MatchError(DecryptionFailedError{Path: path}))
+		})
+	})
+
+	When("the file is changed by another process", func() {
+		It("reads the new credentials", func() {
+			Expect(helper.Store(key, credentials)).To(Succeed())
+			Expect(helper.Get(key)).To(Equal(credentials))
+
+			otherCredentials := Credentials{AccessToken: "bearer other-token"}
+			other := NewEncryptedFileHelper(filepath.Join(dir, "other"))
+			other.Passphrase = helper.Passphrase
+			Expect(other.Store(key, otherCredentials)).To(Succeed())
+			Expect(os.Rename(filepath.Join(dir, "other"), path)).To(Succeed())
+
+			Expect(helper.Get(key)).To(Equal(otherCredentials))
+		})
+	})
+
+	It("derives its key with PBKDF2-HMAC-SHA256", func() {
+		Expect(helper.Store(key, credentials)).To(Succeed())
+
+		raw, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		var file struct {
+			Salt       []byte `json:"salt"`
+			Nonce      []byte `json:"nonce"`
+			Ciphertext []byte `json:"ciphertext"`
+		}
+		Expect(json.Unmarshal(raw, &file)).To(Succeed())
+
+		block, err := aes.NewCipher(pbkdf2.Key([]byte(passphrase), file.Salt, 100000, 32, sha256.New))
+		Expect(err).ToNot(HaveOccurred())
+		gcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+		plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(ContainSubstring("some-access-token"))
+	})
+
+	// Test vectors from RFC 7914, section 11.
+	DescribeTable("PBKDF2-HMAC-SHA256",
+		func(password string, salt string, rounds int, expectedKey string) {
+			Expect(hex.EncodeToString(pbkdf2.Key([]byte(password), []byte(salt), rounds, 64, sha256.New))).To(Equal(expectedKey))
+		},
+		Entry("1 round", "passwd", "salt", 1,
+			"55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"),
+		Entry("80000 rounds", "Password", "NaCl", 80000,
+			"4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56a1d425a1225833549adb841b51c9b3176a272bdebba1d078478f62b397f33c8d"),
+	)
+
+	When("there is no passphrase", func() {
+		BeforeEach(func() {
+			passphrase = ""
+		})
+
+		It("returns a MissingPassphraseError", func() {
+			Expect(helper.Store(key, credentials)).To(MatchError(MissingPassphraseError{}))
+			_, err := helper.Get(key)
+			Expect(err).To(MatchError(MissingPassphraseError{}))
+		})
+	})
+})
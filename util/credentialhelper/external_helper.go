@@ -0,0 +1,85 @@
+package credentialhelper
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"strings"
+)
+
+// ExternalHelperError is returned when an external helper fails.
+type ExternalHelperError struct {
+	Path    string
+	Action  string
+	Message string
+}
+
+func (e ExternalHelperError) Error() string {
+	return "credential helper " + e.Path + " " + e.Action + ": " + e.Message
+}
+
+// ExternalHelper runs an executable that implements the credential helper
+// protocol.
+type ExternalHelper struct {
+	Path string
+}
+
+// NewExternalHelper returns an ExternalHelper that runs the executable at
+// path, which is looked up in PATH if it is not a path.
+func NewExternalHelper(path string) *ExternalHelper {
+	return &ExternalHelper{Path: path}
+}
+
+func (helper ExternalHelper) Get(key Key) (Credentials, error) {
+	output, err := helper.run("get", key)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	if len(bytes.TrimSpace(output)) == 0 {
+		return credentials, nil
+	}
+	err = json.Unmarshal(output, &credentials)
+	if err != nil {
+		return Credentials{}, ExternalHelperError{Path: helper.Path, Action: "get", Message: err.Error()}
+	}
+	return credentials, nil
+}
+
+func (helper ExternalHelper) Store(key Key, credentials Credentials) error {
+	_, err := helper.run("store", struct {
+		Key
+		Credentials
+	}{key, credentials})
+	return err
+}
+
+func (helper ExternalHelper) Erase(key Key) error {
+	_, err := helper.run("erase", key)
+	return err
+}
+
+func (helper ExternalHelper) run(action string, input interface{}) ([]byte, error) {
+	rawInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helper.Path, action)
+	cmd.Stdin = bytes.NewReader(rawInput)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, ExternalHelperError{Path: helper.Path, Action: action, Message: message}
+	}
+
+	return stdout.Bytes(), nil
+}
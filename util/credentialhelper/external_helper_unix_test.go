@@ -0,0 +1,101 @@
+// +build !windows
+
+package credentialhelper_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/credentialhelper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExternalHelper", func() {
+	var (
+		dir    string
+		helper Helper
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "external-helper")
+		Expect(err).ToNot(HaveOccurred())
+
+		// The helper records its action and input, and answers get with a
+		// fixed set of credentials.
+		script := `#!/bin/sh
+input=$(cat)
+echo "$input" > "$(dirname "$0")/$1.json"
+case "$input" in
+  *broken*) echo "helper exploded" >&2; exit 1 ;;
+esac
+if [ "$1" = get ]; then
+  echo '{"AccessToken":"bearer some-token","RefreshToken":"some-refresh-token"}'
+fi
+`
+		helperPath := filepath.Join(dir, ExecutablePrefix+"test")
+		Expect(ioutil.WriteFile(helperPath, []byte(script), 0700)).To(Succeed())
+
+		helper = New(helperPath, dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	readInput := func(action string) string {
+		raw, err := ioutil.ReadFile(filepath.Join(dir, action+".json"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(raw)
+	}
+
+	It("gets credentials from the helper's stdout", func() {
+		credentials, err := helper.Get(Key{ServerURL: "https://api.example.com", Profile: "staging"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(Equal(Credentials{AccessToken: "bearer some-token", RefreshToken: "some-refresh-token"}))
+		Expect(readInput("get")).To(MatchJSON(`{"ServerURL":"https://api.example.com","Profile":"staging"}`))
+	})
+
+	It("sends the key and credentials to store", func() {
+		Expect(helper.Store(Key{ServerURL: "https://api.example.com"}, Credentials{AccessToken: "bearer some-token"})).To(Succeed())
+		Expect(readInput("store")).To(MatchJSON(`{"ServerURL":"https://api.example.com","AccessToken":"bearer some-token"}`))
+	})
+
+	It("sends the key to erase", func() {
+		Expect(helper.Erase(Key{ServerURL: "https://api.example.com"})).To(Succeed())
+		Expect(readInput("erase")).To(MatchJSON(`{"ServerURL":"https://api.example.com"}`))
+	})
+
+	When("the helper fails", func() {
+		It("returns its stderr as the error", func() {
+			_, err := helper.Get(Key{ServerURL: "https://broken.example.com"})
+			Expect(err).To(MatchError(ExternalHelperError{
+				Path:    filepath.Join(dir, ExecutablePrefix+"test"),
+				Action:  "get",
+				Message: "helper exploded",
+			}))
+		})
+	})
+
+	When("the helper does not exist", func() {
+		It("returns an ExternalHelperError", func() {
+			_, err := NewExternalHelper(filepath.Join(dir, "does-not-exist")).Get(Key{})
+			Expect(err).To(BeAssignableToTypeOf(ExternalHelperError{}))
+		})
+	})
+
+	Describe("New", func() {
+		It("prefixes helper names that are not paths", func() {
+			Expect(New("pass", dir)).To(Equal(NewExternalHelper("cf-credential-pass")))
+		})
+
+		It("returns the encrypted-file helper", func() {
+			fileHelper, ok := New(EncryptedFileHelperName, dir).(*EncryptedFileHelper)
+			Expect(ok).To(BeTrue())
+			Expect(fileHelper.Path).To(Equal(filepath.Join(dir, EncryptedFileName)))
+		})
+	})
+})
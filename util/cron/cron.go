@@ -0,0 +1,214 @@
+// Package cron parses cron style schedules and computes when they are next
+// due.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLookahead bounds the search for the next run of a schedule that can
+// never be due, such as "0 0 30 2 *".
+const maxLookahead = 5 * 366 * 24 * time.Hour
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: monthNames},
+	{name: "day of week", min: 0, max: 7, names: dayNames},
+}
+
+// Schedule is a parsed cron schedule.
+type Schedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+
+	// anyDayOfMonth and anyDayOfWeek record a "*" in the day fields. When
+	// both day fields are restricted a day matches if either of them does.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// Parse parses a schedule made of the five fields minute, hour, day of month,
+// month and day of week, or one of the macros @yearly, @annually, @monthly,
+// @weekly, @daily, @midnight and @hourly. Each field is "*", a value, a range
+// "a-b" or a comma separated list of them, optionally followed by a step
+// "/n". Months and days of week may be given as three letter names.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := macros[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return Schedule{}, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(parts))
+	}
+
+	var (
+		bits [5]uint64
+		any  [5]bool
+	)
+	for i, part := range parts {
+		var err error
+		bits[i], err = fields[i].parse(part)
+		if err != nil {
+			return Schedule{}, err
+		}
+		any[i] = part == "*"
+	}
+
+	// Sunday can be written as 0 or 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return Schedule{
+		minutes:       bits[0],
+		hours:         bits[1],
+		daysOfMonth:   bits[2],
+		months:        bits[3],
+		daysOfWeek:    bits[4],
+		anyDayOfMonth: any[2],
+		anyDayOfWeek:  any[4],
+	}, nil
+}
+
+// Next returns the first time after t at which the schedule is due, in the
+// location of t. It returns the zero time if the schedule is never due.
+func (schedule Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxLookahead)
+
+	for t.Before(limit) {
+		switch {
+		case !has(schedule.months, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !schedule.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(schedule.hours, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(schedule.minutes, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (schedule Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := has(schedule.daysOfMonth, t.Day())
+	dayOfWeek := has(schedule.daysOfWeek, int(t.Weekday()))
+
+	if schedule.anyDayOfMonth || schedule.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+func (f field) parse(spec string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(spec, ",") {
+		itemBits, err := f.parseItem(item)
+		if err != nil {
+			return 0, err
+		}
+		bits |= itemBits
+	}
+	return bits, nil
+}
+
+func (f field) parseItem(item string) (uint64, error) {
+	rangeSpec, step := item, 1
+	if i := strings.Index(item, "/"); i >= 0 {
+		var err error
+		rangeSpec = item[:i]
+		step, err = strconv.Atoi(item[i+1:])
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step in %s field: %q", f.name, item)
+		}
+	}
+
+	var first, last int
+	switch {
+	case rangeSpec == "*":
+		first, last = f.min, f.max
+	case strings.Contains(rangeSpec, "-"):
+		bounds := strings.SplitN(rangeSpec, "-", 2)
+		var err error
+		if first, err = f.value(bounds[0]); err != nil {
+			return 0, err
+		}
+		if last, err = f.value(bounds[1]); err != nil {
+			return 0, err
+		}
+		if first > last {
+			return 0, fmt.Errorf("invalid range in %s field: %q", f.name, item)
+		}
+	default:
+		var err error
+		if first, err = f.value(rangeSpec); err != nil {
+			return 0, err
+		}
+		last = first
+		if step > 1 {
+			last = f.max
+		}
+	}
+
+	var bits uint64
+	for value := first; value <= last; value += step {
+		bits |= 1 << uint(value)
+	}
+	return bits, nil
+}
+
+func (f field) value(spec string) (int, error) {
+	if value, ok := f.names[strings.ToLower(spec)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(spec)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("invalid value in %s field: %q", f.name, spec)
+	}
+	return value, nil
+}
+
+func has(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
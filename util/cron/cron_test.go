@@ -0,0 +1,50 @@
+package cron_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// 2019-03-13 is a Wednesday.
+	start := time.Date(2019, time.March, 13, 10, 30, 45, 0, time.UTC)
+
+	DescribeTable("Next",
+		func(spec string, expected time.Time) {
+			schedule, err := Parse(spec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(start)).To(Equal(expected))
+		},
+
+		Entry("every minute", "* * * * *", time.Date(2019, time.March, 13, 10, 31, 0, 0, time.UTC)),
+		Entry("a fixed minute", "15 * * * *", time.Date(2019, time.March, 13, 11, 15, 0, 0, time.UTC)),
+		Entry("a step", "*/20 * * * *", time.Date(2019, time.March, 13, 10, 40, 0, 0, time.UTC)),
+		Entry("a range with a step", "0 9-17/4 * * *", time.Date(2019, time.March, 13, 13, 0, 0, 0, time.UTC)),
+		Entry("a list", "0 8,22 * * *", time.Date(2019, time.March, 13, 22, 0, 0, 0, time.UTC)),
+		Entry("a day of week name", "0 3 * * fri", time.Date(2019, time.March, 15, 3, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 0 * * 7", time.Date(2019, time.March, 17, 0, 0, 0, 0, time.UTC)),
+		Entry("a month name", "0 0 1 jun *", time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 20 * mon", time.Date(2019, time.March, 18, 0, 0, 0, 0, time.UTC)),
+		Entry("a leap day", "0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("@daily", "@daily", time.Date(2019, time.March, 14, 0, 0, 0, 0, time.UTC)),
+		Entry("@hourly", "@hourly", time.Date(2019, time.March, 13, 11, 0, 0, 0, time.UTC)),
+		Entry("a schedule that is never due", "0 0 30 2 *", time.Time{}),
+	)
+
+	DescribeTable("Parse errors",
+		func(spec string, message string) {
+			_, err := Parse(spec)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+
+		Entry("too few fields", "* * * *", "expected 5 fields"),
+		Entry("a value out of range", "60 * * * *", `invalid value in minute field: "60"`),
+		Entry("an unknown name", "0 0 * foo *", `invalid value in month field: "foo"`),
+		Entry("a reversed range", "0 5-1 * * *", `invalid range in hour field: "5-1"`),
+		Entry("an invalid step", "*/0 * * * *", `invalid step in minute field: "*/0"`),
+	)
+})
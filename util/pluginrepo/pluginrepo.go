@@ -0,0 +1,84 @@
+// Package pluginrepo serves a directory of plugin binaries as a plugin
+// repository that can be added with add-plugin-repo.
+package pluginrepo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+)
+
+// IndexPath is the path of the repository index, as requested by the plugin
+// repository client.
+const IndexPath = "/list"
+
+// NewHandler returns a handler that serves the repository index at IndexPath
+// and the files in dir at their paths relative to it, except hidden files and
+// directories such as .git. Binary URLs that are only a path, such as those
+// indexed without a base URL, are served relative to the host the index is
+// requested from.
+func NewHandler(dir string, repository plugin.PluginRepository) (http.Handler, error) {
+	_, err := json.Marshal(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(IndexPath, func(w http.ResponseWriter, r *http.Request) {
+		index, err := json.Marshal(withBaseURL(repository, requestBaseURL(r)))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(index)
+	})
+	files := http.FileServer(http.Dir(dir))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if isHidden(r.URL.Path) {
+			http.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+	return mux, nil
+}
+
+// isHidden returns true if any element of the URL path starts with a dot.
+func isHidden(urlPath string) bool {
+	for _, element := range strings.Split(urlPath, "/") {
+		if strings.HasPrefix(element, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// withBaseURL returns a copy of the repository in which the binary URLs that
+// are only a path are prefixed with baseURL.
+func withBaseURL(repository plugin.PluginRepository, baseURL string) plugin.PluginRepository {
+	plugins := make([]plugin.Plugin, len(repository.Plugins))
+	for i, repoPlugin := range repository.Plugins {
+		binaries := make([]plugin.PluginBinary, len(repoPlugin.Binaries))
+		for j, binary := range repoPlugin.Binaries {
+			if strings.HasPrefix(binary.URL, "/") {
+				binary.URL = baseURL + binary.URL
+			}
+			binaries[j] = binary
+		}
+		repoPlugin.Binaries = binaries
+		plugins[i] = repoPlugin
+	}
+	repository.Plugins = plugins
+	return repository
+}
@@ -0,0 +1,13 @@
+package pluginrepo_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPluginrepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin Repository Suite")
+}
@@ -0,0 +1,108 @@
+package pluginrepo_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/plugin"
+	. "code.cloudfoundry.org/cli/util/pluginrepo"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewHandler", func() {
+	var (
+		dir        string
+		repository plugin.PluginRepository
+		handler    http.Handler
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "plugin-repo")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.Mkdir(filepath.Join(dir, "some-plugin"), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "some-plugin", "some-binary"), []byte("some-contents"), 0600)).To(Succeed())
+
+		repository = plugin.PluginRepository{
+			Plugins: []plugin.Plugin{{
+				Name:     "some-plugin",
+				Version:  "1.2.3",
+				Binaries: []plugin.PluginBinary{{Platform: "linux64", URL: "http://some-url/some-plugin/some-binary", Checksum: "some-checksum"}},
+			}},
+		}
+
+		handler, err = NewHandler(dir, repository)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("serves the repository index", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/list", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var served plugin.PluginRepository
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &served)).To(Succeed())
+		Expect(served).To(Equal(repository))
+	})
+
+	When("the binary URLs are only paths", func() {
+		BeforeEach(func() {
+			repository.Plugins[0].Binaries[0].URL = "/some-plugin/some-binary"
+
+			var err error
+			handler, err = NewHandler(dir, repository)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("serves them relative to the requested host", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://plugins.example.com:8080/list", nil))
+
+			var served plugin.PluginRepository
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &served)).To(Succeed())
+			Expect(served.Plugins[0].Binaries[0].URL).To(Equal("http://plugins.example.com:8080/some-plugin/some-binary"))
+			Expect(repository.Plugins[0].Binaries[0].URL).To(Equal("/some-plugin/some-binary"))
+		})
+	})
+
+	It("serves the plugin binaries", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/some-plugin/some-binary", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(Equal("some-contents"))
+	})
+
+	It("returns 404 for files that do not exist", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/some-plugin/missing", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns 404 for hidden files and directories", func() {
+		Expect(os.Mkdir(filepath.Join(dir, ".git"), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, ".git", "config"), []byte("some-secret"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "some-plugin", ".netrc"), []byte("some-secret"), 0600)).To(Succeed())
+
+		for _, path := range []string{"/.git/config", "/.git/", "/some-plugin/.netrc"} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound), path)
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("some-secret"), path)
+		}
+	})
+})
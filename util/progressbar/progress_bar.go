@@ -34,6 +34,25 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 	return p.bar.NewProxyReader(reader)
 }
 
+// NewFileProgressBarWrapper displays a new progress bar labelled with the file
+// name. Unlike NewProgressBarWrapper it does not wait for Ready, so it can be
+// called for one file after another.
+func (p *ProgressBar) NewFileProgressBarWrapper(reader io.Reader, name string, sizeOfFile int64) io.Reader {
+	log.WithFields(log.Fields{"file_name": name, "file_size": sizeOfFile}).Debug("new file progress bar")
+
+	p.bar = pb.New64(sizeOfFile).SetUnits(pb.U_BYTES).Prefix(name + " ")
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar.NewProxyReader(reader)
+}
+
+// FinishFile completes the progress bar of the current file.
+func (p *ProgressBar) FinishFile() {
+	if p.bar != nil {
+		p.bar.Finish()
+	}
+}
+
 func (p *ProgressBar) Ready() {
 	p.ready <- true
 }
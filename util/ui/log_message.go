@@ -48,3 +48,25 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		fmt.Fprintf(ui.Out, "   %s\n", logLine)
 	}
 }
+
+type structuredLogMessage struct {
+	Timestamp      string `json:"timestamp" yaml:"timestamp"`
+	SourceType     string `json:"source_type" yaml:"source_type"`
+	SourceInstance string `json:"source_instance" yaml:"source_instance"`
+	Type           string `json:"type" yaml:"type"`
+	Message        string `json:"message" yaml:"message"`
+}
+
+// DisplayStructuredLogMessage outputs a given log message in the configured
+// output format: a single line JSON object per message, so that the output
+// can be streamed into tools like jq, or a separate YAML document per
+// message.
+func (ui *UI) DisplayStructuredLogMessage(message LogMessage) {
+	ui.displayStructuredEntry(ui.Out, structuredLogMessage{
+		Timestamp:      message.Timestamp().UTC().Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+}
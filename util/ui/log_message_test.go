@@ -115,4 +115,39 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 	})
+
+	Describe("DisplayStructuredLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\nwith two lines\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("prints out a single JSON object per message to STDOUT", func() {
+				ui.DisplayStructuredLogMessage(message)
+				Expect(out).To(Say(`^\{"timestamp":"2016-07-19T23:08:12Z","source_type":"APP/PROC/WEB","source_instance":"12","type":"ERR","message":"This is a \\"log\\" message\\nwith two lines"\}\n$`))
+			})
+		})
+
+		When("the output format is YAML", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputFormatYAML
+			})
+
+			It("prints out a YAML document per message to STDOUT", func() {
+				ui.DisplayStructuredLogMessage(message)
+				Expect(out).To(Say(`^---\ntimestamp: "2016-07-19T23:08:12Z"\nsource_type: APP/PROC/WEB\nsource_instance: "12"\ntype: ERR\nmessage: \|-\n  This is a "log" message\n  with two lines\n$`))
+			})
+		})
+	})
 })
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"encoding/json"
+	"io"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type structuredError struct {
+	Error string `json:"error" yaml:"error"`
+}
+
+type structuredWarnings struct {
+	Warnings []string `json:"warnings" yaml:"warnings"`
+}
+
+// DisplayStructuredOutput marshals data into the configured output format
+// (JSON or YAML) and outputs the resulting document to ui.Out.
+func (ui *UI) DisplayStructuredOutput(data interface{}) error {
+	raw, err := ui.marshalStructured(data, "  ")
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(raw)
+	return err
+}
+
+// DisplayStructuredEntry outputs data to ui.Out as a single line of JSON or
+// as a separate YAML document, so that streamed output can be piped into
+// tools like jq.
+func (ui *UI) DisplayStructuredEntry(data interface{}) error {
+	return ui.displayStructuredEntry(ui.Out, data)
+}
+
+// IsStructuredOutput returns true if display commands should render a JSON or
+// YAML document instead of tables.
+func (ui *UI) IsStructuredOutput() bool {
+	return ui.OutputFormat == configv3.OutputFormatJSON || ui.OutputFormat == configv3.OutputFormatYAML
+}
+
+// displayStructuredEntry writes a single structured entry to the given
+// writer. Entries are written one per line in JSON and as separate documents
+// in YAML.
+func (ui *UI) displayStructuredEntry(w io.Writer, entry interface{}) error {
+	raw, err := ui.marshalStructured(entry, "")
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	if ui.OutputFormat == configv3.OutputFormatYAML {
+		raw = append([]byte("---\n"), raw...)
+	}
+	_, err = w.Write(raw)
+	return err
+}
+
+// marshalStructured marshals data into YAML or JSON. JSON is indented with
+// jsonIndent, or kept on a single line when jsonIndent is empty.
+func (ui *UI) marshalStructured(data interface{}, jsonIndent string) ([]byte, error) {
+	switch ui.OutputFormat {
+	case configv3.OutputFormatYAML:
+		return yaml.Marshal(data)
+	default:
+		var (
+			raw []byte
+			err error
+		)
+		if jsonIndent == "" {
+			raw, err = json.Marshal(data)
+		} else {
+			raw, err = json.MarshalIndent(data, "", jsonIndent)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return append(raw, '\n'), nil
+	}
+}
@@ -0,0 +1,138 @@
+package ui_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured Output", func() {
+	type document struct {
+		Name  string   `json:"name" yaml:"name"`
+		Items []string `json:"items" yaml:"items"`
+	}
+
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+		errBuff    *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorEnabled)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		errBuff = NewBuffer()
+		ui.Err = errBuff
+	})
+
+	When("the output format is table", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatTable)
+		})
+
+		It("is not structured", func() {
+			Expect(ui.IsStructuredOutput()).To(BeFalse())
+		})
+	})
+
+	When("the output format is JSON", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		})
+
+		It("is structured", func() {
+			Expect(ui.IsStructuredOutput()).To(BeTrue())
+		})
+
+		Describe("DisplayStructuredOutput", func() {
+			It("displays the data as a JSON document to ui.Out", func() {
+				err := ui.DisplayStructuredOutput(document{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out.Contents()).To(MatchJSON(`{"name": "some-name", "items": ["a", "b"]}`))
+			})
+		})
+
+		Describe("DisplayStructuredEntry", func() {
+			It("displays the data as a single line of JSON to ui.Out", func() {
+				err := ui.DisplayStructuredEntry(document{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out).To(Say(`^{"name":"some-name","items":\["a","b"\]}` + "\n$"))
+			})
+		})
+
+		Describe("DisplayWarnings", func() {
+			It("displays the warnings as a single JSON entry to ui.Err", func() {
+				ui.DisplayWarnings([]string{"warning-1", "warning-2"})
+				Expect(errBuff).To(Say(`{"warnings":\["warning-1","warning-2"\]}` + "\n"))
+			})
+
+			It("does not display anything when there are no warnings", func() {
+				ui.DisplayWarnings(nil)
+				Expect(errBuff.Contents()).To(BeEmpty())
+			})
+		})
+
+		Describe("DisplayWarning", func() {
+			It("displays the warning as a JSON entry to ui.Err", func() {
+				ui.DisplayWarning("some-warning {{.Name}}", map[string]interface{}{"Name": "some-name"})
+				Expect(errBuff).To(Say(`{"warnings":\["some-warning some-name"\]}` + "\n"))
+			})
+		})
+
+		Describe("DisplayError", func() {
+			It("displays the error as a JSON entry to ui.Err and does not display FAILED", func() {
+				ui.DisplayError(errors.New("I am a BANANA!"))
+				Expect(errBuff).To(Say(`{"error":"I am a BANANA!"}` + "\n"))
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the output format is YAML", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+		})
+
+		It("is structured", func() {
+			Expect(ui.IsStructuredOutput()).To(BeTrue())
+		})
+
+		Describe("DisplayStructuredOutput", func() {
+			It("displays the data as a YAML document to ui.Out", func() {
+				err := ui.DisplayStructuredOutput(document{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out.Contents()).To(MatchYAML("name: some-name\nitems:\n- a\n- b\n"))
+			})
+		})
+
+		Describe("DisplayStructuredEntry", func() {
+			It("displays the data as a separate YAML document to ui.Out", func() {
+				err := ui.DisplayStructuredEntry(document{Name: "some-name", Items: []string{"a", "b"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out).To(Say("^---\nname: some-name\nitems:\n- a\n- b\n$"))
+			})
+		})
+
+		Describe("DisplayWarnings", func() {
+			It("displays the warnings as a YAML document to ui.Err", func() {
+				ui.DisplayWarnings([]string{"warning-1", "warning-2"})
+				Expect(errBuff).To(Say("---\nwarnings:\n- warning-1\n- warning-2\n"))
+			})
+		})
+	})
+})
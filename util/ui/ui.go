@@ -41,6 +41,11 @@ type UI struct {
 	IsTTY         bool
 	TerminalWidth int
 
+	// OutputFormat is the format in which display commands render their
+	// results. When set to JSON or YAML, warnings and errors are also written
+	// to ui.Err as structured entries.
+	OutputFormat configv3.OutputFormat
+
 	TimezoneLocation *time.Location
 }
 
@@ -65,6 +70,7 @@ func NewUI(config Config) (*UI, error) {
 		fileLock:         &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		OutputFormat:     config.OutputFormat(),
 		TimezoneLocation: location,
 	}, nil
 }
@@ -86,6 +92,7 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 		translate:        translationFunc,
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
+		OutputFormat:     configv3.OutputFormatTable,
 		TimezoneLocation: time.UTC,
 	}
 }
@@ -99,7 +106,8 @@ func (ui *UI) DisplayDeprecationWarning() {
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. It also outputs "FAILED" in bold red to ui.Out. When using
+// structured output, only a structured error entry is written to ui.Err.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
@@ -107,6 +115,12 @@ func (ui *UI) DisplayError(err error) {
 	} else {
 		errMsg = err.Error()
 	}
+
+	if ui.IsStructuredOutput() {
+		ui.displayStructuredEntry(ui.Err, structuredError{Error: errMsg})
+		return
+	}
+
 	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 
 	ui.terminalLock.Lock()
@@ -187,11 +201,28 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 // DisplayWarning translates the warning, substitutes in templateValues, and
 // outputs to ui.Err. Only the first map in templateValues is used.
 func (ui *UI) DisplayWarning(template string, templateValues ...map[string]interface{}) {
+	if ui.IsStructuredOutput() {
+		ui.displayStructuredEntry(ui.Err, structuredWarnings{Warnings: []string{ui.TranslateText(template, templateValues...)}})
+		return
+	}
+
 	fmt.Fprintf(ui.Err, "%s\n\n", ui.TranslateText(template, templateValues...))
 }
 
-// DisplayWarnings translates the warnings and outputs to ui.Err.
+// DisplayWarnings translates the warnings and outputs to ui.Err. When using
+// structured output, the warnings are written as a single structured entry.
 func (ui *UI) DisplayWarnings(warnings []string) {
+	if ui.IsStructuredOutput() {
+		if len(warnings) > 0 {
+			translated := make([]string, 0, len(warnings))
+			for _, warning := range warnings {
+				translated = append(translated, ui.TranslateText(warning))
+			}
+			ui.displayStructuredEntry(ui.Err, structuredWarnings{Warnings: translated})
+		}
+		return
+	}
+
 	for _, warning := range warnings {
 		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(warning))
 	}